@@ -0,0 +1,220 @@
+package lctime
+
+import (
+	"errors"
+	"time"
+)
+
+// Calendar identifies the calendar system of the date directives. The names
+// are those of CLDR and of the -u-ca- extension of BCP 47 language tags.
+type Calendar string
+
+// Supported calendars.
+const (
+	Gregorian    Calendar = "gregorian"
+	Persian      Calendar = "persian"       // Solar Hijri, as used in Iran
+	IslamicCivil Calendar = "islamic-civil" // tabular Hijri
+	Hebrew       Calendar = "hebrew"
+)
+
+// ErrNoCalendar is returned when a locale has no names for a calendar, or the
+// calendar is unknown.
+var ErrNoCalendar = errors.New("Calendar not supported by locale")
+
+// calendar converts between days and the dates of a calendar other than the
+// Gregorian. Days are counted from January 1, 1970, and months from 1.
+type calendar interface {
+	// date returns the date of a day.
+	date(days int) (year, month, day int)
+	// days returns the day of a date, or false if there's no such date.
+	days(year, month, day int) (int, bool)
+	// maxYearDay returns the number of days in the longest years.
+	maxYearDay() int
+}
+
+// calendars holds the conversions of the non-Gregorian calendars.
+var calendars = map[Calendar]calendar{
+	Persian:      persian{},
+	IslamicCivil: islamicCivil{},
+	Hebrew:       hebrew{},
+}
+
+// monthNamer is implemented by calendars whose years differ in their months,
+// to map them to the locale's month names.
+type monthNamer interface {
+	// monthName returns the index of a month in the month names.
+	monthName(year, month int) int
+	// month returns the month with the given name, or false if the year
+	// has no such month.
+	month(year, name int) (int, bool)
+}
+
+// Calendar returns the calendar used by the date directives.
+func (lc *localeData) Calendar() Calendar {
+	return lc.calendar
+}
+
+// WithCalendar returns a localizer for the same locale that formats and
+// parses dates in the given calendar, such as Gregorian for fa_IR, which uses
+// the Persian calendar by default. An empty Calendar selects the locale's
+// default. It returns ErrNoCalendar if the locale has no month names for the
+// calendar.
+func (lc *localeData) WithCalendar(c Calendar) (Localizer, error) {
+	l, err := lc.withCalendar(c)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// withCalendar returns the locale with the calendar's month names in place of
+// the Gregorian ones.
+func (lc *localeData) withCalendar(c Calendar) (*localeData, error) {
+	if c == "" {
+		c = lc.defaultCalendar()
+	}
+	if c == lc.calendar {
+		return lc, nil
+	}
+
+	l := &localeData{LocaleData: lc.data, data: lc.data, eras: lc.eras, calendar: c}
+	if c == Gregorian {
+		return l, nil
+	}
+
+	months := lc.data.calendarMonths(c)
+	if len(months) == 0 {
+		return nil, ErrNoCalendar
+	}
+	l.cal = calendars[c]
+	l.Months, l.ShortMonths = months, months
+	l.GenitiveMonths, l.ShortGenitiveMonths, l.NarrowMonths = nil, nil, nil
+	return l, nil
+}
+
+// defaultCalendar returns the calendar the locale uses by default.
+func (lc *localeData) defaultCalendar() Calendar {
+	if lc.data.Calendar != "" {
+		return Calendar(lc.data.Calendar)
+	}
+	return Gregorian
+}
+
+// calendarMonths returns the locale's month names for a non-Gregorian
+// calendar, or nil if it has none.
+func (d *LocaleData) calendarMonths(c Calendar) []string {
+	switch c {
+	case Persian:
+		return d.PersianMonths
+	case IslamicCivil:
+		return d.IslamicMonths
+	case Hebrew:
+		return d.HebrewMonths
+	}
+	return nil
+}
+
+// date returns the year, month and day of t in the locale's calendar.
+func (lc *localeData) date(t time.Time) (year, month, day int) {
+	if lc.cal == nil {
+		y, m, d := t.Date()
+		return y, int(m), d
+	}
+	return lc.cal.date(epochDays(t))
+}
+
+// month returns the month of t in the locale's calendar, starting with 1.
+func (lc *localeData) month(t time.Time) int {
+	_, month, _ := lc.date(t)
+	return month
+}
+
+// monthName returns the index of t's month in the locale's month names.
+func (lc *localeData) monthName(t time.Time) int {
+	year, month, _ := lc.date(t)
+	if n, ok := lc.cal.(monthNamer); ok {
+		return n.monthName(year, month)
+	}
+	return month - 1
+}
+
+// monthOf returns the month of a year with the given index in the locale's
+// month names, or false if the year has no such month.
+func (lc *localeData) monthOf(year, name int) (int, bool) {
+	if n, ok := lc.cal.(monthNamer); ok {
+		return n.month(year, name)
+	}
+	return name + 1, true
+}
+
+// yearDay returns the day of the year of t in the locale's calendar, starting
+// with 1.
+func (lc *localeData) yearDay(t time.Time) int {
+	if lc.cal == nil {
+		return t.YearDay()
+	}
+	days := epochDays(t)
+	year, _, _ := lc.cal.date(days)
+	first, _ := lc.cal.days(year, 1, 1)
+	return days - first + 1
+}
+
+// maxYearDay returns the largest day of the year in the locale's calendar, as
+// output by %j.
+func (lc *localeData) maxYearDay() int {
+	if lc.cal == nil {
+		return 366
+	}
+	return lc.cal.maxYearDay()
+}
+
+// maxWeek returns the largest week number of %U and %W in the locale's
+// calendar, for a year whose last day is the first of a week.
+func (lc *localeData) maxWeek() int {
+	return (lc.maxYearDay() + 6) / 7
+}
+
+// days returns the day of a date in the locale's calendar, or false if
+// there's no such date.
+func (lc *localeData) days(year, month, day int) (int, bool) {
+	if lc.cal == nil {
+		ok := month >= 1 && month <= 12 && day >= 1 &&
+			day <= daysIn(time.Month(month), year)
+		return gregorianDays(year, month, day), ok
+	}
+	return lc.cal.days(year, month, day)
+}
+
+// fullYear converts a two-digit year to a full one in the locale's calendar.
+// Like expandYear, it picks the year within the hundred years starting with
+// the one that contains January 1, 1969.
+func (lc *localeData) fullYear(yy int) int {
+	if lc.cal == nil {
+		return expandYear(yy)
+	}
+	first, _, _ := lc.cal.date(-365)
+	return first + ((yy-first)%100+100)%100
+}
+
+// epochDays returns the day of t's date, counted from January 1, 1970.
+func epochDays(t time.Time) int {
+	y, m, d := t.Date()
+	return gregorianDays(y, int(m), d)
+}
+
+// gregorianDays returns the day of a Gregorian date, counted from January 1,
+// 1970.
+func gregorianDays(year, month, day int) int {
+	t := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return int(t.Unix() / secondsPerDay)
+}
+
+// gregorianDate returns the Gregorian date of a day counted from January 1,
+// 1970.
+func gregorianDate(days int) (year, month, day int) {
+	y, m, d := time.Unix(int64(days)*secondsPerDay, 0).UTC().Date()
+	return y, int(m), d
+}
+
+// secondsPerDay is the length of a day without leap seconds, as in Unix time.
+const secondsPerDay = 24 * 60 * 60
@@ -0,0 +1,341 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCalendar(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   Calendar
+	}{
+		{"en_US", Gregorian},
+		{"de_DE", Gregorian},
+		{"fa_IR", Persian},
+		{"POSIX", Gregorian},
+	}
+
+	for _, test := range tests {
+		l, err := NewLocalizer(test.locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.Calendar(); got != test.want {
+			t.Errorf(gotWantKey, test.locale, got, test.want)
+		}
+	}
+}
+
+func TestWithCalendar(t *testing.T) {
+	fa, err := NewLocalizer("fa_IR")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	greg, err := fa.WithCalendar(Gregorian)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := greg.Calendar(); got != Gregorian {
+		t.Errorf(gotWant, got, Gregorian)
+	}
+	if got, want := greg.MonthNames(Wide)[11], "دسامبر"; got != want {
+		t.Errorf(gotWant, got, want)
+	}
+
+	back, err := greg.WithCalendar("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := back.Calendar(); got != Persian {
+		t.Errorf(gotWant, got, Persian)
+	}
+	if got, want := back.MonthNames(Wide)[0], "فروردین"; got != want {
+		t.Errorf(gotWant, got, want)
+	}
+
+	en, err := NewLocalizer("en_US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []Calendar{Persian, "julian"} {
+		if _, err := en.WithCalendar(c); err != ErrNoCalendar {
+			t.Errorf(gotWantKey, c, err, ErrNoCalendar)
+		}
+	}
+}
+
+func TestStrftimePersian(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+
+	fa, err := NewLocalizer("fa_IR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	greg, err := fa.WithCalendar(Gregorian)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		l     Localizer
+		input string
+		want  string
+	}{
+		{fa, "%x", "۹۴/۱۰/۰۴"},
+		{fa, "%Y-%m-%d %j", "1394-10-04 280"},
+		{fa, "%F %D %C %y", "1394-10-04 10/04/94 13 94"},
+		{fa, "%e %B %b %q", " 4 دی دی 4"},
+		{fa, "%U %W %G %V", "40 40 2015 52"},
+		{greg, "%x", "۱۵/۱۲/۲۵"},
+		{greg, "%Y-%m-%d %j %B", "2015-12-25 359 دسامبر"},
+	}
+
+	for i, test := range tests {
+		if got := test.l.Strftime(test.input, dt); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestStrptimePersian(t *testing.T) {
+	fa, err := NewLocalizer("fa_IR")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		format string
+		input  string
+		want   time.Time
+	}{
+		{"%Y/%m/%d", "1394/10/04", time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"%d %B %Y", "04 دی 1394", time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"%Y %j", "1394 280", time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"%y/%m/%d", "94/10/04", time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"%y/%m/%d", "47/10/11", time.Date(1969, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"%Y", "1403", time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{"%Y %U %w", "1394 40 5", time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"%Y %W %u", "1394 40 5", time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"%Y/%m/%d", "1403/12/30", time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{"%Y %V %u", "1394 52 5", time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"%Y %V %u", "1394 53 5", time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"%Y %EV %u", "1394 52 5", time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for i, test := range tests {
+		got, err := fa.Strptime(test.format, test.input)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+
+	for _, input := range []string{"1404/12/30", "1394/07/31"} {
+		if _, err := fa.Strptime("%Y/%m/%d", input); err == nil {
+			t.Errorf(gotWantKey, input, err, "*ParseError")
+		}
+	}
+	if _, err := fa.Strptime("%Y %j", "1404 366"); err == nil {
+		t.Errorf(gotWant, err, "*ParseError")
+	}
+	if _, err := fa.Strptime("%Y %U", "1394 53"); err == nil {
+		t.Errorf(gotWant, err, "*ParseError")
+	}
+
+	// The week directives stay Gregorian, and are looked up in the
+	// Gregorian years that the Persian one overlaps. A week can occur twice
+	// in a Persian year, so the parsed date need only format the same.
+	for _, format := range []string{"%Y %V %u", "%Y %EV %u", "%Y %U %w", "%Y %W %u"} {
+		dt := time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC)
+		for i := 0; i < 3*366; i++ {
+			s := fa.Strftime(format, dt.AddDate(0, 0, i))
+			got, err := fa.Strptime(format, s)
+			if err != nil || fa.Strftime(format, got) != s {
+				t.Fatalf(gotWantKey, format, got, s)
+			}
+		}
+	}
+}
+
+func TestIslamicCalendar(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+
+	for _, locale := range []string{"ar_SA", "ar_AE", "ar_QA"} {
+		l, err := NewLocalizer(locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.Calendar(); got != Gregorian {
+			t.Errorf(gotWantKey, locale, got, Gregorian)
+		}
+
+		hijri, err := l.WithCalendar(IslamicCivil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := hijri.Strftime("%Y-%m-%d %j %e %B %b", dt)
+		if want := "1437-03-13 072 13 ربيع الأول ربيع الأول"; got != want {
+			t.Errorf(gotWantKey, locale, got, want)
+		}
+
+		for _, format := range []string{"%c", "%x", "%d %B %Y", "%Y %j"} {
+			s := hijri.Strftime(format, dt)
+			got, err := hijri.Strptime(format, s)
+			if err != nil {
+				t.Errorf("%s %q: %v", locale, format, err)
+				continue
+			}
+			if got.Year() != dt.Year() || got.YearDay() != dt.YearDay() {
+				t.Errorf(gotWantKey, locale+" "+format, got, dt)
+			}
+		}
+	}
+
+	ar, err := NewLocalizer("ar_SA")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hijri, err := ar.WithCalendar(IslamicCivil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := hijri.Strftime("%A %e %B %Y", dt), "الجمعـة 13 ربيع الأول 1437"; got != want {
+		t.Errorf(gotWant, got, want)
+	}
+	if got, want := hijri.MonthNames(Wide)[8], "رمضان"; got != want {
+		t.Errorf(gotWant, got, want)
+	}
+	got, err := hijri.Strptime("%d %B %Y", "01 رمضان 1445")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf(gotWant, got, want)
+	}
+}
+
+func TestHebrewCalendar(t *testing.T) {
+	he, err := NewLocalizer("he_IL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := he.Calendar(); got != Gregorian {
+		t.Errorf(gotWant, got, Gregorian)
+	}
+	heb, err := he.WithCalendar(Hebrew)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input  time.Time
+		format string
+		want   string
+	}{
+		{time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC), "%Od ב%B %Oy", "כ״ה בכסלו תשפ״ו"},
+		{time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC), "%Y-%m-%d %j", "5786-03-25 084"},
+		{time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC), "%d %B %Y %m", "14 אדר ב׳ 5784 07"},
+		{time.Date(2024, 2, 23, 0, 0, 0, 0, time.UTC), "%d %B %Y %m", "14 אדר א׳ 5784 06"},
+		{time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), "%d %B %Y %m", "14 אדר 5785 06"},
+		{time.Date(2025, 4, 13, 0, 0, 0, 0, time.UTC), "%d %B %m", "15 ניסן 07"},
+		{time.Date(2024, 4, 23, 0, 0, 0, 0, time.UTC), "%d %B %m", "15 ניסן 08"},
+		{time.Date(2025, 9, 23, 0, 0, 0, 0, time.UTC), "%Oe %b", "א׳ תשרי"},
+	}
+
+	for i, test := range tests {
+		if got := heb.Strftime(test.format, test.input); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+
+	parse := []struct {
+		format string
+		input  string
+		want   time.Time
+	}{
+		{"%Od ב%B %Oy", "כ״ה בכסלו תשפ״ו", time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)},
+		{"%Od ב%B %Oy", `כ"ה בכסלו תשפ"ו`, time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)},
+		{"%d %B %Y", "14 אדר ב׳ 5784", time.Date(2024, 3, 24, 0, 0, 0, 0, time.UTC)},
+		{"%d %B %Y", "14 אדר 5785", time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)},
+		{"%Y/%m/%d", "5784/13/29", time.Date(2024, 10, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for i, test := range parse {
+		got, err := heb.Strptime(test.format, test.input)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+
+	for _, input := range []string{"14 אדר 5784", "14 אדר א׳ 5785", "30 אדר 5785"} {
+		if _, err := heb.Strptime("%d %B %Y", input); err == nil {
+			t.Errorf(gotWantKey, input, err, "*ParseError")
+		}
+	}
+}
+
+func TestStrptimeHebrewYearDay(t *testing.T) {
+	he, err := NewLocalizer("he_IL")
+	if err != nil {
+		t.Fatal(err)
+	}
+	heb, err := he.WithCalendar(Hebrew)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Leap years have up to 385 days, and %U and %W reach week 55.
+	formats := []string{"%Y %j", "%Y %U %w", "%Y %W %u"}
+	start := time.Date(1899, 1, 1, 12, 0, 0, 0, time.UTC)
+	for dt := start; dt.Year() < 2130; dt = dt.AddDate(0, 0, 1) {
+		if year, _, _ := (hebrew{}).date(epochDays(dt)); !hebrewLeap(year) {
+			continue
+		}
+		for _, format := range formats {
+			s := heb.Strftime(format, dt)
+			got, err := heb.Strptime(format, s)
+			if err != nil {
+				t.Fatalf("%q %q: %v", format, s, err)
+			}
+			if got.Year() != dt.Year() || got.YearDay() != dt.YearDay() {
+				t.Fatalf(gotWantKey, format+" "+s, got, dt)
+			}
+		}
+	}
+
+	if _, err := heb.Strptime("%Y %j", "5660 386"); err == nil {
+		t.Errorf(gotWant, err, "*ParseError")
+	}
+}
+
+func ExampleLocalizer_WithCalendar() {
+	dt := time.Date(2026, 3, 21, 12, 0, 0, 0, time.UTC)
+
+	fa, err := NewLocalizer("fa_IR")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(fa.Strftime("%Y-%m-%d", dt))
+
+	greg, err := fa.WithCalendar(Gregorian)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(greg.Strftime("%Y-%m-%d", dt))
+
+	// Output:
+	// 1405-01-01
+	// 2026-03-21
+}
@@ -0,0 +1,52 @@
+package lctime
+
+import (
+	"context"
+	"time"
+)
+
+// ctxKey is the context key for a locale identifier or a Localizer.
+type ctxKey struct{}
+
+// WithLocale returns a copy of ctx that carries the locale identifier. Use it
+// with StrftimeCtx to format times in a per-request locale without changing
+// the global one.
+func WithLocale(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// WithLocalizer returns a copy of ctx that carries the localizer, such as one
+// negotiated by MatchAcceptLanguage.
+func WithLocalizer(ctx context.Context, l Localizer) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the localizer carried by ctx, as set by WithLocalizer
+// or WithLocale. It returns false if ctx carries no locale, or an unknown
+// one.
+func FromContext(ctx context.Context) (Localizer, bool) {
+	switch v := ctx.Value(ctxKey{}).(type) {
+	case Localizer:
+		return v, true
+	case string:
+		l, err := loadLocale(v)
+		if err != nil {
+			return nil, false
+		}
+		return l, true
+	}
+	return nil, false
+}
+
+// StrftimeCtx formats a time.Time using the locale carried by ctx, or the
+// active locale if ctx has none. It returns ErrNoLocale if the locale in ctx
+// doesn't exist.
+func StrftimeCtx(ctx context.Context, format string, t time.Time) (string, error) {
+	switch v := ctx.Value(ctxKey{}).(type) {
+	case Localizer:
+		return v.Strftime(format, t), nil
+	case string:
+		return StrftimeLoc(v, format, t)
+	}
+	return current().Strftime(format, t), nil
+}
@@ -0,0 +1,100 @@
+package lctime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestStrftimeCtx(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+	SetLocale("en_US")
+
+	tests := []struct {
+		ctx  context.Context
+		want string
+		err  error
+	}{
+		{context.Background(), "Friday", nil},
+		{WithLocale(context.Background(), "es_MX"), "viernes", nil},
+		{WithLocale(context.Background(), "de_DE.UTF-8"), "Freitag", nil},
+		{WithLocale(WithLocale(context.Background(), "es_MX"), "da_DK"), "fredag", nil},
+		{WithLocale(context.Background(), "fake"), "", ErrNoLocale},
+	}
+
+	for i, test := range tests {
+		got, err := StrftimeCtx(test.ctx, "%A", dt)
+		if got != test.want || err != test.err {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+
+	if got := GetLocale(); got != "en_US" {
+		t.Errorf(gotWant, got, "en_US")
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+
+	tests := []struct {
+		ctx  context.Context
+		want string
+		ok   bool
+	}{
+		{context.Background(), "", false},
+		{WithLocale(context.Background(), "es_MX"), "viernes", true},
+		{WithLocale(context.Background(), "fake"), "", false},
+	}
+
+	for i, test := range tests {
+		l, ok := FromContext(test.ctx)
+		if ok != test.ok || !ok && l != nil {
+			t.Errorf(gotWantIdx, i, fmt.Sprint(l, ok), fmt.Sprint(test.ok))
+			continue
+		}
+		if ok && l.Strftime("%A", dt) != test.want {
+			t.Errorf(gotWantIdx, i, l.Strftime("%A", dt), test.want)
+		}
+	}
+}
+
+func TestSetLocaleConcurrent(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+	locales := []string{"en_US", "fake", "es_MX", "", "da_DK"}
+
+	var wg sync.WaitGroup
+	for _, locale := range locales {
+		wg.Add(2)
+		go func(locale string) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				SetLocale(locale)
+			}
+		}(locale)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if Strftime("%a", dt) == "" {
+					t.Error("empty day name")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func ExampleWithLocale() {
+	t := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+	ctx := WithLocale(context.Background(), "es_MX")
+
+	s, err := StrftimeCtx(ctx, "%A, %d de %B de %Y", t)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(s)
+	// Output: viernes, 25 de diciembre de 2015
+}
@@ -5,229 +5,455 @@
 package lctime
 
 import (
-	"fmt"
+	"strconv"
 	"time"
 )
 
-// pera returns the locale's abbreviated weekday name.
-func (lc *localeData) pera(t time.Time) string {
-	return lc.ShortDays[int(t.Weekday())]
+// pera appends the locale's abbreviated weekday name.
+func (lc *localeData) pera(b []byte, t time.Time) []byte {
+	return append(b, lc.ShortDays[int(t.Weekday())]...)
 }
 
-// perA returns the locale's full weekday name.
-func (lc *localeData) perA(t time.Time) string {
-	return lc.Days[int(t.Weekday())]
+// perA appends the locale's full weekday name.
+func (lc *localeData) perA(b []byte, t time.Time) []byte {
+	return append(b, lc.Days[int(t.Weekday())]...)
 }
 
-// perb returns the locale's abbreviated month name.
-func (lc *localeData) perb(t time.Time) string {
-	return lc.ShortMonths[int(t.Month())-1]
+// perEa appends the locale's narrow weekday name, such as "M" for Monday.
+func (lc *localeData) perEa(b []byte, t time.Time) []byte {
+	if len(lc.NarrowDays) == 0 {
+		return appendNarrow(b, lc.Days[int(t.Weekday())])
+	}
+	return append(b, lc.NarrowDays[int(t.Weekday())]...)
+}
+
+// perb appends the locale's abbreviated month name.
+func (lc *localeData) perb(b []byte, t time.Time) []byte {
+	return append(b, lc.ShortMonths[lc.monthName(t)]...)
 }
 
-// perB returns the locale's full month name.
-func (lc *localeData) perB(t time.Time) string {
-	return lc.Months[int(t.Month())-1]
+// perB appends the locale's full month name.
+func (lc *localeData) perB(b []byte, t time.Time) []byte {
+	return append(b, lc.Months[lc.monthName(t)]...)
 }
 
-// perc returns the locale's appropriate date and time representation.
-func (lc *localeData) perc(t time.Time) string {
-	return lc.Strftime(lc.DateTime, t)
+// perEb appends the locale's narrow month name, such as "J" for January.
+func (lc *localeData) perEb(b []byte, t time.Time) []byte {
+	if len(lc.NarrowMonths) == 0 {
+		return appendNarrow(b, lc.Months[lc.monthName(t)])
+	}
+	return append(b, lc.NarrowMonths[lc.monthName(t)]...)
 }
 
-// perC returns the year divided by 100 and truncated to an integer, as a
+// perGenitiveb appends the locale's abbreviated month name in the form used
+// after a day of the month, or the usual name if the locale has no such form.
+func (lc *localeData) perGenitiveb(b []byte, t time.Time) []byte {
+	if len(lc.ShortGenitiveMonths) == 0 {
+		return lc.perb(b, t)
+	}
+	return append(b, lc.ShortGenitiveMonths[lc.monthName(t)]...)
+}
+
+// perGenitiveB appends the locale's full month name in the form used after a
+// day of the month, such as "декабря" rather than "Декабрь" in Russian, or the
+// usual name if the locale has no such form.
+func (lc *localeData) perGenitiveB(b []byte, t time.Time) []byte {
+	if len(lc.GenitiveMonths) == 0 {
+		return lc.perB(b, t)
+	}
+	return append(b, lc.GenitiveMonths[lc.monthName(t)]...)
+}
+
+// perc appends the locale's appropriate date and time representation.
+func (lc *localeData) perc(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, lc.DateTime, t)
+}
+
+// perC appends the year divided by 100 and truncated to an integer, as a
 // decimal number.
-func (lc *localeData) perC(t time.Time) string {
-	return fmt.Sprint(t.Year() / 100)
+func (lc *localeData) perC(b []byte, t time.Time) []byte {
+	year, _, _ := lc.date(t)
+	return appendInt(b, year/100, 1, '0')
 }
 
-// perd returns the day of the month as a decimal number [01,31].
-func (lc *localeData) perd(t time.Time) string {
-	return fmt.Sprintf("%02d", t.Day())
+// perd appends the day of the month as a decimal number [01,31].
+func (lc *localeData) perd(b []byte, t time.Time) []byte {
+	_, _, day := lc.date(t)
+	return appendInt(b, day, 2, '0')
 }
 
-// perD returns the date formatted as %m/%d/%y.
-func (lc *localeData) perD(t time.Time) string {
-	return lc.Strftime("%m/%d/%y", t)
+// perD appends the date formatted as %m/%d/%y.
+func (lc *localeData) perD(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, "%m/%d/%y", t)
 }
 
-// pere returns the day of the month as a decimal number [1,31]; a single digit
+// pere appends the day of the month as a decimal number [1,31]; a single digit
 // is preceded by a space.
-func (lc *localeData) pere(t time.Time) string {
-	d := t.Day()
-	if d < 10 {
-		return fmt.Sprintf(" %d", d)
-	}
-	return fmt.Sprintf("%d", d)
+func (lc *localeData) pere(b []byte, t time.Time) []byte {
+	_, _, day := lc.date(t)
+	return appendInt(b, day, 2, ' ')
 }
 
-// perF returns the date formatted as %Y-%m-%d.
-func (lc *localeData) perF(t time.Time) string {
-	return lc.Strftime("%Y-%m-%d", t)
+// perF appends the date formatted as %Y-%m-%d.
+func (lc *localeData) perF(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, "%Y-%m-%d", t)
 }
 
-// perg returns the last 2 digits of the week-based year as a decimal number
+// perg appends the last 2 digits of the week-based year as a decimal number
 // [00,99].
-func (lc *localeData) perg(t time.Time) string {
+func (lc *localeData) perg(b []byte, t time.Time) []byte {
 	y, _ := t.ISOWeek()
-	return fmt.Sprintf("%02d", y%100)
+	return appendInt(b, y%100, 2, '0')
 }
 
-// perG returns the week-based year as a decimal number (for example, 1977).
-func (lc *localeData) perG(t time.Time) string {
+// perG appends the week-based year as a decimal number (for example, 1977).
+func (lc *localeData) perG(b []byte, t time.Time) []byte {
 	y, _ := t.ISOWeek()
-	return fmt.Sprintf("%d", y)
+	return appendInt(b, y, 1, '0')
 }
 
-// perH returns the hour (24-hour clock) as a decimal number [00,23].
-func (lc *localeData) perH(t time.Time) string {
-	return fmt.Sprintf("%02d", t.Hour())
+// perh appends the locale's abbreviated month name, like %b.
+func (lc *localeData) perh(b []byte, t time.Time) []byte {
+	return lc.perb(b, t)
 }
 
-// perI returns the hour (12-hour clock) as a decimal number [01,12].
-func (lc *localeData) perI(t time.Time) string {
+// perH appends the hour (24-hour clock) as a decimal number [00,23].
+func (lc *localeData) perH(b []byte, t time.Time) []byte {
+	return appendInt(b, t.Hour(), 2, '0')
+}
+
+// perI appends the hour (12-hour clock) as a decimal number [01,12].
+func (lc *localeData) perI(b []byte, t time.Time) []byte {
 	hr := t.Hour() % 12
 	if hr == 0 {
 		hr = 12
 	}
 
-	return fmt.Sprintf("%02d", hr)
+	return appendInt(b, hr, 2, '0')
 }
 
-// perj returns the day of the year as a decimal number [001,366].
-func (lc *localeData) perj(t time.Time) string {
-	return fmt.Sprintf("%03d", t.YearDay())
+// perk appends the hour (24-hour clock) as a decimal number [0,23]; a single
+// digit is preceded by a space.
+func (lc *localeData) perk(b []byte, t time.Time) []byte {
+	return appendInt(b, t.Hour(), 2, ' ')
 }
 
-// perm returns the month as a decimal number [01,12].
-func (lc *localeData) perm(t time.Time) string {
-	return fmt.Sprintf("%02d", t.Month())
+// perl appends the hour (12-hour clock) as a decimal number [1,12]; a single
+// digit is preceded by a space.
+func (lc *localeData) perl(b []byte, t time.Time) []byte {
+	hr := t.Hour() % 12
+	if hr == 0 {
+		hr = 12
+	}
+
+	return appendInt(b, hr, 2, ' ')
 }
 
-// perM returns the minute as a decimal number [00,59].
-func (lc *localeData) perM(t time.Time) string {
-	return fmt.Sprintf("%02d", t.Minute())
+// perj appends the day of the year as a decimal number [001,366].
+func (lc *localeData) perj(b []byte, t time.Time) []byte {
+	return appendInt(b, lc.yearDay(t), 3, '0')
 }
 
-// pern returns a newline.
-func (lc *localeData) pern(t time.Time) string {
-	return "\n"
+// perm appends the month as a decimal number [01,12].
+func (lc *localeData) perm(b []byte, t time.Time) []byte {
+	return appendInt(b, lc.month(t), 2, '0')
 }
 
-// perp returns the locale's equivalent of either a.m. or p.m.
-func (lc *localeData) perp(t time.Time) string {
+// perM appends the minute as a decimal number [00,59].
+func (lc *localeData) perM(b []byte, t time.Time) []byte {
+	return appendInt(b, t.Minute(), 2, '0')
+}
+
+// pern appends a newline.
+func (lc *localeData) pern(b []byte, t time.Time) []byte {
+	return append(b, '\n')
+}
+
+// perp appends the locale's equivalent of either a.m. or p.m.
+func (lc *localeData) perp(b []byte, t time.Time) []byte {
 	if t.Hour() < 12 {
-		return lc.AMPM[0]
+		return append(b, lc.LocaleData.AMPM[0]...)
 	}
-	return lc.AMPM[1]
+	return append(b, lc.LocaleData.AMPM[1]...)
+}
+
+// perP appends the locale's equivalent of either a.m. or p.m. in lower case.
+func (lc *localeData) perP(b []byte, t time.Time) []byte {
+	return toLower(lc.perp(b, t), len(b))
 }
 
-// perr returns the time in a.m. and p.m. notation.
-func (lc *localeData) perr(t time.Time) string {
-	return lc.Strftime(lc.TimeAMPM, t)
+// perq appends the quarter of the year as a decimal number [1,4].
+func (lc *localeData) perq(b []byte, t time.Time) []byte {
+	return appendInt(b, (lc.month(t)+2)/3, 1, '0')
 }
 
-// perR returns the time formatted as %H:%M.
-func (lc *localeData) perR(t time.Time) string {
-	return lc.Strftime("%H:%M", t)
+// perr appends the time in a.m. and p.m. notation.
+func (lc *localeData) perr(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, lc.TimeAMPM, t)
 }
 
-// perS returns the second as a decimal number [00,60].
-func (lc *localeData) perS(t time.Time) string {
-	return fmt.Sprintf("%02d", t.Second())
+// perR appends the time formatted as %H:%M.
+func (lc *localeData) perR(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, "%H:%M", t)
 }
 
-// pert returns a tab.
-func (lc *localeData) pert(t time.Time) string {
-	return "\t"
+// pers appends the number of seconds since the Unix epoch.
+func (lc *localeData) pers(b []byte, t time.Time) []byte {
+	return strconv.AppendInt(b, t.Unix(), 10)
 }
 
-// perT returns the time formatted as %H:%M:%S
-func (lc *localeData) perT(t time.Time) string {
-	return lc.Strftime("%H:%M:%S", t)
+// perS appends the second as a decimal number [00,60].
+func (lc *localeData) perS(b []byte, t time.Time) []byte {
+	return appendInt(b, t.Second(), 2, '0')
 }
 
-// peru returns the weekday as a decimal number [1,7], with 1 representing
+// pert appends a tab.
+func (lc *localeData) pert(b []byte, t time.Time) []byte {
+	return append(b, '\t')
+}
+
+// perT appends the time formatted as %H:%M:%S
+func (lc *localeData) perT(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, "%H:%M:%S", t)
+}
+
+// peru appends the weekday as a decimal number [1,7], with 1 representing
 // Monday.
-func (lc *localeData) peru(t time.Time) string {
+func (lc *localeData) peru(b []byte, t time.Time) []byte {
 	d := int(t.Weekday())
 	if d == 0 {
-		return "7"
+		d = 7
 	}
 
-	return fmt.Sprint(d)
+	return appendInt(b, d, 1, '0')
 }
 
-// perU returns the week number of the year as a decimal number [00,53]. The
+// perU appends the week number of the year as a decimal number [00,53]. The
 // first Sunday of January is the first day of week 1; days in the new year
 // before this are in week 0.
-func (lc *localeData) perU(t time.Time) string {
-	_, wn := t.ISOWeek()
-	return fmt.Sprintf("%02d", wn)
+func (lc *localeData) perU(b []byte, t time.Time) []byte {
+	return appendInt(b, (lc.yearDay(t)+6-int(t.Weekday()))/7, 2, '0')
 }
 
-// perV returns the week number of the year (Monday as the first day of the
+// perV appends the week number of the year (Monday as the first day of the
 // week) as a decimal number [01,53]. If the week containing 1 January has four
 // or more days in the new year, then it is considered week 1. Otherwise, it is
 // the last week of the previous year, and the next week is week 1. Both January
 // 4th and the first Thursday of January are always in week 1.
-func (lc *localeData) perV(t time.Time) string {
+func (lc *localeData) perV(b []byte, t time.Time) []byte {
 	_, wn := t.ISOWeek()
-	return fmt.Sprintf("%02d", wn)
+	return appendInt(b, wn, 2, '0')
 }
 
-// perw returns the weekday as a decimal number [0,6], with 0 representing
+// perEV appends the week number of the year by the locale's rules, as a
+// decimal number [01,53]. It's like %V but with the locale's first day of the
+// week and minimum number of days in the first week.
+func (lc *localeData) perEV(b []byte, t time.Time) []byte {
+	_, wn := lc.week(t)
+	return appendInt(b, wn, 2, '0')
+}
+
+// perw appends the weekday as a decimal number [0,6], with 0 representing
 // Sunday.
-func (lc *localeData) perw(t time.Time) string {
-	return fmt.Sprintf("%d", t.Weekday())
+func (lc *localeData) perw(b []byte, t time.Time) []byte {
+	return appendInt(b, int(t.Weekday()), 1, '0')
 }
 
-// perW returns the week number of the year as a decimal number [00,53]. The
+// perW appends the week number of the year as a decimal number [00,53]. The
 // first Monday of January is the first day of week 1; days in the new year
 // before this are in week 0.
-func (lc *localeData) perW(t time.Time) string {
-	_, wn := t.ISOWeek()
-	return fmt.Sprintf("%02d", wn-1)
+func (lc *localeData) perW(b []byte, t time.Time) []byte {
+	return appendInt(b, (lc.yearDay(t)+6-(int(t.Weekday())+6)%7)/7, 2, '0')
 }
 
-// perx returns the locale's appropriate date representation.
-func (lc *localeData) perx(t time.Time) string {
-	return lc.Strftime(lc.Date, t)
+// perx appends the locale's appropriate date representation.
+func (lc *localeData) perx(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, lc.Date, t)
 }
 
-// perX returns the locale's appropriate time representation.
-func (lc *localeData) perX(t time.Time) string {
-	return lc.Strftime(lc.Time, t)
+// perX appends the locale's appropriate time representation.
+func (lc *localeData) perX(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, lc.Time, t)
 }
 
-// pery returns the last two digits of the year as a decimal number [00,99].
-func (lc *localeData) pery(t time.Time) string {
-	return fmt.Sprintf("%02d", t.Year()%100)
+// pery appends the last two digits of the year as a decimal number [00,99].
+func (lc *localeData) pery(b []byte, t time.Time) []byte {
+	year, _, _ := lc.date(t)
+	return appendInt(b, year%100, 2, '0')
 }
 
-// perY returns the year as a decimal number (for example, 1997).
-func (lc *localeData) perY(t time.Time) string {
-	return fmt.Sprint(t.Year())
+// perY appends the year as a decimal number (for example, 1997).
+func (lc *localeData) perY(b []byte, t time.Time) []byte {
+	year, _, _ := lc.date(t)
+	return appendInt(b, year, 1, '0')
 }
 
-// perz returns the offset from UTC in the ISO 8601:2000 standard format ( +hhmm
+// perz appends the offset from UTC in the ISO 8601:2000 standard format ( +hhmm
 // or -hhmm ), or by no characters if no timezone is determinable. For example,
 // "-0430" means 4 hours 30 minutes behind UTC (west of Greenwich). If tm_isdst
 // is zero, the standard time offset is used. If tm_isdst is greater than zero,
 // the daylight savings time offset is used. If tm_isdst is negative, no
 // characters are returned.
-func (lc *localeData) perz(t time.Time) string {
-	_, off := t.Zone()
-	return fmt.Sprintf("%+05d", off)
+func (lc *localeData) perz(b []byte, t time.Time) []byte {
+	return appendOffset(b, t, 0)
+}
+
+// perColonz appends the offset from UTC as +hh:mm, as GNU's %:z does.
+func (lc *localeData) perColonz(b []byte, t time.Time) []byte {
+	return appendOffset(b, t, 1)
+}
+
+// perColonColonz appends the offset from UTC as +hh:mm:ss, as GNU's %::z
+// does.
+func (lc *localeData) perColonColonz(b []byte, t time.Time) []byte {
+	return appendOffset(b, t, 2)
+}
+
+// perColon3z appends the offset from UTC with a colon and only as much
+// precision as needed, as GNU's %:::z does. For example, "-04", "+05:30" or
+// "+05:45:30".
+func (lc *localeData) perColon3z(b []byte, t time.Time) []byte {
+	return appendOffset(b, t, 3)
 }
 
-// perZ returns the timezone name or abbreviation, or by no bytes if no timezone
+// perN appends the nanoseconds as a decimal number [000000000,999999999].
+func (lc *localeData) perN(b []byte, t time.Time) []byte {
+	return appendFraction(b, t, 9)
+}
+
+// perL appends the milliseconds as a decimal number [000,999], as Ruby's %L
+// does.
+func (lc *localeData) perL(b []byte, t time.Time) []byte {
+	return appendFraction(b, t, 3)
+}
+
+// perf appends the microseconds as a decimal number [000000,999999], as
+// Python's %f does.
+func (lc *localeData) perf(b []byte, t time.Time) []byte {
+	return appendFraction(b, t, 6)
+}
+
+// perZ appends the timezone name or abbreviation, or by no bytes if no timezone
 // information exists.
-func (lc *localeData) perZ(t time.Time) string {
+func (lc *localeData) perZ(b []byte, t time.Time) []byte {
 	tz, _ := t.Zone()
-	return tz
+	return append(b, tz...)
 }
 
-// perper returns a %.
-func (lc *localeData) perper(t time.Time) string {
-	return "%"
+// perplus appends the date and time in the format of date(1).
+func (lc *localeData) perplus(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, lc.dateFmt(), t)
+}
+
+// perper appends a %.
+func (lc *localeData) perper(b []byte, t time.Time) []byte {
+	return append(b, '%')
+}
+
+// perEc appends the locale's alternative date and time representation.
+func (lc *localeData) perEc(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, lc.eraFormat(lc.EraDateTime, lc.DateTime), t)
+}
+
+// perEC appends the name of the base year (period) in the locale's
+// alternative representation, or %C outside of any era.
+func (lc *localeData) perEC(b []byte, t time.Time) []byte {
+	if e := lc.findEra(t); e != nil {
+		return append(b, e.name...)
+	}
+	return lc.perC(b, t)
+}
+
+// perEx appends the locale's alternative date representation.
+func (lc *localeData) perEx(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, lc.eraFormat(lc.EraDate, lc.Date), t)
+}
+
+// perEX appends the locale's alternative time representation.
+func (lc *localeData) perEX(b []byte, t time.Time) []byte {
+	return lc.AppendStrftime(b, lc.eraFormat(lc.EraTime, lc.Time), t)
+}
+
+// perEy appends the offset from %EC (year only) in the locale's alternative
+// representation, or %y outside of any era.
+func (lc *localeData) perEy(b []byte, t time.Time) []byte {
+	if e := lc.findEra(t); e != nil {
+		return appendInt(b, e.year(t.Year()), 1, '0')
+	}
+	return lc.pery(b, t)
+}
+
+// perEY appends the full alternative year representation, or %Y outside of
+// any era.
+func (lc *localeData) perEY(b []byte, t time.Time) []byte {
+	if e := lc.findEra(t); e != nil {
+		return lc.AppendStrftime(b, e.format, t)
+	}
+	return lc.perY(b, t)
+}
+
+// appendOffset appends the zone offset of t in the form selected by the
+// number of colons in %z, %:z, %::z or %:::z. Seconds are dropped unless
+// they're shown.
+func appendOffset(b []byte, t time.Time, colons int) []byte {
+	_, off := t.Zone()
+	sign := byte('+')
+	if off < 0 {
+		sign, off = '-', -off
+	}
+	hh, mm, ss := off/3600, off/60%60, off%60
+
+	b = appendInt(append(b, sign), hh, 2, '0')
+	switch {
+	case colons == 0:
+		return appendInt(b, mm, 2, '0')
+	case colons == 3 && mm == 0 && ss == 0:
+		return b
+	}
+
+	b = appendInt(append(b, ':'), mm, 2, '0')
+	if colons == 2 || colons == 3 && ss != 0 {
+		b = appendInt(append(b, ':'), ss, 2, '0')
+	}
+	return b
+}
+
+// appendFraction appends the first digits of the fractional second. Like GNU
+// date, the digits are truncated rather than rounded, and more than nine
+// digits are the nanoseconds padded on the left with zeros.
+func appendFraction(b []byte, t time.Time, digits int) []byte {
+	ns := t.Nanosecond()
+	if digits > 9 {
+		return appendInt(b, ns, digits, '0')
+	}
+	for i, div := 0, 100000000; i < digits; i++ {
+		b = append(b, byte('0'+ns/div%10))
+		div /= 10
+	}
+	return b
+}
+
+// appendInt appends n as a decimal number, padded on the left with pad to at
+// least width characters.
+func appendInt(b []byte, n, width int, pad byte) []byte {
+	if n < 0 {
+		b = append(b, '-')
+		n = -n
+		width--
+	}
+
+	var buf [20]byte
+	i := len(buf)
+	for n >= 10 {
+		i--
+		buf[i] = byte('0' + n%10)
+		n /= 10
+	}
+	i--
+	buf[i] = byte('0' + n)
+
+	for w := len(buf) - i; w < width; w++ {
+		b = append(b, pad)
+	}
+	return append(b, buf[i:]...)
 }
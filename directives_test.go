@@ -1,6 +1,8 @@
 package lctime
 
 import (
+	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -36,7 +38,7 @@ func TestPera(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.pera(test.input); got != test.want {
+		if got := string(current().pera(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -73,7 +75,7 @@ func TestPerA(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.perA(test.input); got != test.want {
+		if got := string(current().perA(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -110,7 +112,7 @@ func TestPerb(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.perb(test.input); got != test.want {
+		if got := string(current().perb(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -147,7 +149,7 @@ func TestPerB(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.perB(test.input); got != test.want {
+		if got := string(current().perB(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -184,7 +186,7 @@ func TestPerc(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.perc(test.input); got != test.want {
+		if got := string(current().perc(nil, test.input)); got != test.want {
 			t.Error("locale:", test.locale)
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
@@ -200,7 +202,7 @@ func TestPerC(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perC(test.input); got != test.want {
+		if got := string(current().perC(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -215,7 +217,7 @@ func TestPerd(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perd(test.input); got != test.want {
+		if got := string(current().perd(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -230,7 +232,7 @@ func TestPerD(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perD(test.input); got != test.want {
+		if got := string(current().perD(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -246,7 +248,7 @@ func TestPere(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.pere(test.input); got != test.want {
+		if got := string(current().pere(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -261,7 +263,7 @@ func TestPerF(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perF(test.input); got != test.want {
+		if got := string(current().perF(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -277,7 +279,7 @@ func TestPerg(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perg(test.input); got != test.want {
+		if got := string(current().perg(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -293,7 +295,30 @@ func TestPerG(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perG(test.input); got != test.want {
+		if got := string(current().perG(nil, test.input)); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestPerh(t *testing.T) {
+	tests := []struct {
+		input  time.Time
+		locale string
+		want   string
+	}{
+		{time.Date(1962, 9, 25, 18, 45, 19, 195633, time.UTC),
+			"en_US", "Sep"},
+		{time.Date(1988, 12, 1, 17, 51, 22, 853401, time.UTC),
+			"ru_RU", "дек."},
+		{time.Date(2024, 9, 20, 23, 33, 13, 961479, time.UTC),
+			"fr_FR", "sept."},
+	}
+
+	for i, test := range tests {
+		SetLocale(test.locale)
+
+		if got := string(current().perh(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -308,7 +333,7 @@ func TestPerH(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perH(test.input); got != test.want {
+		if got := string(current().perH(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -324,7 +349,41 @@ func TestPerI(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perI(test.input); got != test.want {
+		if got := string(current().perI(nil, test.input)); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestPerk(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  string
+	}{
+		{time.Date(1846, 9, 4, 0, 16, 5, 108059, time.UTC), " 0"},
+		{time.Date(1846, 9, 4, 8, 16, 5, 108059, time.UTC), " 8"},
+		{time.Date(2045, 11, 23, 22, 14, 34, 971351, time.UTC), "22"},
+	}
+
+	for i, test := range tests {
+		if got := string(current().perk(nil, test.input)); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestPerl(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  string
+	}{
+		{time.Date(1846, 9, 4, 0, 16, 5, 108059, time.UTC), "12"},
+		{time.Date(1846, 9, 4, 8, 16, 5, 108059, time.UTC), " 8"},
+		{time.Date(2045, 11, 23, 22, 14, 34, 971351, time.UTC), "10"},
+	}
+
+	for i, test := range tests {
+		if got := string(current().perl(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -339,7 +398,7 @@ func TestPerj(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perj(test.input); got != test.want {
+		if got := string(current().perj(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -354,7 +413,7 @@ func TestPerm(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perm(test.input); got != test.want {
+		if got := string(current().perm(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -369,7 +428,7 @@ func TestPerM(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perM(test.input); got != test.want {
+		if got := string(current().perM(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -384,7 +443,7 @@ func TestPern(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.pern(test.input); got != test.want {
+		if got := string(current().pern(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -421,13 +480,60 @@ func TestPerp(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.perp(test.input); got != test.want {
+		if got := string(current().perp(nil, test.input)); got != test.want {
+			t.Error("locale:", test.locale)
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestPerP(t *testing.T) {
+	tests := []struct {
+		input  time.Time
+		locale string
+		want   string
+	}{
+		{time.Date(1899, 2, 25, 20, 48, 58, 389229, time.UTC),
+			"en_US", "pm"},
+		{time.Date(1866, 6, 24, 7, 46, 55, 436140, time.UTC),
+			"en_GB", "am"},
+		{time.Date(1965, 10, 13, 8, 31, 35, 101666, time.UTC),
+			"de_DE", ""},
+		{time.Date(1849, 11, 23, 15, 11, 46, 889860, time.UTC),
+			"zh_CN", "下午"},
+		{time.Date(1988, 12, 1, 17, 51, 22, 853401, time.UTC),
+			"el_GR", "μμ"},
+	}
+
+	for i, test := range tests {
+		SetLocale(test.locale)
+
+		if got := string(current().perP(nil, test.input)); got != test.want {
 			t.Error("locale:", test.locale)
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
 }
 
+func TestPerq(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  string
+	}{
+		{time.Date(1846, 1, 1, 0, 16, 5, 108059, time.UTC), "1"},
+		{time.Date(1846, 3, 31, 8, 16, 5, 108059, time.UTC), "1"},
+		{time.Date(2045, 4, 1, 22, 14, 34, 971351, time.UTC), "2"},
+		{time.Date(2045, 9, 30, 22, 14, 34, 971351, time.UTC), "3"},
+		{time.Date(2045, 12, 31, 22, 14, 34, 971351, time.UTC), "4"},
+	}
+
+	for i, test := range tests {
+		if got := string(current().perq(nil, test.input)); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
 func TestPerr(t *testing.T) {
 	tests := []struct {
 		input  time.Time
@@ -459,7 +565,7 @@ func TestPerr(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.perr(test.input); got != test.want {
+		if got := string(current().perr(nil, test.input)); got != test.want {
 			t.Error("locale:", test.locale)
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
@@ -476,7 +582,25 @@ func TestPerR(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perR(test.input); got != test.want {
+		if got := string(current().perR(nil, test.input)); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestPers(t *testing.T) {
+	tests := []struct {
+		input time.Time
+		want  string
+	}{
+		{time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC), "0"},
+		{time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC), "1451012521"},
+		{time.Date(2015, 12, 25, 4, 2, 1, 0, time.FixedZone("CET", 3600)), "1451012521"},
+		{time.Date(1846, 9, 4, 22, 16, 5, 108059, time.UTC), "-3891721435"},
+	}
+
+	for i, test := range tests {
+		if got := string(current().pers(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -491,7 +615,7 @@ func TestPerS(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perS(test.input); got != test.want {
+		if got := string(current().perS(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -506,7 +630,7 @@ func TestPert(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.pert(test.input); got != test.want {
+		if got := string(current().pert(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -521,7 +645,7 @@ func TestPerT(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perT(test.input); got != test.want {
+		if got := string(current().perT(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -539,7 +663,7 @@ func TestPeru(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.peru(test.input); got != test.want {
+		if got := string(current().peru(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -556,7 +680,7 @@ func TestPerU(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perU(test.input); got != test.want {
+		if got := string(current().perU(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -573,7 +697,7 @@ func TestPerV(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perV(test.input); got != test.want {
+		if got := string(current().perV(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -588,7 +712,7 @@ func TestPerw(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perw(test.input); got != test.want {
+		if got := string(current().perw(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -606,12 +730,48 @@ func TestPerW(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perW(test.input); got != test.want {
+		if got := string(current().perW(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
 }
 
+// TestWeekNumbers checks %U and %W against glibc for every day of years
+// starting on each weekday.
+func TestWeekNumbers(t *testing.T) {
+	bys, err := os.ReadFile("testdata/weeks.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lc, err := loadLocale("POSIX")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range strings.Split(string(bys), "\n") {
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		dt, err := time.Parse("2006-01-02", line[:10])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := lc.Strftime("%F %U %W", dt); got != line {
+			t.Errorf(gotWant, got, line)
+		}
+
+		// Strptime must agree on where the weeks start.
+		for _, format := range []string{"%Y %U %w", "%Y %W %u"} {
+			got, err := lc.Strptime(format, lc.Strftime(format, dt))
+			if err != nil || !got.Equal(dt) {
+				t.Errorf(gotWantKey, format, got, dt)
+			}
+		}
+	}
+}
+
 func TestPerx(t *testing.T) {
 	tests := []struct {
 		input  time.Time
@@ -643,7 +803,7 @@ func TestPerx(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.perx(test.input); got != test.want {
+		if got := string(current().perx(nil, test.input)); got != test.want {
 			t.Error("locale:", test.locale)
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
@@ -681,7 +841,7 @@ func TestPerX(t *testing.T) {
 	for i, test := range tests {
 		SetLocale(test.locale)
 
-		if got := lc.perX(test.input); got != test.want {
+		if got := string(current().perX(nil, test.input)); got != test.want {
 			t.Error("locale:", test.locale)
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
@@ -698,7 +858,7 @@ func TestPery(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.pery(test.input); got != test.want {
+		if got := string(current().pery(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -713,7 +873,7 @@ func TestPerY(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perY(test.input); got != test.want {
+		if got := string(current().perY(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -725,10 +885,45 @@ func TestPerz(t *testing.T) {
 		want  string
 	}{
 		{time.Date(2065, 6, 19, 5, 29, 39, 858124, time.UTC), "+0000"},
+		{time.Date(2015, 12, 5, 3, 2, 1, 0, time.FixedZone("CET", 3600)), "+0100"},
+		{time.Date(2015, 12, 5, 3, 2, 1, 0, time.FixedZone("NST", -12600)), "-0330"},
+		{time.Date(2015, 12, 5, 3, 2, 1, 0, time.FixedZone("NPT", 20700)), "+0545"},
+		{time.Date(2015, 12, 5, 3, 2, 1, 0, time.FixedZone("", 50400)), "+1400"},
+		{time.Date(2015, 12, 5, 3, 2, 1, 0, time.FixedZone("", 20730)), "+0545"},
+		{time.Date(2015, 12, 5, 3, 2, 1, 0, time.FixedZone("", -30)), "-0000"},
 	}
 
 	for i, test := range tests {
-		if got := lc.perz(test.input); got != test.want {
+		if got := string(current().perz(nil, test.input)); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestPerzColons(t *testing.T) {
+	tests := []struct {
+		offset int
+		want   [3]string // %:z, %::z, %:::z
+	}{
+		{0, [3]string{"+00:00", "+00:00:00", "+00"}},
+		{3600, [3]string{"+01:00", "+01:00:00", "+01"}},
+		{-14400, [3]string{"-04:00", "-04:00:00", "-04"}},
+		{-12600, [3]string{"-03:30", "-03:30:00", "-03:30"}},
+		{19800, [3]string{"+05:30", "+05:30:00", "+05:30"}},
+		{20700, [3]string{"+05:45", "+05:45:00", "+05:45"}},
+		{20730, [3]string{"+05:45", "+05:45:30", "+05:45:30"}},
+		{-30, [3]string{"-00:00", "-00:00:30", "-00:00:30"}},
+	}
+
+	lc := current()
+	for i, test := range tests {
+		dt := time.Date(2015, 12, 5, 3, 2, 1, 0, time.FixedZone("", test.offset))
+		got := [3]string{
+			string(lc.perColonz(nil, dt)),
+			string(lc.perColonColonz(nil, dt)),
+			string(lc.perColon3z(nil, dt)),
+		}
+		if got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -743,7 +938,37 @@ func TestPerZ(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perZ(test.input); got != test.want {
+		if got := string(current().perZ(nil, test.input)); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestPerplus(t *testing.T) {
+	tests := []struct {
+		input  time.Time
+		locale string
+		want   string
+	}{
+		{time.Date(2020, 7, 7, 10, 0, 0, 0, time.UTC),
+			"POSIX", "Tue Jul  7 10:00:00 UTC 2020"},
+		{time.Date(2020, 7, 7, 10, 0, 0, 0, time.UTC),
+			"en_US", "Tue 07 Jul 2020 10:00:00 AM UTC"},
+		{time.Date(2020, 7, 7, 10, 0, 0, 0, time.UTC),
+			"de_DE", "Di 7. Jul 10:00:00 UTC 2020"},
+		{time.Date(2020, 7, 7, 10, 0, 0, 0, time.UTC),
+			"ja_JP", "2020年 7月  7日 火曜日 10:00:00 UTC"},
+		{time.Date(2020, 7, 7, 10, 0, 0, 0, time.UTC),
+			"zh_CN", "2020年 07月 07日 星期二 10:00:00 UTC"},
+		{time.Date(2020, 7, 7, 10, 0, 0, 0, time.UTC),
+			"da_DK", "tir jul  7 10:00:00 UTC 2020"},
+	}
+
+	for i, test := range tests {
+		SetLocale(test.locale)
+
+		if got := string(current().perplus(nil, test.input)); got != test.want {
+			t.Error("locale:", test.locale)
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -758,7 +983,7 @@ func TestPerper(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		if got := lc.perper(test.input); got != test.want {
+		if got := string(current().perper(nil, test.input)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
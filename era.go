@@ -0,0 +1,166 @@
+package lctime
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// era is a parsed entry of a locale's Era table. Entries use the glibc
+// LC_TIME syntax, direction:offset:start_date:end_date:era_name:era_format,
+// where dates are yyyy/mm/dd and end_date may also be -* or +* for the
+// beginning and end of time.
+type era struct {
+	dir    int    // 1 if years count up towards end_date, -1 if they count down
+	offset int    // era year of the start date
+	start  [3]int // year, month, day
+	end    [3]int
+	open   int // 1 for an end_date of +*, -1 for -*, 0 otherwise
+	name   string
+	format string
+}
+
+// parseEra parses an era entry.
+func parseEra(s string) (era, bool) {
+	f := strings.SplitN(s, ":", 6)
+	if len(f) != 6 {
+		return era{}, false
+	}
+
+	var e era
+	switch f[0] {
+	case "+":
+		e.dir = 1
+	case "-":
+		e.dir = -1
+	default:
+		return era{}, false
+	}
+
+	var err error
+	if e.offset, err = strconv.Atoi(f[1]); err != nil {
+		return era{}, false
+	}
+
+	var ok bool
+	if e.start, ok = parseEraDate(f[2]); !ok {
+		return era{}, false
+	}
+
+	switch f[3] {
+	case "+*":
+		e.open = 1
+	case "-*":
+		e.open = -1
+	default:
+		if e.end, ok = parseEraDate(f[3]); !ok {
+			return era{}, false
+		}
+	}
+
+	e.name, e.format = f[4], f[5]
+	return e, true
+}
+
+// parseEraDate parses a yyyy/mm/dd date. Like glibc, negative years count
+// back from 1 BC, so -1 is astronomical year 0.
+func parseEraDate(s string) ([3]int, bool) {
+	var d [3]int
+
+	f := strings.Split(s, "/")
+	if len(f) != 3 {
+		return d, false
+	}
+
+	for i := range f {
+		n, err := strconv.Atoi(f[i])
+		if err != nil {
+			return d, false
+		}
+		d[i] = n
+	}
+
+	if d[0] < 0 {
+		d[0]++
+	}
+	return d, true
+}
+
+// compareDate compares two year, month, day triples.
+func compareDate(a, b [3]int) int {
+	for i := range a {
+		if a[i] != b[i] {
+			if a[i] < b[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// contains reports whether the date falls within the era.
+func (e *era) contains(d [3]int) bool {
+	switch e.open {
+	case 1:
+		return compareDate(d, e.start) >= 0
+	case -1:
+		return compareDate(d, e.start) <= 0
+	}
+
+	lo, hi := e.start, e.end
+	if compareDate(lo, hi) > 0 {
+		lo, hi = hi, lo
+	}
+	return compareDate(d, lo) >= 0 && compareDate(d, hi) <= 0
+}
+
+// first returns the first day of a Gregorian year that falls within the era,
+// which is the era's start in the year it begins.
+func (e *era) first(year int) [3]int {
+	d, lo := [3]int{year, 1, 1}, e.start
+	switch {
+	case e.open == -1:
+		return d
+	case e.open == 0 && compareDate(e.end, e.start) < 0:
+		lo = e.end
+	}
+	if lo[0] == year && compareDate(d, lo) < 0 {
+		return lo
+	}
+	return d
+}
+
+// step returns 1 if the era's years count up with the Gregorian ones, and -1
+// if they count down. As POSIX specifies, the direction is relative to the
+// end date, so years of an era that ends before it starts, such as one for the
+// years BC, count up into the past with '+'.
+func (e *era) step() int {
+	if e.open == -1 || e.open == 0 && compareDate(e.end, e.start) < 0 {
+		return -e.dir
+	}
+	return e.dir
+}
+
+// year converts a Gregorian year to a year of the era.
+func (e *era) year(y int) int {
+	return e.offset + e.step()*(y-e.start[0])
+}
+
+// gregorian converts a year of the era to a Gregorian year.
+func (e *era) gregorian(y int) int {
+	return e.start[0] + e.step()*(y-e.offset)
+}
+
+// findEra returns the era containing t, or nil if there is none.
+func (lc *localeData) findEra(t time.Time) *era {
+	y, m, d := t.Date()
+	date := [3]int{y, int(m), d}
+
+	for i := range lc.eras {
+		if lc.eras[i].contains(date) {
+			return &lc.eras[i]
+		}
+	}
+	return nil
+}
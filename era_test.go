@@ -0,0 +1,167 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestParseEra(t *testing.T) {
+	tests := []struct {
+		input string
+		want  era
+		ok    bool
+	}{
+		{"+:1:-543/01/01:+*:พ.ศ.:%EC %Ey",
+			era{1, 1, [3]int{-542, 1, 1}, [3]int{}, 1, "พ.ศ.", "%EC %Ey"}, true},
+		{"+:2:1990/01/01:+*:Heisei:%EC%Eyy",
+			era{1, 2, [3]int{1990, 1, 1}, [3]int{}, 1, "Heisei", "%EC%Eyy"}, true},
+		{"-:1:-0001/12/31:-*:BC:%Ey %EC",
+			era{-1, 1, [3]int{0, 12, 31}, [3]int{}, -1, "BC", "%Ey %EC"}, true},
+		{"+:1:1989/01/08:2019/04/30:H:%EC%Ey",
+			era{1, 1, [3]int{1989, 1, 8}, [3]int{2019, 4, 30}, 0, "H", "%EC%Ey"}, true},
+		{"+:1:1989/01/08:2019/04/30:a:b:c",
+			era{1, 1, [3]int{1989, 1, 8}, [3]int{2019, 4, 30}, 0, "a", "b:c"}, true},
+		{"", era{}, false},
+		{"*:1:1989/01/08:+*:H:%EC", era{}, false},
+		{"+:x:1989/01/08:+*:H:%EC", era{}, false},
+		{"+:1:1989-01-08:+*:H:%EC", era{}, false},
+		{"+:1:1989/01/08:*:H:%EC", era{}, false},
+	}
+
+	for i, test := range tests {
+		got, ok := parseEra(test.input)
+		if ok != test.ok || got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestEraYear(t *testing.T) {
+	eras := []string{
+		"+:1:2019/05/01:+*:R:%EC%Ey",
+		"+:2:1990/01/01:2019/04/30:H:%EC%Ey",
+		"+:1:1989/01/08:1989/12/31:H:%EC1",
+		"+:1:-0001/12/31:-*:BC:%Ey %EC",
+	}
+
+	l := localeData{LocaleData: LocaleData{Era: eras}}
+	for _, s := range eras {
+		e, _ := parseEra(s)
+		l.eras = append(l.eras, e)
+	}
+
+	tests := []struct {
+		input time.Time
+		name  string
+		year  int
+	}{
+		{time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), "R", 2},
+		{time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), "R", 1},
+		{time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC), "H", 31},
+		{time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC), "H", 1},
+		{time.Date(-2, 6, 1, 0, 0, 0, 0, time.UTC), "BC", 3},
+		{time.Date(0, 6, 1, 0, 0, 0, 0, time.UTC), "BC", 1},
+		{time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC), "", 0},
+	}
+
+	for i, test := range tests {
+		name, year := "", 0
+		if e := l.findEra(test.input); e != nil {
+			name, year = e.name, e.year(test.input.Year())
+		}
+		if name != test.name || year != test.year {
+			t.Errorf(gotWantIdx, i, name, test.name)
+			t.Errorf(gotWantIdx, i, year, test.year)
+		}
+	}
+}
+
+func TestStrftimeEra(t *testing.T) {
+	tests := []struct {
+		locale string
+		input  time.Time
+		format string
+		want   string
+	}{
+		{"ja_JP", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "%EY", "令和8年"},
+		{"ja_JP", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "%Ex", "令和8年10月18日"},
+		{"ja_JP", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "%EC %Ey", "令和 8"},
+		{"ja_JP", time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC), "%EY", "令和元年"},
+		{"ja_JP", time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC), "%EY", "平成31年"},
+		{"ja_JP", time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC), "%EY", "平成元年"},
+		{"ja_JP", time.Date(1989, 1, 7, 0, 0, 0, 0, time.UTC), "%EY", "昭和64年"},
+		{"ja_JP", time.Date(1926, 12, 25, 0, 0, 0, 0, time.UTC), "%EY", "昭和元年"},
+		{"ja_JP", time.Date(1912, 7, 30, 0, 0, 0, 0, time.UTC), "%EY", "大正元年"},
+		{"ja_JP", time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC), "%EY", "明治33年"},
+		{"ja_JP", time.Date(1850, 1, 1, 0, 0, 0, 0, time.UTC), "%EY", "西暦1850年"},
+		{"ja_JP", time.Date(0, 6, 1, 0, 0, 0, 0, time.UTC), "%EY", "紀元前1年"},
+		{"ja_JP", time.Date(-1, 6, 1, 0, 0, 0, 0, time.UTC), "%EY", "紀元前2年"},
+		{"ja_JP", time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC), "%Ec",
+			"平成27年12月25日 03時02分01秒"},
+		{"th_TH", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "%Ey", "2569"},
+		{"th_TH", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "%EY", "พ.ศ. 2569"},
+		{"th_TH", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "%x", "18/10/2569"},
+		{"th_TH", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC), "%Ex", "18 ต.ค. 2569"},
+	}
+
+	for i, test := range tests {
+		got, err := StrftimeLoc(test.locale, test.format, test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestStrptimeEra(t *testing.T) {
+	tests := []struct {
+		locale string
+		format string
+		input  string
+		want   time.Time
+	}{
+		{"ja_JP", "%Ex", "令和8年10月18日", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%Ex", "令和元年05月01日", time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%Ex", "平成元年01月08日", time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%Ex", "平成31年04月30日", time.Date(2019, 4, 30, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%Ex", "明治6年01月01日", time.Date(1873, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%Ex", "紀元前2年01月01日", time.Date(-1, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%EC%Ey年", "昭和64年", time.Date(1989, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%EY", "令和元年", time.Date(2019, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%EY", "平成元年", time.Date(1989, 1, 8, 0, 0, 0, 0, time.UTC)},
+		{"ja_JP", "%EY", "平成31年", time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"th_TH", "%d/%m/%Ey", "18/10/2569", time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for i, test := range tests {
+		got, err := StrptimeLoc(test.locale, test.format, test.input)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+
+	// Dates outside of the named era.
+	for _, input := range []string{"令和元年01月01日", "平成31年05月01日", "平成元年01月07日"} {
+		if _, err := StrptimeLoc("ja_JP", "%Ex", input); err == nil {
+			t.Errorf("%s: no error", input)
+		}
+	}
+}
+
+func ExampleStrftimeLoc_era() {
+	dt := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	s, err := StrftimeLoc("ja_JP", "%EY%m月%d日", dt)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(s)
+	// Output: 令和8年10月18日
+}
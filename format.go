@@ -0,0 +1,128 @@
+package lctime
+
+import (
+	"io"
+	"time"
+)
+
+// Format is a strftime format compiled for a single locale. Locale formats
+// such as %c are expanded once at compile time, so formatting only walks a
+// flat list of literals and fields. A Format is safe for concurrent use.
+type Format struct {
+	lc  *localeData
+	ops []op
+}
+
+// op is either a literal string or a single directive.
+type op struct {
+	lit   string
+	direc directive
+}
+
+// Compile compiles a format for the active locale. Later calls to SetLocale
+// don't affect the returned Format.
+func Compile(format string) (*Format, error) {
+	return current().Compile(format)
+}
+
+// Compile compiles a format for the locale. It returns ErrRecursiveFormat if
+// one of the locale's formats refers to itself.
+func (lc *localeData) Compile(format string) (*Format, error) {
+	f := &Format{lc: lc}
+	if !f.compile(format, 0) {
+		return nil, ErrRecursiveFormat
+	}
+	return f, nil
+}
+
+// compile appends the ops for format, expanding locale formats in place.
+func (f *Format) compile(format string, depth int) bool {
+	if depth > maxDepth {
+		return false
+	}
+
+	end := len(format)
+	for i := 0; i < end; i++ {
+		d, n := directive{}, 0
+		if format[i] == '%' {
+			d, n = parseDirective(format[i:])
+		}
+		if n == 0 {
+			f.literal(format[i : i+1])
+			continue
+		}
+		i += n - 1
+
+		if sub, ok := f.lc.composite(d); ok {
+			if d.plain() {
+				if !f.compile(sub, depth+1) {
+					return false
+				}
+				continue
+			}
+
+			// Flags apply to the whole expansion, so it stays one op.
+			if !(&Format{lc: f.lc}).compile(sub, depth+1) {
+				return false
+			}
+		}
+
+		d.genitive = f.lc.genitive(d, format)
+		switch {
+		case !d.plain():
+			f.ops = append(f.ops, op{direc: d})
+		case d.conv == '%':
+			f.literal("%")
+		case d.conv == 'n':
+			f.literal("\n")
+		case d.conv == 't':
+			f.literal("\t")
+		default:
+			f.ops = append(f.ops, op{direc: d})
+		}
+	}
+
+	return true
+}
+
+// literal appends s, merging it with a preceding literal.
+func (f *Format) literal(s string) {
+	if n := len(f.ops); n > 0 && f.ops[n-1].direc.conv == 0 {
+		f.ops[n-1].lit += s
+		return
+	}
+	f.ops = append(f.ops, op{lit: s})
+}
+
+// Strftime formats a time.Time. The output is identical to calling Strftime
+// with the compiled format on the same locale.
+func (f *Format) Strftime(t time.Time) string {
+	buf := bufPool.Get().(*[]byte)
+	*buf = f.AppendStrftime((*buf)[:0], t)
+	s := string(*buf)
+	bufPool.Put(buf)
+	return s
+}
+
+// AppendStrftime appends the formatted time to dst and returns the extended
+// buffer.
+func (f *Format) AppendStrftime(dst []byte, t time.Time) []byte {
+	for _, o := range f.ops {
+		if o.direc.conv == 0 {
+			dst = append(dst, o.lit...)
+			continue
+		}
+		dst = f.lc.appendDirective(dst, o.direc, t)
+	}
+
+	return dst
+}
+
+// StrftimeTo writes the formatted time to w.
+func (f *Format) StrftimeTo(w io.Writer, t time.Time) (int, error) {
+	buf := bufPool.Get().(*[]byte)
+	*buf = f.AppendStrftime((*buf)[:0], t)
+	n, err := w.Write(*buf)
+	bufPool.Put(buf)
+	return n, err
+}
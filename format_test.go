@@ -0,0 +1,104 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCompile(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 15, 2, 1, 0, time.FixedZone("CET", 3600))
+	formats := []string{
+		"", "abc", "%", "%%", "100%", "%Q%", "%c", "%x %X", "%r", "%D %F %R %T",
+		"%a %A %b %B %C %d %e %g %G %H %I %j %m %M %n %p %S %t %u %U %V %w",
+		"%W %y %Y %z %Z", "%Ey %-e %Od",
+	}
+
+	for _, locale := range GetLocales() {
+		l, err := NewLocalizer(locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, format := range formats {
+			f, err := l.Compile(format)
+			if err != nil {
+				t.Errorf("%s %q: %v", locale, format, err)
+				continue
+			}
+
+			got, want := f.Strftime(dt), l.Strftime(format, dt)
+			if got != want {
+				t.Errorf(gotWantKey, locale+" "+format, got, want)
+			}
+		}
+	}
+}
+
+func TestCompileRecursive(t *testing.T) {
+	l := &localeData{LocaleData: LocaleData{DateTime: "%a %c"}}
+	if _, err := l.Compile("%c"); err != ErrRecursiveFormat {
+		t.Errorf(gotWant, err, ErrRecursiveFormat)
+	}
+}
+
+func TestCompileMerge(t *testing.T) {
+	l, err := loadLocale("en_US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := l.Compile("%D%%%n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := len(f.ops), 6; got != want {
+		t.Errorf(gotWant, got, want)
+	}
+}
+
+func ExampleCompile() {
+	SetLocale("en_US")
+	f, err := Compile("%A, %x")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	t := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+	fmt.Println(f.Strftime(t))
+	// Output: Friday, 12/25/2015
+}
+
+var benchResult string
+
+func BenchmarkStrftime(b *testing.B) {
+	l, err := loadLocale("en_US")
+	if err != nil {
+		b.Fatal(err)
+	}
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchResult = l.Strftime("%c", dt)
+	}
+}
+
+func BenchmarkFormat(b *testing.B) {
+	l, err := loadLocale("en_US")
+	if err != nil {
+		b.Fatal(err)
+	}
+	f, err := l.Compile("%c")
+	if err != nil {
+		b.Fatal(err)
+	}
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = f.AppendStrftime(buf[:0], dt)
+	}
+}
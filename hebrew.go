@@ -0,0 +1,239 @@
+package lctime
+
+import "unicode/utf8"
+
+// hebrew is the Hebrew lunisolar calendar. Years start with Tishrei, and
+// months are numbered from it, so a leap year's 13 months are Tishrei,
+// Heshvan, Kislev, Tevet, Shevat, Adar I, Adar II, Nisan, Iyar, Sivan, Tamuz,
+// Av and Elul, and a common year has a single Adar as its sixth month.
+type hebrew struct{}
+
+// hebrewEpoch is the day of 1 Tishrei AM 1, which is October 7, 3761 BC
+// (Julian).
+const hebrewEpoch = -1373427 - 719163
+
+// hebrewLeap reports whether a year has 13 months, as 7 of every 19 do.
+func hebrewLeap(year int) bool {
+	return floorMod(7*year+1, 19) < 7
+}
+
+// hebrewElapsed returns the number of days from the epoch to the molad of
+// Tishrei of a year, postponed by a day when it falls on a Sunday, Wednesday
+// or Friday.
+func hebrewElapsed(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if floorMod(3*(days+1), 7) < 3 {
+		days++
+	}
+	return days
+}
+
+// hebrewNewYear returns the day of 1 Tishrei of a year, after the
+// postponements that keep the lengths of the year within bounds.
+func hebrewNewYear(year int) int {
+	prev, cur, next := hebrewElapsed(year-1), hebrewElapsed(year), hebrewElapsed(year+1)
+	delay := 0
+	switch {
+	case next-cur == 356:
+		delay = 2
+	case cur-prev == 382:
+		delay = 1
+	}
+	return hebrewEpoch + cur + delay
+}
+
+// hebrewMonthLength returns the number of days in a month, or 0 if the year
+// has no such month.
+func hebrewMonthLength(year, month int) int {
+	months := 12
+	if hebrewLeap(year) {
+		months = 13
+	}
+	if month < 1 || month > months {
+		return 0
+	}
+
+	switch month {
+	case 2:
+		// Heshvan has 30 days in a complete year.
+		if (hebrewNewYear(year+1)-hebrewNewYear(year))%10 == 5 {
+			return 30
+		}
+		return 29
+	case 3:
+		// Kislev has 29 days in a deficient year.
+		if (hebrewNewYear(year+1)-hebrewNewYear(year))%10 == 3 {
+			return 29
+		}
+		return 30
+	case 1, 5:
+		return 30
+	case 4:
+		return 29
+	}
+
+	// Count the remaining months back from Elul, which has 29 days.
+	if (months-month)%2 == 0 {
+		return 29
+	}
+	return 30
+}
+
+func (hebrew) date(days int) (year, month, day int) {
+	year = int(int64(days-hebrewEpoch)*98496/35975351) + 1
+	for hebrewNewYear(year+1) <= days {
+		year++
+	}
+	for hebrewNewYear(year) > days {
+		year--
+	}
+
+	day = days - hebrewNewYear(year) + 1
+	for month = 1; day > hebrewMonthLength(year, month); month++ {
+		day -= hebrewMonthLength(year, month)
+	}
+	return year, month, day
+}
+
+// maxYearDay returns the length of a complete leap year.
+func (hebrew) maxYearDay() int {
+	return 385
+}
+
+func (hebrew) days(year, month, day int) (int, bool) {
+	ok := day >= 1 && day <= hebrewMonthLength(year, month)
+
+	days := hebrewNewYear(year) + day - 1
+	for m := 1; m < month; m++ {
+		days += hebrewMonthLength(year, m)
+	}
+	return days, ok
+}
+
+// monthName maps the months of a common year past Adar to the names of a
+// leap year, which are followed by the name of the common year's Adar.
+func (hebrew) monthName(year, month int) int {
+	switch {
+	case hebrewLeap(year), month < 6:
+		return month - 1
+	case month == 6:
+		return 13
+	}
+	return month
+}
+
+func (hebrew) month(year, name int) (int, bool) {
+	switch {
+	case hebrewLeap(year):
+		return name + 1, name < 13
+	case name < 5:
+		return name + 1, true
+	case name == 13:
+		return 6, true
+	}
+	return name, name > 6
+}
+
+// Hebrew numerals, with the letters for 1 to 9, 10 to 90 and 100 to 400.
+var (
+	hebrewOnes     = []rune("אבגדהוזחט")
+	hebrewTens     = []rune("יכלמנסעפצ")
+	hebrewHundreds = []rune("קרשת")
+)
+
+const (
+	geresh    = '׳' // follows a numeral of a single letter
+	gershayim = '״' // precedes the last letter of a longer numeral
+)
+
+// appendGematria appends n, which must be between 1 and 999, in Hebrew
+// numerals, as in "כ״ה" for 25. Like in dates, 15 and 16 are written ט״ו and
+// ט״ז rather than with the letters of God's name.
+func appendGematria(b []byte, n int) []byte {
+	var letters []rune
+	for h := n / 100; h > 0; h -= 4 {
+		if h >= 4 {
+			letters = append(letters, hebrewHundreds[3])
+			continue
+		}
+		letters = append(letters, hebrewHundreds[h-1])
+		break
+	}
+
+	switch n %= 100; n {
+	case 15, 16:
+		letters = append(letters, hebrewOnes[8], hebrewOnes[n-10])
+	default:
+		if n >= 10 {
+			letters = append(letters, hebrewTens[n/10-1])
+		}
+		if n%10 > 0 {
+			letters = append(letters, hebrewOnes[n%10-1])
+		}
+	}
+
+	if len(letters) == 1 {
+		letters = append(letters, geresh)
+	} else {
+		last := len(letters) - 1
+		letters = append(letters[:last], gershayim, letters[last])
+	}
+	return append(b, string(letters)...)
+}
+
+// parseGematria parses Hebrew numerals at the start of s. It returns the
+// value and the number of bytes used, which is 0 if s doesn't start with a
+// numeral. Final letter forms and ASCII quotes are accepted as well.
+func parseGematria(s string) (n, length int) {
+	letters := 0
+	for length < len(s) {
+		r, size := utf8.DecodeRuneInString(s[length:])
+		v := hebrewValue(r)
+		switch {
+		case v > 0:
+			n += v
+			letters++
+		case letters > 0 && (r == geresh || r == gershayim || r == '\'' || r == '"'):
+		default:
+			return n, length
+		}
+		length += size
+	}
+	return n, length
+}
+
+// hebrewValue returns the numeric value of a Hebrew letter, or 0 for other
+// characters.
+func hebrewValue(r rune) int {
+	for i, l := range hebrewOnes {
+		if r == l {
+			return i + 1
+		}
+	}
+	for i, l := range hebrewTens {
+		if r == l {
+			return (i + 1) * 10
+		}
+	}
+	for i, l := range hebrewHundreds {
+		if r == l {
+			return (i + 1) * 100
+		}
+	}
+
+	switch r {
+	case 'ך':
+		return 20
+	case 'ם':
+		return 40
+	case 'ן':
+		return 50
+	case 'ף':
+		return 80
+	case 'ץ':
+		return 90
+	}
+	return 0
+}
@@ -0,0 +1,153 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHebrewDate(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		gy, gm, gd       int
+	}{
+		{5730, 4, 23, 1970, 1, 1},
+		{5776, 4, 13, 2015, 12, 25},
+		{5784, 7, 14, 2024, 3, 24},  // Purim in Adar II
+		{5785, 1, 1, 2024, 10, 3},   // Rosh Hashanah
+		{5785, 6, 14, 2025, 3, 14},  // Purim in a common year
+		{5785, 7, 15, 2025, 4, 13},  // Passover
+		{5786, 1, 1, 2025, 9, 23},   // Rosh Hashanah
+		{5786, 3, 25, 2025, 12, 15}, // Hanukkah
+		{5787, 2, 7, 2026, 10, 18},
+	}
+
+	for _, test := range tests {
+		days := gregorianDays(test.gy, test.gm, test.gd)
+		year, month, day := hebrew{}.date(days)
+		if year != test.year || month != test.month || day != test.day {
+			t.Errorf(gotWantKey, fmt.Sprint(test.gy, "-", test.gm, "-", test.gd),
+				fmt.Sprint(year, "/", month, "/", day),
+				fmt.Sprint(test.year, "/", test.month, "/", test.day))
+		}
+
+		got, ok := hebrew{}.days(test.year, test.month, test.day)
+		if got != days || !ok {
+			t.Errorf(gotWantKey, fmt.Sprint(test.year, "/", test.month, "/", test.day),
+				got, days)
+		}
+	}
+}
+
+func TestHebrewDays(t *testing.T) {
+	// Every day from 1800 to 2200 follows the previous one.
+	first := gregorianDays(1800, 1, 1)
+	year, month, day := hebrew{}.date(first)
+	for days := first; days < gregorianDays(2200, 1, 1); days++ {
+		y, m, d := hebrew{}.date(days)
+		next := d == day+1 && m == month && y == year ||
+			d == 1 && m == month+1 && y == year ||
+			d == 1 && m == 1 && y == year+1
+		if days > first && !next {
+			t.Fatalf(gotWantKey, fmt.Sprint(days), fmt.Sprint(y, "/", m, "/", d),
+				fmt.Sprint(year, "/", month, "/", day, " + 1"))
+		}
+		if got, ok := (hebrew{}).days(y, m, d); got != days || !ok {
+			t.Fatalf(gotWantKey, fmt.Sprint(y, "/", m, "/", d), got, days)
+		}
+		year, month, day = y, m, d
+	}
+
+	// Years are deficient, regular or complete.
+	for y := 5500; y < 6000; y++ {
+		n := hebrewNewYear(y+1) - hebrewNewYear(y)
+		switch n {
+		case 353, 354, 355, 383, 384, 385:
+		default:
+			t.Errorf(gotWantKey, fmt.Sprint(y), n, "353-355 or 383-385")
+		}
+		if hebrewLeap(y) != (n > 355) {
+			t.Errorf(gotWantKey, fmt.Sprint(y), hebrewLeap(y), n > 355)
+		}
+	}
+
+	invalid := [][3]int{{5785, 13, 1}, {5784, 14, 1}, {5784, 0, 1}, {5785, 6, 30},
+		{5785, 1, 31}, {5785, 12, 30}}
+	for _, d := range invalid {
+		if _, ok := (hebrew{}).days(d[0], d[1], d[2]); ok {
+			t.Errorf(gotWantKey, fmt.Sprint(d), ok, false)
+		}
+	}
+}
+
+func TestHebrewMonthName(t *testing.T) {
+	for _, year := range []int{5784, 5785} {
+		months := 12
+		if hebrewLeap(year) {
+			months = 13
+		}
+		for month := 1; month <= months; month++ {
+			name := hebrew{}.monthName(year, month)
+			if got, ok := (hebrew{}).month(year, name); got != month || !ok {
+				t.Errorf(gotWantKey, fmt.Sprint(year, "/", month), got, month)
+			}
+		}
+	}
+
+	tests := []struct {
+		year, name int
+		ok         bool
+	}{
+		{5784, 5, true},
+		{5784, 13, false},
+		{5785, 5, false},
+		{5785, 6, false},
+		{5785, 13, true},
+	}
+	for _, test := range tests {
+		if _, ok := (hebrew{}).month(test.year, test.name); ok != test.ok {
+			t.Errorf(gotWantKey, fmt.Sprint(test.year, " ", test.name), ok, test.ok)
+		}
+	}
+}
+
+func TestGematria(t *testing.T) {
+	tests := []struct {
+		input int
+		want  string
+	}{
+		{1, "א׳"},
+		{10, "י׳"},
+		{11, "י״א"},
+		{15, "ט״ו"},
+		{16, "ט״ז"},
+		{25, "כ״ה"},
+		{30, "ל׳"},
+		{100, "ק׳"},
+		{500, "ת״ק"},
+		{786, "תשפ״ו"},
+		{800, "ת״ת"},
+		{915, "תתקט״ו"},
+	}
+
+	for _, test := range tests {
+		got := string(appendGematria(nil, test.input))
+		if got != test.want {
+			t.Errorf(gotWantKey, fmt.Sprint(test.input), got, test.want)
+		}
+
+		n, length := parseGematria(got + " x")
+		if n != test.input || length != len(got) {
+			t.Errorf(gotWantKey, got, fmt.Sprint(n, " ", length),
+				fmt.Sprint(test.input, " ", len(got)))
+		}
+	}
+
+	for _, input := range []string{`תשפ"ו`, "תשפו", "כ'"} {
+		if n, length := parseGematria(input); n == 0 || length != len(input) {
+			t.Errorf(gotWantKey, input, fmt.Sprint(n, " ", length), len(input))
+		}
+	}
+	if n, length := parseGematria("'א"); n != 0 || length != 0 {
+		t.Errorf(gotWant, fmt.Sprint(n, " ", length), "0 0")
+	}
+}
@@ -0,0 +1,75 @@
+package lctime
+
+import "time"
+
+// Direction is the order in which a calendar lays out consecutive days.
+type Direction int
+
+// Calendar directions, with the values of glibc's cal_direction.
+const (
+	LeftToRight Direction = 1
+	TopToBottom Direction = 2
+	RightToLeft Direction = 3
+)
+
+// Info describes a locale's calendar conventions, such as the day a calendar
+// widget should start its weeks on.
+type Info struct {
+	FirstWeekday       time.Weekday
+	FirstWorkday       time.Weekday
+	MinDaysInFirstWeek int
+	Direction          Direction
+}
+
+// LocaleInfo returns the calendar conventions of a locale.
+func LocaleInfo(id string) (Info, error) {
+	lc, err := loadLocale(id)
+	if err != nil {
+		return Info{}, err
+	}
+	return lc.Info(), nil
+}
+
+// Info returns the calendar conventions of the locale.
+func (lc *localeData) Info() Info {
+	info := Info{
+		FirstWeekday:       lc.FirstWeekday,
+		FirstWorkday:       lc.FirstWorkday,
+		MinDaysInFirstWeek: lc.MinDaysInFirstWeek,
+		Direction:          Direction(lc.CalendarDirection),
+	}
+	if info.Direction == 0 {
+		info.Direction = LeftToRight
+	}
+	return info
+}
+
+// week returns the year and week number of t by the locale's rules. A week
+// belongs to the year in which it has at least MinDaysInFirstWeek days, so
+// like the ISO 8601 week, it may be week 1 of the next year or the last week
+// of the previous one.
+func (lc *localeData) week(t time.Time) (year, week int) {
+	info := lc.Info()
+	offset := (int(t.Weekday()) - int(info.FirstWeekday) + 7) % 7
+
+	// The day that decides the year: the last one of the week that must
+	// fall in it. For ISO 8601 weeks, it's the Thursday.
+	y, m, d := t.Date()
+	t = time.Date(y, m, d-offset+7-info.MinDaysInFirstWeek, 0, 0, 0, 0, time.UTC)
+	return t.Year(), (t.YearDay()-1)/7 + 1
+}
+
+// weekStart returns the day of January that starts the given week of year,
+// by the locale's rules. It may be zero or negative for a week starting in
+// December.
+func (lc *localeData) weekStart(year, week int) int {
+	info := lc.Info()
+	jan1 := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC).Weekday()
+	offset := (int(jan1) - int(info.FirstWeekday) + 7) % 7
+
+	start := 1 - offset
+	if 7-offset < info.MinDaysInFirstWeek {
+		start += 7
+	}
+	return start + (week-1)*7
+}
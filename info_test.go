@@ -0,0 +1,192 @@
+package lctime
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestLocaleInfo(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   Info
+	}{
+		{"en_US", Info{time.Sunday, time.Monday, 1, LeftToRight}},
+		{"de_DE", Info{time.Monday, time.Monday, 4, LeftToRight}},
+		{"fr_FR", Info{time.Monday, time.Monday, 4, LeftToRight}},
+		{"pt_BR", Info{time.Sunday, time.Monday, 1, LeftToRight}},
+		{"ar_EG", Info{time.Saturday, time.Sunday, 1, RightToLeft}},
+		{"he_IL", Info{time.Sunday, time.Sunday, 1, RightToLeft}},
+		{"fa_IR", Info{time.Saturday, time.Saturday, 1, RightToLeft}},
+		{"POSIX", Info{time.Sunday, time.Monday, 4, LeftToRight}},
+	}
+
+	for _, test := range tests {
+		got, err := LocaleInfo(test.locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf(gotWantKey, test.locale, got, test.want)
+		}
+	}
+
+	if _, err := LocaleInfo("xx_XX"); err != ErrNoLocale {
+		t.Errorf(gotWant, err, ErrNoLocale)
+	}
+}
+
+func TestLocaleInfoDefaults(t *testing.T) {
+	en, err := loadLocale("en_US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		first, work time.Weekday
+		minDays     int
+		want        Info
+		err         error
+	}{
+		{time.Sunday, time.Sunday, 0, Info{time.Sunday, time.Monday, 1, LeftToRight}, nil},
+		{time.Saturday, time.Sunday, 1, Info{time.Saturday, time.Sunday, 1, LeftToRight}, nil},
+		{time.Sunday, time.Sunday, 4, Info{time.Sunday, time.Sunday, 4, LeftToRight}, nil},
+		{time.Monday, time.Monday, 7, Info{time.Monday, time.Monday, 7, LeftToRight}, nil},
+		{time.Saturday, time.Sunday, 0, Info{}, ErrCorruptLocale},
+		{time.Sunday, time.Monday, 0, Info{}, ErrCorruptLocale},
+		{time.Sunday, time.Sunday, -1, Info{}, ErrCorruptLocale},
+		{time.Monday, time.Monday, 8, Info{}, ErrCorruptLocale},
+	}
+
+	for i, test := range tests {
+		data := en.data
+		data.FirstWeekday, data.FirstWorkday = test.first, test.work
+		data.MinDaysInFirstWeek = test.minDays
+		data.CalendarDirection = 0
+		if err := data.validate(); err != nil {
+			if !errors.Is(err, test.err) {
+				t.Errorf(gotWantIdx, i, err, test.err)
+			}
+			continue
+		}
+		if test.err != nil {
+			t.Errorf(gotWantIdx, i, "<nil>", test.err)
+			continue
+		}
+		lc, err := newLocaleData(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := lc.Info(); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestLocaleWeek(t *testing.T) {
+	de, err := loadLocale("de_DE")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With Monday and four days, the locale week is the ISO 8601 week.
+	dt := time.Date(2010, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < 15*366; i++ {
+		day := dt.AddDate(0, 0, i)
+		year, week := de.week(day)
+		isoYear, isoWeek := day.ISOWeek()
+		if year != isoYear || week != isoWeek {
+			t.Fatalf(gotWantKey, day.Format("2006-01-02"),
+				fmt.Sprint(year, " ", week), fmt.Sprint(isoYear, " ", isoWeek))
+		}
+	}
+
+	us, err := loadLocale("en_US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		input time.Time
+		year  int
+		week  int
+	}{
+		{time.Date(2015, 12, 26, 0, 0, 0, 0, time.UTC), 2015, 52},
+		{time.Date(2015, 12, 27, 0, 0, 0, 0, time.UTC), 2016, 1},
+		{time.Date(2016, 1, 2, 0, 0, 0, 0, time.UTC), 2016, 1},
+		{time.Date(2016, 1, 3, 0, 0, 0, 0, time.UTC), 2016, 2},
+		{time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC), 2016, 53},
+		{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 2017, 1},
+		{time.Date(2017, 12, 31, 0, 0, 0, 0, time.UTC), 2018, 1},
+	}
+
+	for _, test := range tests {
+		year, week := us.week(test.input)
+		if year != test.year || week != test.week {
+			t.Errorf(gotWantKey, test.input.Format("2006-01-02"),
+				fmt.Sprint(year, " ", week), fmt.Sprint(test.year, " ", test.week))
+		}
+	}
+}
+
+func TestStrftimeLocaleWeek(t *testing.T) {
+	dt := time.Date(2016, 1, 3, 3, 2, 1, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		input  string
+		want   string
+	}{
+		{"en_US", "%EV", "02"},
+		{"de_DE", "%EV", "53"},
+		{"ar_EG", "%EV", "02"},
+		{"en_US", "%-EV %V %U", "2 53 01"},
+		{"de_DE", "%_3EV", " 53"},
+	}
+
+	for i, test := range tests {
+		got, err := StrftimeLoc(test.locale, test.input, dt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestStrptimeLocaleWeek(t *testing.T) {
+	tests := []struct {
+		locale string
+		format string
+		input  string
+		want   time.Time
+	}{
+		{"en_US", "%Y %EV %w", "2016 02 0", time.Date(2016, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%Y %EV", "2016 01", time.Date(2015, 12, 27, 0, 0, 0, 0, time.UTC)},
+		{"de_DE", "%Y %EV %u", "2016 01 1", time.Date(2016, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"de_DE", "%Y %EV %u", "2015 53 7", time.Date(2016, 1, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for i, test := range tests {
+		got, err := StrptimeLoc(test.locale, test.format, test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Equal(test.want) {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func ExampleLocaleInfo() {
+	info, err := LocaleInfo("de_DE")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(info.FirstWeekday, info.MinDaysInFirstWeek)
+
+	// Output: Monday 4
+}
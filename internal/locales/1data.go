@@ -1,6307 +0,0 @@
-// Code generated by go-bindata.
-// sources:
-// POSIX.json
-// aa_DJ.json
-// aa_ER.json
-// aa_ER@saaho.json
-// aa_ET.json
-// af_ZA.json
-// am_ET.json
-// an_ES.json
-// ar_AE.json
-// ar_BH.json
-// ar_DZ.json
-// ar_EG.json
-// ar_IN.json
-// ar_IQ.json
-// ar_JO.json
-// ar_KW.json
-// ar_LB.json
-// ar_LY.json
-// ar_MA.json
-// ar_OM.json
-// ar_QA.json
-// ar_SA.json
-// ar_SD.json
-// ar_SY.json
-// ar_TN.json
-// ar_YE.json
-// as_IN.json
-// ast_ES.json
-// az_AZ.json
-// be_BY.json
-// be_BY@latin.json
-// bem_ZM.json
-// ber_DZ.json
-// ber_MA.json
-// bg_BG.json
-// bho_IN.json
-// bn_BD.json
-// bn_IN.json
-// bo_CN.json
-// bo_IN.json
-// br_FR.json
-// brx_IN.json
-// bs_BA.json
-// byn_ER.json
-// ca_AD.json
-// ca_ES.json
-// ca_FR.json
-// ca_IT.json
-// crh_UA.json
-// cs_CZ.json
-// csb_PL.json
-// cv_RU.json
-// cy_GB.json
-// da_DK.json
-// de_AT.json
-// de_BE.json
-// de_CH.json
-// de_DE.json
-// de_LI.json
-// de_LU.json
-// dv_MV.json
-// dz_BT.json
-// el_CY.json
-// el_GR.json
-// en_AG.json
-// en_AU.json
-// en_BW.json
-// en_CA.json
-// en_DK.json
-// en_GB.json
-// en_HK.json
-// en_IE.json
-// en_IN.json
-// en_NG.json
-// en_NZ.json
-// en_PH.json
-// en_SG.json
-// en_US.json
-// en_ZA.json
-// en_ZM.json
-// en_ZW.json
-// eo.json
-// eo_US.json
-// es_AR.json
-// es_BO.json
-// es_CL.json
-// es_CO.json
-// es_CR.json
-// es_CU.json
-// es_DO.json
-// es_EC.json
-// es_ES.json
-// es_GT.json
-// es_HN.json
-// es_MX.json
-// es_NI.json
-// es_PA.json
-// es_PE.json
-// es_PR.json
-// es_PY.json
-// es_SV.json
-// es_US.json
-// es_UY.json
-// es_VE.json
-// et_EE.json
-// eu_ES.json
-// eu_FR.json
-// fa_IR.json
-// ff_SN.json
-// fi_FI.json
-// fil_PH.json
-// fo_FO.json
-// fr_BE.json
-// fr_CA.json
-// fr_CH.json
-// fr_FR.json
-// fr_LU.json
-// fur_IT.json
-// fy_NL.json
-// ga_IE.json
-// gd_GB.json
-// gez_ER.json
-// gez_ET.json
-// gl_ES.json
-// gu_IN.json
-// gv_GB.json
-// ha_NG.json
-// he_IL.json
-// hi_IN.json
-// hne_IN.json
-// hr_HR.json
-// hsb_DE.json
-// ht_HT.json
-// hu_HU.json
-// hy_AM.json
-// i18n.json
-// ia.json
-// id_ID.json
-// ig_NG.json
-// ik_CA.json
-// is_IS.json
-// it_CH.json
-// it_IT.json
-// iu_CA.json
-// iw_IL.json
-// ja_JP.json
-// ka_GE.json
-// kk_KZ.json
-// kl_GL.json
-// km_KH.json
-// kn_IN.json
-// ko_KR.json
-// kok_IN.json
-// ks_IN.json
-// ks_IN@devanagari.json
-// ku_TR.json
-// kw_GB.json
-// ky_KG.json
-// lb_LU.json
-// lg_UG.json
-// lij_IT.json
-// lo_LA.json
-// lt_LT.json
-// lv_LV.json
-// mai_IN.json
-// mg_MG.json
-// mhr_RU.json
-// mi_NZ.json
-// mk_MK.json
-// ml_IN.json
-// mn_MN.json
-// mr_IN.json
-// ms_MY.json
-// mt_MT.json
-// my_MM.json
-// nan_TW@latin.json
-// nb_NO.json
-// ne_NP.json
-// nl_AW.json
-// nl_BE.json
-// nl_NL.json
-// nn_NO.json
-// nr_ZA.json
-// nso_ZA.json
-// oc_FR.json
-// om_ET.json
-// om_KE.json
-// or_IN.json
-// os_RU.json
-// pa_IN.json
-// pa_PK.json
-// pap_AN.json
-// pl_PL.json
-// ps_AF.json
-// pt_BR.json
-// pt_PT.json
-// ro_RO.json
-// ru_RU.json
-// ru_UA.json
-// rw_RW.json
-// sa_IN.json
-// sc_IT.json
-// sd_IN.json
-// sd_IN@devanagari.json
-// sd_PK.json
-// se_NO.json
-// shs_CA.json
-// si_LK.json
-// sid_ET.json
-// sk_SK.json
-// sl_SI.json
-// so_DJ.json
-// so_ET.json
-// so_KE.json
-// so_SO.json
-// sq_AL.json
-// sq_MK.json
-// sr_ME.json
-// sr_RS.json
-// sr_RS@latin.json
-// ss_ZA.json
-// st_ZA.json
-// sv_FI.json
-// sv_SE.json
-// sw_KE.json
-// sw_TZ.json
-// ta_IN.json
-// ta_LK.json
-// te_IN.json
-// tg_TJ.json
-// th_TH.json
-// ti_ER.json
-// ti_ET.json
-// tig_ER.json
-// tk_TM.json
-// tl_PH.json
-// tn_ZA.json
-// tr_CY.json
-// tr_TR.json
-// ts_ZA.json
-// tt_RU.json
-// tt_RU@iqtelif.json
-// ug_CN.json
-// ug_CN@latin.json
-// uk_UA.json
-// unm_US.json
-// ur_IN.json
-// ur_PK.json
-// uz_UZ.json
-// uz_UZ@cyrillic.json
-// ve_ZA.json
-// vi_VN.json
-// wa_BE.json
-// wae_CH.json
-// wal_ET.json
-// wo_SN.json
-// xh_ZA.json
-// yi_US.json
-// yo_NG.json
-// yue_HK.json
-// zh_CN.json
-// zh_HK.json
-// zh_SG.json
-// zh_TW.json
-// zu_ZA.json
-// DO NOT EDIT!
-
-package locale
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _posixJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x6b\x84\x30\x14\xc4\xcf\xf1\x53\x84\x07\xb9\x15\xf6\xee\x4d\x90\xd2\x5d\x48\x57\x50\x68\x4b\xe9\x21\xea\xa3\x0a\xeb\x1f\x62\x52\x90\xd2\xef\x5e\xac\x66\x94\xee\xed\xcd\x4c\x76\xe6\xb7\xe0\x77\x24\xe8\x9c\x52\x2c\x29\xbb\xe6\xe7\x57\x7a\x88\x04\xa5\x66\x9e\x28\x96\xef\x91\x10\x94\xfb\xbe\x36\xf3\x62\x0b\xd2\xc3\x7e\x17\x9e\x27\x88\x17\xae\xfb\x83\x2c\x1a\x6f\x77\xf5\x68\x5b\xdc\xb9\x71\xde\x2e\x2a\x12\x1f\xcb\x52\xde\x0c\xd6\xfd\x9b\xc3\x16\x86\x30\x82\x7a\x34\xa3\x36\x34\xea\xa1\x77\x0d\xea\x2e\xa6\xf7\xc6\x06\x10\x2e\xed\xae\xb4\xb1\x55\xb3\x9e\xc9\x68\xdb\x5b\x70\xb7\xf8\xe2\x7b\x0e\xd7\x6d\xf3\x12\xff\xe9\x27\xb7\x4d\xf2\xe8\xb8\x2b\xd9\xae\xf2\x5a\xb9\x01\xe2\x79\xf8\x3a\x44\x29\x57\xab\x3a\xfe\xe7\x3b\x4c\x20\x82\x0e\x6c\xf7\x64\x00\x03\x17\xa0\x80\x03\x14\x50\x04\x80\x44\x67\x3a\x2c\x27\x7a\x8d\x33\x1d\xd2\xd4\x38\x5e\x3e\x07\xd5\x9d\x54\x7d\x52\x33\x05\xb3\x68\xbb\x35\x30\x52\x95\x52\xb1\x54\x4f\xb1\xd2\xb1\xca\xa5\x7a\xfb\x7b\x84\x07\x9b\x0f\x73\x1b\x24\x75\x0e\x3f\x18\x29\xfa\xf9\x0d\x00\x00\xff\xff\x15\xac\x2d\xb5\x7a\x02\x00\x00")
-
-func posixJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_posixJson,
-		"POSIX.json",
-	)
-}
-
-func posixJson() (*asset, error) {
-	bytes, err := posixJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "POSIX.json", size: 634, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _aa_djJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x92\x41\x8b\xdb\x30\x10\x85\xcf\xf6\xaf\x10\x02\xdd\x4a\x7e\x80\x6f\x6e\x5d\x42\x9a\x1a\x1a\x12\x68\xd2\x52\xca\x73\x64\xec\x21\xb2\xbd\x96\x64\xd6\x61\xd9\xff\xbe\x64\x35\xf2\x7a\x6f\xdf\xbc\x79\xcc\x1b\x0d\x7a\x49\x13\xb9\x2b\x64\x26\x24\xf0\xbf\xf8\x21\xbf\xa4\x89\x2c\x70\x77\x32\x13\x7f\xd3\x24\x91\xf9\x15\xd0\x78\xc8\x89\xfc\xee\x4d\x5d\xf7\x14\x8a\x13\x0c\xe0\xb9\x93\xdb\x0a\x23\xf3\x1e\x1d\x91\x63\xd7\x76\xea\xc6\xc5\x75\x44\xe5\x49\xa6\xc9\xbf\x47\xc8\xb1\x1d\xac\x5f\x27\xe1\xca\xb6\xda\x9b\x00\x1e\x0c\xb0\x55\x80\x1b\xba\x00\xcd\xc4\xe0\x50\xc5\x89\xe5\xd0\xfb\x76\x19\x77\x98\xfa\x19\x62\x0b\x8b\xca\x4c\xbc\xd9\xa4\x87\x40\xdf\xa8\x69\xc8\x78\x88\x0f\x29\x6f\x34\xc4\x57\xcc\xe4\x5c\x34\x61\x46\x2b\x72\xe3\x78\xaf\x03\x1c\x44\x41\xd6\x52\xac\xf5\xb0\xae\x7f\x12\x8d\x75\x1f\xf8\x37\xee\x8e\x53\x0b\xf2\xb5\x61\xcb\x99\xba\x21\xf2\x1e\xf3\x6a\xc1\xf5\x55\x3e\x3f\x64\x9c\x78\x66\x8f\x36\xc0\x95\x1a\xbe\x4b\xa3\x59\xc1\x1c\x60\x84\x8b\xc0\x2d\x53\x8f\x01\x9e\x71\x0f\xa0\xc9\x07\x98\xa9\x8b\x67\x9d\xe3\x02\x79\xf9\xab\x8c\xc9\x0e\xb8\x4d\x31\xc0\x5a\x44\x4f\x01\x5f\x3f\x7e\x8c\xd2\x1b\xd5\x6d\xd4\x45\x46\xf1\x44\x5d\x68\x40\x28\x2d\x54\x25\xd4\x45\x28\x2b\xd4\x9f\x77\xc7\xd2\xdd\x65\xaa\xcc\xd4\x71\x11\x39\x53\xaa\xb3\x50\x4f\x32\x7d\x7d\x0b\x00\x00\xff\xff\x60\xd5\xa9\xa7\x94\x02\x00\x00")
-
-func aa_djJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_aa_djJson,
-		"aa_DJ.json",
-	)
-}
-
-func aa_djJson() (*asset, error) {
-	bytes, err := aa_djJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "aa_DJ.json", size: 660, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _aa_erJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xfa\x40\x10\xc5\xcf\xc9\xa7\x18\x16\xf6\x26\x78\xcf\x2d\xfe\x23\x22\xfe\x53\x6a\x15\xaa\x2d\xa5\x3c\x4d\x30\x43\x13\x53\x77\x37\x10\x29\xfd\xee\xc5\x66\x77\x1a\xda\x5b\xe6\xcd\xe3\xbd\xdf\x2c\xf9\x88\x23\xb5\xcc\x54\x42\x0a\x78\x9d\x3f\xa8\x49\x1c\xa9\x0c\x57\xab\x12\x7a\x8e\xa3\x48\xa5\x47\xa0\xc0\x4d\x8e\xd4\xdc\xd5\x65\x79\xe6\x61\xd8\xa2\x06\x9c\xdf\xa4\xe6\x80\x8b\xff\x5e\xa1\x61\xb6\xde\xb5\xe8\x9a\x8b\xb8\x36\x38\x38\x56\x71\xf4\x72\x2b\xd9\x54\xad\x71\xbf\x9a\xa4\x46\x2a\x24\x5e\xb2\x25\x57\x32\x43\x62\xde\x9e\x5d\x25\x71\xeb\xee\xdc\x83\x16\x30\x38\xd4\xdd\xe0\xbd\x43\x05\x63\x99\x56\x5d\xd1\x0e\xca\x3f\x3e\x9d\xb8\x76\x18\x49\xe9\xa9\x00\xcd\xd0\xb3\xb5\xc1\x84\x1e\x15\xa5\xb5\xf5\x7c\x6b\x58\x50\xc6\xc6\x70\x98\x8b\x76\x3c\xff\x2f\x2f\x3f\xcf\xf4\x88\xab\xf5\xf5\x19\xbb\xb2\xf6\xf2\x8e\x9b\x36\x7c\xaf\xd0\x8f\x48\xc7\xcf\xf3\xe7\x22\xb9\x43\xf0\x05\x5a\x58\x05\x52\xe8\x04\x4b\x90\x04\x48\x68\x04\x25\x00\xa4\xf9\x7d\x1e\x9a\x2d\xf0\xe6\x8f\x38\xc2\x18\x04\x4f\x06\x57\xde\x7e\x1d\x5d\x4c\x75\x33\xd5\x7b\x15\xc4\x2d\x37\xc3\x22\x9d\x90\x9e\x91\x2e\x27\xa4\xf7\xa4\x0d\xe9\xa7\x6f\x8f\xec\x97\x89\xce\x13\xbd\x11\xd1\xb7\x2a\xbd\x23\xfd\xae\xe2\xcf\xaf\x00\x00\x00\xff\xff\x36\xb0\xe5\xf7\x9f\x02\x00\x00")
-
-func aa_erJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_aa_erJson,
-		"aa_ER.json",
-	)
-}
-
-func aa_erJson() (*asset, error) {
-	bytes, err := aa_erJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "aa_ER.json", size: 671, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _aa_erSaahoJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x4b\xfb\x40\x10\xc5\xcf\xc9\xa7\x18\x16\xf6\x56\xe8\x3d\xa7\x7f\xfa\x8f\x48\xad\x29\xda\x08\xb6\x8a\xc8\x8b\x09\xcd\x62\xd2\xd8\xdd\x0d\xa4\x88\xdf\x5d\x62\x32\x43\xd0\xdb\xcc\x9b\xe1\xbd\xdf\x2c\xfb\x19\x06\x6a\x9d\xa8\x88\x14\xf0\x7a\xb5\xfb\xe7\x80\xaa\x55\x8b\x30\x50\x09\x2e\x4e\x45\xf4\x1c\x06\x81\xda\x22\x07\x65\x68\x72\xf8\x61\x16\xa8\x0c\x27\xc3\x55\xdd\xb9\xb1\xdc\x21\xef\xce\x63\xf9\x1f\x0d\xab\x37\x5d\x73\x86\xc7\xd8\xdc\x77\xa7\x5e\x9c\xc2\xe0\x65\x08\xca\xaa\xd6\xfa\x5f\x69\x92\x22\x21\x12\x21\x01\x62\x2f\xd6\xec\x98\xb6\x27\x5f\x89\xdd\x98\x79\x0d\x8b\xbc\xee\xc6\xdd\x2d\x2a\x58\x67\x68\xd3\x15\xed\xe4\x67\x8e\x47\x53\x7b\xcc\xa4\xf8\x58\x80\x56\xe8\x8d\x73\xbc\x84\x1e\x15\xc5\xb5\xe3\x6b\xe0\x40\x89\xb1\xd6\x70\x5f\xb4\xf3\xfe\xb6\x3c\x97\x25\x3f\xd4\x23\x2e\x6e\x8a\x4f\x8c\x2f\xeb\x49\xde\x9b\xa6\xe5\x7a\x83\x7e\x46\x3a\x7f\x9e\x3f\x17\xc9\x1d\x82\x2f\xd0\xc2\x2a\x90\x42\x27\x58\x82\x24\x40\x42\x23\x28\x0c\x10\xa7\x77\x29\x27\x3b\xe0\x7d\x3a\xe2\x0d\xd6\x82\x77\x12\xf8\x72\xf8\x44\xba\x58\xea\x66\xa9\x0f\x8a\xc5\x07\xd3\x8c\x83\x78\x41\x7a\x45\xba\x5c\x90\x3e\x90\xb6\xa4\x9f\x7e\x76\x64\xbe\x8e\x74\x1a\xe9\x4c\xc4\x29\x55\xe9\x3d\xe9\x0f\x15\x7e\x7d\x07\x00\x00\xff\xff\x17\x38\x89\x73\xa9\x02\x00\x00")
-
-func aa_erSaahoJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_aa_erSaahoJson,
-		"aa_ER@saaho.json",
-	)
-}
-
-func aa_erSaahoJson() (*asset, error) {
-	bytes, err := aa_erSaahoJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "aa_ER@saaho.json", size: 681, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _aa_etJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x5f\x6b\xe2\x40\x14\xc5\x9f\x93\x4f\x71\x19\x98\x37\xc1\xf7\xbc\xc5\x8d\x88\xb8\x81\x15\x85\xd5\x5d\x4a\x39\x9a\x41\x2f\xcd\x9f\x66\x66\x02\x91\xd2\xef\x5e\x6c\x66\x6e\xa5\x7d\xbb\x7f\x4e\xce\xf9\xdd\x30\x6f\x69\xa2\xd6\x85\xca\x48\x01\xcf\xcb\xbd\x9a\xa5\x89\x2a\x70\x73\x2a\xa3\xff\x69\x92\xa8\xfc\x0c\x54\xb8\x8f\x13\xb5\xf4\xb5\x31\x2d\x4f\xcd\x1e\x35\xe0\xc3\x26\xb7\x27\xf4\xa1\xde\xa0\x61\x76\x41\xb5\x1a\x9a\x5e\x54\x3b\x9c\x3c\xab\x34\x79\xba\x87\xec\xae\x9d\xf5\xdf\x92\x24\x46\x22\xc4\x5e\xbc\xc5\x57\x3c\xa3\x63\xd9\xb5\xfe\x2a\x76\xdb\xa1\x1d\x41\x2b\x58\x9c\xea\x21\x7c\x3d\x54\xdd\x54\xfd\xe2\xcb\x85\x6b\x0f\xfa\x1a\xe5\x97\x0a\xb4\xc0\xc8\xce\x45\x11\x46\x5c\x29\xaf\x5d\xe0\xda\xc2\x81\x0a\xb6\x96\x63\x5f\x75\x8f\xfd\x6f\xe6\xde\xb4\x53\xfd\x17\x37\x17\x52\x0b\xf6\xa6\x0e\x92\x03\x37\x5d\xac\x37\x18\x1f\x00\x1f\xff\xca\x8f\x43\x04\x5f\xe8\x85\x59\x50\x85\x51\xe0\x02\x95\xe9\x05\x49\x80\x84\x46\x50\x22\x40\x5e\xfe\x29\x63\xb2\x03\x5e\xc2\x11\x67\x58\x8b\xa8\x29\xe0\xcd\xfd\xc5\xe8\x6a\xae\x9b\xb9\x3e\xaa\x38\xdc\x73\x33\x2d\xf2\x19\xe9\x05\x69\x33\x23\x7d\x24\x6d\x49\xff\xfb\xd4\xc8\x7e\x9d\xe9\x32\xd3\x3b\x19\x86\x54\xa5\x0f\xa4\x5f\x55\xfa\xfe\x11\x00\x00\xff\xff\xf1\x8d\xde\x79\x96\x02\x00\x00")
-
-func aa_etJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_aa_etJson,
-		"aa_ET.json",
-	)
-}
-
-func aa_etJson() (*asset, error) {
-	bytes, err := aa_etJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "aa_ET.json", size: 662, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _af_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x6a\xf3\x30\x10\x84\xcf\xf2\x53\x88\x05\xdd\x7e\xc8\x3d\x37\x83\xf8\xa1\x06\xb5\x05\x9b\x94\xa4\x94\xb2\xc6\x6a\x62\x1a\x4b\x41\x96\x0a\xa1\xf4\xdd\x8b\x6b\xed\x56\x25\xb7\x9d\x9d\x65\xe6\x13\xe8\xb3\x12\x70\xa7\x61\x2b\x01\xdf\x5e\x0f\x35\xfc\xab\x04\x68\xbc\xce\xb0\x95\xcf\x95\x10\xd0\x7a\x37\xe0\x71\x59\x0b\x30\x88\xbf\x42\x8f\x6e\x66\xf1\xe4\x6d\xa1\xb4\x77\x83\x0d\x2c\x77\xe1\xca\x73\x8b\x71\x75\x2a\xf1\xb2\x54\xb5\x27\x1f\xe2\xdf\x3e\xea\xa2\x1a\x6a\xa0\x6c\x0a\xa5\x40\x8a\x32\xde\xc5\x13\xe7\x34\xe8\x12\x86\xd1\xae\x57\xff\x6d\x1f\x0a\x69\x10\x43\x5c\xc7\xfa\x12\xc6\x73\xde\xda\xdc\xd5\x24\x47\x97\x4d\x3a\xd3\x58\xa7\x63\x9a\x63\x9a\x73\xaf\xbd\x44\x3b\xf5\x36\x63\x3c\xbc\x47\xcf\xe2\xde\x7f\x14\x96\xb6\xf3\xaa\xca\x27\xdf\xc0\x32\x67\x66\x29\xf8\x6e\xe9\x98\x8d\xc9\x18\x8a\x71\x18\x85\x29\x08\xa0\x36\x8f\x86\x9a\x77\x26\xdf\x19\x72\x35\x46\xbb\x7c\x07\x35\x6c\xd4\xb4\x51\x7b\xa0\x65\x37\x4e\xab\x81\x52\x0d\x52\xf5\x52\xed\xa5\xea\xa4\x3a\xfc\x5c\xb0\xdb\xb1\xcc\x3d\x00\xd5\xd7\x77\x00\x00\x00\xff\xff\x89\x52\x2f\x3f\x66\x02\x00\x00")
-
-func af_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_af_zaJson,
-		"af_ZA.json",
-	)
-}
-
-func af_zaJson() (*asset, error) {
-	bytes, err := af_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "af_ZA.json", size: 614, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _am_etJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x92\xbf\x4a\x03\x41\x10\xc6\xeb\xbb\xa7\x58\x16\xb6\x13\xd2\x5f\x17\x89\x45\x8a\x03\x21\x29\x8c\x22\x12\x30\x10\x8b\x18\x89\x69\x44\x04\x45\x84\xd9\xdd\x3b\x50\xc2\x09\x92\x28\x06\x8d\x44\xf1\x20\x68\x52\xfa\x30\xdf\x9b\xc8\xc6\xfb\x93\x2c\x28\x28\x76\x33\xdf\x7c\x3b\x33\xbf\x65\x8e\x5d\x87\x97\x4b\xdc\x63\xbc\xde\xda\x59\xab\xf2\x15\xd7\xe1\xa5\xfa\xd1\x21\xf7\xd8\x96\xeb\x38\x1c\x6a\x04\xba\x82\x9e\x99\x8a\xc3\x41\x13\xa8\xbb\x34\xee\x43\xc5\xcb\xca\x18\x72\x08\x1d\xa5\xe9\x25\xe8\x06\x94\xbe\xd5\x3d\x50\x0c\x39\x4a\x52\x79\x01\xfd\x0e\x1a\x70\xd7\xd9\x36\x63\x2b\xcd\x76\xa7\xfb\xdb\xd9\xff\x31\xd8\x6f\xef\x77\x9b\xf9\xd4\xe0\x1c\x2a\x82\x7e\x86\x26\xd0\x4b\xf2\x26\x0c\x20\x47\xa0\x65\x91\xfa\xf3\xbe\x1f\x49\xaa\x1e\x11\x46\x66\x15\x0a\x53\xc3\x00\x3a\x4e\xe2\xe0\x0c\x2a\xca\x63\xd2\x79\x49\x3d\x21\x20\xd0\x0c\x32\x43\x9d\x9a\x56\x72\x0a\xba\x85\xbc\x07\x2d\x38\x55\x0c\x39\x81\x0e\x97\xf5\x6b\xc8\x57\xdb\xac\xdf\x4c\x9f\x4c\x5c\xfc\xe6\x6f\x90\x2d\xd8\x9f\x31\xff\xc6\x68\x01\x5a\x5c\x16\x8e\x05\x92\x22\x14\xfd\x75\x3f\xdf\x7d\x08\xad\xf3\x9f\x53\x63\x73\x27\xba\x67\x94\xc4\x5e\xaa\x77\x1b\xe6\xc2\xc5\x6e\x41\xb4\x0a\xa2\xc6\x53\xb1\xba\xd7\xfa\x2a\x14\x11\x3e\x30\xb1\xca\x44\x83\x41\x9e\x42\x45\x4c\xd4\x98\xe8\x30\xb1\x39\xf7\x66\xbe\xb2\x27\x7c\x4f\x54\x32\x31\x59\x84\x8b\x0d\x26\x0e\xb8\x7b\xf2\x19\x00\x00\xff\xff\x72\xfb\x00\x33\x4e\x03\x00\x00")
-
-func am_etJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_am_etJson,
-		"am_ET.json",
-	)
-}
-
-func am_etJson() (*asset, error) {
-	bytes, err := am_etJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "am_ET.json", size: 846, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _an_esJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x4b\xc4\x30\x10\x85\xcf\xe9\xaf\x08\x03\xb9\x09\x7b\xdf\x9b\xb0\x1e\x3c\x14\x84\xed\x45\x45\x64\xd2\xc6\x6d\xa0\x99\x81\x34\x3d\x54\xf1\xbf\x4b\x6d\x66\xd4\xf5\xf6\xde\xbc\xf2\xde\x17\xe8\x47\x63\xe0\xfe\x04\x47\x0b\x48\xaf\x77\x67\xb8\x69\x0c\x9c\x70\x9d\xe1\x68\x9f\x1b\x63\x60\xe0\x14\xe9\xc2\xdb\xdd\xc0\xb4\xd0\xbc\xab\x84\xb9\x04\xd1\x31\xf4\x3c\x55\xd3\x8f\x4b\xf0\x92\xf8\x18\x32\x89\x99\xd1\xe3\xc0\xd0\x98\x97\x6d\xe3\x3c\x72\x2e\x57\x43\x3a\xa2\x1b\x3a\xa0\xe5\x5a\xac\xa5\xd2\xd8\x32\x95\x51\xeb\xfa\x31\x52\xc8\x95\xfb\x2d\x07\xaf\x26\x61\x7e\xaf\x12\x7d\x8e\x93\x5c\x57\xd6\x11\x8a\x3f\x7a\x12\x8d\x17\x9e\x4b\xd5\x73\x28\x31\x24\x9f\x2b\x05\xf7\x65\x51\x43\xec\x7f\x67\xe8\x63\xa0\xf2\xf7\xdd\xff\x50\x15\xf3\xea\xe5\xe8\xb3\xe2\x09\x11\x89\x98\x94\x4b\xa1\x14\x47\x51\x14\x42\x00\x6e\xdb\x87\x56\x96\xf7\x50\x92\x13\x96\xb0\xfd\x08\x6e\x38\xb8\x74\x70\x2b\xc8\xb1\x8b\x69\x0f\xd0\xba\xc1\x3a\x6f\xdd\xa3\x75\x9d\x75\x4f\xdf\x5f\x68\xda\xa9\xad\x1b\x00\xcd\xe7\x57\x00\x00\x00\xff\xff\x9e\x17\x68\xf4\x60\x02\x00\x00")
-
-func an_esJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_an_esJson,
-		"an_ES.json",
-	)
-}
-
-func an_esJson() (*asset, error) {
-	bytes, err := an_esJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "an_ES.json", size: 608, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_aeJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x0c\x03\xb3\xeb\x13\x64\x57\xe8\xb7\xe8\x22\xf0\x41\xdd\xa8\x88\x54\x14\xea\x42\x2b\xb5\x1b\x11\x17\xc5\xa6\x84\x79\x0b\xc9\x22\xb1\xe9\x1f\x42\x10\xa9\x4f\x72\xee\xdb\xc8\xa4\x33\x93\x9b\xe2\xea\x72\x7f\xe7\xce\x9d\x73\x26\x79\x0d\x03\x39\x1c\xc8\x48\xc8\xf1\xec\xba\xff\x4f\xf6\xc2\x40\x0e\xc6\x2f\xcf\x32\x12\x97\x61\x10\x48\xe4\xb4\x44\x86\x2d\xf6\x46\xb2\x7d\x8e\x92\x56\xa4\x69\xc5\x58\x79\xe4\xc8\xf1\xc1\x68\x86\x0a\x05\x0e\x27\x74\x47\x09\x69\xd4\x8c\x6c\x28\xc1\x01\x9f\x8c\xd4\x28\xb0\x96\x61\x70\x65\x1c\x8d\x26\xd3\xd9\xbc\x63\x6b\x7b\x1c\xf5\x16\x4a\x5b\x2b\x5b\x77\xb6\x6e\x6c\xad\xdd\xaa\x78\xfa\x38\x9f\xf8\x3d\x26\x04\x72\xd2\xee\x1c\x2d\x50\xa0\xea\x90\x04\x39\x2a\x6f\x36\x33\x3a\x69\x5a\xb6\x2a\x69\x4a\x6d\xa7\x29\x6d\x1e\x86\xf7\xcb\xb6\x47\x86\x1f\xd4\xf8\xf6\xdb\x9a\x90\x94\x98\x9d\x6e\x82\xde\xb1\xa6\xb4\x25\xb4\xa2\x94\x16\x9d\x99\xbd\x79\x3c\x4b\xf8\x03\xfd\x15\x8d\xc7\xe2\x81\x78\x1c\x1e\x85\x07\xe1\x21\x78\x00\x6e\x9e\xdb\xe6\x86\xb9\x55\x67\xb2\x1f\xff\x8f\xfd\x07\xfc\x72\xb7\x3a\x75\x30\x9e\xdf\x99\xff\x50\xdd\x0a\x75\xd3\x13\xea\x5c\x3a\x7a\x76\xff\xd0\x55\x84\xba\x10\x6a\x18\xa9\x38\x52\x23\xa1\x9e\x9a\x41\x3f\xd4\x4a\x9e\xdb\x8b\xe5\xc9\xb1\xf0\xed\x37\x00\x00\xff\xff\x1d\xc2\x34\x77\xfe\x02\x00\x00")
-
-func ar_aeJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_aeJson,
-		"ar_AE.json",
-	)
-}
-
-func ar_aeJson() (*asset, error) {
-	bytes, err := ar_aeJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_AE.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_bhJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x0c\x03\xb3\xeb\x13\x64\xf7\x7d\x74\x61\x17\x01\xa1\x6e\x54\x44\x2a\x0a\x75\xa1\x95\xda\x8d\x88\x8b\x62\x53\xc2\xbc\x85\x64\x91\xd8\xf4\x0f\x21\x88\xd4\x27\x39\xf7\x6d\x64\xd2\x99\xc9\x4d\x71\x75\xb9\xbf\x73\xe7\xce\x39\x93\xbc\x86\x81\x1c\xf4\x65\x24\xe4\x68\x7a\xfd\xff\x44\xf6\xc2\x40\xf6\x47\x2f\xcf\x32\x12\x97\x61\x10\x48\xe4\xb4\x40\x86\x0d\x76\x46\xb2\x7d\x8e\x92\x96\xa4\x69\xc9\x58\x79\xe0\xc8\xf1\xc1\x68\x86\x0a\x05\xf6\x47\x74\x4b\x09\x69\xd4\x8c\xac\x29\xc1\x1e\x9f\x8c\xd4\x28\xb0\x92\x61\x70\x65\x1c\x0d\xc7\x93\xe9\xac\x63\x6b\x73\x18\xf5\x16\x4a\x5b\x2b\x5b\xb7\xb6\xae\x6d\xad\xdd\xaa\x78\xf2\x38\x1b\xfb\x3d\x26\x04\x72\xd2\xee\x1c\xcd\x51\xa0\xea\x90\x04\x39\x2a\x6f\x36\x33\x3a\x69\x5a\xb4\x2a\x69\x4a\x6d\xa7\x29\x6d\x1e\x86\xf7\x8b\xb6\x47\x86\x1f\xd4\xf8\xf6\xdb\x9a\x90\x94\x98\x9d\x6e\x82\xde\xb1\xa2\xb4\x25\xb4\xa4\x94\xe6\x9d\x99\x9d\x79\x3c\x4b\xf8\x03\xfd\x15\x8d\xc7\xe2\x81\x78\x1c\x1e\x85\x07\xe1\x21\x78\x00\x6e\x9e\xdb\xe6\x86\xb9\x55\x67\xf2\x5f\x7c\x1a\xfb\x0f\xf8\xe5\x6e\x75\x6a\x7f\x34\xbb\x33\xff\xa1\xba\x15\xea\xa6\x27\xd4\xb9\x74\xf4\xec\xfe\xa1\xab\x08\x75\x21\xd4\x20\x52\x71\xa4\x86\x42\x3d\x35\x83\x7e\xa8\x95\x3c\xb7\x17\xcb\xa3\x63\xe1\xdb\x6f\x00\x00\x00\xff\xff\x62\x6d\x07\x36\xfe\x02\x00\x00")
-
-func ar_bhJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_bhJson,
-		"ar_BH.json",
-	)
-}
-
-func ar_bhJson() (*asset, error) {
-	bytes, err := ar_bhJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_BH.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_dzJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xc3\x40\x10\xc6\xcf\xc9\x53\x2c\x0b\x7b\xeb\x13\xe4\x26\xe4\xd2\x43\x40\xa8\x17\x2b\x22\x15\x85\x7a\xd0\x4a\xed\x45\xc4\x43\xb1\x29\x61\xdf\x42\x72\x48\x6d\xfa\x87\x10\x44\xea\x93\x7c\xf3\x36\xb2\xe9\xee\x66\x52\x3c\x0d\xf3\xfb\x66\x67\xbf\x6f\x93\xb7\x30\x90\xfd\x58\x46\x42\x8e\xa6\x37\xf1\x50\xf6\xc2\x40\xc6\xa3\xd7\x17\x19\x89\xab\x30\x08\x24\x0a\x5a\x20\xc7\x16\x7b\x23\xd9\xbe\x40\x49\x4b\xd2\xb4\x64\xac\x3c\x72\x14\xf8\x64\x34\x47\x85\x15\x0e\x27\x74\x47\x29\x69\xd4\x8c\x6c\x28\xc5\x01\x5f\x8c\xd4\x58\x61\x2d\xc3\xe0\xda\x38\x1a\x8c\x27\xd3\x59\xc7\xd6\xf6\x38\xea\x2d\x94\xb6\x56\xb6\xee\x6c\xdd\xd8\x5a\xbb\x55\xc9\xe4\x69\x36\xf6\x7b\x4c\x08\x14\xa4\xdd\x39\x9a\x63\x85\xaa\x43\x52\x14\xa8\xbc\xd9\xdc\xe8\xa4\x69\xd1\xaa\xa4\x29\xb3\x9d\xa6\xac\x79\x18\xde\x2f\xda\x1e\x39\x7e\x51\xe3\xc7\x6f\x6b\x42\x52\x6a\x76\xba\x09\xfa\xc0\x9a\xb2\x96\xd0\x92\x32\x9a\x77\x66\xf6\xe6\xf1\x2c\xe1\x0f\xf4\x5f\x34\x1e\x8b\x07\xe2\x71\x78\x14\x1e\x84\x87\xe0\x01\xb8\x79\x6e\x9b\x1b\xe6\x56\x9d\xc9\xb3\xe4\x3c\xf1\x1f\xf0\xdb\xdd\xea\xd4\x78\x34\xbb\x37\xff\xa1\xba\x13\xea\xb6\x27\xd4\xa5\x74\xf4\xe2\xe1\xb1\xab\x08\x35\x14\xaa\x1f\xa9\x24\x52\x03\xa1\x9e\x9b\x41\x3f\xd4\x4a\x9e\xdb\x8b\xe5\xc9\xb1\xf0\xfd\x2f\x00\x00\xff\xff\x3c\x50\xb0\x74\xfe\x02\x00\x00")
-
-func ar_dzJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_dzJson,
-		"ar_DZ.json",
-	)
-}
-
-func ar_dzJson() (*asset, error) {
-	bytes, err := ar_dzJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_DZ.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_egJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x0c\x03\xb3\xeb\x13\x64\xf7\x41\x3f\xa4\x8b\x80\x50\x37\x2a\x22\x15\x85\xba\xd0\x4a\xed\x46\xc4\x45\xb1\x29\x61\xde\x42\xb2\x48\x6c\xfa\x87\x10\x44\xea\x93\x9c\xfb\x36\x32\xe9\xcc\xe4\xa6\xb8\xba\xdc\xdf\xb9\x73\xe7\x9c\x49\x5e\xc3\x40\x0e\xfa\x32\x12\x72\x34\xbd\xfe\x7f\x22\x7b\x61\x20\xfb\xa3\x97\x67\x19\x89\xcb\x30\x08\x24\x72\x5a\x20\xc3\x06\x3b\x23\xd9\x3e\x47\x49\x4b\xd2\xb4\x64\xac\x3c\x70\xe4\xf8\x60\x34\x43\x85\x02\xfb\x23\xba\xa5\x84\x34\x6a\x46\xd6\x94\x60\x8f\x4f\x46\x6a\x14\x58\xc9\x30\xb8\x32\x8e\x86\xe3\xc9\x74\xd6\xb1\xb5\x39\x8c\x7a\x0b\xa5\xad\x95\xad\x5b\x5b\xd7\xb6\xd6\x6e\x55\x3c\x79\x9c\x8d\xfd\x1e\x13\x02\x39\x69\x77\x8e\xe6\x28\x50\x75\x48\x82\x1c\x95\x37\x9b\x19\x9d\x34\x2d\x5a\x95\x34\xa5\xb6\xd3\x94\x36\x0f\xc3\xfb\x45\xdb\x23\xc3\x0f\x6a\x7c\xfb\x6d\x4d\x48\x4a\xcc\x4e\x37\x41\xef\x58\x51\xda\x12\x5a\x52\x4a\xf3\xce\xcc\xce\x3c\x9e\x25\xfc\x81\xfe\x8a\xc6\x63\xf1\x40\x3c\x0e\x8f\xc2\x83\xf0\x10\x3c\x00\x37\xcf\x6d\x73\xc3\xdc\xaa\x33\xf9\x2f\x3e\x8d\xfd\x07\xfc\x72\xb7\x3a\xb5\x3f\x9a\xdd\x99\xff\x50\xdd\x0a\x75\xd3\x13\xea\x5c\x3a\x7a\x76\xff\xd0\x55\x84\xba\x10\x6a\x10\xa9\x38\x52\x43\xa1\x9e\x9a\x41\x3f\xd4\x4a\x9e\xdb\x8b\xe5\xd1\xb1\xf0\xed\x37\x00\x00\xff\xff\x10\x60\xb3\xc1\xfe\x02\x00\x00")
-
-func ar_egJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_egJson,
-		"ar_EG.json",
-	)
-}
-
-func ar_egJson() (*asset, error) {
-	bytes, err := ar_egJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_EG.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xc3\x40\x10\xc6\xcf\xc9\x53\x2c\x0b\x7b\xf3\x09\x72\xab\xf4\xd2\x43\x44\xa8\x17\x15\x91\x82\x42\x3d\x68\xa5\xf6\x22\xe2\xa1\xd8\x94\xb0\x6f\x21\x39\xa4\x36\xfd\x43\x08\x22\xf5\x49\xbe\x79\x1b\xd9\x74\x77\x33\x09\x9e\x86\xf9\x7d\xb3\xb3\xdf\xb7\xc9\x5b\x18\xc8\x41\x5f\x46\x42\x8e\xa6\xb7\x83\x33\x79\x12\x06\xb2\x3f\x7a\x7d\x91\x91\xb8\x0e\x83\x40\x22\xa7\x05\x32\x6c\xb1\x37\x92\xed\x73\x14\xb4\x24\x4d\x4b\xc6\x8a\x23\x47\x8e\x4f\x46\x33\x94\x58\xe1\xd0\xa1\x3b\x4a\x48\xa3\x62\x64\x43\x09\x0e\xf8\x62\xa4\xc2\x0a\x6b\x19\x06\x37\xc6\xd1\x70\x3c\x99\xce\x5a\xb6\xb6\xc7\x51\x6f\xa1\xb0\xb5\xb4\x75\x67\xeb\xc6\xd6\xca\xad\x8a\x27\x4f\xb3\xb1\xdf\x63\x42\x20\x27\xed\xce\xd1\x1c\x2b\x94\x2d\x92\x20\x47\xe9\xcd\x66\x46\x27\x4d\x8b\x46\x25\x4d\xa9\xed\x34\xa5\xf5\xc3\xf0\x7e\xd1\xf4\xc8\xf0\x8b\x0a\x3f\x7e\x5b\x1d\x92\x12\xb3\xd3\x4d\xd0\x07\xd6\x94\x36\x84\x96\x94\xd2\xbc\x35\xb3\x37\x8f\x67\x09\x7f\xa0\xff\xa2\xf1\x58\x3c\x10\x8f\xc3\xa3\xf0\x20\x3c\x04\x0f\xc0\xcd\x73\xdb\xdc\x30\xb7\xea\x4c\xf6\xe2\xf3\xd8\x7f\xc0\x6f\x77\xab\x53\xfb\xa3\xd9\xbd\xf9\x0f\x55\x4f\xa8\x3b\xa1\x4e\x85\xba\x94\x8e\x5f\x3c\x3c\x76\x35\xa1\x06\x91\x8a\x23\x35\x14\xea\x59\xa8\xab\x7a\xd4\x8f\x79\xa9\xe1\xf6\x72\xd9\x39\x16\xbe\xff\x05\x00\x00\xff\xff\x4a\xf3\x77\xc7\x02\x03\x00\x00")
-
-func ar_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_inJson,
-		"ar_IN.json",
-	)
-}
-
-func ar_inJson() (*asset, error) {
-	bytes, err := ar_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_IN.json", size: 770, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_iqJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xc3\x40\x10\xc6\xcf\xc9\x53\x2c\x0b\x7b\xeb\x13\xe4\x26\xf4\xd2\x43\x40\xa9\x17\x15\x91\x8a\x42\x3d\x68\xa5\xf6\x22\xe2\xa1\xd8\x94\xb0\x6f\x21\x39\x24\x36\xfd\x43\x08\x22\xf5\x49\xbe\x79\x1b\xd9\x74\x77\x33\x29\x9e\x86\xf9\x7d\xb3\xb3\xdf\xb7\xc9\x5b\x18\xc8\x41\x5f\x46\x42\x8e\xa6\x37\x83\x33\xd9\x0b\x03\xd9\x1f\xbd\xbe\xc8\x48\x5c\x85\x41\x20\x91\xd3\x02\x19\x36\xd8\x19\xc9\xf6\x39\x4a\x5a\x92\xa6\x25\x63\xe5\x81\x23\xc7\x27\xa3\x19\x2a\x14\xd8\x1f\xd1\x2d\x25\xa4\x51\x33\xb2\xa6\x04\x7b\x7c\x31\x52\xa3\xc0\x4a\x86\xc1\xb5\x71\x34\x1c\x4f\xa6\xb3\x8e\xad\xcd\x61\xd4\x5b\x28\x6d\xad\x6c\xdd\xda\xba\xb6\xb5\x76\xab\xe2\xc9\xd3\x6c\xec\xf7\x98\x10\xc8\x49\xbb\x73\x34\x47\x81\xaa\x43\x12\xe4\xa8\xbc\xd9\xcc\xe8\xa4\x69\xd1\xaa\xa4\x29\xb5\x9d\xa6\xb4\x79\x18\xde\x2f\xda\x1e\x19\x7e\x51\xe3\xc7\x6f\x6b\x42\x52\x62\x76\xba\x09\xfa\xc0\x8a\xd2\x96\xd0\x92\x52\x9a\x77\x66\x76\xe6\xf1\x2c\xe1\x0f\xf4\x5f\x34\x1e\x8b\x07\xe2\x71\x78\x14\x1e\x84\x87\xe0\x01\xb8\x79\x6e\x9b\x1b\xe6\x56\x9d\xc9\x93\xf8\x34\xf6\x1f\xf0\xdb\xdd\xea\xd4\xfe\x68\x76\x6f\xfe\x43\x75\x27\xd4\x6d\x4f\xa8\x0b\xe9\xe8\xf9\xc3\x63\x57\x11\xea\x52\xa8\x41\xa4\xe2\x48\x0d\x85\x7a\x6e\x06\xfd\x50\x2b\x79\x6e\x2f\x96\x47\xc7\xc2\xf7\xbf\x00\x00\x00\xff\xff\x47\x41\x9b\x9b\xfe\x02\x00\x00")
-
-func ar_iqJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_iqJson,
-		"ar_IQ.json",
-	)
-}
-
-func ar_iqJson() (*asset, error) {
-	bytes, err := ar_iqJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_IQ.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_joJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x93\x4f\x4a\xc3\x40\x18\xc5\xd7\xc9\x29\x86\x81\xd9\xf5\x04\xd9\x09\xd9\x54\x08\x0a\x75\xa3\x22\x12\x51\xa8\x0b\xad\xd4\x6c\x44\x5c\x99\x84\x90\x5b\x68\x16\x13\x5b\xab\xa6\xa9\x48\x6f\xf2\xbe\xdb\xc8\x34\x93\xc9\x34\xe0\x5e\xe8\x2e\xef\xf7\xde\x97\x79\x5f\xfe\x3c\xba\x0e\x1f\xfa\xdc\x63\x3c\x9c\x9e\xef\x1f\xf0\x81\xeb\x70\x3f\x7c\xb8\xe7\x1e\x3b\x75\x1d\x87\x43\x52\x8c\x02\x0b\x7c\x2a\x4b\x6b\x89\x39\xa5\x94\x53\x6a\xb1\x79\xc3\x21\xf1\x62\xd1\x02\x15\x4a\xac\x7b\xf4\x83\x12\xca\x51\x5b\xe4\x9d\x12\xac\xf1\x66\x91\x1a\x25\x66\xdc\x75\xce\x54\xa3\xd1\x78\x32\x8d\xfe\x5f\xad\x60\x72\x1b\x8d\x4d\x27\x7a\x86\xa4\x94\x32\x4a\x59\x73\xf4\x46\xe6\x7a\x78\x85\x12\x12\x3f\x5a\xbd\xe2\x0b\x12\x55\xa3\x54\x0a\xb5\x4a\x1b\x9d\x75\x2e\x16\x58\x52\x8e\xaa\xf3\x31\xa3\x84\x32\x2c\xcd\xbd\x4a\x7d\x55\x50\x4e\x31\x65\x14\xb7\x39\xac\x50\xa9\x07\xd2\x14\x2a\xfe\xb6\xb6\xba\xf6\x17\x69\xe6\xec\x57\xb1\x8b\x8b\xef\x05\x87\x81\xf9\xfc\xbe\xf5\x40\xd2\xba\x7e\x18\x5d\xa9\xbf\x48\x5c\x32\x71\x31\x60\xe2\x98\xb7\xf4\xe8\xfa\x66\xdb\x61\xe2\x84\x89\xa1\x27\x02\x4f\x8c\x98\xb8\xdb\x04\x4d\xa8\xb3\x0c\xd7\x07\xf3\xde\x98\xfb\xf4\x1b\x00\x00\xff\xff\xbc\x8a\xcc\xd0\xbc\x03\x00\x00")
-
-func ar_joJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_joJson,
-		"ar_JO.json",
-	)
-}
-
-func ar_joJson() (*asset, error) {
-	bytes, err := ar_joJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_JO.json", size: 956, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_kwJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x0c\x03\xb3\xeb\x13\x64\xf7\x41\x37\xe5\x23\x20\x54\x10\x15\x91\x8a\x42\x5d\x68\xa5\x76\x23\xe2\xa2\xd8\x94\x30\x6f\x21\x59\x24\x36\xfd\x43\x08\x22\xf5\x49\xce\x7d\x1b\x99\x74\x66\x72\x53\x5c\x5d\xee\xef\xdc\xb9\x73\xce\x24\xaf\x61\x20\x07\x7d\x19\x09\x39\x9a\x5e\xff\x3f\x93\xbd\x30\x90\xfd\xd1\xcb\xb3\x8c\xc4\x65\x18\x04\x12\x39\x2d\x90\x61\x83\x9d\x91\x6c\x9f\xa3\xa4\x25\x69\x5a\x32\x56\x1e\x38\x72\x7c\x30\x9a\xa1\x42\x81\xfd\x11\xdd\x52\x42\x1a\x35\x23\x6b\x4a\xb0\xc7\x27\x23\x35\x0a\xac\x64\x18\x5c\x19\x47\xc3\xf1\x64\x3a\xeb\xd8\xda\x1c\x46\xbd\x85\xd2\xd6\xca\xd6\xad\xad\x6b\x5b\x6b\xb7\x2a\x9e\x3c\xce\xc6\x7e\x8f\x09\x81\x9c\xb4\x3b\x47\x73\x14\xa8\x3a\x24\x41\x8e\xca\x9b\xcd\x8c\x4e\x9a\x16\xad\x4a\x9a\x52\xdb\x69\x4a\x9b\x87\xe1\xfd\xa2\xed\x91\xe1\x07\x35\xbe\xfd\xb6\x26\x24\x25\x66\xa7\x9b\xa0\x77\xac\x28\x6d\x09\x2d\x29\xa5\x79\x67\x66\x67\x1e\xcf\x12\xfe\x40\x7f\x45\xe3\xb1\x78\x20\x1e\x87\x47\xe1\x41\x78\x08\x1e\x80\x9b\xe7\xb6\xb9\x61\x6e\xd5\x99\xfc\x17\x9f\xc4\xfe\x03\x7e\xb9\x5b\x9d\xda\x1f\xcd\xee\xcc\x7f\xa8\x6e\x85\xba\xe9\x09\x75\x2e\x1d\x3d\xbd\x7f\xe8\x2a\x42\x5d\x08\x35\x88\x54\x1c\xa9\xa1\x50\x4f\xcd\xa0\x1f\x6a\x25\xcf\xed\xc5\xf2\xe8\x58\xf8\xf6\x1b\x00\x00\xff\xff\x2d\x36\x26\x65\xfe\x02\x00\x00")
-
-func ar_kwJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_kwJson,
-		"ar_KW.json",
-	)
-}
-
-func ar_kwJson() (*asset, error) {
-	bytes, err := ar_kwJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_KW.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_lbJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x93\x4f\x4a\xc3\x40\x18\xc5\xd7\xc9\x29\x86\x81\xd9\xf5\x04\xd9\x29\xd9\x14\x0c\x08\x75\xa3\x22\x12\x51\xa8\x0b\xad\xd4\x6c\x44\x5c\x99\x84\x90\x5b\x68\x16\x13\x5b\xab\xa6\xa9\x48\x6f\xf2\xbe\xdb\xc8\x34\x93\xc9\x34\xe0\x5e\xe8\x2e\xef\xf7\xde\x97\x79\x5f\xfe\x3c\xba\x0e\x1f\xfa\xdc\x63\x3c\x9c\x9e\x1f\xec\xf3\x81\xeb\x70\x3f\x7c\xb8\xe7\x1e\x3b\x75\x1d\x87\x43\x52\x8c\x02\x0b\x7c\x2a\x4b\x6b\x89\x39\xa5\x94\x53\x6a\xb1\x79\xc3\x21\xf1\x62\xd1\x02\x15\x4a\xac\x7b\xf4\x83\x12\xca\x51\x5b\xe4\x9d\x12\xac\xf1\x66\x91\x1a\x25\x66\xdc\x75\xce\x54\xa3\xd1\x78\x32\x8d\xfe\x5f\xad\x60\x72\x1b\x8d\x4d\x27\x7a\x86\xa4\x94\x32\x4a\x59\x73\xf4\x46\xe6\x7a\x78\x85\x12\x12\x3f\x5a\xbd\xe2\x0b\x12\x55\xa3\x54\x0a\xb5\x4a\x1b\x9d\x75\x2e\x16\x58\x52\x8e\xaa\xf3\x31\xa3\x84\x32\x2c\xcd\xbd\x4a\x7d\x55\x50\x4e\x31\x65\x14\xb7\x39\xac\x50\xa9\x07\xd2\x14\x2a\xfe\xb6\xb6\xba\xf6\x17\x69\xe6\xec\x57\xb1\x8b\x8b\xef\x05\x87\x81\xf9\xfc\xbe\xf5\x40\xd2\xba\x7e\x18\x5d\xa9\xbf\x48\x5c\x32\x71\x31\x60\xe2\x98\xb7\xf4\xe8\xfa\x66\xdb\x61\xe2\x84\x89\xa1\x27\x02\x4f\x8c\x98\xb8\xdb\x04\x4d\xa8\xb3\x0c\xd7\x07\xf3\xde\x98\xfb\xf4\x1b\x00\x00\xff\xff\x0b\x93\x9c\x74\xbc\x03\x00\x00")
-
-func ar_lbJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_lbJson,
-		"ar_LB.json",
-	)
-}
-
-func ar_lbJson() (*asset, error) {
-	bytes, err := ar_lbJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_LB.json", size: 956, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_lyJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\xcd\x4a\xf3\x40\x14\x5d\x27\x4f\x31\x0c\xcc\xae\x4f\x90\xdd\x07\xdd\x14\xbe\x80\x50\x37\x2a\x22\x15\x0b\x75\xa1\x95\x9a\x8d\x88\x8b\x62\x53\xc2\xbc\x85\x64\x91\xda\xf4\x87\x10\x44\xea\x93\x9c\xfb\x36\x32\xe9\xcc\xe4\xa6\xb8\xba\xdc\x73\xce\xdc\x39\xe7\xce\xbc\x86\x81\x1c\xf4\x65\x24\xe4\x68\x76\xf3\xff\x42\xf6\xc2\x40\xf6\x47\x2f\xcf\x32\x12\x57\x61\x10\x48\x14\xb4\x40\x8e\x2d\xf6\x86\xb2\x7d\x81\x92\x96\xa4\x69\xc9\xb0\xf2\x88\xa3\xc0\x07\x43\x73\x54\x58\xe1\x70\x82\xee\x28\x25\x8d\x9a\x21\x1b\x4a\x71\xc0\x27\x43\x6a\xac\xb0\x96\x61\x70\x6d\x1c\x0d\x27\xd3\x59\xd2\xb1\xb5\x3d\x4a\xbd\x85\xd2\xd6\xca\xd6\x9d\xad\x1b\x5b\x6b\x37\x2a\x9e\x3e\x26\x13\x3f\xc7\x84\x40\x41\xda\x9d\xa3\x39\x56\xa8\x3a\x48\x8a\x02\x95\x37\x9b\x1b\x9e\x34\x2d\x5a\x96\x34\x65\xb6\xd3\x94\x35\x8b\xe1\xfd\xa2\xed\x91\xe3\x07\x35\xbe\xfd\xb4\x26\x24\xa5\x66\xa6\x53\xd0\x3b\xd6\x94\xb5\x08\x2d\x29\xa3\x79\x47\xb3\x37\xcb\xb3\x08\x5f\xd0\x5f\xd1\x78\x2c\x1e\x88\xc7\xe1\x51\x78\x10\x1e\x82\x07\xe0\xe6\xb9\x6d\x6e\x98\x5b\x75\x26\xff\xc5\x67\xb1\x7f\xc0\x2f\x77\xab\x63\xfb\xa3\x64\x6c\xfe\xa1\xba\x13\xea\xb6\x27\x94\xfb\x8b\xc9\xf8\xfc\xfe\xa1\xcb\x08\x75\x29\xd4\x20\x52\x71\xa4\x86\x42\x3d\x35\x42\x2f\x6a\x29\x8f\xdb\x8b\xe5\xc9\xb1\xf0\xed\x37\x00\x00\xff\xff\x2b\x37\x88\x8a\xfe\x02\x00\x00")
-
-func ar_lyJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_lyJson,
-		"ar_LY.json",
-	)
-}
-
-func ar_lyJson() (*asset, error) {
-	bytes, err := ar_lyJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_LY.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_maJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xc3\x40\x10\xc6\xcf\xc9\x53\x2c\x0b\x7b\xeb\x13\xe4\x56\xe8\xa5\x87\x80\x50\x2f\x2a\x22\x15\x85\x7a\xd0\x4a\xed\x45\xc4\x43\xb1\x29\x61\xdf\x42\x72\x48\x6c\xfa\x87\x10\x44\xea\x93\x7c\xf3\x36\xb2\xe9\xee\x66\x52\x3c\x0d\xf3\xfb\x66\x67\xbf\x6f\x93\xb7\x30\x90\xc3\x81\x8c\x84\x1c\xcf\x6e\xe2\xbe\xec\x85\x81\x1c\x8c\x5f\x5f\x64\x24\xae\xc2\x20\x90\xc8\x69\x89\x0c\x5b\xec\x8d\x64\xfb\x1c\x25\xad\x48\xd3\x8a\xb1\xf2\xc8\x91\xe3\x93\xd1\x0c\x15\x0a\x1c\x4e\xe8\x8e\x12\xd2\xa8\x19\xd9\x50\x82\x03\xbe\x18\xa9\x51\x60\x2d\xc3\xe0\xda\x38\x1a\x4d\xa6\xb3\x79\xc7\xd6\xf6\x38\xea\x2d\x94\xb6\x56\xb6\xee\x6c\xdd\xd8\x5a\xbb\x55\xf1\xf4\x69\x3e\xf1\x7b\x4c\x08\xe4\xa4\xdd\x39\x5a\xa0\x40\xd5\x21\x09\x72\x54\xde\x6c\x66\x74\xd2\xb4\x6c\x55\xd2\x94\xda\x4e\x53\xda\x3c\x0c\xef\x97\x6d\x8f\x0c\xbf\xa8\xf1\xe3\xb7\x35\x21\x29\x31\x3b\xdd\x04\x7d\x60\x4d\x69\x4b\x68\x45\x29\x2d\x3a\x33\x7b\xf3\x78\x96\xf0\x07\xfa\x2f\x1a\x8f\xc5\x03\xf1\x38\x3c\x0a\x0f\xc2\x43\xf0\x00\xdc\x3c\xb7\xcd\x0d\x73\xab\xce\x64\x3f\x3e\x8b\xfd\x07\xfc\x76\xb7\x3a\x75\x30\x9e\xdf\x9b\xff\x50\xdd\x09\x75\xdb\x13\xea\x42\x3a\x7a\xfe\xf0\xd8\x55\x84\xba\x14\x6a\x18\xa9\x38\x52\x23\xa1\x9e\x9b\x41\x3f\xd4\x4a\x9e\xdb\x8b\xe5\xc9\xb1\xf0\xfd\x2f\x00\x00\xff\xff\xa3\x3a\xf9\x47\xfe\x02\x00\x00")
-
-func ar_maJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_maJson,
-		"ar_MA.json",
-	)
-}
-
-func ar_maJson() (*asset, error) {
-	bytes, err := ar_maJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_MA.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_omJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xc3\x40\x10\xc6\xcf\xc9\x53\x2c\x0b\x7b\xeb\x13\xe4\x26\xf4\xd2\x43\x50\xa8\x17\x15\x91\x8a\x42\x3d\x68\xa5\xf6\x22\xe2\xa1\xd8\x94\xb0\x6f\x21\x39\x24\x36\xfd\x43\x08\x22\xf5\x49\xbe\x79\x1b\xd9\x74\x77\x33\x29\x9e\x86\xf9\x7d\xb3\xb3\xdf\xb7\xc9\x5b\x18\xc8\x41\x5f\x46\x42\x8e\xa6\x37\xa7\xb1\xec\x85\x81\xec\x8f\x5e\x5f\x64\x24\xae\xc2\x20\x90\xc8\x69\x81\x0c\x1b\xec\x8c\x64\xfb\x1c\x25\x2d\x49\xd3\x92\xb1\xf2\xc0\x91\xe3\x93\xd1\x0c\x15\x0a\xec\x8f\xe8\x96\x12\xd2\xa8\x19\x59\x53\x82\x3d\xbe\x18\xa9\x51\x60\x25\xc3\xe0\xda\x38\x1a\x8e\x27\xd3\x59\xc7\xd6\xe6\x30\xea\x2d\x94\xb6\x56\xb6\x6e\x6d\x5d\xdb\x5a\xbb\x55\xf1\xe4\x69\x36\xf6\x7b\x4c\x08\xe4\xa4\xdd\x39\x9a\xa3\x40\xd5\x21\x09\x72\x54\xde\x6c\x66\x74\xd2\xb4\x68\x55\xd2\x94\xda\x4e\x53\xda\x3c\x0c\xef\x17\x6d\x8f\x0c\xbf\xa8\xf1\xe3\xb7\x35\x21\x29\x31\x3b\xdd\x04\x7d\x60\x45\x69\x4b\x68\x49\x29\xcd\x3b\x33\x3b\xf3\x78\x96\xf0\x07\xfa\x2f\x1a\x8f\xc5\x03\xf1\x38\x3c\x0a\x0f\xc2\x43\xf0\x00\xdc\x3c\xb7\xcd\x0d\x73\xab\xce\xe4\x49\x7c\x16\xfb\x0f\xf8\xed\x6e\x75\x6a\x7f\x34\xbb\x37\xff\xa1\xba\x13\xea\xb6\x27\xd4\x85\x74\xf4\xfc\xe1\xb1\xab\x08\x75\x29\xd4\x20\x52\x71\xa4\x86\x42\x3d\x37\x83\x7e\xa8\x95\x3c\xb7\x17\xcb\xa3\x63\xe1\xfb\x5f\x00\x00\x00\xff\xff\x81\x36\xa0\x49\xfe\x02\x00\x00")
-
-func ar_omJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_omJson,
-		"ar_OM.json",
-	)
-}
-
-func ar_omJson() (*asset, error) {
-	bytes, err := ar_omJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_OM.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_qaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x0c\x03\xb3\xeb\x13\x64\x57\xe8\xa6\x8b\xc0\xf7\x51\x37\x2a\x22\x15\x85\xba\xd0\x4a\xed\x46\xc4\x45\xb1\x29\x61\xde\x42\xb2\x48\x6c\xfa\x87\x10\x44\xea\x93\x9c\xfb\x36\x32\xe9\xcc\xe4\xa6\xb8\xba\xdc\xdf\xb9\x73\xe7\x9c\x49\x5e\xc3\x40\x0e\x07\x32\x12\x72\x3c\xbb\xfe\xdf\x97\xbd\x30\x90\x83\xf1\xcb\xb3\x8c\xc4\x65\x18\x04\x12\x39\x2d\x91\x61\x8b\xbd\x91\x6c\x9f\xa3\xa4\x15\x69\x5a\x31\x56\x1e\x39\x72\x7c\x30\x9a\xa1\x42\x81\xc3\x09\xdd\x51\x42\x1a\x35\x23\x1b\x4a\x70\xc0\x27\x23\x35\x0a\xac\x65\x18\x5c\x19\x47\xa3\xc9\x74\x36\xef\xd8\xda\x1e\x47\xbd\x85\xd2\xd6\xca\xd6\x9d\xad\x1b\x5b\x6b\xb7\x2a\x9e\x3e\xce\x27\x7e\x8f\x09\x81\x9c\xb4\x3b\x47\x0b\x14\xa8\x3a\x24\x41\x8e\xca\x9b\xcd\x8c\x4e\x9a\x96\xad\x4a\x9a\x52\xdb\x69\x4a\x9b\x87\xe1\xfd\xb2\xed\x91\xe1\x07\x35\xbe\xfd\xb6\x26\x24\x25\x66\xa7\x9b\xa0\x77\xac\x29\x6d\x09\xad\x28\xa5\x45\x67\x66\x6f\x1e\xcf\x12\xfe\x40\x7f\x45\xe3\xb1\x78\x20\x1e\x87\x47\xe1\x41\x78\x08\x1e\x80\x9b\xe7\xb6\xb9\x61\x6e\xd5\x99\xec\xc7\xff\x62\xff\x01\xbf\xdc\xad\x4e\x1d\x8c\xe7\x77\xe6\x3f\x54\xb7\x42\xdd\xf4\x84\x3a\x97\x8e\x9e\xdd\x3f\x74\x15\xa1\x2e\x84\x1a\x46\x2a\x8e\xd4\x48\xa8\xa7\x66\xd0\x0f\xb5\x92\xe7\xf6\x62\x79\x72\x2c\x7c\xfb\x0d\x00\x00\xff\xff\x9a\x12\x01\x60\xfe\x02\x00\x00")
-
-func ar_qaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_qaJson,
-		"ar_QA.json",
-	)
-}
-
-func ar_qaJson() (*asset, error) {
-	bytes, err := ar_qaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_QA.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_saJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\xd1\x6a\xa3\x50\x14\x7c\xd6\xaf\x90\x0b\xbe\xed\x17\xf8\xe6\x22\x81\x0d\xb8\xbb\x60\x60\x59\x96\xa5\xd8\x54\x9a\xd0\x36\x06\xab\x85\x50\x0a\x42\x8d\x04\xff\xa2\x95\xa2\x4d\x9a\xb6\x26\x81\x92\x3f\x99\xf3\x37\xc5\xdc\x7b\x13\x23\xf4\x6d\xce\xcc\x78\xee\x9c\xc1\x5b\x55\x61\x3f\x2c\x66\x68\xcc\x0d\x4e\x1c\x93\x7d\x53\x15\x66\xb9\x93\x6b\x66\x68\xff\x54\x45\x61\x28\x28\x41\x8e\x25\xde\x6a\x49\xcc\x4f\x58\x50\x4a\x19\xa5\x0d\x6e\x41\x09\x0a\x2c\x50\xe0\xa1\xc1\xe6\xa8\x50\x62\xdb\x62\x5f\x69\x4a\x19\xd6\x0d\xe6\x85\xa6\xd8\x52\x8c\xe7\x06\xb7\x46\x89\x39\x53\x95\xff\x75\x26\x67\xe0\x07\x61\x33\x98\x13\x8d\xb8\xd7\xf6\x05\xe8\x45\x1e\x07\x7f\xbc\x33\xc1\x0c\x22\x0e\x3a\xc1\x90\x03\xc7\x0d\xe5\x46\xdb\x1f\x85\x83\xfd\x3a\xba\x47\x41\x29\xcd\x28\xd5\xf8\x39\xbb\x31\x13\x71\x36\x28\x51\xe0\x43\x4c\x8f\x78\x47\x81\x8a\x4f\xb5\x0b\x6b\x8a\x6b\xbf\xd0\x73\xca\x0e\x3a\x96\x58\x51\x86\xaa\xa1\xcf\x29\xa6\x29\xc5\x34\xc3\x6a\xbf\xb1\x3c\x7c\x4b\x09\xcd\x28\x91\x5e\x6c\x50\xd5\x55\xf3\x58\xf9\xd7\xd2\x51\xe2\xf6\x39\xfc\xbb\x66\x97\xc7\xe7\x77\x5d\x51\x62\xc7\x3b\x15\xb5\xba\x01\x07\xe6\x38\x90\xcc\x84\x83\xae\xac\xbe\x1b\x5d\x0a\x4f\x74\x2e\xfa\xf5\xc6\x1c\xfc\xea\x87\x1c\xfc\xf4\x6f\x38\xb0\xbc\xbe\x0c\x60\xda\xbf\x6d\xf9\x32\x17\xa5\x62\xb9\xa1\x57\xff\x8c\xba\xa9\xe9\x9e\xa6\x7f\xd7\xf4\xbf\x4c\xf2\xbd\xe1\x55\x5b\xd3\xf4\x0b\x43\xb7\x0d\xdd\xd9\x99\xf6\x86\x36\x29\xde\x3b\x08\xea\xdd\x67\x00\x00\x00\xff\xff\xc1\x4d\xd8\x03\xf8\x02\x00\x00")
-
-func ar_saJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_saJson,
-		"ar_SA.json",
-	)
-}
-
-func ar_saJson() (*asset, error) {
-	bytes, err := ar_saJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_SA.json", size: 760, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_sdJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x0c\x03\xb3\xeb\x13\x64\xf7\x41\x36\x5d\x04\x3e\xa8\x1b\x15\x91\x8a\x42\x5d\x68\xa5\x76\x23\xe2\xa2\xd8\x94\x30\x6f\x21\x59\x24\x36\xfd\x43\x08\x22\xf5\x49\xce\x7d\x1b\x99\x74\x66\x72\x53\x5c\x5d\xee\xef\xdc\xb9\x73\xce\x24\xaf\x61\x20\x87\xb1\x8c\x84\x1c\xcf\xae\x47\xb1\x1c\x84\x81\x8c\xc7\x2f\xcf\x32\x12\x97\x61\x10\x48\x14\xb4\x44\x8e\x2d\xf6\x46\xb2\x7d\x81\x8a\x56\xa4\x69\xc5\x58\x75\xe4\x28\xf0\xc1\x68\x8e\x1a\x25\x0e\x27\x74\x47\x29\x69\x34\x8c\x6c\x28\xc5\x01\x9f\x8c\x34\x28\xb1\x96\x61\x70\x65\x1c\x8d\x26\xd3\xd9\xbc\x67\x6b\x7b\x1c\xf5\x16\x2a\x5b\x6b\x5b\x77\xb6\x6e\x6c\x6d\xdc\xaa\x64\xfa\x38\x9f\xf8\x3d\x26\x04\x0a\xd2\xee\x1c\x2d\x50\xa2\xee\x91\x14\x05\x6a\x6f\x36\x37\x3a\x69\x5a\x76\x2a\x69\xca\x6c\xa7\x29\x6b\x1f\x86\xf7\xcb\xae\x47\x8e\x1f\x34\xf8\xf6\xdb\xda\x90\x94\x9a\x9d\x6e\x82\xde\xb1\xa6\xac\x23\xb4\xa2\x8c\x16\xbd\x99\xbd\x79\x3c\x4b\xf8\x03\xfd\x15\x8d\xc7\xe2\x81\x78\x1c\x1e\x85\x07\xe1\x21\x78\x00\x6e\x9e\xdb\xe6\x86\xb9\x55\x67\xf2\x5f\xf2\x3f\xf1\x1f\xf0\xcb\xdd\xea\xd4\x78\x3c\xbf\x33\xff\xa1\xba\x15\xea\x66\x20\xd4\xb9\x74\xf4\xec\xfe\xa1\xaf\x08\x75\x21\xd4\x30\x52\x49\xa4\x46\x42\x3d\xb5\x83\x7e\xa8\x93\x3c\xb7\x17\xcb\x93\x63\xe1\xdb\x6f\x00\x00\x00\xff\xff\x6c\x71\x92\xb6\xfe\x02\x00\x00")
-
-func ar_sdJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_sdJson,
-		"ar_SD.json",
-	)
-}
-
-func ar_sdJson() (*asset, error) {
-	bytes, err := ar_sdJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_SD.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_syJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x53\xcd\x4a\xf3\x40\x14\x5d\x27\x4f\x31\x0c\xcc\xae\x4f\x90\xdd\x07\xd9\x74\x11\xf8\x20\x6e\x54\x44\x22\x16\xea\x42\x2b\x35\x1b\x11\x57\x26\x21\xe4\x2d\x34\x8b\x89\xad\x55\xd3\x54\xa4\x6f\x72\xee\xdb\xc8\x34\x93\xe9\x24\xe0\x52\x74\x97\xf3\x73\x33\xe7\x4c\x72\xef\x5c\x87\x8f\x7d\xee\x31\x1e\xcd\x4f\xc3\x43\x3e\x72\x1d\xee\x47\xb7\x37\xdc\x63\xc7\xae\xe3\x70\x48\x4a\x50\x62\x85\x37\x25\x69\x2c\xb1\xa4\x8c\x0a\xca\x2c\x6e\xd9\xf2\x90\x78\xb4\xd8\x12\x35\x2a\x6c\x07\xec\x2b\xa5\x54\xa0\xb1\x98\x17\x4a\xb1\xc5\xb3\xc5\x34\xa8\xb0\xe0\xae\x73\xa2\x12\x85\xd3\xd9\x3c\xfe\x7b\xb1\x82\xd9\x55\x3c\x35\x99\xe8\x01\x92\x32\xca\x29\x63\xed\xd1\x3b\x58\xe8\xe1\x0d\x2a\x48\x7c\x6a\xf4\x84\x77\x48\xd4\x2d\x52\x2e\x34\xca\x6d\x70\xae\xd4\x3d\x83\x15\xd6\x54\x74\x7e\x2c\x28\xa5\x1c\x6b\xf3\xae\x4a\x3f\x95\x54\x50\x42\x39\x25\x9d\x0f\x1b\xd4\xea\x42\xda\x40\xe5\xf7\x52\x2f\xeb\xb0\x48\x3b\x67\x7f\x8a\x1f\x2e\xde\x2f\x6d\x5d\xc3\x6f\x16\xff\x17\xfc\x0f\xcc\xef\xf7\xa1\x07\xd2\x4e\xf5\xa3\x78\xa2\xb6\x48\x9c\x33\x71\x36\x62\xa2\xdb\xa4\x78\x72\x70\x71\xd9\x57\x98\x38\x62\x62\xec\x89\xc0\x13\x21\x13\xd7\x3b\xa3\x31\xed\x25\xc3\xeb\x83\xf9\x60\xcc\xbd\xff\x0a\x00\x00\xff\xff\x50\x5f\x27\xcc\xbc\x03\x00\x00")
-
-func ar_syJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_syJson,
-		"ar_SY.json",
-	)
-}
-
-func ar_syJson() (*asset, error) {
-	bytes, err := ar_syJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_SY.json", size: 956, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_tnJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x0c\x03\xb3\xeb\x13\x64\xf7\x41\x37\x5d\xe4\x43\x68\x37\x2a\x22\x15\x85\xba\xd0\x4a\xed\x46\xc4\x45\xb1\x29\x61\xde\x42\xb2\x48\x6c\xfa\x87\x10\x44\xea\x93\x9c\xfb\x36\x32\xe9\xcc\xe4\xa6\xb8\xba\xdc\xdf\xb9\x73\xe7\x9c\x49\x5e\xc3\x40\x0e\xfa\x32\x12\x72\x3c\xbb\x1e\xfd\x97\xbd\x30\x90\xfd\xf1\xcb\xb3\x8c\xc4\x65\x18\x04\x12\x39\x2d\x91\x61\x8b\xbd\x91\x6c\x9f\xa3\xa4\x15\x69\x5a\x31\x56\x1e\x39\x72\x7c\x30\x9a\xa1\x42\x81\xc3\x09\xdd\x51\x42\x1a\x35\x23\x1b\x4a\x70\xc0\x27\x23\x35\x0a\xac\x65\x18\x5c\x19\x47\xc3\xc9\x74\x36\xef\xd8\xda\x1e\x47\xbd\x85\xd2\xd6\xca\xd6\x9d\xad\x1b\x5b\x6b\xb7\x2a\x9e\x3e\xce\x27\x7e\x8f\x09\x81\x9c\xb4\x3b\x47\x0b\x14\xa8\x3a\x24\x41\x8e\xca\x9b\xcd\x8c\x4e\x9a\x96\xad\x4a\x9a\x52\xdb\x69\x4a\x9b\x87\xe1\xfd\xb2\xed\x91\xe1\x07\x35\xbe\xfd\xb6\x26\x24\x25\x66\xa7\x9b\xa0\x77\xac\x29\x6d\x09\xad\x28\xa5\x45\x67\x66\x6f\x1e\xcf\x12\xfe\x40\x7f\x45\xe3\xb1\x78\x20\x1e\x87\x47\xe1\x41\x78\x08\x1e\x80\x9b\xe7\xb6\xb9\x61\x6e\xd5\x99\xfc\x17\x9f\xc5\xfe\x03\x7e\xb9\x5b\x9d\xda\x1f\xcf\xef\xcc\x7f\xa8\x6e\x85\xba\xe9\x09\x75\x2e\x1d\x1d\xdd\x3f\x74\x15\xa1\x2e\x84\x1a\x44\x2a\x8e\xd4\x50\xa8\xa7\x66\xd0\x0f\xb5\x92\xe7\xf6\x62\x79\x72\x2c\x7c\xfb\x0d\x00\x00\xff\xff\xba\x41\x54\x39\xfe\x02\x00\x00")
-
-func ar_tnJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_tnJson,
-		"ar_TN.json",
-	)
-}
-
-func ar_tnJson() (*asset, error) {
-	bytes, err := ar_tnJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_TN.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ar_yeJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x0c\x03\xb3\xeb\x13\x64\xf7\x41\xbf\x45\x17\x01\xa1\x6e\xaa\x88\x54\x14\xea\x42\x2b\xb5\x1b\x11\x17\xc5\xa6\x84\x79\x0b\xc9\x22\xb1\xe9\x1f\x42\x10\xa9\x4f\x72\xee\xdb\xc8\xa4\x33\x93\x9b\xe2\xea\x72\x7f\xe7\xce\x9d\x73\x26\x79\x0d\x03\x39\xe8\xcb\x48\xc8\xf1\xec\x7a\xf4\x5f\xf6\xc2\x40\xf6\xc7\x2f\xcf\x32\x12\x97\x61\x10\x48\xe4\xb4\x44\x86\x2d\xf6\x46\xb2\x7d\x8e\x92\x56\xa4\x69\xc5\x58\x79\xe4\xc8\xf1\xc1\x68\x86\x0a\x05\x0e\x27\x74\x47\x09\x69\xd4\x8c\x6c\x28\xc1\x01\x9f\x8c\xd4\x28\xb0\x96\x61\x70\x65\x1c\x0d\x27\xd3\xd9\xbc\x63\x6b\x7b\x1c\xf5\x16\x4a\x5b\x2b\x5b\x77\xb6\x6e\x6c\xad\xdd\xaa\x78\xfa\x38\x9f\xf8\x3d\x26\x04\x72\xd2\xee\x1c\x2d\x50\xa0\xea\x90\x04\x39\x2a\x6f\x36\x33\x3a\x69\x5a\xb6\x2a\x69\x4a\x6d\xa7\x29\x6d\x1e\x86\xf7\xcb\xb6\x47\x86\x1f\xd4\xf8\xf6\xdb\x9a\x90\x94\x98\x9d\x6e\x82\xde\xb1\xa6\xb4\x25\xb4\xa2\x94\x16\x9d\x99\xbd\x79\x3c\x4b\xf8\x03\xfd\x15\x8d\xc7\xe2\x81\x78\x1c\x1e\x85\x07\xe1\x21\x78\x00\x6e\x9e\xdb\xe6\x86\xb9\x55\x67\xf2\x5f\x7c\x16\xfb\x0f\xf8\xe5\x6e\x75\x6a\x7f\x3c\xbf\x33\xff\xa1\xba\x15\xea\xa6\x27\xd4\x48\x3a\x7a\x7e\xff\xd0\x55\x84\xba\x10\x6a\x10\xa9\x38\x52\x43\xa1\x9e\x9a\x41\x3f\xd4\x4a\x9e\xdb\x8b\xe5\xc9\xb1\xf0\xed\x37\x00\x00\xff\xff\xc1\x50\x7f\xd6\xfe\x02\x00\x00")
-
-func ar_yeJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ar_yeJson,
-		"ar_YE.json",
-	)
-}
-
-func ar_yeJson() (*asset, error) {
-	bytes, err := ar_yeJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ar_YE.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _as_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x94\xbf\x4a\x33\x41\x14\xc5\xeb\xdd\xa7\x18\x06\xa6\x4b\xf2\x00\xe9\xbe\x8f\x34\x29\x56\x84\xd8\x44\x11\x49\x11\x88\x45\x8c\x98\x34\x22\x82\x7f\xd0\x14\x12\x2c\x44\xc4\x10\x08\x42\xb8\xb3\xc4\x75\x89\x29\xd4\x34\xe6\x55\xee\xa3\xc8\xdc\xcc\x6c\x66\x77\x47\x5b\xb1\x09\x37\xe7\xdc\x3b\xfc\xce\x09\xe4\xc4\xf7\x78\xb5\xc2\xcb\x8c\x37\xba\x7b\xd5\x0d\x5e\xf0\x3d\x5e\x69\x1c\x77\x79\x99\xed\xf8\x9e\xc7\x11\x00\x65\x1f\xe1\x0e\x21\x42\xf8\x44\x39\x53\x2b\x4a\xff\x40\x79\x83\x10\xe7\xf4\x18\xe1\x11\xe5\x00\xe1\x01\x61\x9e\x73\x23\x94\xe7\x08\xd2\xa5\x5f\x22\x2c\x10\xde\xe9\x76\x8a\x30\x41\x58\xe6\xd6\xde\xe8\xfc\x5e\xed\xc8\x99\xc3\x85\x30\x75\xe5\x7b\xbb\x2a\x50\xad\xd5\x39\xea\x39\x53\x65\xc2\x38\x33\x64\xd0\x7f\x26\x76\x82\x66\xf8\x0c\x56\xd0\x39\xe8\xb5\x2c\xa6\x91\xa2\x86\x50\x5d\xca\xd7\x55\x02\x94\x67\xe6\xf8\x99\x88\x23\x1d\xdd\xbd\x13\x1b\x65\x80\x30\x34\xe2\xad\x82\xd3\x85\x2d\xad\x3c\x31\xca\xbe\x99\x47\x84\x1b\xa6\xbf\xce\x89\x27\xd9\xb9\xa6\x3a\x56\x69\xc7\x46\x1c\x12\x15\xbd\x0f\x13\x9a\x63\x9a\x23\x2b\xf6\x95\x2e\x02\xc6\xd4\xb2\x6d\x85\x08\x2f\xdf\x5c\x3d\x11\xed\x30\xeb\xda\x3f\xe9\x1f\x2c\xf0\xb7\x7b\xfb\x17\x6c\x06\xeb\xc2\xa6\x28\x2f\x4c\xdc\x48\x7f\x2a\xe4\x05\xcd\xa1\x05\x32\xa5\xf4\x96\xa5\xdf\xab\x34\x7a\x4d\xf5\xef\x21\x9a\x45\xd1\x2e\x8a\x3a\x37\xe2\xd6\x7e\x5b\x1b\x4c\xfc\x2f\x30\x51\x67\xa2\x5a\x12\x41\x49\xd4\x98\x38\x64\x62\x9b\x16\x93\xa5\xb5\x95\xe8\x9a\x34\xe5\xf9\xa7\x5f\x01\x00\x00\xff\xff\xc4\xff\x55\xf9\xb0\x04\x00\x00")
-
-func as_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_as_inJson,
-		"as_IN.json",
-	)
-}
-
-func as_inJson() (*asset, error) {
-	bytes, err := as_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "as_IN.json", size: 1200, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ast_esJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x10\x86\xcf\xe9\x53\x84\x81\xdc\x84\xbd\xef\x4d\xa8\x07\x0f\x05\x61\x7b\x51\x11\x99\x6c\xe3\x36\xd0\x24\x92\xa4\xcb\x56\xf1\x61\x3c\xfa\x1c\xfb\x62\x52\x9b\x99\xe2\xee\xed\xff\x67\xc2\xff\x7f\x53\xfa\x59\x09\xb8\xaf\x61\x2b\x01\x53\x7e\xbd\xdb\xc1\x4d\x25\xa0\xc6\x29\xc1\x56\x3e\x57\x42\x40\x17\x9c\xf5\x87\x71\x9e\x0b\x18\x86\xd1\x9b\xb4\x68\x87\x31\xb3\xb6\xe7\x9f\xb8\x0f\x03\xf9\xd3\x68\x8e\xa4\x8f\xd6\xf8\x48\x26\x9d\xbf\x35\x76\x23\x54\xe2\x65\x2e\xda\xf5\x21\xe6\x8b\x36\x6e\xe2\x9a\xb5\x83\xd3\x39\x7a\x8d\xa5\xcc\x26\xf8\xdc\x73\xe0\xc9\x7a\x13\x4b\xd4\x9b\xd1\x91\x8d\xc3\xf8\x51\x24\xea\x68\x07\x9a\x4e\x23\x95\xf8\x55\x99\x4c\x4f\x0f\x21\x91\x4e\x26\x5b\xe3\x74\x2c\x0c\x61\xdf\x07\x36\xef\x38\x21\x1f\x8d\xf3\x27\xc8\xff\x8f\xbe\xa2\x64\xc4\x8b\xb3\x51\x47\x46\x63\x9e\x22\x7c\x66\x2a\x46\x62\x18\x06\x61\x08\x02\xb8\x6d\x1e\x1a\x6a\x5e\x96\xb4\xa9\x31\x9b\xf9\x5f\x50\xdd\x46\xb9\x8d\x9a\x80\x86\xad\x75\xcb\x02\xa5\xea\xa4\xd2\x52\x3d\x4a\xd5\x4a\xf5\xf4\xf7\x82\xb7\x2d\xdb\xd2\x01\x50\x7d\xfd\x06\x00\x00\xff\xff\x8f\x88\xf4\xf7\x63\x02\x00\x00")
-
-func ast_esJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ast_esJson,
-		"ast_ES.json",
-	)
-}
-
-func ast_esJson() (*asset, error) {
-	bytes, err := ast_esJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ast_ES.json", size: 611, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _az_azJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xb1\x4e\xc3\x30\x18\x84\xe7\xe4\x29\x2c\x4b\xde\xaa\x3e\x40\xb7\xa0\x08\x89\x21\x80\xd4\x2e\x01\x21\xf4\x87\x18\x1a\x85\x38\xad\xe3\x46\x75\x11\x0f\x92\xa9\x23\x2b\xec\xd9\xd2\x0e\x79\x2b\x14\xfc\xdb\x0d\xd0\xed\xf3\x9d\x7d\x77\x91\xf2\xe6\x7b\xf4\x2a\xa4\x33\x42\x61\xf7\x18\xdc\xd1\x89\xef\xd1\x10\x74\x45\x67\xe4\xde\xf7\x3c\x9a\xc0\x0e\x24\x79\xe9\x5a\xd1\xb5\x83\x69\x15\x2e\x55\xdf\x54\x99\x91\xba\x8f\xbe\x91\xc7\x7d\xdf\x88\xa4\x6f\x08\x6c\x8f\x7b\x28\x0e\x5f\x67\x3c\x23\x3d\x75\x6d\xf1\xef\x9e\x11\x0d\xbb\xeb\xbe\xf7\x30\x0c\x9a\x2f\x4b\xa9\xfe\xac\xc2\x31\x5c\xda\x1a\xd8\x8e\x0a\x31\xd3\x6a\x43\xb8\x8d\x16\x89\x8d\x8d\x4a\xa1\x96\x2e\x53\x83\xa8\x01\x1f\x3e\xf3\x5a\xc2\xab\xe1\x02\xa4\x32\x04\x2b\xc9\x9d\xa8\x0d\x64\x7a\x23\x1c\xa1\x09\xf5\x7a\x53\xe1\x9b\x8a\x0b\xa5\x21\xc1\xdc\x32\x1f\x1d\x44\x79\xe2\x94\xe7\x03\x8f\xbf\xf7\xf7\xba\x18\xb0\xe6\x92\xd7\x06\x22\x3b\x36\x58\x49\xab\xe0\xa8\xc3\xa7\x16\x8e\x70\x54\x50\xaf\x0d\xcc\x39\x7a\x37\x39\x6e\xbc\x2e\xf1\x5d\xc8\x73\x3b\x21\x88\x6e\x23\xdb\x6d\x4c\xeb\x84\xa0\xf8\xf0\xbf\xb0\x74\xca\x8a\x29\x8b\xa9\x15\x17\x59\x61\x8c\x60\x42\x58\x4a\xd8\x05\x61\x31\x61\x8b\x1f\xdf\x79\xa7\x23\x36\x50\xea\xbf\x7f\x07\x00\x00\xff\xff\x8e\xf0\x87\x10\x85\x02\x00\x00")
-
-func az_azJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_az_azJson,
-		"az_AZ.json",
-	)
-}
-
-func az_azJson() (*asset, error) {
-	bytes, err := az_azJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "az_AZ.json", size: 645, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _be_byJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\x4f\x4b\x02\x41\x1c\x3d\xef\x7e\x8a\x65\x60\x6f\xe1\x07\xf0\x56\x78\xe9\x20\x04\x79\x91\x88\x50\x14\xec\x60\x42\xed\x25\x22\x58\x57\xb0\x83\x96\xe1\x61\x0f\x4a\x85\x5d\xbc\x4e\xa2\x06\xc6\xea\x57\x78\xef\x1b\xc5\xac\xbb\xb3\xb3\x7a\x9c\xf7\xe6\xf7\x7b\x7f\x66\x9e\x6c\x4b\x9c\x97\x44\xd1\x11\xf5\xe6\xcd\x59\x55\x9c\xd8\x96\x28\xd5\x1e\x1f\x44\xd1\xb9\xb2\x2d\x4b\xe0\x83\x23\x2c\xf1\x8b\x15\xfe\x38\x52\xb4\x25\xf0\x05\x89\x28\xc3\x21\xb1\x49\x98\x77\x7e\x32\xc0\x96\xbe\x81\xcd\xb0\x8a\xcf\x4b\xc8\x04\x99\x43\xb2\x8f\x85\xc2\xd3\x8d\x1c\x31\x40\xc4\x90\x7d\x7d\x6b\xc6\x1e\x7e\xb0\x65\x00\x29\x6c\xeb\x5a\x39\xbb\x6c\x75\xee\xbd\x63\x7b\xa6\x2d\xd3\x88\x5e\xe4\xeb\x3b\x73\x25\x6c\x8a\x9a\x62\xa9\x4c\xb9\x73\xe7\xb5\x32\x8d\x19\x03\xf6\x92\xb0\x11\x87\xc9\xc4\x94\x6f\x0c\x38\xd0\x21\x25\x36\x90\x58\x30\xd4\xc1\x27\x2a\x36\xbb\x07\xe8\x77\x5c\xc6\x22\xb7\x6b\xce\x57\xfa\x07\xd8\x94\x21\x76\x39\x24\x54\x05\x1d\xdc\x1a\xef\xcb\x65\x37\x87\x4e\x14\xc2\x80\x3e\x07\x7c\x89\xa7\x36\xd9\x56\xc5\x40\x62\xa7\x9e\x44\xbb\x8f\xb0\xc2\x3a\x7e\xd6\x61\xae\xec\xe3\x2a\xf4\x50\x5c\x80\x19\x3f\x8b\xcd\xae\x19\xd6\x0c\x69\x86\xcb\x62\xe9\x27\x19\x1b\xb3\x13\x65\xd4\xb4\x6d\x98\x5d\xa7\x26\x4f\xcb\x17\xe5\xd4\xdd\x9e\x4f\x99\x52\xcd\x6b\xaa\x7f\xed\x36\x0a\x6e\xbb\xe0\xa6\x5f\xdb\x6b\x56\x6e\xdb\x7b\xa2\xe6\xb8\x0d\xc7\xad\x3b\x6e\xd5\x71\x2b\x31\xad\xa9\xec\x98\x08\x08\x61\x3f\xff\x07\x00\x00\xff\xff\x42\xbf\x62\x10\x2c\x03\x00\x00")
-
-func be_byJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_be_byJson,
-		"be_BY.json",
-	)
-}
-
-func be_byJson() (*asset, error) {
-	bytes, err := be_byJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "be_BY.json", size: 812, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _be_byLatinJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x4a\x03\x31\x14\x86\xd7\x99\x53\x84\x07\xd9\x49\x0f\xd0\x95\x95\x6e\xa4\xad\x14\xa6\x08\x45\x44\x5e\x9d\x40\x1f\x6d\x33\x25\x93\x0e\x8c\xe2\x46\x0a\x6e\xea\x05\xbc\x83\x47\xd0\xd5\xcc\xbd\x64\x3a\xc9\xcb\xa8\xcb\xff\xfd\x79\xff\xff\x25\xe4\x39\x11\x70\x3d\x86\xa1\x84\x95\x7e\xb8\x5a\x5e\x6e\xd1\x91\x81\x8b\x44\xc0\x18\xab\x02\x86\xf2\x2e\x11\x02\x6e\x08\xb3\x27\xd2\x5b\x6c\x1d\x01\x73\x34\xdd\xa0\x79\xc5\x4d\x37\x1a\x35\x9f\x2e\xb7\x41\xa5\xa4\x2d\x66\xfe\x74\x7d\xc2\xfa\xad\x24\x6d\xfd\x32\xa1\x33\xf4\xe8\xcd\xf4\xb0\xca\x1d\x42\x22\xee\xdb\xce\x74\x9d\x5b\xf7\xa7\x98\x2b\x63\x11\x97\xf4\x0a\x38\x9c\x73\x43\xe8\x2c\x37\x6e\xcd\x89\xa9\x3b\x9c\xc9\x8f\xdd\xb9\xe9\xc1\x55\x7e\x03\x37\x58\x92\xbf\xc0\xc4\x62\x11\xd5\xc2\x62\x19\x57\xea\x93\xb6\x3d\x39\xa5\x7d\x14\xcd\x97\xa1\x9e\x77\xdb\xbe\x43\x11\xf5\x04\x0b\x67\xab\xfa\xdd\x84\xe4\x29\x15\x0e\xf7\x98\xf9\xed\x0f\x43\xba\xf9\xc6\xe6\xf8\xeb\x41\xfe\x5d\x80\xd1\x99\x9c\xa9\x19\x38\xb2\x32\x66\x44\x64\x3a\xc6\x62\x9c\x48\x12\x18\x46\xb3\xf9\x2c\x94\x77\x6e\x70\xc6\xe8\x74\xfb\x75\x54\x36\x50\xbb\x81\x5a\x42\x18\x2e\x68\xd7\x19\x28\x55\x26\xd5\x4a\xaa\xa5\x54\x8b\xb3\xcd\x56\x94\xbe\x00\x20\x79\xf9\x09\x00\x00\xff\xff\xa9\x57\x79\xe6\x8f\x02\x00\x00")
-
-func be_byLatinJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_be_byLatinJson,
-		"be_BY@latin.json",
-	)
-}
-
-func be_byLatinJson() (*asset, error) {
-	bytes, err := be_byLatinJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "be_BY@latin.json", size: 655, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bem_zmJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xdf\x6b\x83\x30\x10\xc7\x9f\xf5\xaf\x08\x81\xbc\x0d\xfa\xee\x5b\xc1\x15\x5a\xc8\x56\x66\x61\xb4\x63\x8c\xd3\x1e\x55\x1a\xa3\x68\x6e\x50\xc6\xfe\xf7\x91\x7a\x89\x95\xbd\x7d\x7f\x9c\x77\x9f\x80\x3f\x69\x22\xb7\xb9\xcc\x84\x2c\xb1\xfd\x3a\x69\xf9\x94\x26\x32\x87\xdb\x28\x33\xf1\x91\x26\x89\xdc\x83\xd0\x64\xc8\x5e\xc8\x57\xde\x9b\xa6\xaa\x9b\xb6\x5b\xd8\x92\x4c\xb3\x08\x1c\xb8\xe5\x07\x16\x17\x76\x04\x1b\x37\xf8\xef\xd1\xd0\x58\x37\x32\x4d\x3e\x3d\x40\x51\x77\x83\x7b\xa4\x28\xc8\x4e\xd3\xba\x63\x71\x20\x5e\xf8\x8e\x67\x4e\x6a\xbe\xb8\x19\x98\xa5\x00\x17\x36\xea\xce\xba\x3a\xae\xdb\x81\x25\x08\x53\x1b\x2c\x87\xd9\x69\x8f\x33\xc9\xe7\x7e\x40\x86\xd4\xc8\xd9\x8e\x6c\x54\x06\x58\xbe\x5e\x60\x74\xe1\x26\xf6\x0e\xdb\x12\xb8\xb9\xba\x2e\xe8\x97\xee\x7b\x2e\xf2\x66\xbc\x9b\xc7\x07\xff\x63\x8c\x7c\x01\x6d\x98\xc4\xba\x1f\x42\x72\x8b\x58\x91\x8a\xa3\x35\x5d\x22\x11\xc3\x54\x2e\x92\x30\x05\x56\x81\x60\xad\xf7\x3a\x9c\x26\x43\x55\x8d\x86\xdf\x0e\x57\x18\x69\x46\xcd\xc1\xa1\xff\x5f\x54\xbb\x52\xe7\x95\x3a\xca\x10\x1e\x9a\x76\x2a\x40\xa8\xb3\x50\xa5\x50\x47\xa1\xde\x84\x3a\xdd\x27\x62\x7b\x88\x96\x4f\x4a\xb5\xcd\x94\xce\x54\x21\x54\x2f\xd3\xdf\xbf\x00\x00\x00\xff\xff\xca\x20\xbc\x2a\x92\x02\x00\x00")
-
-func bem_zmJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_bem_zmJson,
-		"bem_ZM.json",
-	)
-}
-
-func bem_zmJson() (*asset, error) {
-	bytes, err := bem_zmJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "bem_ZM.json", size: 658, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ber_dzJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x4e\xf3\x30\x14\x85\x67\xe7\x29\x2c\x4b\xde\xaa\x3e\x40\xb7\xfc\x8a\x7e\x89\x21\x80\xd4\x2e\x05\x21\x74\xd3\x18\x1a\xa5\x75\x5a\xc7\x8d\x64\x10\xcf\xc2\xc8\x4a\x17\xa6\x6c\x0e\xef\x85\x52\x5f\x3b\x21\x6c\xe7\x9e\x93\x7b\xcf\x17\xc9\xaf\x11\x61\x57\x09\x5b\x50\x96\x09\xf5\x98\xdc\xb1\x59\x44\x58\x02\xa6\x66\x0b\x7a\x1f\x11\xc2\x32\x78\x01\x45\x9f\x6d\x2b\x6d\xdb\x87\x84\x65\x85\x2a\xe4\xa6\xb8\x78\xce\x29\xca\x89\x61\x5b\xfb\x61\x5b\xb9\xb1\xed\xc8\xcc\xed\x97\xca\xff\xb8\x99\xf8\x7e\x9f\x6c\xc3\x4e\x77\x67\xb9\xe9\xce\xce\x8b\xc8\x43\x0f\xb5\xdc\x56\x4a\x4f\xc8\x02\x50\xe0\x18\xf7\x0f\xb5\x43\x55\x68\xf0\x67\xd3\x4a\xea\x6d\xb8\x69\x40\x36\x80\x9f\x3f\x89\x46\xc1\xce\xe9\x3d\x28\x8d\xab\x07\x25\x82\x69\xb0\xd7\x9c\x64\x50\x18\x42\x73\x3c\xd5\xb8\x53\x0b\xa9\x0d\x64\x78\xb7\x2a\x47\x83\xac\x06\x9d\x8b\xb2\xd7\xe3\xff\xfd\x4d\xb7\x06\xac\xf9\x2f\x1a\x27\x52\x0f\x1b\x1f\x94\x77\x10\xaa\xfb\x34\x32\x28\x84\x8a\x9b\xa3\x13\x4b\x81\xd9\x4d\x89\x8c\xd7\x15\xee\x25\xa2\xf4\x08\x71\x7a\x9b\xfa\x6e\x17\xfa\x24\x01\x2d\xfa\x47\xc3\xf3\x39\xdf\xcf\xf9\x9a\x79\x73\x55\xec\x5d\x10\xcf\x28\xcf\x29\xff\x47\xf9\x9a\xf2\xd5\x25\x0f\xd9\x30\x62\x03\x63\xd1\xdb\x4f\x00\x00\x00\xff\xff\xd6\x4c\x10\x71\x8a\x02\x00\x00")
-
-func ber_dzJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ber_dzJson,
-		"ber_DZ.json",
-	)
-}
-
-func ber_dzJson() (*asset, error) {
-	bytes, err := ber_dzJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ber_DZ.json", size: 650, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ber_maJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x4e\xc3\x30\x10\x86\x67\xe7\x29\x2c\x4b\xde\xaa\x3e\x40\xb7\xa0\x08\x89\x21\x80\xd4\x2e\x15\x42\xe8\xd2\x18\x1a\xa5\x75\x5a\xc7\x8d\x64\x10\xcf\xc2\xc8\x4a\x17\xa6\x6c\x0e\xef\x85\x52\x9f\x9d\x10\xb6\xff\xfe\x3f\x77\xff\x17\xc9\x6f\x11\x61\x37\x09\x5b\x50\x96\x09\xf5\x94\xc6\x6c\x16\x11\x96\x80\xa9\xd9\x82\x3e\x44\x84\xb0\x0c\x5e\x41\xd1\x17\xdb\x4a\xdb\xf6\x21\x61\x59\xa1\x0a\xb9\x29\x2e\x9e\x73\x8a\x72\x62\xd8\xd6\x7e\xda\x56\x6e\x6c\x3b\x32\x73\xfb\xad\xf2\x7f\x6e\x26\x7e\x3e\x26\xdb\xb0\xd3\xdd\x59\x6e\xba\xb3\xf3\x22\xf2\xd8\x43\x2d\xb7\x95\xd2\x13\xb2\x00\x14\x38\xc6\xfd\x43\xed\x50\x15\x1a\xfc\xd9\xb4\x92\x7a\x1b\x6e\x1a\x90\x0d\xe0\xe7\xcf\xa2\x51\xb0\x73\x7a\x0f\x4a\xe3\xea\x41\x89\x60\x1a\xec\x35\x27\x19\x14\x86\xd0\x1c\x4f\x35\xee\xd4\x42\x6a\x03\x19\xde\xad\xca\xd1\x20\xab\x41\xe7\xa2\xec\xf5\xf8\x7f\xff\xd2\xad\x01\x6b\xae\x45\xe3\x44\xea\x61\xe3\x83\xf2\x0e\x42\x75\x5f\x46\x06\x85\x50\x71\x73\x74\x62\x29\x30\xbb\x2b\x91\xf1\xb6\xc2\xbd\x44\x94\x1e\x21\x4e\xef\x53\xdf\xed\x42\x9f\x24\xa0\x45\xff\x68\x78\x3e\xe7\xfb\x39\x5f\x33\x6f\xae\x8a\xbd\x0b\xe2\x19\xe5\x39\xe5\x57\x94\xaf\x29\x5f\x5d\xf2\x90\x0d\x23\x36\x30\x16\xbd\xff\x06\x00\x00\xff\xff\x7d\x6c\x09\x72\x8a\x02\x00\x00")
-
-func ber_maJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ber_maJson,
-		"ber_MA.json",
-	)
-}
-
-func ber_maJson() (*asset, error) {
-	bytes, err := ber_maJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ber_MA.json", size: 650, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bg_bgJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xcb\x4a\xfb\x40\x18\xc5\xd7\x93\xa7\x08\x03\x03\xff\x3f\x94\x3e\x40\x77\x4a\x41\x5c\x04\x84\xba\xf0\x82\x48\x85\x62\x45\x6b\x41\xbb\x50\x44\x48\x53\x41\xe8\xc2\xbe\x4a\x1a\x33\x50\x93\x26\x79\x85\xf3\xbd\x91\x8c\x9d\x9b\xd5\x8d\xbb\xce\xf9\x7d\x97\x73\xbe\xe6\x29\x60\x7c\xbf\xcb\x3b\x21\xbf\xb8\x3c\xdf\xdd\xe3\xad\x80\xf1\x6e\xff\xf1\x9e\x77\xc2\xd3\x80\x31\x8e\x0a\x12\x39\x24\x4a\x5a\x28\xc8\x38\x1a\xd4\x4e\x45\x85\x15\x0a\x4d\x32\x4a\x50\x53\xec\x6b\x34\xa5\x98\x16\xc8\x91\xea\xf7\x2b\x24\x25\xc8\x68\x4e\x31\x25\x34\xb7\xbd\x8d\xd2\xdd\x9b\xa6\x34\xc7\x12\x35\x25\x48\x79\xc0\xce\x94\xaf\xde\x70\x7c\x37\xd9\x32\x97\xdb\xf6\xca\x99\x70\xab\xcd\x52\xa3\xa1\x71\x14\x4b\x33\x38\x1a\xdf\x4e\x86\x76\x2a\x2d\x50\xd1\x0c\x29\xc5\x58\xe9\xda\x17\x48\x64\x14\x7f\x57\xb1\x56\x2f\x3b\x39\x45\xa3\x18\x4a\x47\xf1\xa1\xfb\xdf\xd4\x49\xec\xef\xd2\x4e\x48\x91\xe1\x9d\x66\x34\xf5\x5c\x49\xe5\x11\x12\x6b\xb5\xd1\x56\xd6\x28\xd4\x71\xb7\xd4\x0a\xf5\x8f\x4a\xf5\xb7\x14\x9e\xea\x1f\xef\xb7\xa0\x7e\x44\x3f\x98\x1f\xeb\xaf\x91\xfc\x30\x7e\x00\xdf\xb6\x6f\xd7\x98\xdc\x89\x0e\x22\xe3\x6e\xc3\x0d\xe9\xf6\x27\x03\xf5\x95\x8a\x41\x5b\x8c\xda\xe2\x98\x1b\xf1\xf0\x6a\xb4\x01\x0f\xe1\x3f\xd1\xff\x1f\x8a\xa3\x50\x9c\x7c\x51\x4b\xae\x5b\x22\x6a\x89\x9e\x15\xf5\x16\x2e\x6e\x34\x08\x9e\x3f\x03\x00\x00\xff\xff\x00\x6b\xaf\xd6\x07\x03\x00\x00")
-
-func bg_bgJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_bg_bgJson,
-		"bg_BG.json",
-	)
-}
-
-func bg_bgJson() (*asset, error) {
-	bytes, err := bg_bgJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "bg_BG.json", size: 775, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bho_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x53\xc1\x4a\xc3\x40\x14\x3c\x27\x5f\xb1\x2c\xec\xcd\x2f\xc8\xad\x90\x4b\x0e\x11\xa1\x5e\x54\x44\x5a\x14\xe2\x41\x23\x9a\x8b\x88\x60\x0b\x81\x82\x78\x94\x82\x14\xbc\xbc\x68\xa8\x25\x04\xa1\x12\x2f\xf5\x57\xde\xa7\xc8\xbe\x64\x9b\xcd\x6e\x3e\xa1\x97\x65\x3b\xf3\xde\x30\x33\xdb\x3c\xb8\x0e\x0f\x7c\xee\x31\x3e\x8e\xe2\xb3\x60\x9f\xef\xb9\x0e\xf7\x47\xf7\x77\xdc\x63\x27\xae\xe3\x70\x84\x12\x61\x8d\xf0\x47\xe7\x06\xa1\x94\x23\x12\xaf\x30\x7b\x46\x28\x2c\xbc\x40\x98\x22\xcc\x11\xbe\x2d\x6a\x85\xd9\x04\xe1\xd3\xc2\xe7\x84\x97\x74\x1a\xd4\x0f\x81\xaf\x98\xbd\x28\x27\x5d\x16\xf2\x8e\x37\xd7\x39\x95\x09\x86\x51\x7c\x9b\xf4\xc6\x30\xdc\xdb\xa6\x0d\xaf\xb6\xc5\x5e\x67\x86\x21\xe5\x23\x8c\xaf\x93\x48\x33\xb1\x20\x7a\x4d\x42\x4f\x6a\xe7\x4b\x99\xd3\xc1\xa2\x0e\x44\xea\x6f\x0a\x4c\x11\x96\x4d\x13\xd9\x4c\xf3\x5a\x20\xcc\xd4\x7d\x81\xd9\x14\x21\xd7\x7e\x4e\xe8\x29\x36\xda\x4c\x4a\x51\x2b\x92\x82\xb6\x11\x59\x24\x48\x35\x89\xaf\xb4\x54\xa9\xca\xf9\x4e\xe2\x3a\x55\xc7\xb1\x57\x3e\x48\xad\xea\x50\xfa\xe3\xec\x9a\xe9\x69\x66\x10\x1e\x84\x6d\x25\x4b\x92\xac\x83\xd6\xff\xef\x5f\xba\xe7\x9d\xd0\xb2\x21\x8d\x6a\x94\xfc\x51\x72\x21\xbf\x6a\x31\x60\xe2\x9c\x89\x31\x13\x47\x5c\xe1\x87\x97\x57\x26\xc7\x44\xe0\x89\xd0\x13\x43\x26\x6e\x98\x38\xa6\xd1\xed\xd8\x96\x6a\xf1\xc6\x28\x37\xd6\xdc\xc7\xff\x00\x00\x00\xff\xff\x42\x65\xa0\x7f\x50\x04\x00\x00")
-
-func bho_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_bho_inJson,
-		"bho_IN.json",
-	)
-}
-
-func bho_inJson() (*asset, error) {
-	bytes, err := bho_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "bho_IN.json", size: 1104, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bn_bdJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\xcf\x4a\xeb\x40\x14\xc6\xd7\xc9\x53\x0c\x03\xb3\xbb\x4f\x90\x5d\x2f\xd9\x74\x11\x10\xea\x46\x45\xa4\x45\xa1\x2e\x6c\x45\xbb\x11\x71\xa1\x45\xbb\x90\xe2\x4a\x44\x29\x04\x51\xce\x84\x5a\x43\xe8\x42\xda\x8d\x7d\x95\xf3\x28\x32\x27\x99\x74\x3a\x99\x40\x37\xe1\xe4\xfc\x9b\xef\xfb\x4d\x72\xed\x7b\xbc\x19\xf2\x80\xf1\x4e\xef\xe8\x7f\xc8\xff\xf9\x1e\x0f\xdb\x57\x97\x3c\x60\x07\xbe\xe7\x71\x84\x0c\x61\x86\xb0\xa2\xe7\x2f\x42\xa6\x5a\x54\x7e\x81\xf2\x11\x21\xad\xe4\x53\x84\x57\x94\x63\x84\x17\x84\x79\xa5\x3a\x43\x79\x8b\x20\x5d\xf9\x21\xc2\x92\xd6\x8e\x11\xa6\x08\x9f\xae\x43\x7f\x68\xfc\x99\x7a\x32\x47\x15\x92\x8d\x29\xdf\x3b\x54\x86\x5a\xdd\xfe\xc5\xc0\xe9\xca\x32\xe3\xf4\x60\x49\xaf\x28\x1e\x3a\xc5\x59\x9a\xb4\x94\xa8\xdf\x1b\x74\x0d\x1d\x13\x52\x9a\xa8\x49\x19\xe7\xaa\x0d\x59\x5f\x28\x47\x74\x12\xd9\x75\xf7\xa4\x45\x46\xf5\xbc\xe9\xe4\x93\x42\x58\x40\x5a\x19\x1e\x52\x94\x23\x1d\x4f\x48\x6e\xb2\xf9\x3a\xa7\x6d\x65\xcf\x03\x21\xc8\xef\x24\x36\x60\x8d\xf4\xfe\x98\xe2\x94\xe2\x99\x61\xfb\x5e\x83\x88\x89\xac\x59\x4a\x10\xbe\x6b\xa6\xde\x49\xed\xc2\xae\x9a\xd7\x58\x0f\xb0\x0e\xda\xf6\xa0\xb6\xa7\x64\xf2\xa9\xc3\xe2\x44\x61\x41\xa8\x1a\xd7\x66\x1b\xd1\x4e\xb4\x76\x39\x45\x79\xa7\xb5\xe7\x1f\xf7\x92\xe2\x0f\xe3\x90\x29\x99\x30\x4a\xc5\xa6\xb0\x3d\x38\x51\x3f\xb8\x68\x30\x71\xcc\x44\x87\x89\x3d\xae\xf3\xbb\xa7\x67\x76\x8d\x89\x66\x20\xa2\x40\xb4\x98\x38\x67\x62\x9f\x5a\xcb\xb6\xb2\xb4\xce\x17\x42\xb9\x35\xe6\xdf\xfc\x05\x00\x00\xff\xff\xb4\x42\xca\xb0\x5b\x04\x00\x00")
-
-func bn_bdJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_bn_bdJson,
-		"bn_BD.json",
-	)
-}
-
-func bn_bdJson() (*asset, error) {
-	bytes, err := bn_bdJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "bn_BD.json", size: 1115, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bn_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xb1\x4a\xc3\x50\x14\x9d\x93\xaf\x78\x3c\x78\x9b\x5f\x90\xad\xd0\xa5\x43\x44\xa8\x8b\x8a\x48\x8b\x42\x1d\x6c\x45\xbb\x88\x38\xa8\x68\x06\x29\x4e\x22\x4a\x21\x88\x72\x5f\x88\x31\x84\x0e\xd2\x2e\xf6\x57\xee\xa7\xc8\xbb\xc9\x4b\x5f\x5f\x9f\x0e\xce\x2e\xe5\xf6\x9c\x73\x6f\xce\x39\xa5\x39\xf7\x3d\xde\x6a\xf2\x80\xf1\x6e\x7f\xaf\xb5\xce\xd7\x7c\x8f\x37\x3b\x67\xa7\x3c\x60\x3b\xbe\xe7\x71\x84\x02\x21\x43\x98\xd3\xe7\x17\x42\xa1\x24\x0a\x9f\xa2\xbc\x43\xc8\x57\xf0\x1c\xe1\x09\xe5\x08\xe1\x11\x61\xb2\xc2\x66\x28\x2f\x11\xa4\x0b\xbf\x46\x98\xd1\xd9\x11\x42\x8a\xf0\xe6\x7a\xe8\x27\xad\x3f\x90\xa6\x70\xb0\x90\x2c\x6d\xf9\xde\xae\x0a\xd4\xee\x0d\x4e\x86\xce\x54\x56\x18\x67\x06\xcb\xfa\xef\x8e\x9d\x46\x2d\x7f\xda\x56\x38\xe8\x0f\x7b\x86\xa7\x31\xb9\x4e\xd4\xa6\x8c\xcb\x04\xc6\xc5\x77\x94\x11\x3d\x95\xa2\xbb\x35\x79\x85\x28\xcd\xb3\x06\xef\x95\xb9\xaa\xb0\xb9\x91\x27\x47\x19\xe9\x79\x4c\x76\x93\xe5\xaf\x13\xba\x56\x6b\x6e\xa9\x8e\x32\x6d\x6c\x14\x17\xe9\xfb\x31\xcd\x39\xcd\x99\x11\xfb\x46\x17\x11\x53\xcb\x26\x95\x20\x7c\xfc\xb0\xf5\x42\x6e\xa7\x36\x6b\xfe\xa4\xff\x05\xfe\xbd\xc0\x46\xb8\x11\x2e\x9a\x4b\x51\x5e\xe9\xdc\xe5\x9f\x67\x46\xf3\xab\x61\x21\xa5\x02\x0c\xaa\xba\xd4\xec\x0c\x0f\xd4\x0b\x44\x34\x98\xd8\x67\xa2\xcb\xc4\x16\xd7\xf8\xe6\xe1\x91\xcd\x31\xd1\x0a\x44\x18\x88\x36\x13\xc7\x4c\x6c\x93\xb4\x96\xd5\xd4\x02\xaf\x8c\x72\x6b\xcd\xbf\xf8\x0e\x00\x00\xff\xff\x21\x33\x19\xb1\xbb\x04\x00\x00")
-
-func bn_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_bn_inJson,
-		"bn_IN.json",
-	)
-}
-
-func bn_inJson() (*asset, error) {
-	bytes, err := bn_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "bn_IN.json", size: 1211, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bo_cnJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\xcb\x4a\xf3\x40\x14\xc7\xd7\xc9\x53\x94\x81\xec\xbe\x27\xe8\xee\xc3\x2e\xec\x22\x22\xd4\x9d\x88\x54\x14\xea\xa2\x56\xb4\x9b\x22\x82\x84\x5a\x90\xae\x62\x2f\x84\x2e\xec\x25\x35\xea\xd2\x58\x10\x24\x67\x9e\xe5\x3c\x8a\xcc\xe9\x8c\x49\x3a\x8d\x69\x71\x13\x26\x7f\x7e\xe7\xf2\x3f\x73\xb9\x31\x0d\x56\x2e\xb1\x62\x81\x9d\x34\x8e\x77\xf6\xd8\x3f\xd3\x60\xa5\x6a\xeb\x9a\x15\x0b\x87\xa6\x61\x30\x04\x07\x61\x8c\x30\xc1\xa8\x8b\xf0\x80\x10\xd2\xc2\xc3\xa8\x2b\xd8\x55\x60\x8c\xfc\x83\x16\xc3\x0c\xc0\x13\x19\xc0\xa1\xb5\x4b\xbf\xb3\x0c\xd2\x47\xfe\xa9\xc8\x41\x06\xd3\x47\x58\x2c\x33\x88\x8a\xb0\xc8\xc4\xc4\x37\x40\x68\x8b\xef\x7a\x26\x40\x3e\x47\xf8\x42\xe8\xc5\x15\x4d\xe3\x48\xcc\xa3\x52\x6b\x5c\x35\xd3\x43\x91\x83\x50\x89\xa4\x6d\xf5\xbb\x34\x99\x34\x16\x9b\x51\x4a\xdc\xba\x52\xf4\xfe\x52\x3d\xa9\x6e\xec\xc6\x45\xb3\x96\x68\x25\x35\x72\x1a\x6a\xfb\xc7\xf4\x4a\x7f\x8a\x71\xa4\x01\x2a\x96\x47\x06\xd4\xa8\x97\x47\x0e\x11\x9e\xd4\xe9\xf8\x05\xf3\x91\xf7\xb5\x2d\xd5\x2c\xf0\x90\x8a\x3a\x1b\x14\x75\x89\xec\xe5\x61\x33\xe4\x8f\xc8\xdf\x05\x9f\x93\xd3\x25\xd7\x8b\x0d\x4a\xdf\x6f\x89\x39\xb4\x0e\x37\xf3\x95\x8c\xd2\x36\x2b\x79\x30\xb3\xce\x43\x34\x5d\x4d\x1e\xcd\x34\xc5\xd7\x94\xb9\xa6\x3c\x6b\x4a\xa0\x29\x2f\x9a\xf2\xaa\x29\x6f\x9a\x32\xc5\x68\xb2\x4e\xd4\x3b\x9f\x8a\xe6\xa5\xeb\xff\xf6\xbe\x1d\xdb\x6d\xab\xc1\x74\x54\xd0\x80\xf6\x39\x94\xa2\x0c\x2a\x55\x9b\x67\xe2\x95\xa3\x8b\x17\x24\x08\x1f\x21\xb2\x5a\xf4\x10\xf8\x56\x1d\x61\x44\x17\x2e\xb0\x4e\x99\x0a\x3b\x38\xaf\x6f\x19\x8a\xd0\x51\xbb\x37\x42\x88\x10\x5c\x6b\x17\xe1\x8e\xee\xd2\xf2\xb1\xf2\x2c\x3b\x2d\x74\xac\x0a\x15\x8c\x8b\xfd\x29\x85\x9c\xd1\xba\x34\xe5\xfc\x34\x05\xeb\x92\x99\xb7\xdf\x01\x00\x00\xff\xff\x19\x53\x51\x80\x1b\x06\x00\x00")
-
-func bo_cnJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_bo_cnJson,
-		"bo_CN.json",
-	)
-}
-
-func bo_cnJson() (*asset, error) {
-	bytes, err := bo_cnJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "bo_CN.json", size: 1563, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bo_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x94\xcb\x4a\xc3\x40\x14\x86\xd7\xc9\x53\x94\x81\xec\x7c\x82\xee\x84\x2e\xec\x22\x22\xd4\x9d\x88\x54\x14\xea\xa2\x56\xb4\x9b\x22\x82\x84\x5a\x90\xae\x62\x2f\x84\x2e\xec\x25\x35\xea\xd2\x58\x10\x24\x67\x9e\xe5\x3c\x8a\xcc\xe9\x8c\x49\x3a\x8d\x69\x71\x13\x26\x3f\xdf\xb9\xfc\x67\x2e\xb7\xa6\xc1\xca\x25\x56\x2c\xb0\xd3\xc6\x49\x79\x9f\xed\x98\x06\x2b\x55\x5b\x37\xac\x58\x38\x32\x0d\x83\x21\x38\x08\x63\x84\x09\x46\x5d\x84\x47\x84\x90\x16\x1e\x46\x5d\xc1\xae\x02\x63\xe4\x9f\xb4\x18\x66\x00\x9e\xc8\x00\x0e\xad\x5d\xfa\x9d\x65\x90\x3e\xf2\x2f\x45\x0e\x32\x98\x3e\xc2\x62\x99\x41\x54\x84\x45\x26\x26\xbe\x01\x42\x5b\x7c\xd7\x33\x01\xf2\x39\xc2\x37\x42\x2f\xae\x68\x1a\xc7\x62\x1e\x95\x5a\xe3\xba\x99\x1e\x8a\x1c\x84\x4a\x24\x6d\xab\xdf\xa5\xc9\xa4\xb1\xd8\x8c\x52\xe2\xd6\x95\xa2\xf7\x97\xea\x49\x75\x63\x37\x2e\x9b\xb5\x44\x2b\xa9\x91\xd3\x50\xdb\xbf\xa6\x57\xfa\x53\x8c\x23\x0d\x50\xb1\x3c\x32\xa0\x46\xbd\x3c\x72\x88\xf0\xac\x4e\xc7\x1f\x98\x8f\xbc\xaf\x6d\xa9\x66\x81\x87\x54\xd4\xd9\xa0\xa8\x4b\x64\x2f\x0f\x9b\x21\x7f\x42\xfe\x21\xf8\x9c\x9c\x2e\xb9\x5e\x6c\x50\xfa\x61\x4b\xcc\xa1\x75\xb8\x99\xaf\x64\x94\xb6\x59\xc9\x83\x99\x75\x1e\xa2\xe9\x6a\xf2\x68\xa6\x29\xbe\xa6\xcc\x35\xe5\x45\x53\x02\x4d\x79\xd5\x94\x37\x4d\x79\xd7\x94\x29\x46\x93\x75\xa2\xde\xf9\x54\x34\x2f\x5d\xef\xda\x07\x76\x6c\xb7\xad\x06\xd3\x51\x41\x03\xda\xe7\x50\x8a\x32\xa8\x54\x6d\x9e\x8b\x57\x8e\x2e\x5e\x90\x20\x7c\x84\xc8\x6a\xd1\x43\xe0\x5b\x75\x84\x11\x5d\xb8\xc0\x3a\x63\x2a\xec\xf0\xa2\xbe\x65\x28\x42\x47\xed\xde\x08\x21\x42\x70\xad\x3d\x84\x7b\xba\x4b\xcb\xc7\xca\xb3\xec\xb4\xd0\xb1\x2a\x54\x30\x2e\xf6\xaf\x14\x72\x46\xeb\xd2\x94\xf3\xd3\x14\xac\x2b\x66\xde\xfd\x04\x00\x00\xff\xff\xe7\xd9\x75\x22\x1b\x06\x00\x00")
-
-func bo_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_bo_inJson,
-		"bo_IN.json",
-	)
-}
-
-func bo_inJson() (*asset, error) {
-	bytes, err := bo_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "bo_IN.json", size: 1563, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _br_frJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xcf\x6a\xf3\x30\x10\xc4\xcf\xf2\x53\x88\x05\xe1\xcb\x47\x1e\xc0\xb7\x7c\xb5\x9b\x86\x22\x28\x4d\x2e\xa5\x94\xa2\xd4\x4b\x14\xb0\xa5\x22\x5b\x36\x71\xe9\xbb\x17\xd7\xda\xed\x9f\x1c\x7a\xdb\x9d\x59\xcf\xfc\x8c\xde\x32\x01\xdb\x12\x0a\x09\x87\xf0\x7c\x7d\x0f\xff\x32\x01\xa5\x39\x77\x50\xc8\xc7\x4c\x08\xe8\x62\x33\x6b\x02\x9a\xe8\x96\xa1\xc5\x18\x26\x4b\x73\x78\xc9\x2d\x86\x65\x3b\x1b\x1f\x97\xe9\x38\xa2\x23\xb5\x33\xb5\x0f\x0e\x32\xf1\x34\x67\xef\xac\x0f\xfd\x9f\x05\x9c\xce\xc1\x9c\xcb\xa1\x94\xa8\xbd\xeb\x2d\xc7\x6d\xd0\x0d\xf4\xd9\x55\x6e\x47\x1c\x02\xad\xfa\x1b\x78\x75\x08\x98\x7a\xb5\x41\xf2\x27\x8b\x03\x26\x8a\x8d\x8f\x18\x92\x53\xf9\xae\x4f\xea\x88\xee\xe8\x9b\x84\x73\xc3\x17\x65\x42\xbe\xc5\x30\xc5\x1f\xff\x7a\x81\xf7\xc5\xc6\x58\xcc\xf4\x8b\xa8\x1a\x90\x61\x98\x84\x41\x98\xe1\x02\x81\x00\xd6\xfa\x4e\x53\xf3\x62\x92\x53\x9a\x1e\xe7\x47\x57\xf5\x4a\xb5\x2b\xf5\x00\x24\xee\x4f\xed\xa7\x51\xe6\x26\x48\xb5\x96\xaa\x96\x46\x0e\xa7\x49\xaa\xff\x32\x9d\xd1\x89\xda\xf3\x9a\x8a\x40\x6d\x51\xe9\x42\xed\xa4\x7a\x85\xec\xfd\x23\x00\x00\xff\xff\x37\x9e\x56\xf2\x5c\x02\x00\x00")
-
-func br_frJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_br_frJson,
-		"br_FR.json",
-	)
-}
-
-func br_frJson() (*asset, error) {
-	bytes, err := br_frJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "br_FR.json", size: 604, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _brx_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x4f\x4b\xf3\x40\x10\xc6\xcf\xc9\xa7\x58\x16\xf6\xf6\xd2\x0f\x90\x5b\x21\x97\x1c\xf2\x22\xd4\x8b\x8a\x48\x8b\x42\x3d\x68\xa5\xe6\xa0\x88\x60\x0b\x9a\x83\x14\x6f\x7a\xc9\x71\xb6\x04\x1b\x42\x08\x08\x39\x88\x7e\x95\xf9\x28\xb2\xd3\x6c\xb2\xcd\x9f\x8b\x67\x2f\xcb\xf6\x99\x67\x66\x7f\xf3\xb4\xf4\xce\xb6\xb8\xe7\x72\x87\xf1\xc9\xfc\xe6\xc4\xfb\xcf\xff\xd9\x16\x77\xc7\xb7\xd7\xdc\x61\x47\xb6\x65\x71\x84\x0c\x21\x41\xf8\xa6\xf3\x0b\x21\x53\x16\xa5\x17\x28\x9f\x5b\x62\x8a\xb0\x44\x78\x43\xc8\x5b\xa5\x04\xe5\x02\x61\xdd\xa1\xab\xe1\x05\x82\xec\x79\x65\x81\xf0\x4a\x67\xd6\x53\x8d\x77\x1a\x6d\xeb\x58\x2d\x31\x9a\xce\xe6\x41\xe7\x26\x75\x33\xa4\x6d\xee\x06\x6e\x27\x65\x27\x5c\x9b\x49\xa3\xf8\xb3\xcb\x60\x6a\x70\x44\x44\x1a\x93\xef\x63\x4b\x8d\xf2\x41\xf7\x6f\x50\x86\xf4\xfc\x8a\xf4\x4e\x4f\xaa\x95\x15\x42\xa1\xc5\x17\x84\xf7\xb2\x4b\xb1\xe6\xb5\x59\x86\xfa\x1e\x6d\xe1\x76\x3f\xe6\x34\xad\xf2\x3c\x51\x10\x05\x8d\x92\xc6\x56\x44\x05\x92\x2e\x11\x55\x13\x84\x4f\x63\xf3\x47\x95\x85\x0a\x28\x31\xc4\x98\x76\xe9\x6b\x59\x97\xb1\xb6\x0d\xe6\xd7\xf8\x17\xe0\xef\x03\x1c\xfa\x7b\x7e\x9d\xdc\x86\x78\x97\x03\xe3\x57\x1e\x6a\x7e\x35\x64\xa0\xdb\xdc\x71\x70\xa6\xfe\x16\xc4\x90\x89\x53\x26\x26\x4c\x1c\x70\xad\xef\x9f\x5f\x34\x6b\x4c\x78\x8e\xf0\x1d\x31\x62\xe2\x8a\x89\x43\xb2\x56\xb6\xaa\x54\xeb\x25\x15\x6f\xb4\xd9\xf7\x3f\x01\x00\x00\xff\xff\xb7\xfd\x1b\x23\x91\x04\x00\x00")
-
-func brx_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_brx_inJson,
-		"brx_IN.json",
-	)
-}
-
-func brx_inJson() (*asset, error) {
-	bytes, err := brx_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "brx_IN.json", size: 1169, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _bs_baJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x10\x85\xd7\xd2\x29\xc4\x80\x76\x3f\x39\x40\x76\xfe\x31\x85\x06\x9c\x1a\x9c\x2e\x42\x29\x45\xaa\x87\xc6\x8e\x6d\x05\x59\x0a\x94\xd2\x1b\xf4\x58\x3d\x58\x71\xa5\x99\x84\x66\xf7\xde\x3c\xe5\xbd\x2f\xe0\x0f\x29\xe0\xbe\x84\xb5\x02\x3b\xbf\xfc\x2f\xe0\x9f\x14\x50\x9a\xf7\x19\xd6\xea\x49\x0a\x01\x5b\x6c\x7b\x1c\x7a\xb3\x04\x02\x6a\x37\x65\x7f\x4c\x87\xc7\xe0\x3c\xe9\xc6\x77\x3d\xb6\xf9\xe5\xf7\x17\x86\xb3\x0f\x94\xd5\xc8\xb2\x89\xd6\x05\x03\x52\x3c\x2f\x5b\xcd\xc1\xf9\xf0\x67\x90\xb7\x78\x83\x07\x2e\xe5\xdc\xcb\xad\x54\x59\xb9\x29\x1c\xb8\x6f\x63\xa6\x68\x7c\x7a\x74\x87\xd6\xb3\xa9\x8c\xcf\xbf\x2d\x4e\xbe\x1b\xe8\xd8\x27\xb1\x89\x53\x47\x6a\xc8\xaa\x88\x6f\x71\xa6\x3d\x3c\x05\x1c\x2d\x95\x3d\x1c\x83\x63\xb3\x75\xe7\xab\xa8\xc4\xd7\xe4\xae\xff\xf1\x0d\x23\x03\x32\x1c\xb3\xdd\x92\x31\x18\x73\x31\x14\xe3\x30\x0a\x53\x10\x40\x51\xd5\x15\x2d\xa7\x90\x92\xd2\x04\x5c\x3e\x05\xdd\xae\xf4\xb8\xd2\x7b\xa0\xe3\xae\x1b\x53\x60\x94\x6e\x95\xb6\x4a\xef\x95\xde\xfd\xc6\x1c\x5d\x6c\x1e\x00\x90\x9f\x3f\x01\x00\x00\xff\xff\x1d\x80\xf5\xc9\x5f\x02\x00\x00")
-
-func bs_baJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_bs_baJson,
-		"bs_BA.json",
-	)
-}
-
-func bs_baJson() (*asset, error) {
-	bytes, err := bs_baJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "bs_BA.json", size: 607, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _byn_erJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xcd\x6a\xd4\x50\x14\x5e\x27\x4f\x71\xb8\x70\x77\x42\xf6\xd9\x55\xc6\x45\x17\x01\xb1\x2e\xac\x22\x52\xb1\x50\x17\x6d\xa5\xce\xa6\x88\x2b\x09\x9c\xfb\x93\x85\x1d\x0a\x05\x09\x68\xb5\x0b\x67\x18\x88\x96\x01\x11\xf2\x06\xbe\xc4\xf7\x26\x72\x66\x72\x33\xd7\xe0\x94\xd9\x84\x9c\xdf\xef\xe7\x24\xef\xd2\x44\xed\x8e\x54\x4e\xea\xe5\xf9\xc9\x8b\x07\x8f\xd4\xbd\x34\x51\xa3\x83\xf3\xb7\x2a\xa7\x67\x69\x92\x28\x70\x03\x7b\x09\xf3\x19\x3c\x27\x98\x12\xee\x16\xae\x94\xb6\xae\xf6\x71\xfd\xce\x16\xbe\x0e\x21\xc3\x4f\x08\x8e\xc1\x53\x92\xc8\xdc\xc0\xb9\xae\x68\xbf\x82\x6b\xb8\x45\x14\xce\x61\x6e\xa2\xad\x3d\x22\xb7\xf0\x13\x41\x4c\x93\xe7\xc2\x6d\xef\xe8\xf4\x6c\x3c\x20\x98\xc1\x6c\xcb\x68\x4b\x02\x19\xb8\x0d\x88\xc5\xe9\xc9\xf8\x28\x82\xab\xe0\x1a\x98\x05\x78\x1a\xa6\x67\x22\xce\xb6\xf2\x34\x3f\x43\x52\xf6\x81\x83\xe2\xca\xc1\x97\xb0\xbf\xa3\xa9\x39\xcc\xb5\x18\xda\x67\xb8\x86\x9d\xc2\x7e\x03\x57\x24\x00\x7e\xf2\xa7\x84\xbd\x88\x46\x1a\xa1\x69\xbf\x87\xfe\x4f\x12\xba\x19\xb8\xa6\x7f\x08\xb9\x19\xec\x2f\xd8\x0b\x02\x5f\x81\x6f\x97\x20\x1c\xe1\x5c\xc1\x34\x60\xf3\x3f\x58\x19\x68\x57\x57\xeb\xca\xd2\x7a\x29\x5b\x78\x89\x10\x9f\x61\x83\x33\x03\x5b\xee\x36\x64\xe0\x46\xcf\x29\x8b\x16\x89\xec\x81\xe6\x81\xd2\x4d\xca\x32\x70\x3d\xd0\x11\x14\xec\x14\x0f\x8b\x9e\x7a\xe5\xe0\x7e\x80\x17\x04\xff\x61\xfd\x1d\xac\xb3\xae\x11\x9b\xcc\x75\x18\x1e\x1d\x8c\x0f\xe5\xa7\xd1\xaf\x32\x7d\x9c\xe9\x7d\x15\x92\x8f\x5f\x1f\xaf\x0a\x3b\xa8\xbe\x90\xbe\x4f\xfa\x90\xe0\x2b\x21\xed\x1d\xe9\x7d\xd2\x67\xa4\x9f\x2e\xdb\xfb\xd6\xdd\x5c\x17\xb9\xde\xeb\x93\x1d\x33\xa5\x9f\x90\x7e\xa3\xd2\xf7\x7f\x03\x00\x00\xff\xff\xa5\x54\x5d\x5a\xa4\x03\x00\x00")
-
-func byn_erJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_byn_erJson,
-		"byn_ER.json",
-	)
-}
-
-func byn_erJson() (*asset, error) {
-	bytes, err := byn_erJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "byn_ER.json", size: 932, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ca_adJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcd\x4a\xc5\x30\x10\x85\xd7\xed\x53\x84\x81\xec\x84\xbb\xbf\xbb\x0b\xdd\xb8\x28\x08\x76\xa3\x22\x92\xb6\x63\x6f\x4b\x7e\x20\x3f\x85\x22\x3e\x8f\x0f\xe2\x8b\x49\xcd\x64\xac\xdc\xd5\x99\x93\x33\xe4\x7c\x2d\xf9\xa8\x2b\xb8\x6f\xe0\x2c\x60\x50\x6f\x97\x06\xee\xea\x0a\x1a\xb5\x05\x38\x8b\x97\xba\xaa\x60\x9c\x93\x41\x3b\xe1\x1e\xec\x4e\xeb\x64\x43\x31\x46\xf9\xf8\x67\x70\xf0\xc8\x6e\x71\x89\xe7\x15\xed\x78\x88\x42\x50\x7d\x44\xa8\xab\xd7\xbd\xec\xf1\xea\x7c\xfc\xd7\x38\xd1\xa2\x26\x8d\xa4\x03\xe9\x42\xba\x92\x86\x72\x55\xeb\x6c\xbc\xf2\x3d\x13\x5a\xf4\x79\xe5\x1d\x7b\x5f\x66\xa3\xfc\xf7\x57\x1e\x55\xef\x67\x5d\x4e\x67\xaa\x5d\x92\xdd\xca\xa4\x67\x47\xb9\x9a\x5c\x20\x8e\x80\x11\x4d\xef\xe9\x8f\xb8\x21\x26\x36\xd6\xad\x87\x68\xc4\x90\xdd\xf1\x4b\x6f\x18\x99\x90\xf1\x18\x8e\xd1\x98\x8c\xc1\x98\x8a\x99\x18\x87\x51\x98\xa2\x00\x5c\xda\x87\xb6\x34\xe7\xb0\x24\x8d\x8a\xb8\xbf\x01\x39\x9e\xa4\x39\xc9\x0d\xca\x61\x37\x9b\x1c\x28\x21\x47\x21\x7b\x21\x9f\x84\xec\x84\x7c\xfe\xdd\xe0\xb4\x63\x4b\x1d\x00\xf5\xe7\x4f\x00\x00\x00\xff\xff\x35\x53\x8e\x32\x5b\x02\x00\x00")
-
-func ca_adJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ca_adJson,
-		"ca_AD.json",
-	)
-}
-
-func ca_adJson() (*asset, error) {
-	bytes, err := ca_adJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ca_AD.json", size: 603, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ca_esJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcd\x4a\xc5\x30\x10\x85\xd7\xed\x53\x84\x81\xec\x84\xbb\xbf\x3b\xa1\x2e\x5c\x14\x84\xdb\x8d\x8a\x48\xda\x8e\xbd\x2d\xf9\x81\xfc\x14\x8a\xf8\x3c\x3e\x88\x2f\x26\x35\x93\xb1\xe2\xea\xcc\xc9\x19\x72\xbe\x96\xbc\xd7\x15\xdc\x37\x70\x16\x30\xa8\xd7\xbb\x0b\xdc\xd4\x15\x34\x6a\x0b\x70\x16\xcf\x75\x55\xc1\x38\x27\x83\x76\xc2\x3d\xd8\x9d\xd6\xc9\x86\x62\x8c\xf2\xf1\xd7\xe0\xe0\x91\xdd\xe2\x12\xcf\x2b\xda\xf1\x10\x85\xa0\xfa\x88\x50\x57\x2f\x7b\xd9\xe5\xea\x7c\xfc\xd3\x38\xd1\xa2\x26\x8d\xa4\x03\xe9\x42\xba\x92\x86\x72\x55\xeb\x6c\xbc\xf2\x3d\x13\x5a\xf4\x79\xe5\x0d\x7b\x5f\x66\xa3\xfc\xd7\x67\x1e\x55\xef\x67\x5d\x4e\x67\xaa\x5d\x92\xdd\xca\xa4\x67\x47\xb9\x9a\x5c\x20\x8e\x80\x11\x4d\xef\xe9\x8f\xb8\x21\x26\x36\xd6\xad\x87\x68\xc4\x90\xdd\xf1\x4b\xff\x31\x32\x21\xe3\x31\x1c\xa3\x31\x19\x83\x31\x15\x33\x31\x0e\xa3\x30\x45\x01\xb8\x6d\x1f\xda\xd2\x9c\xc3\x92\x34\x2a\xe2\xfe\x06\xe4\x78\x92\xe6\x24\x37\x28\x87\xdd\x6c\x72\xa0\x84\x1c\x85\xec\x85\x7c\x14\xb2\x13\xf2\xe9\x67\x83\xd3\x8e\x2d\x75\x00\xd4\x1f\xdf\x01\x00\x00\xff\xff\x3d\x69\x27\xa8\x5b\x02\x00\x00")
-
-func ca_esJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ca_esJson,
-		"ca_ES.json",
-	)
-}
-
-func ca_esJson() (*asset, error) {
-	bytes, err := ca_esJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ca_ES.json", size: 603, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ca_frJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcd\x4a\xc5\x30\x10\x85\xd7\xed\x53\x84\x81\xec\x84\xbb\xbf\x3b\xa1\x08\x2e\x0a\xa2\xdd\xa8\x88\xa4\xed\xd8\xdb\x92\x1f\xc8\x4f\xa1\x88\xcf\xe3\x83\xf8\x62\x52\x33\x19\x2b\xae\xce\x9c\x9c\x21\xe7\x6b\xc9\x7b\x5d\xc1\x6d\x03\x67\x01\x83\x7a\xbd\xb9\x87\xab\xba\x82\x46\x6d\x01\xce\xe2\xb9\xae\x2a\x18\xe7\x64\xd0\x4e\xb8\x07\xbb\xd3\x3a\xd9\x50\x8c\x51\x3e\xfe\x1a\x1c\x3c\xb2\x5b\x5c\xe2\x79\x45\x3b\x1e\xa2\x10\x54\x1f\x11\xea\xea\x65\x2f\x7b\xb8\x38\x1f\xff\x34\x4e\xb4\xa8\x49\x23\xe9\x40\xba\x90\xae\xa4\xa1\x5c\xd5\x3a\x1b\x2f\x7c\xcf\x84\x16\x7d\x5e\x79\xc3\xde\x97\xd9\x28\xff\xf5\x99\x47\xd5\xfb\x59\x97\xd3\x99\x6a\x97\x64\xb7\x32\xe9\xd9\x51\xae\x26\x17\x88\x23\x60\x44\xd3\x7b\xfa\x23\x6e\x88\x89\x8d\x75\xeb\x21\x1a\x31\x64\x77\xfc\xd2\x7f\x8c\x4c\xc8\x78\x0c\xc7\x68\x4c\xc6\x60\x4c\xc5\x4c\x8c\xc3\x28\x4c\x51\x00\xae\xdb\xbb\xb6\x34\xe7\xb0\x24\x8d\x8a\xb8\xbf\x01\x39\x9e\xa4\x39\xc9\x0d\xca\x61\x37\x9b\x1c\x28\x21\x47\x21\x7b\x21\x1f\x85\xec\x84\x7c\xfa\xd9\xe0\xb4\x63\x4b\x1d\x00\xf5\xc7\x77\x00\x00\x00\xff\xff\x1d\x5f\xe5\x65\x5b\x02\x00\x00")
-
-func ca_frJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ca_frJson,
-		"ca_FR.json",
-	)
-}
-
-func ca_frJson() (*asset, error) {
-	bytes, err := ca_frJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ca_FR.json", size: 603, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ca_itJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcd\x4a\xc5\x30\x10\x85\xd7\xed\x53\x84\x81\xec\x84\xbb\xbf\x3b\xa1\x9b\xbb\x28\x08\x76\xa3\x22\x92\xb6\x63\x6f\x4b\x7e\x20\x3f\x85\x22\x3e\x8f\x0f\xe2\x8b\x49\xcd\x64\xac\xdc\xd5\x99\x93\x33\xe4\x7c\x2d\xf9\xa8\x2b\xb8\x34\x70\x16\x30\xa8\xb7\x4b\x07\x77\x75\x05\x8d\xda\x02\x9c\xc5\x4b\x5d\x55\x30\xce\xc9\xa0\x9d\x70\x0f\x76\xa7\x75\xb2\xa1\x18\xa3\x7c\xfc\x33\x38\x78\x64\xb7\xb8\xc4\xf3\x8a\x76\x3c\x44\x21\xa8\x3e\x22\xd4\xd5\xeb\x5e\xf6\x78\x75\x3e\xfe\x6b\x9c\x68\x51\x93\x46\xd2\x81\x74\x21\x5d\x49\x43\xb9\xaa\x75\x36\x5e\xf9\x9e\x09\x2d\xfa\xbc\xf2\x8e\xbd\x2f\xb3\x51\xfe\xfb\x2b\x8f\xaa\xf7\xb3\x2e\xa7\x33\xd5\x2e\xc9\x6e\x65\xd2\xb3\xa3\x5c\x4d\x2e\x10\x47\xc0\x88\xa6\xf7\xf4\x47\xdc\x10\x13\x1b\xeb\xd6\x43\x34\x62\xc8\xee\xf8\xa5\x37\x8c\x4c\xc8\x78\x0c\xc7\x68\x4c\xc6\x60\x4c\xc5\x4c\x8c\xc3\x28\x4c\x51\x00\xee\xdb\x87\xb6\x34\xe7\xb0\x24\x8d\x8a\xb8\xbf\x01\x39\x9e\xa4\x39\xc9\x0d\xca\x61\x37\x9b\x1c\x28\x21\x47\x21\x7b\x21\x9f\x84\xec\x84\x7c\xfe\xdd\xe0\xb4\x63\x4b\x1d\x00\xf5\xe7\x4f\x00\x00\x00\xff\xff\xab\x4c\xfc\x66\x5b\x02\x00\x00")
-
-func ca_itJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ca_itJson,
-		"ca_IT.json",
-	)
-}
-
-func ca_itJson() (*asset, error) {
-	bytes, err := ca_itJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ca_IT.json", size: 603, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _crh_uaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xf3\x40\x14\x85\xd7\xc9\x53\x0c\x03\x77\xf7\xd3\x07\xc8\x2e\xbf\x51\xe8\x22\x2a\xa4\x2e\x8a\x88\xdc\xd8\x8b\x29\x35\x49\x3b\x99\x04\xa2\xb8\xf6\x25\x0a\x05\x9f\xc0\x3e\x43\xd2\xf7\x92\x38\x33\x77\x8a\xee\xce\x3d\x77\xe6\x9c\x2f\x61\xde\xc2\x40\xce\x13\x19\x09\xf9\xa4\x8a\xc7\xbb\x58\xfe\x0b\x03\x99\x60\xdf\xc8\x48\xdc\x87\x41\x20\xff\xe3\x2b\xaa\xc9\xb5\x92\x94\xa6\x66\x6d\x8c\x0c\x5f\xc6\xa3\x91\xc3\x07\xaa\xd3\x81\xca\x9c\xcc\x7c\xd1\x96\x88\xbb\xd3\x01\x4b\x77\x62\x72\xbc\xb2\x31\x61\xf0\x30\x15\x66\x45\xad\xf4\xaf\x56\xdb\x49\x8a\xbb\x7c\x93\xcd\xc1\x1d\x07\x5a\x41\xca\x45\xa6\x75\xa5\x0b\xce\x5b\x62\xd5\xb9\x6b\x57\xd4\x29\x17\x96\xa2\xd2\x46\xc5\x5b\x45\x6c\xf6\xe3\xb1\x31\x7a\xfc\xea\xdb\xca\x4b\x7b\x22\xee\x9e\xdb\xc6\x5e\xcc\xa8\xd2\xc3\x67\x6e\xc3\x6f\x36\x67\xc3\x75\xdd\xa3\xd3\x09\x6d\x26\x7d\xfe\xc1\x7f\x10\x99\x8f\xe1\x98\x8d\xc9\x98\xc5\x53\x79\x28\x26\x62\x18\x06\x61\x0a\x87\x10\xa7\xb7\xa9\xeb\x1e\xf6\x97\xf6\xe7\xee\x33\xb7\x4f\x50\xd3\xf4\x2e\x60\x35\x83\x72\x06\x4b\xe9\xcc\xc5\xba\x34\x0b\x14\xb0\x12\x90\x0b\x58\x0a\x58\xfc\xac\x79\xe5\x47\x5b\x23\x61\x1e\x41\x1a\x41\x26\x60\x2b\xc3\xf7\xef\x00\x00\x00\xff\xff\xb9\x0b\x13\x27\x77\x02\x00\x00")
-
-func crh_uaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_crh_uaJson,
-		"crh_UA.json",
-	)
-}
-
-func crh_uaJson() (*asset, error) {
-	bytes, err := crh_uaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "crh_UA.json", size: 631, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _cs_czJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x4e\x83\x40\x14\x86\xd7\xc3\x29\x26\x93\xcc\xae\xed\x01\xd8\xa9\x2c\xec\x02\xd3\x04\x37\xd5\x18\x33\x74\x26\x29\x96\x32\x64\x18\x49\xac\xf1\x06\x7a\x00\xec\xaa\x07\xf0\x02\x26\xae\x80\x7b\x99\xc9\xf0\x1e\x68\x77\xef\x7f\x3f\xfc\xff\x07\x79\xaf\x01\x61\xcb\x88\x85\x94\x6d\xaa\xc7\xab\x3b\x36\x0b\x08\x8b\xc4\x4b\xc5\x42\x7a\x1f\x10\xc2\x6e\x94\xec\x8e\xb9\x72\x7b\xc2\x56\xba\x70\xaa\xfd\xf2\xb2\xfd\xb4\xca\xb4\x3f\x5e\x24\xb6\x6f\x94\x14\x5e\x74\xef\xb6\x36\x56\xed\x86\xd7\xda\x13\xce\x89\x4e\xb5\x15\x2c\x20\x0f\xae\x29\xd9\x6a\x63\xff\xd6\x41\x13\x56\x40\x3c\x26\x63\x28\x24\x42\x5a\xac\x0b\xbb\xc5\xa8\x5c\x49\x55\x0c\x29\xdf\x85\x36\x7e\x4c\xfb\x46\x1d\x60\x2f\x9f\x53\x18\x77\x75\x77\xb4\x20\xba\x0f\x65\xea\x7f\x42\x6d\xbc\xac\x4c\x09\xce\xa1\x3d\xf5\x0d\xfc\x0c\x37\x3d\x81\x93\x67\x95\xd5\xa5\x90\x5e\x95\x46\x57\x99\x0b\x98\x7e\xf5\x19\xec\x88\x3a\x92\x22\xe6\x08\x89\x50\x23\xde\x48\x36\xe1\x9a\x50\x21\x13\xe2\x00\xc9\x45\xbc\x8a\x01\xc1\x9b\xe0\x44\xc2\x2a\x77\x15\x7c\x2e\x17\x7c\xbe\x5f\xf0\x35\x83\xf5\x6d\xb6\xf7\x96\xa0\xce\xa5\xfc\x92\xf2\xf5\x8c\xf2\xeb\x90\xc7\x21\x4f\x28\xf7\x57\x84\x8f\x0d\x7b\x5c\x0e\xa5\x8c\x2f\x07\x23\x78\xfb\x0d\x00\x00\xff\xff\xf7\x4c\x19\x74\x86\x02\x00\x00")
-
-func cs_czJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_cs_czJson,
-		"cs_CZ.json",
-	)
-}
-
-func cs_czJson() (*asset, error) {
-	bytes, err := cs_czJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "cs_CZ.json", size: 646, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _csb_plJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\x03\x31\x18\xc4\xcf\xd9\xa7\x08\x1f\xe4\x66\x5f\xa0\x37\xa1\x17\xc1\x42\xc1\x5e\xaa\x88\xa4\xbb\x8b\x4d\xdb\x24\x4b\x92\xb2\xb8\xe2\xa5\xe8\xc3\x94\xde\x6a\x8f\xde\x3e\xf7\xbd\x64\xbb\xf9\xb3\xa8\xb7\xcc\x4c\x3a\xf3\x4b\xd9\xd7\x8c\xc0\xcd\x04\xc6\x14\x72\xbb\x7c\x9a\xdd\xc2\x55\x46\x60\xc2\x5f\x2c\x8c\xe9\x43\x46\x08\x28\x51\x16\x4d\xb9\xe5\x5d\x40\xa0\xc2\xd3\xc5\xc0\x73\xbb\xdf\xf4\x56\xed\xf0\xd3\xf8\xb3\x75\xa6\xd1\x85\xbf\x9b\x37\xb5\xc0\xb3\x71\x3e\xab\xc4\xf7\x47\x38\x5b\xbd\xc4\x93\xe3\x90\x91\xc7\x6e\xef\x6e\xa5\x8d\xfb\x35\x9a\xf6\xd2\x4c\x1c\x89\x03\xa9\x3a\x16\x87\xd2\xa9\x56\x6e\x15\x1b\xad\xc3\x63\xde\x28\xe1\x01\x9e\x8d\x96\x62\xa0\xad\x33\x3b\x29\xb8\x8a\x46\xbb\x6f\xbf\xf0\xb8\xe9\x5e\xe0\x7a\x47\xe2\x79\x1d\x76\x4b\x53\x8b\xf6\x3d\xef\xe5\x16\x8f\x95\x50\x5e\x34\x78\xd8\xa6\x56\x3c\xd4\x51\x98\xdd\x9a\xab\xf8\x0b\xeb\x74\xc5\x8b\xc4\x83\xa7\x8b\x18\xfe\x21\x7f\x1f\x10\xd9\x03\xb4\x1c\xc2\xfe\x83\x99\x00\x13\x5d\x42\x8b\x5c\x89\x2a\xd1\x04\x94\xeb\xe9\x6c\x1a\x18\xfa\x34\x24\x13\xee\xca\xee\xc3\x61\x8b\x11\x93\x23\x56\x40\x30\xe7\x42\xf6\x01\xa7\xac\xa0\x6c\x49\xd9\x82\xb2\x39\x65\xf7\x97\x1b\x31\x9d\x47\xe9\x37\x00\xb2\xb7\x9f\x00\x00\x00\xff\xff\x3d\xd2\xe6\x6c\x90\x02\x00\x00")
-
-func csb_plJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_csb_plJson,
-		"csb_PL.json",
-	)
-}
-
-func csb_plJson() (*asset, error) {
-	bytes, err := csb_plJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "csb_PL.json", size: 656, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _cv_ruJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x92\x4f\x6a\xf3\x30\x10\xc5\xd7\xf2\x29\x84\x40\xbb\x8f\x1c\x20\x3b\xf1\x65\xd3\x86\x94\x90\xc6\x85\x50\x4a\x99\x3a\x86\xa4\xb6\x95\x20\x4b\x06\x53\xba\x89\xaf\xd0\xec\x4a\x1d\xdf\xa3\xbb\x48\xf7\x2a\xae\xfe\xb8\xde\xfd\xde\xbc\xf1\x7b\x23\xf0\x5b\x84\xc8\xcd\x8c\x4c\x31\x49\xaa\xe7\x55\x4c\xfe\x45\x88\xcc\xa0\x2e\xc9\x14\x3f\x46\x08\x91\xaa\x16\x25\x08\xbe\xcf\x14\xef\x3d\x44\xa4\xe2\x72\x50\xb5\xcc\x41\x0c\xf2\x55\xf1\xc0\x99\x3e\x9b\x96\xa7\x7f\xbf\x4d\x05\x4f\x83\x30\x9d\x6e\x0a\x90\xbd\x8e\xd0\x53\xdf\x7b\xbf\x3b\x08\x39\x2a\x17\xae\x33\xb4\xb9\x1a\x5f\x61\x5a\x1f\xec\x33\x0b\x1f\xb6\x38\x70\xb9\x0b\x49\x99\x6e\x44\x0e\x89\xdd\xe2\x20\x74\x53\x5a\x3e\x2a\xd3\x59\x82\x0c\x5c\x48\xab\x3c\xe8\xb3\x90\x45\x6a\x95\x92\xba\x09\x0b\x22\x77\xcb\x50\xe9\x26\x3c\xfe\xe8\x86\xc9\xf5\x3b\xb3\x24\xc0\x74\x12\xaa\xd1\x0b\xc7\x97\xcd\xf5\x69\x65\x77\xef\x98\x83\x65\x6c\x2e\x96\xd8\x9c\xb9\xca\xaf\xd8\x83\xfe\x70\x6b\xf1\x5a\x9f\x82\xeb\x66\xec\xc1\xcf\x6e\xe3\xa5\x85\xff\xd7\xcf\xb9\xa5\x15\x33\x17\x7f\x09\x5b\x2c\x17\xfe\x04\xeb\x7a\x67\x06\x32\xed\xff\x07\xba\x9d\xd0\x62\x42\x37\xc4\x0f\xd7\xfb\xc2\x1a\x80\xe9\x16\xd3\x17\x4c\x37\x98\xae\x7f\xed\x60\x0d\xd2\x15\x10\x12\xbd\xff\x04\x00\x00\xff\xff\xbb\xe5\x6d\x12\x64\x02\x00\x00")
-
-func cv_ruJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_cv_ruJson,
-		"cv_RU.json",
-	)
-}
-
-func cv_ruJson() (*asset, error) {
-	bytes, err := cv_ruJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "cv_RU.json", size: 612, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _cy_gbJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xcd\x4a\xc4\x30\x10\x80\xcf\xe9\x53\x84\xc0\xdc\x64\x1f\xa0\xb7\xae\x5d\xd6\x05\x03\x8b\xed\x45\x45\x24\xbb\x49\x4c\xa1\x4d\x61\xda\x12\x82\xf8\xee\xd2\xcd\x8f\x68\xf1\x36\x7f\xf9\xe6\x1b\xc8\x67\x41\xd8\xa9\x66\x25\x65\x57\xff\x7e\xdc\xb3\xbb\x82\xb0\x5a\xf8\x89\x95\xf4\xb5\x20\x84\x35\x4b\xbf\xd6\x08\x7b\xec\x17\x1b\x22\x2e\x1c\xce\x26\xc6\x0a\xaf\x46\x61\x48\x4e\x62\x09\xc1\xd1\x29\x9b\x8a\x8d\x90\x0e\x2d\x2b\xc8\xdb\x8a\x6e\xcc\x88\xf3\x7f\xfc\x8c\xcf\xec\x2d\x37\x43\x13\x91\x8f\x76\x36\x19\x77\x1a\xad\x70\xf1\xd9\xbd\x71\x4a\xe3\x88\x5b\xeb\xc3\x05\xbb\xbe\x4f\xf5\x2e\xad\x33\x4a\x77\xf1\xc6\xe3\x88\x5a\x2b\x6b\x85\x0e\x79\xe5\xa6\x39\x8d\xc9\xf8\xe0\xc1\x4b\x54\xb1\xdf\x8a\xab\x71\x4a\xca\x90\x3d\x19\xf1\xa1\x3d\xfe\x3a\x7a\xe3\x99\x25\xff\x9c\x7d\xb8\xe0\xd6\x2c\x5b\x65\x9d\x6c\x93\x65\x7e\x4c\xb2\x45\x32\xa8\xf8\x99\xa7\xd5\x15\x0f\xed\x33\x4f\xdd\x5a\xcc\x6a\xfd\x01\x20\x77\x30\xec\xc0\xb3\x54\x6c\xbb\xe1\xd6\xa8\xbd\x94\x14\x2a\x0a\x92\x0e\xdd\x44\x61\x4f\xe1\x99\x42\x4b\xe1\xe5\x36\x9a\xc6\xa0\xcd\x69\x5c\xc8\xa0\x2f\x81\x97\xd0\x50\x38\xaf\xd3\xc5\xd7\x77\x00\x00\x00\xff\xff\x0c\x77\xc6\x0a\x70\x02\x00\x00")
-
-func cy_gbJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_cy_gbJson,
-		"cy_GB.json",
-	)
-}
-
-func cy_gbJson() (*asset, error) {
-	bytes, err := cy_gbJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "cy_GB.json", size: 624, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _da_dkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x73\x5f\x60\x6f\x42\x2f\x22\x0b\x82\xbd\xac\x22\xf2\xd7\xc4\xdd\xd6\x26\x59\x92\x54\x10\xf1\xbd\xf6\xbe\x2f\x26\x35\xcd\xb4\xb8\xb7\x99\x7f\xc2\xcc\x57\xe8\x77\x25\xe8\xae\xa6\xad\x24\xcd\xaf\xf5\x3d\xdd\x54\x82\x6a\xfe\x8a\xb4\x95\xcf\x95\x10\x14\x2f\x67\xa7\xf9\x30\xdd\x05\x59\x5e\x74\xea\x42\x84\xf1\x6e\xd1\xc9\xaf\x82\xf7\x60\xa0\x87\xcb\x39\x4c\xa6\x12\x2f\xd3\xca\xe3\xd1\x87\xf4\x7f\x0a\x3b\x18\xc1\x00\xda\xd1\xbc\xd4\x96\xce\x9d\x77\xe9\x88\xc2\x9e\xdd\xc8\xe5\xb9\x69\x03\x8c\xe5\x90\xe6\x3e\x3e\x85\x6e\x28\xd7\x3e\x8b\x7e\x74\x5d\x51\xc3\xac\x78\x3c\x8c\x31\x65\x1d\xcd\x29\x19\xdb\x9a\xc2\xf6\x91\x3c\x8c\xf3\x9f\xab\x48\x9b\xb7\xec\xd6\xdf\x7c\x05\x09\x42\xd0\x81\xed\x9a\x0c\x60\xe0\x02\x14\x70\x80\x02\x8a\x02\x70\xbb\x7b\xd8\x95\xe5\x1c\x96\xa4\xe6\x64\xa6\xdf\x40\xe9\x8d\xb2\x1b\xb5\xa7\x72\x6c\x3a\x9b\x03\x96\x4a\x4b\xd5\x4a\xb5\x97\xaa\x91\xea\xe9\xef\x05\xd2\x06\x76\xde\x20\xaa\x7e\x7e\x03\x00\x00\xff\xff\x79\x41\x3e\x69\x5e\x02\x00\x00")
-
-func da_dkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_da_dkJson,
-		"da_DK.json",
-	)
-}
-
-func da_dkJson() (*asset, error) {
-	bytes, err := da_dkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "da_DK.json", size: 606, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _de_atJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xc3\x30\x10\x45\xd7\xf2\x29\xc4\x80\x76\xcd\x05\xb2\x33\x98\x42\x03\x6e\x0b\xf6\x26\x2d\xa5\x28\xb5\x88\x45\x63\x29\xc8\x72\x4b\x53\x7a\x9b\xdc\x24\x17\x2b\x8a\xa4\xef\x90\xec\xfe\xcc\x13\xf3\x9f\xc1\xbf\x05\xa3\x87\x8a\x96\x9c\x3a\xf5\x5e\xb6\x74\x57\x30\xaa\xe4\xcf\x48\x4b\xfe\x5a\x30\x46\x8d\x35\xc6\xcb\x6d\xd8\x33\xaa\xed\x9c\x2b\xad\xcc\x38\x13\xed\xfd\xb7\xfd\xe8\x13\xb3\xc6\x28\x37\xd3\x7b\xa7\x34\x86\x46\x0e\x67\x52\xb0\xb7\x50\xd6\xf4\xd6\xf9\xab\x46\xb4\xa1\x0a\x2d\x28\xc0\x65\x5c\xcd\x17\x83\x65\x8f\x73\xab\xd3\x31\xc8\xa4\xe7\x6a\x93\x63\x7d\x3a\xba\x43\x8c\xe5\xde\xe9\x5d\xda\x4a\x1d\xc3\x6a\x32\x48\xbb\x94\xca\x69\x3b\x8d\xc9\xa0\x51\x7b\xaf\x06\x5c\x7b\xfa\xf4\x16\xc3\xa3\xfd\xba\x40\x95\x3a\xc4\xe9\xf2\x83\x6f\x1d\x21\x38\xeb\xc1\xee\xd6\x0d\x6a\x30\x83\x16\x84\x20\x03\x8f\xac\x50\xd6\xcf\x75\xee\x8e\x30\x93\x4a\x7a\x15\x7e\x06\xb1\x5e\x88\x61\x21\x3a\xca\xcb\x56\x0f\x11\x48\x2e\x3a\x2e\x36\x5c\xac\xb9\x68\xb9\x78\x39\xbf\x00\x6d\x31\xa6\x0e\xa2\xe2\xef\x3f\x00\x00\xff\xff\x3c\xc2\x1b\x7a\x64\x02\x00\x00")
-
-func de_atJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_de_atJson,
-		"de_AT.json",
-	)
-}
-
-func de_atJson() (*asset, error) {
-	bytes, err := de_atJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "de_AT.json", size: 612, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _de_beJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x4a\xf4\x30\x14\x84\xaf\xd3\xa7\x08\x07\x72\xf7\xef\x0b\xec\x5d\x7f\xaa\xe0\x42\x55\x68\x6f\x56\x11\x49\xed\x61\x1b\xdc\x26\x4b\x9a\x2a\xae\xf8\x36\xbe\x89\x2f\x26\xdd\x26\xd3\xc5\xbd\x9b\x39\x13\x66\xbe\x42\x3f\x33\x41\x37\x05\xad\x25\xb5\xfc\xfc\xff\x8a\xfe\x65\x82\x0a\xfd\x31\xd0\x5a\x3e\x66\x42\x50\xe5\xac\x0d\x7a\x37\xdd\x05\x95\x6e\xd1\x85\x61\x3b\x2c\x89\x09\xe1\xdd\xbd\x74\x31\x73\xd6\xb2\x5f\xd2\x6b\xcf\x06\xa6\xd2\xfd\x29\xc9\xc4\xd3\x34\x56\x75\xce\x87\x3f\x8b\x58\xc3\x14\x56\x30\x80\x66\xb4\xa6\xc6\x89\xb2\x43\xdd\x46\xdb\x51\xfb\xf8\x9a\x1b\x0f\x53\xfe\x7c\xfb\xe3\x2c\xf3\x83\x37\xfb\x78\xd5\x66\x16\x9b\xd1\x42\xed\xa3\xca\xc7\xdd\x38\x44\x84\x8a\x0f\x81\xfb\x86\x63\xdb\xdd\x6b\x70\x30\xb7\xee\xed\x2c\x2a\xf8\x38\xbb\xf3\x2f\xbe\x80\x04\xe1\x42\x07\xb8\x4b\x34\x90\x01\x0c\x54\xe0\x01\x0b\x30\x12\x41\x5e\xde\x97\x69\x7a\x0e\x53\x52\xe8\xc0\xd3\xcf\xa0\xb6\x2b\xd5\xaf\x54\x4b\xe9\x58\x9b\x7e\x0e\xb4\x54\xad\x54\x8d\x54\x5b\xa9\x6a\xa9\x1e\x4e\x2f\x90\xd6\xb0\x71\x83\x28\xfb\xfa\x0d\x00\x00\xff\xff\x91\x3c\x6a\x0a\x64\x02\x00\x00")
-
-func de_beJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_de_beJson,
-		"de_BE.json",
-	)
-}
-
-func de_beJson() (*asset, error) {
-	bytes, err := de_beJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "de_BE.json", size: 612, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _de_chJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x4a\xf4\x30\x14\x84\xaf\xd3\xa7\x08\x07\x72\xf7\xb3\x0f\xb0\x77\xe5\x2f\xa2\x0b\x55\xa1\xbd\x59\x45\x24\xb5\x87\x6d\x70\x9b\x2c\x69\xaa\xb8\xe2\xdb\xf8\x26\xbe\x98\x74\x9b\x4c\x17\xf7\x6e\xe6\x4c\x98\xf9\x0a\xfd\xcc\x04\xdd\x14\xb4\x96\xd4\xf2\xf3\xff\x6b\xfa\x97\x09\x2a\xf4\xc7\x40\x6b\xf9\x98\x09\x41\x95\xb3\x36\xe8\xdd\x74\x17\x54\xba\x45\x17\x86\xed\xb0\x24\x26\x84\x77\xf7\xd2\xc5\xcc\x59\xcb\x7e\x49\xaf\x3c\x1b\x98\x4a\xf7\xa7\x24\x13\x4f\xd3\x58\xd5\x39\x1f\xfe\x2c\x62\x0d\x53\x58\xc1\x00\x9a\xd1\x9a\x1a\x27\xca\x0e\x75\x1b\x6d\x47\xed\xe3\x6b\x6e\x3c\x4c\xf9\xf3\xed\x8f\xb3\xcc\x0f\xde\xec\xe3\x55\x9b\x59\x6c\x46\x0b\xb5\x8f\x2a\x1f\x77\xe3\x10\x11\x2a\x3e\x04\xee\x1b\x8e\x6d\x77\xaf\xc1\xc1\xdc\xba\xb7\xb3\xa8\xe0\xe3\xec\xce\xbf\xf8\x02\x12\x84\x0b\x1d\xe0\x2e\xd1\x40\x06\x30\x50\x81\x07\x2c\xc0\x48\x04\x79\x79\x5f\xa6\xe9\x39\x4c\x49\xa1\x03\x4f\x3f\x83\x6a\x57\xaa\x5f\xa9\x2d\xa5\x63\x6d\xfa\x39\xd0\x52\xb5\x52\x35\x52\x6d\xa5\xaa\xa5\x7a\x38\xbd\x40\x5a\xc3\xc6\x0d\xa2\xec\xeb\x37\x00\x00\xff\xff\xaf\x5e\x09\x3b\x64\x02\x00\x00")
-
-func de_chJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_de_chJson,
-		"de_CH.json",
-	)
-}
-
-func de_chJson() (*asset, error) {
-	bytes, err := de_chJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "de_CH.json", size: 612, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _de_deJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xeb\x30\x10\x86\xd7\xd2\x29\xc4\x80\x76\x8f\x1c\x20\x3b\x83\x5e\xa0\x01\xb7\x05\x7b\x93\x96\x52\xe4\x7a\x88\x45\x63\x29\xc8\x72\x4b\x53\x7a\x9b\xde\xa4\x17\x2b\x8e\x35\xe3\x94\xec\x66\xe6\x13\xff\xff\x19\xfc\x29\x05\xdc\x18\x58\x2b\x68\xf1\xd9\xfc\x87\x7f\x52\x80\xb1\x1f\x03\xac\xd5\xa3\x14\x02\xaa\xe0\x7d\xb2\xfb\xe9\x2e\xa0\x0c\xcb\x6c\x1c\xfa\x61\x21\x2e\xa5\xf7\xf0\xd2\x65\x16\xbc\xc7\xb8\xd0\x4d\x44\xc7\x4b\x65\xfb\x33\x91\xe2\x69\x2a\xab\xba\x10\xd3\xdf\x46\x2a\xa3\x22\xaa\xa0\x70\x0a\xa5\x3c\x8a\x9a\xf4\x3a\xce\xd9\x5a\x3f\xda\xfc\x66\x83\x4d\xe4\xa5\xfc\xf9\x8e\xa7\x79\x2c\x8e\xd1\x1d\xf2\xd5\xe6\xfc\xed\xe8\x79\x3a\xe4\xa9\x18\xf7\xe3\x90\x72\x1f\x1e\x13\xf6\x0d\xe6\xb4\xbb\xd7\x14\x78\xb9\x0d\x6f\x17\xc8\xe0\x69\xde\x2e\x3f\xf5\x4a\x92\x0d\x17\x3b\x96\xbb\x56\x63\x33\x16\x63\x2b\xf6\x61\x17\xd6\x20\x83\xa2\xbc\x2f\xa9\x7a\x86\x44\x8c\x4d\x38\xfd\x05\xba\x5d\xe9\x7e\xa5\x77\x40\xc7\xda\xf5\x33\xb0\x4a\xb7\x4a\x37\x4a\xef\x94\xae\x95\x7e\x38\xbf\x60\x5a\xf3\x9a\x3b\x00\xe4\xd7\x6f\x00\x00\x00\xff\xff\xcb\x44\x00\xd4\x5d\x02\x00\x00")
-
-func de_deJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_de_deJson,
-		"de_DE.json",
-	)
-}
-
-func de_deJson() (*asset, error) {
-	bytes, err := de_deJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "de_DE.json", size: 605, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _de_liJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x14\x84\xd7\xf2\x29\xc4\x03\xed\x7e\x72\x80\xec\x0c\xe6\x87\x84\xba\x2d\xd8\x9b\xb4\x94\x22\xd7\x8f\x58\x34\x96\x82\x2c\xb7\x34\xa5\xb7\xe9\x4d\x7a\xb1\xe2\x58\x1a\x87\x66\x37\xf3\x46\xcc\x7c\x06\x7f\x66\x82\x36\x05\xad\x25\xb5\xfc\x7c\xb3\xa1\x7f\x99\xa0\x42\x7f\x0c\xb4\x96\x8f\x99\x10\x54\x39\x6b\x83\xde\x4f\x77\x41\xa5\x5b\x74\x61\xd8\x0e\x4b\x62\x42\x78\x77\x2f\x5d\xcc\x9c\xb5\xec\x97\xf4\xbf\x67\x03\x53\xe9\xfe\x9c\x64\xe2\x69\x1a\xab\x3a\xe7\xc3\x9f\x45\xac\x61\x0a\x2b\x18\x40\x33\x5a\x53\xe3\x44\xd9\xa1\x6e\xab\xed\xa8\x7d\x7c\xcd\x8d\x87\x29\x7f\xbe\xfd\x69\x96\xf9\xd1\x9b\x43\xbc\x6a\x33\x8b\xed\x68\xa1\x0e\x51\xe5\xe3\x7e\x1c\x22\x42\xc5\xc7\xc0\x7d\xc3\xb1\xed\xee\x35\x38\x98\x5b\xf7\x76\x11\x15\x7c\x9a\xdd\xe5\x17\x5f\x41\x82\x70\xa1\x03\xdc\x35\x1a\xc8\x00\x06\x2a\xf0\x80\x05\x18\x89\x20\x2f\xef\xcb\x34\x3d\x87\x29\x29\x74\xe0\xe9\x67\x50\xed\x4a\xf5\x2b\xb5\xa3\x74\xac\x4d\x3f\x07\x5a\xaa\x56\xaa\x46\xaa\x9d\x54\xb5\x54\x0f\xe7\x17\x48\x6b\xd8\xb8\x41\x94\x7d\xfd\x06\x00\x00\xff\xff\x19\xab\x9b\xaa\x64\x02\x00\x00")
-
-func de_liJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_de_liJson,
-		"de_LI.json",
-	)
-}
-
-func de_liJson() (*asset, error) {
-	bytes, err := de_liJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "de_LI.json", size: 612, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _de_luJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x4a\xf4\x30\x14\x84\xaf\xd3\xa7\x08\x07\x72\xf7\xef\x0b\xec\x5d\xa1\xfc\xe0\x62\x55\x68\xbd\x58\x45\x24\xb5\x87\x6d\x70\x9b\x2c\x69\xaa\xb8\xe2\xdb\xf8\x26\xbe\x98\x74\x9b\x4c\x17\xf7\x6e\xe6\x4c\x98\xf9\x0a\xfd\xcc\x04\x5d\x15\xb4\x96\xd4\xf2\xf3\xf5\x3d\xfd\xcb\x04\x15\xfa\x63\xa0\xb5\x7c\xcc\x84\xa0\xca\x59\x1b\xf4\x6e\xba\x0b\x2a\xdd\xa2\x0b\xc3\x76\x58\x12\x13\xc2\xbb\x7b\xe9\x62\xe6\xac\x65\xbf\xa4\xff\x3d\x1b\x98\x4a\xf7\xa7\x24\x13\x4f\xd3\x58\xd5\x39\x1f\xfe\x2c\x62\x0d\x53\x58\xc1\x00\x9a\xd1\x9a\x1a\x27\xca\x0e\x75\x1b\x6d\x47\xed\xe3\x6b\x6e\x3c\x4c\xf9\xf3\xed\x8f\xb3\xcc\x0f\xde\xec\xe3\x55\x9b\x59\x6c\x46\x0b\xb5\x8f\x2a\x1f\x77\xe3\x10\x11\x2a\x3e\x04\xee\x1b\x8e\x6d\xb7\xaf\xc1\xc1\xdc\xb8\xb7\xb3\xa8\xe0\xe3\xec\xce\xbf\xf8\x02\x12\x84\x0b\x1d\xe0\x2e\xd1\x40\x06\x30\x50\x81\x07\x2c\xc0\x48\x04\x79\x79\x57\xa6\xe9\x39\x4c\x49\xa1\x03\x4f\x3f\x83\xda\xae\x54\xbf\x52\x2d\xa5\x63\x6d\xfa\x39\xd0\x52\xb5\x52\x35\x52\x6d\xa5\xaa\xa5\x7a\x38\xbd\x40\x5a\xc3\xc6\x0d\xa2\xec\xeb\x37\x00\x00\xff\xff\x6a\x59\xec\xff\x64\x02\x00\x00")
-
-func de_luJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_de_luJson,
-		"de_LU.json",
-	)
-}
-
-func de_luJson() (*asset, error) {
-	bytes, err := de_luJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "de_LU.json", size: 612, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dv_mvJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x93\xcd\x4a\xc3\x40\x14\x85\xd7\xc9\x53\x0c\x03\xb3\x13\xba\xcf\x4e\xe8\xc2\x2e\x02\x85\x8a\x50\x45\xa4\x52\xa1\x2e\x6a\x41\x8b\x20\x22\x94\xb4\x34\xa6\x7f\x54\x8a\xf5\x0d\xd2\x34\x0d\x35\x4f\xf4\x3d\x8b\x64\x4c\xa7\xa9\xf8\x00\x2e\x5c\xde\x73\xce\xbd\xf3\xdd\x1b\xf2\x6c\x5b\xb2\x52\x96\x8e\x90\xcd\xc7\x2b\xf7\x4c\x1e\xd9\x96\x2c\x37\x9e\x1e\xa4\x23\x2e\x6c\xcb\x92\xf8\xac\x18\xb3\xc6\x27\x65\x42\x98\x05\x2c\x49\x8f\x4f\x02\x53\xf9\x84\x78\xa4\xcc\x58\xd1\x37\xea\x80\x98\xf1\x41\xd5\x67\xc5\x9c\x94\x11\x21\x13\x22\x33\x2b\x66\xa8\xdd\xd8\x28\x5b\x3c\x22\x7a\x44\x5a\xb5\xad\xcb\x8c\xab\xd6\xea\xdc\x77\xff\x2a\x9c\xdb\xb9\xeb\xb6\xf6\x64\x4b\x12\x3c\x62\xfd\x7a\x9f\x75\xde\x3b\x22\x61\x40\x9a\xf5\xfd\x70\x02\x4d\xf7\x41\x6a\xa8\x13\xde\x75\x72\xcd\xd4\xa8\x01\x09\xbe\x61\x5b\xb2\xc9\x36\x33\x55\xcc\x94\xb0\xe0\xfb\x6c\x99\x11\xea\xad\x16\x26\x37\xcf\x27\x2f\x34\x61\xca\x20\xe3\x28\x74\x0c\xb5\xb7\x39\xd0\x3d\xb6\xbc\xfe\x92\x7f\x23\xd2\xf3\xf6\x7a\xf1\x53\xfd\x9f\xa4\x78\x92\x63\xb7\xea\x9a\x5b\x04\x0c\x0d\xff\x68\x97\x28\x37\xba\x37\xd9\x9f\xa8\x9a\x25\xd5\x2e\xa9\xba\xdc\x89\xa7\xb7\xed\x6f\xe3\x5c\xa8\x13\x47\xb9\x8e\xaa\x09\x55\x17\xea\x5a\xa8\xa6\x50\x0d\x9d\x33\x99\x3c\x60\xc4\xfc\x5d\xa9\xaa\x42\x55\x72\xcf\x7e\xf9\x0a\x00\x00\xff\xff\x03\xd9\x80\x78\xf8\x03\x00\x00")
-
-func dv_mvJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_dv_mvJson,
-		"dv_MV.json",
-	)
-}
-
-func dv_mvJson() (*asset, error) {
-	bytes, err := dv_mvJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dv_MV.json", size: 1016, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _dz_btJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4d\x4b\x02\x51\x14\x5d\xcf\xfc\x0a\x79\x30\xbb\x7e\x81\xbb\xc2\x45\x2e\x06\x02\xdd\x45\x84\x60\x60\x0b\x33\xca\x8d\x45\x10\x83\x09\xe1\x6a\xf2\x03\x71\x51\xea\xd8\x54\xcb\x26\x21\x88\x77\xe7\xb7\xdc\x9f\x12\xef\xce\x7b\xcd\xe8\x73\x1c\xa5\x8d\x3c\x0f\xe7\x9e\x7b\xce\xbd\xf3\xde\xad\x69\xb0\x62\x81\xe5\x73\xac\x7a\x73\x7a\x50\x66\x7b\xa6\xc1\x0a\x95\xd6\x35\xcb\xe7\x8e\x4d\xc3\x60\x08\x0e\xc2\x0b\xc2\x04\x79\x57\x1c\xc2\x2f\x3a\x0c\x91\x77\x05\x77\x95\x30\x42\x08\x04\x22\xce\x2e\xfd\x9d\xa5\x30\x3d\x0c\xbf\x15\x73\x90\xc2\xe9\x23\x2c\x22\x05\xd1\x11\x16\xa9\x34\xf1\xeb\x23\xb4\xc5\xef\x7a\x8e\x8f\xe1\x1c\xe1\x07\xa1\xb7\xb1\xe3\xa3\xf0\x1f\x05\xe1\x5d\x66\x1a\x27\x62\x1e\xa5\x5a\xe3\xaa\xb9\x3c\x14\x39\x08\x25\x11\xc5\x4e\x46\x8d\xe3\x29\x24\x0e\xa3\x10\xdd\xf1\x92\x4b\x05\x4a\x4f\xca\x8d\xdd\xb8\x68\xd6\x74\x2b\xd1\x4e\x68\xea\xed\xbf\xa9\x28\x89\x15\x8e\x23\x45\xa9\x77\x16\xd3\x27\xdf\xa3\x2c\xe6\x10\xe1\x59\x0d\x6f\x03\xcd\xc3\xb0\xaf\x6d\x40\x8b\x10\x06\xd4\xd4\xd9\xa2\xa9\x4b\xcc\x5e\x16\x6d\x86\xe1\x13\x86\x9f\x82\x9f\xa1\xe9\x52\xea\xc5\x16\xad\x1f\x76\xa4\x39\x74\x0e\xb6\xcb\x95\xac\xd2\x96\x95\xfc\x30\xd3\xbe\x07\x3e\x5d\x15\xe7\x33\x0d\xf1\x34\x64\xae\x21\xaf\x1a\xe2\x6b\xc8\x9b\x86\xbc\x6b\xc8\x87\x86\x4c\x91\x4f\xd6\x81\xba\xf3\xa9\x30\x2f\x53\xef\xdb\x47\x76\x1c\xb7\xad\x06\xd3\x51\x45\x03\xda\x73\x20\x41\x59\x54\xa8\x34\xcf\xc4\x2b\x47\xf7\xd0\x4f\x30\x3c\x04\x6e\xb5\xe8\x09\xf0\xac\x3a\xc2\x98\xee\x9f\x6f\x55\x99\x2a\x2b\x9f\xd7\x77\x2c\x45\xe8\xa8\xed\x8d\x11\x38\x82\x6b\x1d\x22\xdc\xd3\x5d\x8a\x5e\xb3\x91\x65\x2f\x03\x1d\xab\x44\x0d\xe3\x66\xff\x92\x90\x33\x5a\x27\x53\xcc\x96\xc9\x59\x97\xcc\xbc\xfb\x0d\x00\x00\xff\xff\xcc\x5f\x74\xcd\x1b\x06\x00\x00")
-
-func dz_btJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_dz_btJson,
-		"dz_BT.json",
-	)
-}
-
-func dz_btJson() (*asset, error) {
-	bytes, err := dz_btJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "dz_BT.json", size: 1563, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _el_cyJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x53\xcd\x4a\xf3\x40\x14\x5d\x27\x4f\x31\x0c\xcc\xee\x83\xee\xb3\xfb\xb0\x9b\x2e\x02\x42\xdd\x54\x11\xa9\xb4\x50\xc1\x5a\xa9\xd9\x88\x08\x8d\x4d\x97\x15\x84\x6e\x2a\x8a\x42\xc5\x55\xab\x24\x12\xa4\x69\x4a\xde\xe0\xdc\x57\x92\xa9\x49\xbc\x69\xb3\x9c\x73\xee\xdc\xf3\x33\xcc\x8d\x69\xc8\x5a\x55\x5a\x42\xb6\xcf\x4f\xf6\x1a\xf2\x9f\x69\xc8\x6a\xf3\xfa\x4a\x5a\xe2\xc8\x34\x0c\x89\x47\x1a\x91\x8b\x25\x7c\x44\xf8\xd0\xb4\x21\x31\x41\x48\x23\xf2\xb0\x20\x17\x7e\x8a\xbd\x91\x8b\x4f\xf2\xf0\x9d\x9d\x11\x92\x87\x39\xb9\x0c\x7b\xc5\x02\x31\x0d\x0a\x88\xaf\x77\xd0\x10\x91\xde\x99\x2b\xcc\x30\x47\x80\x00\x3e\x79\x48\xa4\x69\x1c\x6b\x5f\xf5\x4e\xaf\xef\xec\x9a\xe3\xa6\x98\x99\x25\x37\x92\xcb\x85\x88\xb9\x74\x2e\xe7\x23\xc8\x64\xec\xde\x85\xd3\xf9\xd3\x98\xc2\xc7\x1a\x09\x8d\x74\x18\x2c\x91\xd0\x5d\x7a\xeb\x1d\x21\x02\x72\x4b\xb9\xa7\x34\x3a\xc7\x1e\x68\xa0\x4b\xc2\x6a\x7b\xb2\x70\x9e\x22\xa1\x31\xd6\x25\xd8\x6a\x6b\xdb\x18\x5f\x5a\x9b\x86\xba\xa4\x1c\x9f\x21\xdc\x54\xbc\x40\xbc\x71\xc7\xef\xbc\x20\x22\x8f\xee\x77\xf0\x67\x24\xa5\xf3\x13\x84\x88\xb6\x18\xfe\x18\x65\x55\xf1\x72\x78\x19\xbc\x04\x1e\xbd\x18\xbb\x18\x98\x47\xe5\xf1\x78\x1c\x1e\x81\xdb\xce\x8c\xfe\xb7\xf7\xed\xcc\x21\x0d\xf2\xe7\x8f\x11\x67\x13\xd5\xa6\xd3\xd6\x3f\x40\xb5\x2a\xaa\x5b\x51\xd9\x27\x70\xda\x07\x67\xdd\x5f\xa2\x29\x54\x4b\xa8\x53\xa1\x1a\x42\xf5\x85\x3a\xdc\x4c\xe4\x6c\x3f\x3f\xa6\x5a\x52\xd5\x2c\x65\x5b\xaa\x2e\xd4\xa5\x34\x6f\x7f\x02\x00\x00\xff\xff\x1d\x07\xee\xfa\x64\x03\x00\x00")
-
-func el_cyJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_el_cyJson,
-		"el_CY.json",
-	)
-}
-
-func el_cyJson() (*asset, error) {
-	bytes, err := el_cyJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "el_CY.json", size: 868, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _el_grJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x53\x3d\x4b\xc3\x50\x14\x9d\x93\x5f\xf1\x78\xf0\x36\xa1\x7b\x36\xa1\x20\x1d\x02\x62\x5d\xaa\x88\x54\x5a\xa8\x60\xad\xd4\x2e\x22\x42\x63\xd3\xb1\x82\xd0\xa5\xa2\x28\x54\x9c\x5a\x25\x91\x20\x4d\x53\xf2\x0f\xce\xfd\x4b\xf2\x6a\x12\x6f\xda\x8c\xf7\x9c\x9b\x7b\x3e\x1e\xb9\x35\x0d\x59\x29\x4b\x4b\xc8\xe6\xc5\xe9\xde\x81\xdc\x31\x0d\x59\xae\xdf\x5c\x4b\x4b\x1c\x9b\x86\x21\xf1\x44\x43\x72\xb0\x80\x87\x10\x9f\x9a\x36\x24\xc6\x08\x68\x48\x2e\xe6\xe4\xc0\x4b\xb0\x77\x72\xf0\x45\x2e\x7e\xd2\x19\x01\xb9\x98\x91\xc3\xb0\x37\xcc\x11\x51\x3f\x87\x78\xfa\x06\x0d\x10\xea\x9b\x99\xc2\x14\x33\xf8\xf0\xe1\x91\x8b\x58\x9a\xc6\x89\xf6\x55\x6d\x75\xba\xbd\x6d\x73\xdc\x14\x33\xb3\xe0\x46\x32\xb9\x00\x11\x97\xce\xe4\x3c\xf8\xa9\x8c\xdd\xb9\xec\xb5\xfe\x35\x26\xf0\xb0\x42\x4c\x43\x1d\x06\x0b\xc4\x74\x9f\x7c\xf5\x81\x00\x3e\x39\x85\xdc\x73\x12\x9d\x63\x8f\xd4\xd7\x25\x61\xb9\xb9\x99\x9b\x27\x88\x69\x84\x55\x01\xb6\xdc\xb8\x36\xc2\xb7\xd6\xa6\x81\x2e\x29\xc3\xa7\x08\xd6\x15\xcf\x11\xad\xdd\xf1\x6f\x5e\x11\x92\x4b\x0f\x5b\xf8\x0b\xe2\xc2\xfd\x31\x02\x84\x1b\x0c\x7f\x8c\xa2\xaa\x78\x39\xbc\x0c\x5e\x02\x8f\x9e\x8f\x9d\x0f\xcc\xa3\xf2\x78\x3c\x0e\x8f\xc0\x6d\xa7\x46\x77\xed\x7d\x3b\x75\x48\xfd\xec\xf9\x23\x44\xe9\x46\xb9\xde\x6b\xea\x3f\x40\x35\x4a\xaa\x5d\x52\x35\x99\x82\x87\xe7\xed\x3f\xa2\x2e\x54\x43\xa8\x33\xa1\x6a\x42\x75\x85\x3a\x5a\x6f\x64\x6c\x37\x1b\x13\x2d\xa9\x2a\x96\xb2\x2d\x55\x15\xea\x4a\x9a\x77\xbf\x01\x00\x00\xff\xff\xb9\x91\x42\x9f\x64\x03\x00\x00")
-
-func el_grJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_el_grJson,
-		"el_GR.json",
-	)
-}
-
-func el_grJson() (*asset, error) {
-	bytes, err := el_grJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "el_GR.json", size: 868, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_agJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\x84\x30\x10\x85\xcf\xf1\x57\x84\xc0\xdc\x0a\x7b\xf7\x26\x48\x4b\x17\xd2\x0a\x0a\xa5\x2d\xa5\x44\x0d\x55\xd0\xb8\xc4\xa4\x20\xa5\xff\xbd\xd8\x98\xa7\xec\xde\xe6\x9b\x17\xe6\x7d\x82\x3f\x09\x13\x8f\xb9\x48\xb9\xd0\xe6\x33\x7b\x10\x77\x09\x13\xb9\x5a\x66\x91\xf2\xf7\x84\x31\x51\x7a\xd3\xaa\x65\x5d\x33\x21\xa7\x7d\xae\xbc\x9e\x01\x2f\xba\x35\x07\xac\x3a\x6f\x77\xba\xb7\x3d\xe6\x52\x39\x6f\x57\x4a\xd8\xc7\xda\x54\x76\x93\x75\x57\x75\xe8\x42\x11\x4a\x70\x1e\x97\x71\x36\x5e\x94\x93\x71\x1d\xce\x9d\x95\xf1\xca\x46\x11\x5d\xdb\x9d\xa4\xb2\x4d\x17\xc6\xec\x62\xfb\x21\x6e\xb7\xf8\xec\x8d\x8e\xd3\xb0\xed\x32\xff\xe5\x67\xb7\x55\xea\x8b\xd3\x63\xad\x6d\xc0\xe7\xc6\x4d\x80\xa7\xe9\xfb\x10\xe5\xba\x09\x74\xfc\xe6\x1b\x4d\x28\xc2\x0e\x6e\xb7\x66\x10\x83\x17\xa4\xa0\x03\x15\x58\x44\x81\x4c\x16\x32\x36\x67\x32\xc4\x85\x8c\x69\xae\x9c\x5e\x7f\x07\x6a\x4f\x34\x9e\x68\x11\x71\x59\xf5\x63\x08\x14\xa7\x96\x53\xcd\xe9\x95\x53\xc5\xe9\xed\xff\x05\xd2\x0a\xb8\xf5\x08\x1a\x52\x92\x29\x95\x9c\x8a\xf5\x75\xf2\xfb\x17\x00\x00\xff\xff\x04\x73\x52\x90\x74\x02\x00\x00")
-
-func en_agJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_agJson,
-		"en_AG.json",
-	)
-}
-
-func en_agJson() (*asset, error) {
-	bytes, err := en_agJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_AG.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_auJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\x84\x30\x10\x85\xcf\xf1\x57\x84\x81\xb9\x15\xf6\xee\x4d\x90\xc2\x2e\xa4\x5d\xd0\x52\xda\x52\x4a\x56\x43\x15\xd6\x28\x31\x29\x48\xe9\x7f\x2f\x56\xf3\x94\xee\x6d\xbe\x79\x32\xef\x13\xf2\x9d\x08\x3a\xe6\x94\x4a\x32\xf6\x23\x7b\xa2\xbb\x44\x50\xae\xa7\x91\x52\xf9\x96\x08\x41\x45\xb0\xb5\x9e\xe6\xb5\x20\xd5\x6f\x73\x19\xcc\x08\x78\x36\xb5\xdd\x61\xd9\x04\xb7\xd1\xbd\x6b\x31\x17\xda\x07\x37\x53\x22\xde\xe7\xa6\xa2\xe9\x9d\xff\x57\x87\x2e\x14\xa1\x04\xe7\x71\x19\x67\xe3\x45\xd5\x5b\xdf\xe0\xdc\x49\xdb\xa0\x5d\x14\x31\x17\xb7\x91\xd2\xae\x6a\x96\x31\x1b\x5c\x7b\x8d\xdb\x35\x3e\x05\x6b\xe2\x74\x5d\x77\x59\xf8\x0c\xa3\x5f\x2b\xcd\xe0\x4d\x77\x31\x6e\xc1\xc7\xca\xf7\x80\x87\xfe\x6b\x17\xe5\xa6\x5a\x68\xff\xcf\x37\x9a\x50\x84\x1d\xdc\x6e\xcd\x20\x06\x2f\x48\x41\x07\x2a\xb0\x88\x02\x99\x3a\xab\xd8\x9c\xa9\x25\x3e\xab\x98\xe6\xda\x9b\xf9\x39\x70\x7d\xe0\xee\xc0\x13\xc5\x65\xd9\x76\x4b\xa0\x25\xd7\x92\x2f\x92\x5f\x24\x97\x92\x5f\xff\xbe\x40\x5a\x02\xd7\x1e\xe2\x63\xca\x2a\xe5\x42\xf2\x40\xc9\xcf\x6f\x00\x00\x00\xff\xff\xd9\x0b\x31\x00\x71\x02\x00\x00")
-
-func en_auJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_auJson,
-		"en_AU.json",
-	)
-}
-
-func en_auJson() (*asset, error) {
-	bytes, err := en_auJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_AU.json", size: 625, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_bwJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\x84\x30\x10\x85\xcf\xf1\x57\x84\x81\xdc\x0a\x7b\xdf\x9b\x45\x0a\x5d\xb0\x2d\x28\x2c\xdb\x52\x4a\xd4\xa1\x0a\x6b\xb2\xc4\xa4\xb0\x94\xfe\xf7\x62\x63\x9e\xd2\xbd\xcd\x37\x4f\xe6\x7d\x42\xbe\x33\x41\x8f\x05\xed\x25\xb1\xf9\xb8\x3f\xd2\x5d\x26\xa8\xd0\xd7\x89\xf6\xf2\x2d\x13\x82\xaa\x60\x3a\x7d\x9d\xd7\x82\x4a\xbb\xce\x75\xe0\x09\x70\xe4\xce\x6c\xb0\xee\x83\x5b\xe9\xc1\x0d\x98\x2b\xed\x83\x9b\x29\x13\xef\x73\x53\xd5\x5b\xe7\xff\xd5\xa1\x0b\x45\x28\xc1\x79\x5c\xc6\xd9\x74\xb1\xb4\xc6\xf7\x38\x77\xd0\x26\x68\x97\x44\xb8\x71\x2b\x95\xda\xb5\x7d\x1c\xf3\x8b\x1b\xce\x69\xbb\xc4\x87\x60\x38\x4d\xe7\x65\x97\x87\xcf\x30\xf9\xa5\x92\x2f\x9e\xc7\x86\x5d\xc4\xe7\xd6\x5b\xc0\x93\xfd\xda\x44\x05\xb7\x91\xb6\xff\x7c\xa3\x09\x45\xd8\xc1\xed\xd6\x0c\x62\xf0\x82\x14\x74\xa0\x02\x8b\x24\x90\x97\x2f\x65\x6a\x8e\x61\x4a\x0a\xed\x79\x7e\x0a\xaa\xdb\xa9\x71\xa7\x4e\x94\x96\xf5\x30\xc6\x40\x4b\xd5\x49\xd5\x48\x75\x92\xaa\x96\xea\xf5\xef\x0b\xa4\x35\x70\xe9\x20\xca\x7e\x7e\x03\x00\x00\xff\xff\xe7\xb4\x35\x5f\x62\x02\x00\x00")
-
-func en_bwJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_bwJson,
-		"en_BW.json",
-	)
-}
-
-func en_bwJson() (*asset, error) {
-	bytes, err := en_bwJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_BW.json", size: 610, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_caJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x6a\x84\x30\x10\x45\x9f\xe3\x57\x84\xc0\xbc\x75\x7f\xc0\x37\xa9\x14\x76\x21\xed\x82\x0b\xa5\x2d\xa5\x44\x0d\x55\x58\xa3\x8c\x49\x41\x4a\xff\xbd\x58\xcd\x55\xba\x6f\x73\xe6\xca\xdc\x23\xe4\x3b\x11\xea\x98\xab\x54\x2a\xeb\x3e\xee\x33\x75\x97\x08\x95\x9b\x69\x54\xa9\x7c\x4b\x84\x50\x45\x70\xb5\x99\xe6\xb5\x50\xba\xdf\xe6\x4b\xb0\x23\xe0\xd9\xd6\x6e\x87\x97\x26\xf0\x46\x0f\xdc\x62\x2e\x8c\x0f\x3c\x53\x22\xde\xe7\xa6\xa2\xe9\xd9\xff\xab\x43\x17\x8a\x50\x82\xf3\xb8\x8c\xb3\xf1\xa2\xee\x9d\x6f\x70\xee\x64\x5c\x30\x1c\x45\x6c\xc9\x1b\x69\xc3\x55\xb3\x8c\xd9\xc0\xed\x35\x6e\xd7\xf8\x14\x9c\x8d\xd3\x75\xdd\x65\xe1\x33\x8c\x7e\xad\xb4\x83\xb7\x5d\x69\x79\xc1\xa7\xca\xf7\x80\xc7\xfe\x6b\x17\xe5\xb6\x5a\x68\xff\xcf\x37\x9a\x50\x84\x1d\xdc\x6e\xcd\x20\x06\x2f\x48\x41\x07\x2a\xb0\x88\x02\x99\x3e\xeb\xd8\x9c\xe9\x25\x3e\xeb\x98\xe6\xc6\xdb\xf9\x39\xd0\x74\xa0\xee\x40\xb5\x8a\xcb\x4b\xdb\x2d\x81\x91\x54\x4b\x2a\x25\xbd\x48\x62\x49\xaf\x7f\x5f\x20\x65\xe0\xda\xa3\xe8\x98\x92\x4e\xa9\x90\x34\xa8\xe4\xe7\x37\x00\x00\xff\xff\xb7\x14\x07\xdc\x71\x02\x00\x00")
-
-func en_caJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_caJson,
-		"en_CA.json",
-	)
-}
-
-func en_caJson() (*asset, error) {
-	bytes, err := en_caJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_CA.json", size: 625, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_dkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x4a\xf4\x30\x10\x85\xaf\xd3\xa7\x28\x03\xb9\xfb\xf7\x05\xf6\xae\x10\x7e\x70\x25\x2a\x34\x20\x2a\x22\xd9\x76\xb0\x85\x6d\xb2\xa4\x89\xb0\x88\xef\x2e\xb5\xcd\x69\x75\xef\xe6\x9b\x03\x73\xbe\x40\x3e\x0b\x41\x37\x8a\xf6\x25\xb1\x7b\x53\xb7\xf4\xaf\x10\xa4\xec\x65\xa4\x7d\xf9\x52\x08\x41\x75\x72\xad\xbd\x4c\x6b\x41\xda\xaf\xb3\x49\x3c\x02\x1e\xb9\x75\x1b\x34\x5d\x0a\x2b\xfd\x0f\x3d\xe6\xda\xc6\x14\x26\x2a\xc4\xeb\xd4\x54\x77\x3e\xc4\x3f\x75\xe8\x42\x11\x4a\x70\x1e\x97\x71\x36\x5f\xd4\xde\xc5\x0e\xe7\x0e\xd6\x25\x1b\xb2\x08\x1f\xc3\x4a\xda\x86\xa6\x9b\xc7\xea\x1c\xfa\x53\xde\x2e\xf1\x21\x39\xce\xd3\x69\xd9\x55\xe9\x3d\x8d\x71\xa9\xe4\x73\xe4\xe1\xc8\x61\xc6\xfb\x26\x7a\xc0\x9d\xff\xd8\x44\x8a\x9b\x99\xb6\x6f\xbe\xd2\x84\x22\xec\xe0\x76\x6d\x06\x31\x78\x41\x0a\x3a\x50\x81\x45\x16\xa8\xf4\x83\xce\xcd\x73\x98\x13\x65\x23\x4f\x5f\x41\x3e\xed\xe4\xb0\x93\x2d\xe5\xa5\xe9\x87\x5f\x81\x91\xa6\x94\xcf\x3f\x31\x22\x03\x5c\x0a\x88\x8a\xaf\xef\x00\x00\x00\xff\xff\x8a\x1c\x00\x93\x5f\x02\x00\x00")
-
-func en_dkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_dkJson,
-		"en_DK.json",
-	)
-}
-
-func en_dkJson() (*asset, error) {
-	bytes, err := en_dkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_DK.json", size: 607, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_gbJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x4b\x85\x30\x14\xc6\x9f\xe7\x5f\x31\x06\xe7\x2d\xb8\xef\xbe\x19\x52\x74\x61\x25\x28\x44\x45\xc4\xd4\x91\x82\xce\xcb\xdc\x02\x89\xfe\xf7\xb0\xb9\x4f\xe9\xbe\x9d\xdf\xf9\xc6\xf9\x7e\x82\xdf\x09\x13\x0f\xb9\x48\xb9\xd0\xe6\xe3\xfe\x56\xdc\x24\x4c\xe4\x6a\x99\x45\xca\xdf\x12\xc6\x44\xe9\x4d\xab\x96\x75\xcd\x84\x9c\xf6\xb9\xf2\x7a\x06\x3c\xeb\xd6\x1c\xb0\xea\xbc\xdd\xe9\xce\xf6\x98\x4b\xe5\xbc\x5d\x29\x61\xef\x6b\x53\xd9\x4d\xd6\xfd\xab\x43\x17\x8a\x50\x82\xf3\xb8\x8c\xb3\xf1\xa2\x9c\x8c\xeb\x70\xee\xac\x8c\x57\x36\x8a\xe8\xda\xee\x24\x95\x6d\xba\x30\x66\x17\xdb\x0f\x71\xbb\xc5\x67\x6f\x74\x9c\x86\x6d\x97\xf9\x4f\x3f\xbb\xad\x52\x5f\x9c\x1e\x6b\x6d\x03\x3e\x35\x6e\x02\x3c\x4e\x5f\x87\x28\xd7\x4d\xa0\xe3\x37\x5f\x69\x42\x11\x76\x70\xbb\x36\x83\x18\xbc\x20\x05\x1d\xa8\xc0\x22\x0a\x64\xb2\x90\xb1\x39\x93\x21\x2e\x64\x4c\x73\xe5\xf4\xfa\x3b\x50\x7b\xa2\xf1\x44\x8b\x88\xcb\xaa\x1f\x43\xa0\x38\xb5\x9c\x6a\x4e\x2f\x9c\x2a\x4e\xaf\x7f\x2f\x90\x56\xc0\xad\x47\xd0\x90\x92\x4c\xa9\xe4\x54\xac\xaf\x93\x9f\xdf\x00\x00\x00\xff\xff\x38\xa6\x4c\x58\x74\x02\x00\x00")
-
-func en_gbJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_gbJson,
-		"en_GB.json",
-	)
-}
-
-func en_gbJson() (*asset, error) {
-	bytes, err := en_gbJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_GB.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_hkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x6b\x84\x30\x14\x84\xcf\xf1\x57\x84\x40\x6e\xfe\x02\x6f\x16\x29\xdd\x2d\x69\x17\x14\x4a\x5b\x4a\xc9\xea\xa3\x0a\xbb\x89\xc4\xa4\xb0\x94\xfe\xf7\x62\x35\xa3\xad\x7b\x7b\xf3\xe6\x39\xf3\x09\xf9\x4a\x98\xd8\x15\x22\xe3\x82\xcc\xfb\xdd\xbd\x48\x13\x26\x0a\x7d\x19\x44\xc6\x5f\x13\xc6\x44\x19\x4c\xa3\x2f\xe3\x9a\x09\x65\x97\xb9\x0a\x34\x40\x3c\x51\x63\x56\xb2\x6a\x83\x5b\xd4\xad\xeb\x30\x97\xda\x07\x37\xaa\x84\xbd\x8d\x4d\x65\x6b\x9d\xff\x57\x87\x2e\x14\xa1\x04\xf1\x48\x46\x6c\x4c\x54\xd6\xf8\x16\x71\x7b\x6d\x82\x76\x11\x84\x8e\x6e\x51\x4a\xbb\xba\x9d\xc6\xbc\x77\xdd\x29\x6e\x67\x7b\x1f\x0c\xc5\xe9\x34\xef\xf2\xf0\x11\x06\x3f\x57\x52\xef\xe9\x7c\x24\x37\xc9\xc7\xda\x5b\x88\x07\xfb\xb9\xb2\x0a\xaa\x27\xb5\xfe\xe7\x0d\x26\x10\x41\x07\xb6\x2d\x19\xc0\xc0\x05\x28\xe0\x00\x05\x14\x11\x20\x57\x07\x15\x9b\x73\x35\xd9\x07\x15\xdd\x42\x7b\x1a\x9f\x83\xcc\x53\x2e\x6f\xb8\x6c\x52\x2e\x9f\x45\x74\xaa\xee\xbc\x75\xb9\xec\xe5\x2e\x93\x2a\x93\x25\x97\x2f\xbf\xb7\xb8\xbb\xb2\x9f\xfb\xc5\xdf\xaf\x92\xef\x9f\x00\x00\x00\xff\xff\xef\xba\x5f\xf2\x8b\x02\x00\x00")
-
-func en_hkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_hkJson,
-		"en_HK.json",
-	)
-}
-
-func en_hkJson() (*asset, error) {
-	bytes, err := en_hkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_HK.json", size: 651, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_ieJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\x84\x30\x10\x85\xcf\xf1\x57\x84\x81\xdc\x0a\x7b\xdf\x9b\x60\x0b\xbb\x90\x76\x61\x85\xd2\x96\x52\xa2\x0e\x55\x58\xe3\x12\x93\x82\x94\xfe\xf7\x62\x63\x9e\xd2\xbd\xcd\x37\x4f\xe6\x7d\x42\xbe\x33\x41\x87\x82\xf6\x92\xd8\x7e\x1c\xee\xe9\x2e\x13\x54\x98\x69\xa4\xbd\x7c\xcb\x84\xa0\x73\xb0\x8d\x99\xe6\xb5\x20\x3d\xac\x73\x19\x78\x04\x3c\x73\x63\x37\x58\xb6\xc1\xad\xf4\xe0\x3a\xcc\x67\xe3\x83\x9b\x29\x13\xef\x73\xd3\xb9\x1d\x9c\xff\x57\x87\x2e\x14\xa1\x04\xe7\x71\x19\x67\xd3\x45\x3d\x58\xdf\xe2\xdc\xd1\xd8\x60\x5c\x12\xe1\xca\xad\xa4\x8d\xab\xdb\x38\xe6\x57\xd7\x5d\xd2\x76\x89\x8f\xc1\x72\x9a\x2e\xcb\x2e\x0f\x9f\x61\xf4\x4b\x25\x5f\x3d\xf7\x15\xbb\x88\x4f\xb5\x1f\x00\x8f\xc3\xd7\x26\x2a\xb8\x8e\xb4\xfd\xe7\x1b\x4d\x28\xc2\x0e\x6e\xb7\x66\x10\x83\x17\xa4\xa0\x03\x15\x58\x24\x81\x5c\x9f\x74\x6a\xce\x75\x8c\x4f\x3a\xa5\x85\xf1\x3c\x3f\x07\xd5\xec\x54\xbf\x53\x13\xa5\x65\xd9\xf5\x31\x30\x52\x35\x52\x55\x52\xbd\x48\x55\x4a\xf5\xfa\xf7\x05\xd2\x12\xb8\xf4\x10\x65\x3f\xbf\x01\x00\x00\xff\xff\x06\x28\x2f\x21\x66\x02\x00\x00")
-
-func en_ieJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_ieJson,
-		"en_IE.json",
-	)
-}
-
-func en_ieJson() (*asset, error) {
-	bytes, err := en_ieJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_IE.json", size: 614, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x6a\x83\x30\x18\x85\xaf\xe3\x53\x84\x1f\x72\xb7\x27\xf0\xce\x21\x03\x0b\xe9\x0a\x0a\x63\x1b\x63\xa4\xfa\x33\x85\x36\x4a\x4c\x06\x65\xec\xdd\x87\xd3\x1c\xc5\xde\xfd\xe7\x9c\xf4\x9c\xaf\xe0\x4f\x22\xa8\xc8\x29\x95\xc4\xf6\xb3\x38\xd2\x43\x22\x28\x37\xb7\x91\x52\xf9\x9e\x08\x41\x65\xb0\x8d\xb9\x4d\xb6\x20\xdd\xaf\x77\x15\x78\x84\x78\xe1\xc6\x6e\x64\xd5\x06\xb7\xaa\x27\xd7\xe1\x2e\x8d\x0f\x6e\x52\x89\xf8\x98\x96\xca\xb6\x77\x7e\x37\x87\x2d\x0c\x61\x04\xf5\x68\x46\x6d\x6c\xd4\xbd\xf5\x2d\xea\x0e\xc6\x06\xe3\x22\x08\x9f\xdd\xaa\xb4\x71\x75\x3b\x9f\xd9\xe0\xba\x4b\x74\x97\xf8\x10\x2c\xc7\xeb\xb2\x78\x59\xf8\x0a\xa3\x5f\x26\x79\xf0\x7c\x3d\xb3\x9b\xe5\x73\xed\x7b\x88\x63\xff\xbd\x89\x72\xae\x67\xb5\xfd\xcf\x77\x98\x40\x04\x1d\xd8\xee\xc9\x00\x06\x2e\x40\x01\x07\x28\xa0\x88\x00\x99\x3e\xe9\xb8\x9c\xe9\x39\x3e\xe9\x98\xe6\xc6\xf3\xf4\x39\xa8\x4c\xaa\x46\xaa\x47\xa9\x5e\x29\xfa\x55\x77\xdd\x67\x52\x15\xa9\xd2\xa9\x2a\xa5\x1a\xa4\x7a\xfb\x7f\x8a\x67\x88\x56\x7f\x19\xa7\xdd\xcf\x92\xdf\xbf\x00\x00\x00\xff\xff\xe6\xd5\x57\x2f\x89\x02\x00\x00")
-
-func en_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_inJson,
-		"en_IN.json",
-	)
-}
-
-func en_inJson() (*asset, error) {
-	bytes, err := en_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_IN.json", size: 649, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_ngJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\x84\x30\x10\x85\xcf\xf1\x57\x84\x81\xdc\x0a\x7b\xdf\x9b\x20\x2d\x5d\x70\x5b\x50\x28\xdb\x52\x4a\xd4\xa1\x0a\x6b\xb2\xc4\xa4\xb0\x94\xfe\xf7\x62\x63\x9e\xd2\xbd\xcd\x37\x4f\xe6\x7d\x42\xbe\x33\x41\x8f\x05\xed\x25\xb1\xf9\x38\x3e\xd0\x5d\x26\xa8\xd0\xd7\x89\xf6\xf2\x2d\x13\x82\xaa\x60\x3a\x7d\x9d\xd7\x82\x4a\xbb\xce\x75\xe0\x09\xf0\xc2\x9d\xd9\x60\xdd\x07\xb7\xd2\xbd\x1b\x30\x57\xda\x07\x37\x53\x26\xde\xe7\xa6\xaa\xb7\xce\xff\xab\x43\x17\x8a\x50\x82\xf3\xb8\x8c\xb3\xe9\x62\x69\x8d\xef\x71\xee\xa0\x4d\xd0\x2e\x89\x70\xe3\x56\x2a\xb5\x6b\xfb\x38\xe6\x17\x37\x9c\xd3\x76\x89\x0f\xc1\x70\x9a\xce\xcb\x2e\x0f\x9f\x61\xf2\x4b\x25\x5f\x3c\x8f\x0d\xbb\x88\x4f\xad\xb7\x80\xa3\xfd\xda\x44\x05\xb7\x91\xb6\xff\x7c\xa3\x09\x45\xd8\xc1\xed\xd6\x0c\x62\xf0\x82\x14\x74\xa0\x02\x8b\x24\x90\x97\xcf\x65\x6a\x8e\x61\x4a\x0a\xed\x79\x7e\x0a\xaa\xdb\xa9\x71\xa7\x4e\x94\x96\xf5\x30\xc6\x40\x4b\xd5\x49\xd5\x48\x75\x92\xaa\x96\xea\xf5\xef\x0b\xa4\x35\x70\xe9\x20\xca\x7e\x7e\x03\x00\x00\xff\xff\xda\xe3\x36\x7a\x62\x02\x00\x00")
-
-func en_ngJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_ngJson,
-		"en_NG.json",
-	)
-}
-
-func en_ngJson() (*asset, error) {
-	bytes, err := en_ngJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_NG.json", size: 610, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_nzJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xb4\x30\x10\x86\xcf\xf1\x57\x84\x81\xb9\x7d\xb0\x77\x6f\x82\x7c\xb0\x0b\xd9\x2e\x28\x94\xb6\x94\x92\x5d\x43\x15\xd6\x28\x31\x29\x48\xe9\x7f\x2f\x12\xf3\x2a\xdd\xdb\x3c\xf3\xc2\xbc\x4f\x20\xdf\x99\xa0\x63\x49\xb9\x24\x63\x3f\xce\xaf\xf4\x2f\x13\x54\xea\x79\xa2\x5c\xbe\x65\x42\x50\x15\x6c\xa3\xe7\x65\x2d\x48\x0d\xdb\x5c\x07\x33\x01\x9e\x4d\x63\x77\x58\xb7\xc1\x6d\xf4\xdf\x75\x98\x2b\xed\x83\x5b\x28\x13\xef\x4b\x53\xd5\x0e\xce\xff\xa9\x43\x17\x8a\x50\x82\xf3\xb8\x8c\xb3\xe9\xa2\x1a\xac\x6f\x71\xee\xa4\x6d\xd0\x2e\x89\x98\xab\xdb\x48\x69\x77\x6b\xe3\x58\x8c\xae\xbb\xa7\xed\x1a\x9f\x82\x35\x69\xba\xaf\xbb\x22\x7c\x86\xc9\xaf\x95\x66\xf4\xa6\xbf\x1a\x17\xf1\xe9\xe6\x07\xc0\x79\xf8\xda\x45\xa5\xb9\x45\xda\xbf\xf9\x41\x13\x8a\xb0\x83\xdb\xa3\x19\xc4\xe0\x05\x29\xe8\x40\x05\x16\x49\xa0\x50\x17\x95\x9a\x0b\x15\xe3\x8b\x4a\x69\xa9\xbd\x59\xbe\x03\x37\x07\xee\x0f\x3c\x53\x5a\xd6\x5d\x1f\x03\x2d\xb9\x91\x7c\x95\xfc\x22\xb9\x96\x1c\xff\x0c\xd2\x1a\xb8\xf6\x10\x1f\x73\x56\x39\x57\x92\x47\xca\x7e\x7e\x03\x00\x00\xff\xff\x09\x44\x5b\x69\x71\x02\x00\x00")
-
-func en_nzJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_nzJson,
-		"en_NZ.json",
-	)
-}
-
-func en_nzJson() (*asset, error) {
-	bytes, err := en_nzJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_NZ.json", size: 625, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_phJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\x84\x30\x14\x84\xcf\xf1\x57\x84\x40\x6e\xfe\x02\x6f\x16\x29\xdd\x85\xb4\x82\x42\x69\x4b\x29\x59\x7d\x54\x61\x37\x91\x98\x14\x96\xd2\xff\x5e\x5c\xcd\x28\xeb\xed\xcd\x9b\xe7\xcc\x27\xe4\x37\x61\xe2\x50\x88\x8c\x0b\x32\x5f\xe5\x93\x48\x13\x26\x0a\x7d\x1d\x45\xc6\x3f\x12\xc6\x44\x15\x4c\xab\xaf\xd3\x9a\x09\x65\xd7\xb9\x0e\x34\x42\xbc\x52\x6b\x36\xb2\xee\x82\x5b\xd5\xa3\xeb\x31\x57\xda\x07\x37\xa9\x84\x7d\x4e\x4d\x55\x67\x9d\xbf\xab\x43\x17\x8a\x50\x82\x78\x24\x23\x36\x26\x2a\x6b\x7c\x87\xb8\xa3\x36\x41\xbb\x08\x42\x27\xb7\x2a\xa5\x5d\xd3\xcd\x63\x3e\xb8\xfe\x1c\xb7\x8b\x7d\x0c\x86\xe2\x74\x5e\x76\x79\xf8\x0e\xa3\x5f\x2a\x69\xf0\x74\x39\x91\x9b\xe5\x4b\xe3\x2d\xc4\xb3\xfd\xd9\x58\x05\x35\xb3\xda\xfe\xf3\x0e\x13\x88\xa0\x03\xdb\x9e\x0c\x60\xe0\x02\x14\x70\x80\x02\x8a\x08\x90\xab\x52\xc5\xe6\x5c\xcd\x76\xa9\xa2\x5b\x68\x4f\xd3\x73\x90\x79\xca\x65\xcb\xe5\x43\xca\xe5\x9b\x88\x4e\xdd\x5f\xf6\x2e\x97\x87\x4c\xaa\x4c\x56\x5c\x0e\x5c\xbe\xdf\x8e\x71\x08\x6b\xdd\x2f\x00\xe2\xee\xb3\xe4\xef\x3f\x00\x00\xff\xff\x31\xf4\xaf\x74\x8d\x02\x00\x00")
-
-func en_phJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_phJson,
-		"en_PH.json",
-	)
-}
-
-func en_phJson() (*asset, error) {
-	bytes, err := en_phJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_PH.json", size: 653, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_sgJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\x84\x30\x10\x85\xcf\xf1\x57\x84\x81\xb9\x15\xf6\xee\x4d\x90\x96\x5d\x48\xbb\xa0\x50\x96\x52\x4a\xd4\x50\x85\x35\x4a\x4c\x0a\x4b\xe9\x7f\x2f\x56\xf3\x94\xee\x6d\xbe\x79\x30\xef\x0b\xe4\x3b\x11\x74\xcc\x29\x95\x64\xec\x47\xf1\x44\x0f\x89\xa0\x5c\xdf\x26\x4a\xe5\x5b\x22\x04\x15\xc1\x36\xfa\x36\xaf\x05\xa9\x61\x9b\xcb\x60\x26\xc0\xab\x69\xec\x0e\xcb\x36\xb8\x8d\x1e\x5d\x87\xb9\xd0\x3e\xb8\x99\x12\xf1\x3e\x37\x15\xed\xe0\xfc\xbf\x3a\x74\xa1\x08\x25\x38\x8f\xcb\x38\x1b\x2f\xaa\xc1\xfa\x16\xe7\x4e\xda\x06\xed\xa2\x88\xa9\xdc\x46\x4a\xbb\xba\x5d\xc6\x6c\x74\xdd\x35\x6e\xd7\xf8\x14\xac\x89\xd3\x75\xdd\x65\xe1\x33\x4c\x7e\xad\x34\xa3\x37\x7d\x65\xdc\x82\x2f\xb5\x1f\x00\xcf\xc3\xd7\x2e\xca\x4d\xbd\xd0\xfe\xcd\x77\x9a\x50\x84\x1d\xdc\xee\xcd\x20\x06\x2f\x48\x41\x07\x2a\xb0\x88\x02\x99\x3a\xab\xd8\x9c\xa9\x25\x3e\xab\x98\xe6\xda\x9b\xf9\x3b\x70\x73\xe0\xfe\xc0\x17\x8a\xcb\xb2\xeb\x97\x40\x4b\x6e\x24\x57\x92\x2f\x92\xff\xe4\x08\x51\x09\x5c\x4b\x88\x8f\x29\xab\x94\x0b\xc9\x23\x25\x3f\xbf\x01\x00\x00\xff\xff\xd3\xba\x12\x8e\x6e\x02\x00\x00")
-
-func en_sgJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_sgJson,
-		"en_SG.json",
-	)
-}
-
-func en_sgJson() (*asset, error) {
-	bytes, err := en_sgJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_SG.json", size: 622, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_usJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xb4\x30\x10\x86\xcf\xf1\x57\x84\xc0\xdc\x3e\xd8\xbb\x37\x41\x3e\xd8\x85\xb4\x0b\x5a\xca\xb6\x94\x12\x35\x54\x61\x8d\x32\x26\x85\xa5\xf4\xbf\x17\xab\x79\x95\xee\x6d\x9e\x79\x65\xde\x47\xc8\x57\x22\xd4\x31\x57\xa9\x54\xd6\xbd\x3f\x15\xea\x5f\x22\x54\x6e\x6e\x93\x4a\xe5\x6b\x22\x84\x2a\x82\x6b\xcc\x6d\x5e\x0b\xa5\x87\x6d\x2e\x83\x9d\x00\xcf\xb6\x71\x3b\x2c\xdb\xc0\x1b\xfd\xe7\x0e\x73\x61\x7c\xe0\x99\x12\xf1\x36\x37\x15\xed\xc0\xfe\x4f\x1d\xba\x50\x84\x12\x9c\xc7\x65\x9c\x8d\x17\xf5\xe0\x7c\x8b\x73\x27\xe3\x82\xe1\x28\x62\x2b\xde\x48\x1b\xae\xdb\x65\xcc\x46\xee\xae\x71\xbb\xc6\xa7\xe0\x6c\x9c\xae\xeb\x2e\x0b\x1f\x61\xf2\x6b\xa5\x1d\xbd\xed\x2b\xcb\x0b\x3e\xd6\x7e\x00\x3c\x0c\x9f\xbb\x28\xb7\xf5\x42\xfb\x7f\xbe\xd3\x84\x22\xec\xe0\x76\x6f\x06\x31\x78\x41\x0a\x3a\x50\x81\x45\x14\xc8\xf4\x59\xc7\xe6\x4c\x2f\xf1\x59\xc7\x34\x37\xde\xce\xcf\x81\xfa\x03\x35\x07\xba\xa8\xb8\x2c\xbb\x7e\x09\x8c\xa4\x46\x52\x25\xe9\x22\x89\x25\xbd\xfc\x7e\x81\x94\x81\x6b\x8f\xa2\x63\x4a\x3a\xa5\x42\xd2\xa8\x92\xef\x9f\x00\x00\x00\xff\xff\xf2\x99\x35\xcb\x71\x02\x00\x00")
-
-func en_usJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_usJson,
-		"en_US.json",
-	)
-}
-
-func en_usJson() (*asset, error) {
-	bytes, err := en_usJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_US.json", size: 625, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x51\x6b\x83\x30\x14\x85\x9f\xe3\xaf\x08\x17\xf2\x36\xe8\x7b\xdf\x04\x19\xac\xe0\x36\x50\x18\xed\x18\x23\xea\x65\x0a\x35\x29\x31\x19\x94\xb1\xff\x3e\x5c\xcc\x51\xda\xb7\xfb\xdd\x23\xf7\x7c\x42\x7e\x32\x41\x4f\x05\xed\x25\xb1\xf9\x3c\xe5\xf4\x90\x09\x2a\xf4\x75\xa2\xbd\x7c\xcf\x84\xa0\x2a\x98\x4e\x5f\xe7\xb5\xa0\xd2\xae\x73\x1d\x78\x02\xbc\x71\x67\x36\x58\xf7\xc1\xad\xf4\xe8\x06\xcc\x95\xf6\xc1\xcd\x94\x89\x8f\xb9\xa9\xea\xad\xf3\x37\x75\xe8\x42\x11\x4a\x70\x1e\x97\x71\x36\x5d\x2c\xad\xf1\x3d\xce\x1d\xb4\x09\xda\x25\x11\x6e\xdc\x4a\xa5\x76\x6d\x1f\xc7\xfc\xe2\x86\x73\xda\x2e\xf1\x21\x18\x4e\xd3\x79\xd9\xe5\xe1\x2b\x4c\x7e\xa9\xe4\x8b\xe7\xb1\x61\x17\xf1\xa5\xf5\x16\xf0\x6c\xbf\x37\x51\xc1\x6d\xa4\xed\x3f\xdf\x69\x42\x11\x76\x70\xbb\x37\x83\x18\xbc\x20\x05\x1d\xa8\xc0\x22\x09\xe4\xe5\x6b\x99\x9a\x63\x98\x92\x42\x7b\x9e\x9f\x82\xea\x76\x6a\xdc\xa9\x23\xa5\x65\x3d\x8c\x31\xd0\x52\x75\x52\x35\x52\x1d\xa5\xaa\xa5\x3a\xfd\x7f\x81\xb4\x06\x2e\x1d\x44\xd9\xef\x5f\x00\x00\x00\xff\xff\xac\xa8\x04\xeb\x62\x02\x00\x00")
-
-func en_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_zaJson,
-		"en_ZA.json",
-	)
-}
-
-func en_zaJson() (*asset, error) {
-	bytes, err := en_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_ZA.json", size: 610, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_zmJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\x84\x30\x10\x85\xcf\xf1\x57\x84\xc0\xdc\x0a\x7b\xf7\x26\x48\xa1\x0b\x69\x05\x85\xd2\x2d\xa5\x44\x0d\x55\xd0\xb8\xc4\xa4\x20\xa5\xff\xbd\xd8\x98\xa7\xec\xde\xe6\x9b\x17\xe6\x7d\x82\x3f\x09\x13\x4f\xb9\x48\xb9\xd0\xe6\xf3\x22\xc5\x43\xc2\x44\xae\x96\x59\xa4\xfc\x3d\x61\x4c\x94\xde\xb4\x6a\x59\xd7\x4c\xc8\x69\x9f\x2b\xaf\x67\xc0\xab\x6e\xcd\x01\xab\xce\xdb\x9d\x1e\x6d\x8f\xb9\x54\xce\xdb\x95\x12\xf6\xb1\x36\x95\xdd\x64\xdd\x4d\x1d\xba\x50\x84\x12\x9c\xc7\x65\x9c\x8d\x17\xe5\x64\x5c\x87\x73\x67\x65\xbc\xb2\x51\x44\xd7\x76\x27\xa9\x6c\xd3\x85\x31\xbb\xda\x7e\x88\xdb\x2d\x3e\x7b\xa3\xe3\x34\x6c\xbb\xcc\x7f\xf9\xd9\x6d\x95\xfa\xea\xf4\x58\x6b\x1b\xf0\xa5\x71\x13\xe0\x79\xfa\x3e\x44\xb9\x6e\x02\x1d\xbf\xf9\x4e\x13\x8a\xb0\x83\xdb\xbd\x19\xc4\xe0\x05\x29\xe8\x40\x05\x16\x51\x20\x93\x85\x8c\xcd\x99\x0c\x71\x21\x63\x9a\x2b\xa7\xd7\xdf\x81\xda\x13\x8d\x27\x5a\x44\x5c\x56\xfd\x18\x02\xc5\xa9\xe5\x54\x73\x7a\xe3\x54\x71\xba\xfc\xbf\x40\x5a\x01\xb7\x1e\x41\x43\x4a\x32\xa5\x92\x53\xb1\xbe\x4e\x7e\xff\x02\x00\x00\xff\xff\x70\xfc\x6e\xc6\x74\x02\x00\x00")
-
-func en_zmJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_zmJson,
-		"en_ZM.json",
-	)
-}
-
-func en_zmJson() (*asset, error) {
-	bytes, err := en_zmJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_ZM.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _en_zwJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x51\x6b\x83\x30\x14\x85\x9f\xe3\xaf\x08\x17\xf2\x36\xe8\x7b\xdf\x04\x19\xac\xe0\x36\x50\x28\xed\x18\x23\xea\x65\x0a\x35\x29\x31\x19\x94\xb1\xff\x3e\x5c\xcc\x51\xd6\xb7\xfb\xdd\x23\xf7\x7c\x42\xbe\x33\x41\x4f\x05\xed\x25\xb1\xf9\x38\x1f\xe9\x21\x13\x54\xe8\xdb\x44\x7b\xf9\x96\x09\x41\x55\x30\x9d\xbe\xcd\x6b\x41\xa5\x5d\xe7\x3a\xf0\x04\x38\x72\x67\x36\x58\xf7\xc1\xad\xf4\xe8\x06\xcc\x95\xf6\xc1\xcd\x94\x89\xf7\xb9\xa9\xea\xad\xf3\xff\xea\xd0\x85\x22\x94\xe0\x3c\x2e\xe3\x6c\xba\x58\x5a\xe3\x7b\x9c\x3b\x68\x13\xb4\x4b\x22\xdc\xb8\x95\x4a\xed\xda\x3e\x8e\xf9\xd5\x0d\x97\xb4\x5d\xe2\x43\x30\x9c\xa6\xcb\xb2\xcb\xc3\x67\x98\xfc\x52\xc9\x57\xcf\x63\xc3\x2e\xe2\x4b\xeb\x2d\xe0\xd9\x7e\x6d\xa2\x82\xdb\x48\xdb\x7f\xbe\xd3\x84\x22\xec\xe0\x76\x6f\x06\x31\x78\x41\x0a\x3a\x50\x81\x45\x12\xc8\xcb\xd7\x32\x35\xc7\x30\x25\x85\xf6\x3c\x3f\x05\xd5\xed\xd4\xb8\x53\x27\x4a\xcb\x7a\x18\x63\xa0\xa5\xea\xa4\x6a\xa4\x3a\x49\x55\x4b\x75\xfe\xfb\x02\x69\x0d\x5c\x3a\x88\xb2\x9f\xdf\x00\x00\x00\xff\xff\xd5\x3f\xe3\x0b\x62\x02\x00\x00")
-
-func en_zwJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_en_zwJson,
-		"en_ZW.json",
-	)
-}
-
-func en_zwJson() (*asset, error) {
-	bytes, err := en_zwJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "en_ZW.json", size: 610, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _eoJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x4a\xc3\x40\x18\x85\xd7\x93\x53\x0c\x3f\xcc\xce\x5e\xa0\xbb\x40\x10\x2c\x44\x85\x66\x53\xc4\xc5\x1f\x33\xd8\xd8\x64\xa6\x4c\x26\x05\x15\x0f\xe0\x61\xba\xf4\x06\xed\xbd\xa4\x4d\xfe\x97\xa0\xbb\xf7\xfe\x97\xbc\xf7\x05\xf2\x99\x28\xba\xcb\x68\xa9\xc9\x7a\xba\x49\x14\x65\xfc\xde\xd1\x52\x3f\x25\x4a\x51\x55\xb7\xec\x4e\xdf\xd7\x40\x51\xd3\xbb\x6a\x94\x2d\x07\x48\x1b\x76\xc1\x8a\x3b\xfd\xf0\xf9\x28\xe6\x60\x5d\x35\x45\x1d\x97\x1c\x3d\x25\xea\xf9\x32\xb3\xde\xfa\x10\xff\x6c\x61\x06\x23\x98\x98\xb5\xa3\x1b\xb5\xd2\x99\x7b\x17\xb7\x28\x5c\xb1\xeb\x39\x8c\xe3\xb7\xb6\x0c\x93\xcb\x39\xc4\x51\xa6\xfb\x50\x37\x38\xbf\x8d\x6a\xd5\xbb\x1a\xb2\x11\x99\x9e\x8f\xaf\x7d\x27\x6f\xae\xed\x3e\xda\xb6\x94\xce\x87\x5d\xf4\x30\xf7\xfe\x30\x8b\x32\xfb\x32\xb8\xf9\xb7\xff\x83\x05\x28\x18\x41\x08\x3c\xd0\x81\x6d\x22\x03\x15\x78\xc0\x02\x0c\x21\x48\xf3\xc7\x5c\xa6\x87\x50\x92\x8c\xa3\xbd\xfc\x0d\x66\xb3\x30\xed\xc2\x54\x24\xc7\xa2\x6e\x87\x80\xb5\xa9\xb4\x29\xb5\xd9\x68\x53\x68\xf3\x71\x7d\x02\x69\x01\x3b\x6e\x10\x25\x5f\xbf\x01\x00\x00\xff\xff\x85\x53\x60\x36\x65\x02\x00\x00")
-
-func eoJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_eoJson,
-		"eo.json",
-	)
-}
-
-func eoJson() (*asset, error) {
-	bytes, err := eoJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "eo.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _eo_usJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x14\x84\xd7\xf2\x29\xc4\x83\xb7\xfb\x21\x7b\xef\x0c\xe6\x87\x04\xd4\x06\x9c\x2e\x42\x29\x45\xae\x45\xe3\x26\x96\x82\x2c\x07\xda\xd2\x03\xf4\x30\x59\xf6\x06\xc9\xbd\x8a\x6b\x6b\x6c\xda\xdd\xcc\x1b\x7b\xe6\x33\xf8\x3d\x11\xb4\xcc\x29\x95\x64\xdc\xe3\x5d\x41\xff\x12\x41\xb9\x7e\x6d\x29\x95\xf7\x89\x10\x54\xd5\x8d\xb6\x97\x4f\xd7\x07\x82\x0e\x9d\xad\x46\xd9\x68\x0f\x69\xfc\xde\x9b\xe8\x2e\x5f\xfa\x7a\x8e\xe6\x64\x6c\x35\x45\xad\x2e\x75\x70\x94\x88\x87\x7e\xa6\xd8\x39\x1f\x7e\x6d\x61\x06\x23\x98\x98\xb5\xa3\x1b\xb5\xb1\x53\x39\x1b\x76\x28\x5c\x69\xdb\x69\x3f\x8e\xff\x37\xa5\x9f\x9c\xd2\x3e\x8c\x32\x3b\xfa\xfa\x80\xf3\xcb\xa8\x56\x9d\xad\x21\x0f\x51\x66\xd7\xf3\x73\xd7\xc6\x37\x0b\x73\x0c\xa6\x29\x63\xe7\xed\x3e\x38\x98\x1b\x77\x9a\x45\xb9\x79\x1a\xdc\xfc\xdb\xff\xc0\x02\x14\x8c\x20\x04\x1e\xe8\xc0\x36\x91\x81\x0a\x3c\x60\x01\x46\x24\xc8\xd4\x5a\xc5\xe9\x4c\x0d\xf1\x5a\xc5\x34\xd7\xc1\xf4\x3f\x05\x37\x0b\xae\x16\xbc\xa5\x78\xdc\xd4\xcd\x10\x68\xc9\x95\xe4\x52\xf2\x56\xf2\x46\xf2\xdb\xcf\x13\x48\x3d\xec\xb8\x43\xbc\x4c\x59\xa5\x5c\x48\x3e\x52\xf2\xf1\x1d\x00\x00\xff\xff\x35\x35\x9b\xe5\x77\x02\x00\x00")
-
-func eo_usJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_eo_usJson,
-		"eo_US.json",
-	)
-}
-
-func eo_usJson() (*asset, error) {
-	bytes, err := eo_usJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "eo_US.json", size: 631, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_arJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xda\x0a\xd9\xb3\x05\xbc\x74\x30\x94\xd6\x4b\x5b\x4a\x91\x6c\x35\x51\xb1\xa4\x22\xc9\x06\xb7\xf4\x61\x3a\xf6\x39\xf2\x62\xc5\xb5\x74\x09\xc9\x76\xf7\x5f\x72\xf7\x09\xfc\x55\x31\xba\xad\x69\xcb\x49\xc7\xd7\xdd\x3d\xdd\x54\x8c\x6a\x39\x47\xda\xf2\xe7\x8a\x31\xea\xbd\x35\x6e\xef\x97\x3b\xa3\x61\x74\x3a\xae\xd2\xca\x90\xa0\xcd\xf1\x37\x74\x7e\x28\xfe\x7d\xd4\x53\xd1\x93\xd1\x01\x7f\x8a\xc7\x1f\x25\x7b\x4f\x15\x7b\x59\x76\x1e\x0e\x3e\xa4\x8b\x31\x0c\x61\xe6\xb4\x81\x76\x54\x9f\x6a\x4b\x67\xe3\x5d\x3a\xa0\x50\x3b\x1d\x32\xfb\x9b\x56\x01\xc6\xca\xf0\x99\xa5\x54\xc1\x0c\xe5\x3a\xfb\xb2\xe1\x0c\xe4\x50\xa4\xdc\xfb\x98\xb2\x8e\xfa\x23\x19\x6d\x55\xc8\x0c\xbe\x4b\x23\x8c\xf3\xd3\x79\xd6\x9b\x2e\xdb\xf3\x87\x5f\x91\x82\xf3\xe2\xe9\x52\x05\xf0\x01\x0f\x70\x40\x03\x17\x80\x00\x03\x8c\x02\xb0\x6b\xee\x9a\xb2\xbc\x86\x25\xa9\x65\xd2\xcb\xd7\x20\xfa\x8d\xb0\x1b\x31\x53\x39\xb6\xc6\xae\x81\xe4\xa2\xe7\x42\x71\xf1\xc8\x45\xcb\xc5\xd3\xff\x2f\x90\xb6\xb0\x79\x83\xa8\xfa\xfe\x0b\x00\x00\xff\xff\x9c\x9c\x63\xab\x65\x02\x00\x00")
-
-func es_arJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_arJson,
-		"es_AR.json",
-	)
-}
-
-func es_arJson() (*asset, error) {
-	bytes, err := es_arJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_AR.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_boJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x13\xf6\xbe\x37\xa5\x17\x0f\x45\xc1\x5e\x54\x44\x92\x36\xee\x46\x9a\x44\x92\xb4\x50\xc5\x87\xf1\xe8\x73\xec\x8b\x49\x6d\x32\xbb\xec\xde\x66\xfe\xd9\x9d\xf9\x02\xfd\xaa\x18\xdd\xd6\xb4\xe5\xa4\xe3\xeb\xcd\x1d\x5d\x55\x8c\x6a\x39\x47\xda\xf2\xe7\x8a\x31\xea\xbd\x35\x6e\xe7\x97\x3b\xa3\x61\x74\x3a\xae\xd2\xca\x90\xa0\xcd\xe1\x37\x74\x7e\x28\xfe\x7d\xd4\x53\xd1\x93\xd1\x01\x7f\x8a\x87\x1f\x25\x7b\x4f\x15\x7b\x59\x76\x1e\xf6\x3e\xa4\xb3\x31\x0c\x61\xe6\xb8\x81\x76\x54\x1f\x6b\x4b\x67\xe3\x5d\xda\xa3\x50\x3b\x1d\x32\xfb\x9b\x56\x01\xc6\xca\xf0\x99\xa5\x54\xc1\x0c\xe5\x3a\xfb\xb2\xe1\x0c\xe4\x50\xa4\xdc\xf9\x98\xb2\x8e\xfa\x23\x19\x6d\x55\xc8\x0c\xbe\x4b\x23\x8c\xf3\xd3\x69\xd6\x9b\x2e\xdb\xd3\x87\x5f\x90\x82\xf3\xec\xe9\x52\x05\xf0\x01\x0f\x70\x40\x03\x17\x80\x00\x03\x8c\x02\x70\xdd\xdc\x37\x65\x79\x0d\x4b\x52\xcb\xa4\x97\xaf\x41\xf4\x1b\x61\x37\x62\xa6\x72\x6c\x8d\x5d\x03\xc9\x45\xcf\x85\xe2\xe2\x91\x8b\x96\x8b\xa7\xff\x5f\x20\x6d\x61\xf3\x06\x51\xf5\xfd\x17\x00\x00\xff\xff\x38\x5a\x85\x6f\x65\x02\x00\x00")
-
-func es_boJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_boJson,
-		"es_BO.json",
-	)
-}
-
-func es_boJson() (*asset, error) {
-	bytes, err := es_boJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_BO.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_clJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x13\xf6\xbe\x37\xb1\x17\xc1\x82\x60\x2f\x2a\x22\x49\x1b\x77\x23\x4d\x22\x49\x5a\xa8\xe2\xc3\x78\xf4\x39\xf6\xc5\xa4\x36\x99\x5d\x76\x6f\x33\xff\xec\xce\x7c\x81\x7e\x55\x8c\x6e\x6b\xda\x72\xd2\xf1\xf5\xe6\x8e\xae\x2a\x46\xb5\x9c\x23\x6d\xf9\x73\xc5\x18\xf5\xde\x1a\xb7\xf3\xcb\x9d\xd1\x30\x3a\x1d\x57\x69\x65\x48\xd0\xe6\xf0\x1b\x3a\x3f\x14\xff\x3e\xea\xa9\xe8\xc9\xe8\x80\x3f\xc5\xc3\x8f\x92\xbd\xa7\x8a\xbd\x2c\x3b\x0f\x7b\x1f\xd2\xd9\x18\x86\x30\x73\xdc\x40\x3b\xaa\x8f\xb5\xa5\xb3\xf1\x2e\xed\x51\xa8\x9d\x0e\x99\xfd\x4d\xab\x00\x63\x65\xf8\xcc\x52\xaa\x60\x86\x72\x9d\x7d\xd9\x70\x06\x72\x28\x52\xee\x7c\x4c\x59\x47\xfd\x91\x8c\xb6\x2a\x64\x06\xdf\xa5\x11\xc6\xf9\xe9\x34\xeb\x4d\x97\xed\xe9\xc3\x2f\x48\xc1\x79\xf6\x74\xa9\x02\xf8\x80\x07\x38\xa0\x81\x0b\x40\x80\x01\x46\x01\xb8\x6e\xee\x9b\xb2\xbc\x86\x25\xa9\x65\xd2\xcb\xd7\x20\xfa\x8d\xb0\x1b\x31\x53\x39\xb6\xc6\xae\x81\xe4\xa2\xe7\x42\x71\xf1\xc8\x45\xcb\xc5\xd3\xff\x2f\x90\xb6\xb0\x79\x83\xa8\xfa\xfe\x0b\x00\x00\xff\xff\x9e\xd3\x01\xe8\x65\x02\x00\x00")
-
-func es_clJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_clJson,
-		"es_CL.json",
-	)
-}
-
-func es_clJson() (*asset, error) {
-	bytes, err := es_clJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_CL.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_coJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xff\x56\xc8\xee\x2d\x34\x4b\x06\xd1\x40\xbc\xb4\xa5\x14\xc9\x56\x13\x15\x4b\x0a\x92\x6c\x70\x4b\x1f\xa6\x63\x9f\x23\x2f\x56\x5c\x4b\x7f\x42\xba\xdd\xfd\x97\xdc\x7d\x02\x7f\x56\x0c\xb6\x1b\xa8\x39\xe8\xf8\x7a\xff\x00\x77\x15\x83\x8d\x9c\x22\xd4\xfc\xb9\x62\x0c\x3a\x6f\x8d\x3b\xf8\xf9\xce\xa0\x1f\x9c\x8e\x8b\xb4\x32\x24\xd2\xe6\xfc\x13\x5a\xdf\x17\xff\x3e\xe8\xb1\xe8\xd1\xe8\x40\x7f\x8a\xe7\x6f\x25\x3b\x0f\x15\x7b\x99\x77\xf6\x47\x1f\xd2\xcd\x18\x0d\xd1\xcc\x65\x83\xda\xa9\xfa\x52\x5b\x3a\x85\x77\xe9\x48\x85\xda\xe9\x90\xd9\xdf\xb4\x0a\x64\xac\x0c\x1f\x59\x4a\x15\x4c\x5f\xae\x93\x2f\x1b\xce\x90\xec\x8b\x94\x07\x1f\x53\xd6\x51\x9f\x92\xd1\x56\x85\xcc\xe0\xdb\x34\x90\x71\x7e\xbc\xce\x3a\xd3\x66\x7b\xfd\xf0\x7f\xa4\xc4\x79\xf3\x74\xa9\x02\xf1\x11\x1e\xc1\x11\x1a\x71\x11\x10\xc1\x10\x46\x01\x58\x8b\x9d\x28\xcb\x6b\xb1\xc4\x3b\x51\xd2\x8d\x4c\x7a\xfe\x22\xb0\x5b\xa1\x5d\xe1\x04\xe5\xd8\x18\xbb\x04\x92\x63\xc7\x51\x71\x7c\xe4\xd8\x70\x7c\xfa\xfb\x05\xa5\x0d\xd9\xbc\x03\xb8\xad\x51\xd4\xb8\xe7\x78\x82\xea\xeb\x37\x00\x00\xff\xff\x66\x7a\x10\x66\x74\x02\x00\x00")
-
-func es_coJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_coJson,
-		"es_CO.json",
-	)
-}
-
-func es_coJson() (*asset, error) {
-	bytes, err := es_coJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_CO.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_crJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x10\x86\x67\xf9\x29\xc4\xc1\x6d\x25\xd9\xbd\x95\x7a\xc9\x60\x28\x8d\x97\xb4\x94\x22\xd9\x6a\xa2\x62\x49\x46\x92\x0d\x69\xe9\xc3\x74\xec\x73\xe4\xc5\x8a\x6b\xe9\x12\x92\xed\xff\xef\xce\xff\xff\x09\xfc\x55\x30\xd8\x54\x50\x72\x50\xe1\xed\xe1\x09\xee\x0a\x06\x95\x38\x06\x28\xf9\x4b\xc1\x18\x74\xce\x68\xbb\x77\xf3\x9c\x41\x3f\x5a\x15\x16\x69\x84\x8f\xa4\xf5\xe9\xd7\xb7\xae\xcf\xfe\x63\x54\x53\xd6\x93\x56\x9e\x3e\x0a\xa7\x1f\x29\x3a\x07\x05\x7b\x9d\x7b\xb6\x07\xe7\xe3\x55\x19\x15\x51\xcd\xb9\x83\xd2\x29\xfa\x1c\x9b\x33\x6b\x67\xe3\x81\x02\x95\x55\x3e\xb1\xbf\x2b\xe9\xc9\x18\xe1\x3f\x93\x14\xd2\xeb\x3e\x4f\x8f\x2e\x77\x58\x4d\xb2\xcf\x52\xec\x5d\x88\x49\x07\x35\x44\xad\x8c\xf4\x89\xc1\xb5\x71\x24\x63\xdd\x74\xb9\xeb\x74\x9b\xec\xe5\xc3\x6f\x48\x89\xf3\xea\xe9\x42\x7a\xe2\x23\x3c\x82\x23\x34\xe2\x22\x20\x82\x21\x8c\x0c\x70\x5f\x3f\xd6\xb9\x59\xac\xcc\x6a\x39\x18\x66\x95\x2e\x2a\x11\xd5\xfc\x57\x60\xb7\x46\xb3\xc6\x1d\xe4\x61\xa3\xcd\xb2\x10\x1c\x3b\x8e\x92\xe3\x8e\x63\xc3\xf1\xf9\xff\x82\xb6\x0d\xd9\xd4\x05\xb8\x29\xb1\x2e\x71\xcb\x71\x80\xe2\xfb\x2f\x00\x00\xff\xff\x7f\xa2\x7f\x7b\x78\x02\x00\x00")
-
-func es_crJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_crJson,
-		"es_CR.json",
-	)
-}
-
-func es_crJson() (*asset, error) {
-	bytes, err := es_crJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_CR.json", size: 632, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_cuJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x13\xf6\xbe\x37\xb1\x17\x0f\x05\xc1\x7a\x50\x11\x49\xda\xb8\x1b\x69\x12\x49\xd2\x42\x15\x1f\xc6\xa3\xcf\xb1\x2f\x26\xb5\xc9\xec\xb2\x7b\x9b\xf9\x67\x77\xe6\x0b\xf4\xab\x62\x74\x5b\xd3\x96\x93\x8e\xaf\x37\x0f\x74\x55\x31\xaa\xe5\x1c\x69\xcb\x9f\x2b\xc6\xa8\xf7\xd6\xb8\x9d\x5f\xee\x8c\x86\xd1\xe9\xb8\x4a\x2b\x43\x82\x36\x87\xdf\xd0\xf9\xa1\xf8\xf7\x51\x4f\x45\x4f\x46\x07\xfc\x29\x1e\x7e\x94\xec\x3d\x55\xec\x65\xd9\xb9\xdf\xfb\x90\xce\xc6\x30\x84\x99\xe3\x06\xda\x51\x7d\xac\x2d\x9d\x8d\x77\x69\x8f\x42\xed\x74\xc8\xec\x6f\x5a\x05\x18\x2b\xc3\x67\x96\x52\x05\x33\x94\xeb\xec\xcb\x86\x33\x90\x43\x91\x72\xe7\x63\xca\x3a\xea\x8f\x64\xb4\x55\x21\x33\xf8\x2e\x8d\x30\xce\x4f\xa7\x59\x6f\xba\x6c\x4f\x1f\x7e\x41\x0a\xce\xb3\xa7\x4b\x15\xc0\x07\x3c\xc0\x01\x0d\x5c\x00\x02\x0c\x30\x0a\xc0\x75\x73\xd7\x94\xe5\x35\x2c\x49\x2d\x93\x5e\xbe\x06\xd1\x6f\x84\xdd\x88\x99\xca\xb1\x35\x76\x0d\x24\x17\x3d\x17\x8a\x8b\x47\x2e\x5a\x2e\x9e\xfe\x7f\x81\xb4\x85\xcd\x1b\x44\xd5\xf7\x5f\x00\x00\x00\xff\xff\xb9\xec\x93\xa7\x65\x02\x00\x00")
-
-func es_cuJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_cuJson,
-		"es_CU.json",
-	)
-}
-
-func es_cuJson() (*asset, error) {
-	bytes, err := es_cuJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_CU.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_doJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x10\x86\x67\xf9\x29\xc4\xc1\x6d\x25\xd9\xbd\x15\xbc\x64\x30\x2d\xc4\x4b\x5b\x4a\x91\x6c\x35\x51\xb1\x24\x23\xc9\x06\xb7\xf4\x61\x3a\xf6\x39\xf2\x62\xc5\xb5\x74\x09\xc9\xf6\xff\x77\xe7\xff\xff\x04\xfe\x2a\x18\xec\x2a\x28\x39\xa8\xf0\x56\x3d\xc0\x5d\xc1\xa0\x12\x73\x80\x92\xbf\x14\x8c\x41\xe7\x8c\xb6\x07\xb7\xcc\x19\xf4\xa3\x55\x61\x95\x46\xf8\x48\x5a\x9f\x7e\x7d\xeb\xfa\xec\x3f\x46\x35\x65\x3d\x69\xe5\xe9\xa3\x70\xfa\x91\xa2\x73\x50\xb0\xd7\xa5\x67\x7f\x74\x3e\x5e\x95\x51\x11\xd5\x9c\x3b\x28\x9d\xa2\xcf\xb1\x39\xb3\x76\x36\x1e\x29\x50\x59\xe5\x13\xfb\xbb\x92\x9e\x8c\x11\xfe\x33\x49\x21\xbd\xee\xf3\x74\x76\xb9\xc3\x6a\x92\x7d\x96\xe2\xe0\x42\x4c\x3a\xa8\x21\x6a\x65\xa4\x4f\x0c\xae\x8d\x23\x19\xeb\xa6\xcb\x5d\xa7\xdb\x64\x2f\x1f\x7e\x43\x4a\x9c\x57\x4f\x17\xd2\x13\x1f\xe1\x11\x1c\xa1\x11\x17\x01\x11\x0c\x61\x64\x80\xfb\xfa\xb1\xce\xcd\x62\x63\x36\xeb\xc1\xb0\xa8\x74\x51\x89\xa8\x96\xbf\x02\xbb\x2d\x9a\x2d\xce\x90\x87\x8d\x36\xeb\x42\x70\xec\x38\x4a\x8e\x4f\x1c\x1b\x8e\xcf\xff\x17\xb4\x6d\xc8\xa6\x2e\xc0\x5d\x89\x75\x89\x7b\x8e\x03\x14\xdf\x7f\x01\x00\x00\xff\xff\x45\x84\x96\x04\x78\x02\x00\x00")
-
-func es_doJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_doJson,
-		"es_DO.json",
-	)
-}
-
-func es_doJson() (*asset, error) {
-	bytes, err := es_doJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_DO.json", size: 632, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_ecJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x13\xf6\xbe\x37\xb1\x1e\x3c\x14\x04\x7b\x51\x11\x49\xda\xb8\x1b\x69\x12\x49\xd2\x42\x15\x1f\xc6\xa3\xcf\xb1\x2f\x26\xb5\xc9\xec\xb2\x7b\x9b\xf9\x67\x77\xe6\x0b\xf4\xab\x62\x74\x57\xd3\x96\x93\x8e\xaf\xb7\x37\x74\x55\x31\xaa\xe5\x1c\x69\xcb\x9f\x2b\xc6\xa8\xf7\xd6\xb8\x9d\x5f\xee\x8c\x86\xd1\xe9\xb8\x4a\x2b\x43\x82\x36\x87\xdf\xd0\xf9\xa1\xf8\xf7\x51\x4f\x45\x4f\x46\x07\xfc\x29\x1e\x7e\x94\xec\x3d\x55\xec\x65\xd9\x79\xd8\xfb\x90\xce\xc6\x30\x84\x99\xe3\x06\xda\x51\x7d\xac\x2d\x9d\x8d\x77\x69\x8f\x42\xed\x74\xc8\xec\x6f\x5a\x05\x18\x2b\xc3\x67\x96\x52\x05\x33\x94\xeb\xec\xcb\x86\x33\x90\x43\x91\x72\xe7\x63\xca\x3a\xea\x8f\x64\xb4\x55\x21\x33\xf8\x2e\x8d\x30\xce\x4f\xa7\x59\x6f\xba\x6c\x4f\x1f\x7e\x41\x0a\xce\xb3\xa7\x4b\x15\xc0\x07\x3c\xc0\x01\x0d\x5c\x00\x02\x0c\x30\x0a\xc0\x75\x73\xdf\x94\xe5\x35\x2c\x49\x2d\x93\x5e\xbe\x06\xd1\x6f\x84\xdd\x88\x99\xca\xb1\x35\x76\x0d\x24\x17\x3d\x17\x8a\x8b\x47\x2e\x5a\x2e\x9e\xfe\x7f\x81\xb4\x85\xcd\x1b\x44\xd5\xf7\x5f\x00\x00\x00\xff\xff\xc2\x17\xb3\x43\x65\x02\x00\x00")
-
-func es_ecJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_ecJson,
-		"es_EC.json",
-	)
-}
-
-func es_ecJson() (*asset, error) {
-	bytes, err := es_ecJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_EC.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_esJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xda\x0a\xd9\xb3\x15\xdc\xa1\x83\xa1\x10\x2f\x6d\x29\x45\xb2\xd5\x44\xc5\x92\x8a\x24\x1b\xdc\xd2\x87\xe9\xd8\xe7\xc8\x8b\x15\xd7\xd2\x25\x24\xdb\xdd\x7f\xc9\xdd\x27\xf0\x57\xc5\xe8\xbe\xa6\x2d\x27\x1d\x5f\xef\x76\x74\x53\x31\xaa\xe5\x1c\x69\xcb\x9f\x2b\xc6\xa8\xf7\xd6\xb8\xbd\x5f\xee\x8c\x86\xd1\xe9\xb8\x4a\x2b\x43\x82\x36\xc7\xdf\xd0\xf9\xa1\xf8\xf7\x51\x4f\x45\x4f\x46\x07\xfc\x29\x1e\x7f\x94\xec\x3d\x55\xec\x65\xd9\xd9\x1d\x7c\x48\x17\x63\x18\xc2\xcc\x69\x03\xed\xa8\x3e\xd5\x96\xce\xc6\xbb\x74\x40\xa1\x76\x3a\x64\xf6\x37\xad\x02\x8c\x95\xe1\x33\x4b\xa9\x82\x19\xca\x75\xf6\x65\xc3\x19\xc8\xa1\x48\xb9\xf7\x31\x65\x1d\xf5\x47\x32\xda\xaa\x90\x19\x7c\x97\x46\x18\xe7\xa7\xf3\xac\x37\x5d\xb6\xe7\x0f\xbf\x22\x05\xe7\xc5\xd3\xa5\x0a\xe0\x03\x1e\xe0\x80\x06\x2e\x00\x01\x06\x18\x05\xe0\xb6\x79\x68\xca\xf2\x1a\x96\xa4\x96\x49\x2f\x5f\x83\xe8\x37\xc2\x6e\xc4\x4c\xe5\xd8\x1a\xbb\x06\x92\x8b\x9e\x0b\xc5\xc5\x23\x17\x2d\x17\x4f\xff\xbf\x40\xda\xc2\xe6\x0d\xa2\xea\xfb\x2f\x00\x00\xff\xff\xc8\x7b\x5f\xa6\x65\x02\x00\x00")
-
-func es_esJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_esJson,
-		"es_ES.json",
-	)
-}
-
-func es_esJson() (*asset, error) {
-	bytes, err := es_esJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_ES.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_gtJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\x03\x31\x18\x84\xcf\xd9\xa7\x08\x3f\xe4\x26\xf4\xde\x9b\x50\x10\x0f\x0b\x82\x7b\x51\x11\x49\x36\xb1\x8d\x6c\x12\x49\xb2\x0b\xab\xf8\x30\x1e\x7d\x8e\xbe\x98\xac\x9b\x4c\x4b\xbd\xcd\xfc\xd3\xce\x7c\x81\xfd\x6c\x18\xdd\xee\x68\xcb\xc9\xa4\x97\x9b\x8e\xae\x1a\x46\x3b\x39\x27\xda\xf2\xa7\x86\x31\xd2\xc1\x59\xbf\x0f\xcb\x9d\xd1\x30\x7a\x93\x56\xe9\x64\xcc\xd0\xf6\xf8\x13\xfb\x30\x54\xff\x36\x9a\xa9\xea\xc9\x9a\x88\x3f\xa5\xe3\xb7\x92\x3a\x50\xc3\x9e\x97\x9d\xfb\x43\x88\xf9\x62\x0c\x43\x98\x39\x6d\xa0\x1d\xd5\xa7\xda\xda\xd9\x06\x9f\x0f\x28\x34\xde\xc4\xc2\xfe\x6a\x54\x84\x71\x32\x7e\x14\x29\x55\xb4\x43\xbd\xce\xa1\x6e\x78\x0b\x39\x54\x29\xf7\x21\xe5\xa2\x93\x79\xcf\xd6\x38\x15\x0b\x43\xe8\xf3\x08\xe3\xc3\x74\x9e\x69\xdb\x17\x7b\xfe\xf0\x7f\xa4\xe0\xbc\x78\xba\x54\x11\x7c\xc0\x03\x1c\xd0\xc0\x05\x20\xc0\x00\xa3\x02\x5c\xb7\x77\x6d\x5d\x5e\xc3\x9a\xec\x64\x36\xcb\xd7\x20\xf4\x46\xb8\x8d\x98\xa9\x1e\x3b\xeb\xd6\x40\x72\xa1\xb9\x50\x5c\x3c\x70\xd1\x71\xf1\xf8\xf7\x0b\xa4\x1d\x6c\xd9\x20\x6a\xbe\x7e\x03\x00\x00\xff\xff\xed\x0b\xaf\xaa\x65\x02\x00\x00")
-
-func es_gtJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_gtJson,
-		"es_GT.json",
-	)
-}
-
-func es_gtJson() (*asset, error) {
-	bytes, err := es_gtJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_GT.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_hnJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x13\xf6\xbe\x37\xa1\x07\x3d\x54\x04\x7b\x51\x11\x49\xda\xb8\x1b\x69\x12\x49\xd2\x42\x15\x1f\xc6\xa3\xcf\xb1\x2f\x26\xb5\xc9\xec\xb2\x7b\x9b\xf9\x67\x77\xe6\x0b\xf4\xab\x62\x74\x5b\xd3\x96\x93\x8e\xaf\x37\x77\x74\x55\x31\xaa\xe5\x1c\x69\xcb\x9f\x2b\xc6\xa8\xf7\xd6\xb8\x9d\x5f\xee\x8c\x86\xd1\xe9\xb8\x4a\x2b\x43\x82\x36\x87\xdf\xd0\xf9\xa1\xf8\xf7\x51\x4f\x45\x4f\x46\x07\xfc\x29\x1e\x7e\x94\xec\x3d\x55\xec\x65\xd9\x79\xd8\xfb\x90\xce\xc6\x30\x84\x99\xe3\x06\xda\x51\x7d\xac\x2d\x9d\x8d\x77\x69\x8f\x42\xed\x74\xc8\xec\x6f\x5a\x05\x18\x2b\xc3\x67\x96\x52\x05\x33\x94\xeb\xec\xcb\x86\x33\x90\x43\x91\x72\xe7\x63\xca\x3a\xea\x8f\x64\xb4\x55\x21\x33\xf8\x2e\x8d\x30\xce\x4f\xa7\x59\x6f\xba\x6c\x4f\x1f\x7e\x41\x0a\xce\xb3\xa7\x4b\x15\xc0\x07\x3c\xc0\x01\x0d\x5c\x00\x02\x0c\x30\x0a\xc0\x75\x73\xdf\x94\xe5\x35\x2c\x49\x2d\x93\x5e\xbe\x06\xd1\x6f\x84\xdd\x88\x99\xca\xb1\x35\x76\x0d\x24\x17\x3d\x17\x8a\x8b\x47\x2e\x5a\x2e\x9e\xfe\x7f\x81\xb4\x85\xcd\x1b\x44\xd5\xf7\x5f\x00\x00\x00\xff\xff\x97\x7c\xcf\xad\x65\x02\x00\x00")
-
-func es_hnJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_hnJson,
-		"es_HN.json",
-	)
-}
-
-func es_hnJson() (*asset, error) {
-	bytes, err := es_hnJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_HN.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_mxJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xbf\x6a\xc3\x30\x18\xc4\x67\xf9\x29\xc4\x07\xda\x0a\xd9\xb3\x15\xb2\x74\x30\x14\xea\xa1\x7f\x28\x45\xb2\xd4\x44\xc5\x92\x8a\x24\x1b\xdc\xd2\x87\xe9\xd8\xe7\xc8\x8b\x15\xd7\xd2\x25\xa4\xdb\xdd\x77\xc9\xdd\x4f\xe0\xcf\x86\xd1\xcd\x8e\xb6\x9c\x4c\x7a\x69\xef\xe9\xaa\x61\xb4\x93\x73\xa2\x2d\x7f\x6a\x18\x23\x1d\x9c\xf5\xfb\xb0\xdc\x19\x0d\xa3\x37\x69\x95\x4e\xc6\x0c\x6d\x8f\x3f\xb1\x0f\x43\xf5\x6f\xa3\x99\xaa\x9e\xac\x89\xf8\x53\x3a\x7e\x2b\xa9\x03\x35\xec\x79\xd9\xb9\x3b\x84\x98\x2f\xc6\x30\x84\x99\xd3\x06\xda\x51\x7d\xaa\xad\x9d\x6d\xf0\xf9\x80\x42\xe3\x4d\x2c\xec\xaf\x46\x45\x18\x27\xe3\x47\x91\x52\x45\x3b\xd4\xeb\x1c\xea\x86\xb7\x90\x43\x95\x72\x1f\x52\x2e\x3a\x99\xf7\x6c\x8d\x53\xb1\x30\x84\x3e\x8f\x30\x3e\x4c\xe7\x99\xb6\x7d\xb1\xe7\x0f\xff\x47\x0a\xce\x8b\xa7\x4b\x15\xc1\x07\x3c\xc0\x01\x0d\x5c\x00\x02\x0c\x30\x2a\xc0\x75\x7b\xdb\xd6\xe5\x35\xac\xc9\x4e\x66\xb3\x7c\x0d\x42\x6f\x84\xdb\x88\x99\xea\xb1\xb3\x6e\x0d\x24\x17\x9a\x0b\xc5\xc5\x03\x17\x1d\x17\x8f\x7f\xbf\x40\xda\xc1\x96\x0d\xa2\xe6\xeb\x37\x00\x00\xff\xff\x4d\xe6\x58\x16\x65\x02\x00\x00")
-
-func es_mxJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_mxJson,
-		"es_MX.json",
-	)
-}
-
-func es_mxJson() (*asset, error) {
-	bytes, err := es_mxJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_MX.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_niJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xf3\x30\x14\x85\x67\xf9\x29\xc4\x85\xbb\xfd\x90\xdd\x5b\x20\x8b\x07\xfd\x04\xe2\xa5\x2d\xa5\x48\xb6\x9a\xa8\x58\x52\x90\x64\x83\x5b\xfa\x30\x1d\xfb\x1c\x79\xb1\xe2\x5a\xba\x09\xe9\x76\xce\x3d\xc9\x39\x9f\xc0\x1f\x15\x83\x66\x07\x35\x07\x1d\x5f\xfe\x37\xf0\xaf\x62\xb0\x93\x73\x84\x9a\x3f\x55\x8c\x41\xef\xad\x71\x47\xbf\xdc\x19\x0c\xa3\xd3\x71\x95\x56\x86\x44\xda\x5c\xbe\x43\xe7\x87\xe2\xdf\x46\x3d\x15\x3d\x19\x1d\xe8\x4f\xf1\xf2\xa5\x64\xef\xa1\x62\xcf\xcb\xce\xe1\xe4\x43\xba\x1b\xa3\x21\x9a\xb9\x6e\x50\x3b\x55\x5f\x6b\x4b\xa7\xf0\x2e\x9d\xa8\x50\x3b\x1d\x32\xfb\xab\x56\x81\x8c\x95\xe1\x3d\x4b\xa9\x82\x19\xca\x75\xf6\x65\xc3\x19\x92\x43\x91\xf2\xe8\x63\xca\x3a\xea\x73\x32\xda\xaa\x90\x19\x7c\x97\x46\x32\xce\x4f\xb7\x59\x6f\xba\x6c\x6f\x1f\xfe\x87\x94\x38\xef\x9e\x2e\x55\x20\x3e\xc2\x23\x38\x42\x23\x2e\x02\x22\x18\xc2\x28\x00\x5b\xb1\x17\x65\x79\x2b\xd6\x78\x2f\x4a\xba\x93\x49\x2f\x5f\x04\xf6\x1b\xb4\x1b\x9c\xa1\x1c\x5b\x63\xd7\x40\x72\xec\x39\x2a\x8e\x0f\x1c\x5b\x8e\x8f\xbf\xbf\xa0\xb4\x25\x9b\x77\x00\x9b\x1a\x45\x8d\x07\x8e\x67\xa8\x3e\x7f\x02\x00\x00\xff\xff\x20\x45\xd5\x60\x74\x02\x00\x00")
-
-func es_niJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_niJson,
-		"es_NI.json",
-	)
-}
-
-func es_niJson() (*asset, error) {
-	bytes, err := es_niJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_NI.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_paJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xda\x0a\xd9\xb3\x05\xbc\x74\x30\x04\xea\xa5\x2d\xa5\x48\xb6\x9a\xa8\x58\x52\x91\x64\x83\x5b\xfa\x30\x1d\xfb\x1c\x79\xb1\xe2\x5a\xba\x84\x64\xbb\xfb\x2f\xb9\xfb\x04\xfe\xaa\x18\xdd\xd7\xb4\xe5\xa4\xe3\xeb\x7e\x47\x77\x15\xa3\x5a\xce\x91\xb6\xfc\xb9\x62\x8c\x7a\x6f\x8d\x3b\xf8\xe5\xce\x68\x18\x9d\x8e\xab\xb4\x32\x24\x68\x73\xfa\x0d\x9d\x1f\x8a\x7f\x1f\xf5\x54\xf4\x64\x74\xc0\x9f\xe2\xe9\x47\xc9\xde\x53\xc5\x5e\x96\x9d\x87\xa3\x0f\xe9\x6a\x0c\x43\x98\x39\x6f\xa0\x1d\xd5\xe7\xda\xd2\xd9\x78\x97\x8e\x28\xd4\x4e\x87\xcc\xfe\xa6\x55\x80\xb1\x32\x7c\x66\x29\x55\x30\x43\xb9\xce\xbe\x6c\x38\x03\x39\x14\x29\x0f\x3e\xa6\xac\xa3\xfe\x48\x46\x5b\x15\x32\x83\xef\xd2\x08\xe3\xfc\x74\x99\xf5\xa6\xcb\xf6\xf2\xe1\x37\xa4\xe0\xbc\x7a\xba\x54\x01\x7c\xc0\x03\x1c\xd0\xc0\x05\x20\xc0\x00\xa3\x00\xec\x9a\x7d\x53\x96\xd7\xb0\x24\xb5\x4c\x7a\xf9\x1a\x44\xbf\x11\x76\x23\x66\x2a\xc7\xd6\xd8\x35\x90\x5c\xf4\x5c\x28\x2e\x1e\xb9\x68\xb9\x78\xfa\xff\x05\xd2\x16\x36\x6f\x10\x55\xdf\x7f\x01\x00\x00\xff\xff\x42\x7c\x3b\x29\x65\x02\x00\x00")
-
-func es_paJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_paJson,
-		"es_PA.json",
-	)
-}
-
-func es_paJson() (*asset, error) {
-	bytes, err := es_paJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_PA.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_peJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xf3\x30\x14\x85\x67\xf9\x29\xc4\x85\xbb\xfd\x90\xdd\x5b\x20\xff\x90\x41\x60\x88\x97\xb6\x94\x22\xd9\x6a\xa2\x62\x49\x41\x92\x0d\x6e\xe9\xc3\x74\xec\x73\xe4\xc5\x8a\x6b\xe9\x26\xa4\xdb\x39\xf7\x24\xe7\x7c\x02\x7f\x54\x0c\xf6\x3b\xa8\x39\xe8\xf8\xd2\xfc\x87\x7f\x15\x83\x9d\x9c\x23\xd4\xfc\xa9\x62\x0c\x7a\x6f\x8d\x3b\xfa\xe5\xce\x60\x18\x9d\x8e\xab\xb4\x32\x24\xd2\xe6\xf2\x1d\x3a\x3f\x14\xff\x36\xea\xa9\xe8\xc9\xe8\x40\x7f\x8a\x97\x2f\x25\x7b\x0f\x15\x7b\x5e\x76\x0e\x27\x1f\xd2\xdd\x18\x0d\xd1\xcc\x75\x83\xda\xa9\xfa\x5a\x5b\x3a\x85\x77\xe9\x44\x85\xda\xe9\x90\xd9\x5f\xb5\x0a\x64\xac\x0c\xef\x59\x4a\x15\xcc\x50\xae\xb3\x2f\x1b\xce\x90\x1c\x8a\x94\x47\x1f\x53\xd6\x51\x9f\x93\xd1\x56\x85\xcc\xe0\xbb\x34\x92\x71\x7e\xba\xcd\x7a\xd3\x65\x7b\xfb\xf0\x3f\xa4\xc4\x79\xf7\x74\xa9\x02\xf1\x11\x1e\xc1\x11\x1a\x71\x11\x10\xc1\x10\x46\x01\xd8\x8a\x46\x94\xe5\xad\x58\xe3\x46\x94\x74\x27\x93\x5e\xbe\x08\xec\x37\x68\x37\x38\x43\x39\xb6\xc6\xae\x81\xe4\xd8\x73\x54\x1c\x1f\x38\xb6\x1c\x1f\x7f\x7f\x41\x69\x4b\x36\xef\x00\xee\x6b\x14\x35\x1e\x38\x9e\xa1\xfa\xfc\x09\x00\x00\xff\xff\xf9\x83\x5e\xef\x74\x02\x00\x00")
-
-func es_peJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_peJson,
-		"es_PE.json",
-	)
-}
-
-func es_peJson() (*asset, error) {
-	bytes, err := es_peJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_PE.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_prJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xda\x0a\xd9\xb3\x15\xbc\x74\x30\x84\xd6\x4b\x5b\x4a\x91\x6c\x35\x51\xb1\xa4\x22\xc9\x06\xb7\xf4\x61\x3a\xf6\x39\xf2\x62\xc5\xb5\x74\x09\xc9\x76\xf7\x5f\x72\xf7\x09\xfc\x55\x31\xba\xab\x69\xcb\x49\xc7\xd7\xdd\x3d\xdd\x54\x8c\x6a\x39\x47\xda\xf2\xe7\x8a\x31\xea\xbd\x35\x6e\xef\x97\x3b\xa3\x61\x74\x3a\xae\xd2\xca\x90\xa0\xcd\xf1\x37\x74\x7e\x28\xfe\x7d\xd4\x53\xd1\x93\xd1\x01\x7f\x8a\xc7\x1f\x25\x7b\x4f\x15\x7b\x59\x76\x1e\x0e\x3e\xa4\x8b\x31\x0c\x61\xe6\xb4\x81\x76\x54\x9f\x6a\x4b\x67\xe3\x5d\x3a\xa0\x50\x3b\x1d\x32\xfb\x9b\x56\x01\xc6\xca\xf0\x99\xa5\x54\xc1\x0c\xe5\x3a\xfb\xb2\xe1\x0c\xe4\x50\xa4\xdc\xfb\x98\xb2\x8e\xfa\x23\x19\x6d\x55\xc8\x0c\xbe\x4b\x23\x8c\xf3\xd3\x79\xd6\x9b\x2e\xdb\xf3\x87\x5f\x91\x82\xf3\xe2\xe9\x52\x05\xf0\x01\x0f\x70\x40\x03\x17\x80\x00\x03\x8c\x02\x70\xdb\xec\x9a\xb2\xbc\x86\x25\xa9\x65\xd2\xcb\xd7\x20\xfa\x8d\xb0\x1b\x31\x53\x39\xb6\xc6\xae\x81\xe4\xa2\xe7\x42\x71\xf1\xc8\x45\xcb\xc5\xd3\xff\x2f\x90\xb6\xb0\x79\x83\xa8\xfa\xfe\x0b\x00\x00\xff\xff\x71\xb9\x3e\x7e\x65\x02\x00\x00")
-
-func es_prJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_prJson,
-		"es_PR.json",
-	)
-}
-
-func es_prJson() (*asset, error) {
-	bytes, err := es_prJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_PR.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_pyJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xda\x0a\xd9\xb3\x15\xbc\x74\x30\x04\xea\x25\x2d\xa5\x48\xb6\x9a\xa8\x58\x52\x91\x64\x83\x5b\xfa\x30\x1d\xfb\x1c\x79\xb1\xe2\x5a\xba\x84\x64\xbb\xfb\x2f\xb9\xfb\x04\xfe\xaa\x18\x3d\xd4\xb4\xe5\xa4\xe3\xeb\x6e\x4f\x77\x15\xa3\x5a\xce\x91\xb6\xfc\xb9\x62\x8c\x7a\x6f\x8d\x3b\xf8\xe5\xce\x68\x18\x9d\x8e\xab\xb4\x32\x24\x68\x73\xfa\x0d\x9d\x1f\x8a\x7f\x1f\xf5\x54\xf4\x64\x74\xc0\x9f\xe2\xe9\x47\xc9\xde\x53\xc5\x5e\x96\x9d\xc7\xa3\x0f\xe9\x6a\x0c\x43\x98\x39\x6f\xa0\x1d\xd5\xe7\xda\xd2\xd9\x78\x97\x8e\x28\xd4\x4e\x87\xcc\xfe\xa6\x55\x80\xb1\x32\x7c\x66\x29\x55\x30\x43\xb9\xce\xbe\x6c\x38\x03\x39\x14\x29\x0f\x3e\xa6\xac\xa3\xfe\x48\x46\x5b\x15\x32\x83\xef\xd2\x08\xe3\xfc\x74\x99\xf5\xa6\xcb\xf6\xf2\xe1\x37\xa4\xe0\xbc\x7a\xba\x54\x01\x7c\xc0\x03\x1c\xd0\xc0\x05\x20\xc0\x00\xa3\x00\xdc\x37\xbb\xa6\x2c\xaf\x61\x49\x6a\x99\xf4\xf2\x35\x88\x7e\x23\xec\x46\xcc\x54\x8e\xad\xb1\x6b\x20\xb9\xe8\xb9\x50\x5c\xec\xb9\x68\xb9\x78\xfa\xff\x05\xd2\x16\x36\x6f\x10\x55\xdf\x7f\x01\x00\x00\xff\xff\x4d\x26\x21\xbe\x65\x02\x00\x00")
-
-func es_pyJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_pyJson,
-		"es_PY.json",
-	)
-}
-
-func es_pyJson() (*asset, error) {
-	bytes, err := es_pyJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_PY.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_svJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x13\xf6\xbe\x37\xa1\x17\x0f\x05\x61\x8b\xa0\x22\x92\xb4\x71\x37\xd2\x24\x92\xa4\x85\x2a\x3e\x8c\x47\x9f\x63\x5f\x4c\x6a\x93\xd9\x65\xf7\x36\xf3\xcf\xee\xcc\x17\xe8\x57\xc5\xe8\xae\xa6\x2d\x27\x1d\x5f\x77\x0f\x74\x53\x31\xaa\xe5\x1c\x69\xcb\x9f\x2b\xc6\xa8\xf7\xd6\xb8\xbd\x5f\xee\x8c\x86\xd1\xe9\xb8\x4a\x2b\x43\x82\x36\xc7\xdf\xd0\xf9\xa1\xf8\xf7\x51\x4f\x45\x4f\x46\x07\xfc\x29\x1e\x7f\x94\xec\x3d\x55\xec\x65\xd9\xd9\x1d\x7c\x48\x17\x63\x18\xc2\xcc\x69\x03\xed\xa8\x3e\xd5\x96\xce\xc6\xbb\x74\x40\xa1\x76\x3a\x64\xf6\x37\xad\x02\x8c\x95\xe1\x33\x4b\xa9\x82\x19\xca\x75\xf6\x65\xc3\x19\xc8\xa1\x48\xb9\xf7\x31\x65\x1d\xf5\x47\x32\xda\xaa\x90\x19\x7c\x97\x46\x18\xe7\xa7\xf3\xac\x37\x5d\xb6\xe7\x0f\xbf\x22\x05\xe7\xc5\xd3\xa5\x0a\xe0\x03\x1e\xe0\x80\x06\x2e\x00\x01\x06\x18\x05\xe0\xb6\xb9\x6f\xca\xf2\x1a\x96\xa4\x96\x49\x2f\x5f\x83\xe8\x37\xc2\x6e\xc4\x4c\xe5\xd8\x1a\xbb\x06\x92\x8b\x9e\x0b\xc5\xc5\x23\x17\x2d\x17\x4f\xff\xbf\x40\xda\xc2\xe6\x0d\xa2\xea\xfb\x2f\x00\x00\xff\xff\xf2\x40\x4a\xf5\x65\x02\x00\x00")
-
-func es_svJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_svJson,
-		"es_SV.json",
-	)
-}
-
-func es_svJson() (*asset, error) {
-	bytes, err := es_svJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_SV.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_usJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xda\x0a\xd9\xb3\x15\xbc\x74\x30\x14\xe2\x0e\x6d\x29\x45\xb2\xd5\x44\xc5\x92\x8a\x24\x1b\xdc\xd2\x87\xe9\xd8\xe7\xc8\x8b\x15\xd7\xd2\x25\x24\xdb\xdd\x7f\xc9\xdd\x27\xf0\x57\xc5\xe8\xae\xa6\x2d\x27\x1d\x5f\x1f\x76\x74\x53\x31\xaa\xe5\x1c\x69\xcb\x9f\x2b\xc6\xa8\xf7\xd6\xb8\xbd\x5f\xee\x8c\x86\xd1\xe9\xb8\x4a\x2b\x43\x82\x36\xc7\xdf\xd0\xf9\xa1\xf8\xf7\x51\x4f\x45\x4f\x46\x07\xfc\x29\x1e\x7f\x94\xec\x3d\x55\xec\x65\xd9\xd9\x1d\x7c\x48\x17\x63\x18\xc2\xcc\x69\x03\xed\xa8\x3e\xd5\x96\xce\xc6\xbb\x74\x40\xa1\x76\x3a\x64\xf6\x37\xad\x02\x8c\x95\xe1\x33\x4b\xa9\x82\x19\xca\x75\xf6\x65\xc3\x19\xc8\xa1\x48\xb9\xf7\x31\x65\x1d\xf5\x47\x32\xda\xaa\x90\x19\x7c\x97\x46\x18\xe7\xa7\xf3\xac\x37\x5d\xb6\xe7\x0f\xbf\x22\x05\xe7\xc5\xd3\xa5\x0a\xe0\x03\x1e\xe0\x80\x06\x2e\x00\x01\x06\x18\x05\xe0\xb6\xb9\x6f\xca\xf2\x1a\x96\xa4\x96\x49\x2f\x5f\x83\xe8\x37\xc2\x6e\xc4\x4c\xe5\xd8\x1a\xbb\x06\x92\x8b\x9e\x0b\xc5\xc5\x23\x17\x2d\x17\x4f\xff\xbf\x40\xda\xc2\xe6\x0d\xa2\xea\xfb\x2f\x00\x00\xff\xff\xba\x7e\x6f\x46\x65\x02\x00\x00")
-
-func es_usJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_usJson,
-		"es_US.json",
-	)
-}
-
-func es_usJson() (*asset, error) {
-	bytes, err := es_usJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_US.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_uyJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xda\x0a\xd9\xb3\x15\xbc\x74\x30\x14\xea\x0e\x69\x29\x45\xb2\xd5\x44\xc5\x92\x8a\x24\x1b\xdc\xd2\x87\xe9\xd8\xe7\xc8\x8b\x15\xd7\xd2\x25\x24\xdb\xdd\x7f\xc9\xdd\x27\xf0\x57\xc5\xe8\xae\xa6\x2d\x27\x1d\x5f\x1f\x77\x74\x53\x31\xaa\xe5\x1c\x69\xcb\x9f\x2b\xc6\xa8\xf7\xd6\xb8\xbd\x5f\xee\x8c\x86\xd1\xe9\xb8\x4a\x2b\x43\x82\x36\xc7\xdf\xd0\xf9\xa1\xf8\xf7\x51\x4f\x45\x4f\x46\x07\xfc\x29\x1e\x7f\x94\xec\x3d\x55\xec\x65\xd9\x79\x38\xf8\x90\x2e\xc6\x30\x84\x99\xd3\x06\xda\x51\x7d\xaa\x2d\x9d\x8d\x77\xe9\x80\x42\xed\x74\xc8\xec\x6f\x5a\x05\x18\x2b\xc3\x67\x96\x52\x05\x33\x94\xeb\xec\xcb\x86\x33\x90\x43\x91\x72\xef\x63\xca\x3a\xea\x8f\x64\xb4\x55\x21\x33\xf8\x2e\x8d\x30\xce\x4f\xe7\x59\x6f\xba\x6c\xcf\x1f\x7e\x45\x0a\xce\x8b\xa7\x4b\x15\xc0\x07\x3c\xc0\x01\x0d\x5c\x00\x02\x0c\x30\x0a\xc0\x6d\x73\xdf\x94\xe5\x35\x2c\x49\x2d\x93\x5e\xbe\x06\xd1\x6f\x84\xdd\x88\x99\xca\xb1\x35\x76\x0d\x24\x17\x3d\x17\x8a\x8b\x1d\x17\x2d\x17\x4f\xff\xbf\x40\xda\xc2\xe6\x0d\xa2\xea\xfb\x2f\x00\x00\xff\xff\xae\x84\xf8\x5e\x65\x02\x00\x00")
-
-func es_uyJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_uyJson,
-		"es_UY.json",
-	)
-}
-
-func es_uyJson() (*asset, error) {
-	bytes, err := es_uyJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_UY.json", size: 613, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _es_veJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x10\x86\xcf\xe9\x53\x84\x81\xb9\x09\x7b\xef\x4d\xa8\x87\x3d\x14\x84\x2d\x82\x8a\x48\xd2\xc4\xdd\x48\x93\x94\x24\x2d\x54\xf1\x61\x3c\xfa\x1c\xfb\x62\x52\x37\x99\x5d\xd6\xdb\xff\xcf\xb4\xff\xff\x4d\xe9\x67\xc5\x60\xdb\x40\xcd\x41\xc7\xd7\x87\x3b\xb8\xa9\x18\x34\x62\x89\x50\xf3\xe7\x8a\x31\x50\xde\x1a\xb7\xf7\xeb\x9c\xc1\x30\x39\x1d\x4f\xd2\x8a\x90\x48\x9b\xe3\x4f\xe8\xfd\x50\xfc\xfb\xa4\xe7\xa2\x67\xa3\x03\xbd\x14\x8f\xdf\x52\x28\x0f\x15\x7b\x59\x7b\x76\x07\x1f\xd2\x55\x19\x15\x51\xcd\xb9\x83\xd2\x29\xfa\x1c\x5b\x32\x5b\xef\xd2\x81\x02\xb5\xd3\x21\xb3\xbf\x69\x19\xc8\x58\x11\x3e\xb2\x14\x32\x98\xa1\x4c\x17\x5f\x3a\x9c\x21\x39\x14\x29\xf6\x3e\xa6\xac\xa3\x1e\x93\xd1\x56\x86\xcc\xe0\xfb\x34\x91\x71\x7e\xbe\xdc\x29\xd3\x67\x7b\x79\xf8\x3f\x52\xe2\xbc\x3a\x5d\xc8\x40\x7c\x84\x47\x70\x84\x46\x5c\x04\x44\x30\x84\x51\x00\x6e\xdb\xfb\xb6\x34\x8b\xfc\xcd\x47\x5b\xb6\x8d\x48\x7a\xfd\x23\x50\x6d\xd0\x6e\x70\x81\x32\xec\x8c\x3d\x2d\x04\x47\xc5\x51\x72\x7c\xe4\xd8\x71\x7c\xfa\x7b\x82\xb6\x1d\xd9\xdc\x03\xb8\xad\xb1\xad\x71\xc7\x71\x84\xea\xeb\x37\x00\x00\xff\xff\x8d\xe5\x8f\xbd\x74\x02\x00\x00")
-
-func es_veJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_es_veJson,
-		"es_VE.json",
-	)
-}
-
-func es_veJson() (*asset, error) {
-	bytes, err := es_veJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "es_VE.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _et_eeJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xcf\x4a\xc4\x30\x10\xc6\xcf\xe9\x53\x84\x40\x6e\xb2\x0f\xb0\x37\x61\x7b\x10\xad\x2c\xda\xcb\x2a\x22\x53\x3a\xd8\x76\x93\xa6\xe4\x4f\x41\xc4\xb7\xd9\xc7\xf0\xd6\x17\x93\x3a\x6d\x1a\xd0\x83\xa7\xef\xfb\xf2\x0d\x33\xbf\x42\x3f\x32\x26\x6e\x0e\x62\xcf\x05\xfa\xd7\x3c\x17\x57\x19\x13\x07\x78\x77\x62\xcf\x9f\x33\xc6\xc4\x30\x7d\x35\x30\x4c\x17\x1c\xe7\x8a\x09\x74\x1a\x5c\x92\x3d\xb6\xae\x4d\xf2\xd9\x28\x9d\xce\xf7\xa8\xba\x34\x5b\xc4\x1a\xc9\x2a\x08\x54\x64\xec\x65\x3e\xfb\xd8\x18\xeb\xd3\xdb\x47\x9a\xcb\x49\x4a\x92\x5b\x92\x7b\x92\x07\x92\xbb\x75\x47\x61\x7a\xdf\xc4\x05\x1d\x40\x1f\xc0\xd2\xcc\x88\x58\xd9\x98\xf4\x74\xb1\xde\x91\x87\xc1\xb6\x4a\x2d\xef\xd0\x92\xe9\x42\xe8\x37\xab\x16\x0b\xe1\x2d\x38\x4f\xde\xe1\xe0\x51\x57\xb8\x6c\x34\x67\x6f\x4c\x4c\xbd\x19\x93\xae\x46\xef\x28\xa6\xdf\xfa\x1b\x36\x21\xfd\x13\xf3\xbf\x8c\x1b\x60\x64\x8b\x58\x1b\xd1\x0a\x73\x5d\x1c\x8b\x95\x82\xda\xb5\x39\x80\xc7\xf9\xdf\x90\xf5\x4e\xea\x9d\x3c\x89\xf5\xb1\x6c\x35\x15\xc0\x65\xcd\x65\xc5\xe5\x89\xcb\x92\xcb\xa7\x9f\x89\xd8\x96\x31\x2e\x37\x84\xc8\x3e\xbf\x03\x00\x00\xff\xff\xdd\x83\xd5\xe1\x73\x02\x00\x00")
-
-func et_eeJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_et_eeJson,
-		"et_EE.json",
-	)
-}
-
-func et_eeJson() (*asset, error) {
-	bytes, err := et_eeJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "et_EE.json", size: 627, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _eu_esJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xc3\x30\x0c\x85\xcf\xce\xaf\x30\x02\xdf\xda\xfe\x80\xde\x06\xdd\x61\x87\xc2\xa0\xbd\x6c\x63\x0c\x85\x98\xd6\x24\xb1\x87\x22\xc3\xea\xb1\xff\x3e\xb2\x5a\x6a\xca\x6e\x7a\x79\x2f\x7a\x9f\xc0\xdf\x8d\x81\xa7\x1d\x6c\x2d\xf8\xfc\xf1\x78\x80\x55\x63\x60\x87\x97\x09\xb6\xf6\xad\x31\x06\xc2\x09\x63\xe7\x71\xfe\x6e\x00\x27\xf6\x83\x3f\xfb\xb8\xd0\x48\xbc\xb4\xb1\xf4\x6a\xa7\x89\xfd\x29\x2f\x54\x20\x1c\xaa\x1a\x90\x72\x6c\x91\x11\x1a\xf3\x3e\x97\x1e\xce\x89\xf8\xbe\x79\x53\xd7\x0e\x32\x90\x0c\xa5\x0e\x49\x32\x49\xac\x81\x36\xb2\x71\x9f\x22\x9f\x75\x5d\x26\x46\xa2\x20\xfd\x89\x27\x54\x31\x22\xf1\x57\x92\x2b\x3e\x03\x2d\x9c\x80\x5c\xaa\xf0\x3d\x06\xb9\x26\x17\xbe\xfd\x8f\x6d\x2e\x9c\xab\x08\x74\x33\x32\x51\x90\x4c\x41\xd2\x8a\xd6\xc7\x2e\xdf\x9f\xfe\x8f\x56\x39\x95\x51\xf9\x94\x4d\xb9\x14\x4a\x81\x14\x46\x49\x94\x43\x21\x04\xe0\x61\xff\xbc\x97\xe6\xab\x29\xce\x0e\xd9\xcf\x8f\xc3\xe1\xca\xba\x97\x8d\xef\x93\x75\x2d\xf9\x68\x5d\x87\x20\x81\x63\x18\xaf\xa1\xcb\xda\x8d\x6b\xd7\x59\x77\xb4\xee\xf5\xcf\x56\xeb\xa8\xb2\x96\x01\x34\x3f\xbf\x01\x00\x00\xff\xff\xfd\x09\x57\x83\x7d\x02\x00\x00")
-
-func eu_esJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_eu_esJson,
-		"eu_ES.json",
-	)
-}
-
-func eu_esJson() (*asset, error) {
-	bytes, err := eu_esJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "eu_ES.json", size: 637, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _eu_frJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xc3\x30\x0c\x85\xcf\xce\xaf\x30\x02\xdf\xda\xfe\x80\xde\x06\x65\xb0\x43\x61\x6c\xbd\x6c\x63\x0c\x85\x98\xd6\x24\xb1\x87\x22\xc3\xea\xb1\xff\x3e\xb2\x5a\x6a\xca\x6e\x7a\x79\x2f\x7a\x9f\xc0\xdf\x8d\x81\x87\x1d\x6c\x2d\xf8\xfc\x71\xff\x04\xab\xc6\xc0\x0e\xcf\x13\x6c\xed\x5b\x63\x0c\x84\x23\xc6\xce\xe3\xfc\xdd\x00\x4e\xec\x07\x7f\xf2\x71\xa1\x91\x78\x69\x63\xe9\xd5\x4e\x13\xfb\x63\x5e\xa8\x40\x38\x54\x35\x20\xe5\xd8\x22\x23\x34\xe6\x7d\x2e\x7d\x3e\x25\xe2\xdb\xe6\x4d\x5d\x3b\xc8\x40\x32\x94\x3a\x24\xc9\x24\xb1\x06\xda\xc8\xc6\x7d\x8a\x7c\xd2\x75\x99\x18\x89\x82\xf4\x27\x9e\x50\xc5\x88\xc4\x5f\x49\xae\xf8\x0c\xb4\x70\x02\x72\xa9\xc2\xf7\x18\xe4\x9a\x5c\xf8\xfa\x3f\xb6\xb9\x70\xae\x22\xd0\xd5\xc8\x44\x41\x32\x05\x49\x2b\x5a\x1f\xbb\x7c\x7b\xfa\x3f\x5a\xe5\x54\x46\xe5\x53\x36\xe5\x52\x28\x05\x52\x18\x25\x51\x0e\x85\x10\x80\xbb\xfd\xe3\x5e\x9a\x2f\xa6\x38\x3b\x64\x3f\x3f\x0e\x87\x2b\xeb\x5e\x36\xbe\x4f\xd6\xb5\xe4\xa3\x75\x1d\x82\x04\x0e\x61\xbc\x84\xce\x6b\x37\xae\x5d\x67\xdd\xc1\xba\xd7\x3f\x5b\xad\x83\xca\x5a\x06\xd0\xfc\xfc\x06\x00\x00\xff\xff\xa4\xdf\x3c\x3b\x7d\x02\x00\x00")
-
-func eu_frJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_eu_frJson,
-		"eu_FR.json",
-	)
-}
-
-func eu_frJson() (*asset, error) {
-	bytes, err := eu_frJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "eu_FR.json", size: 637, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fa_irJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x92\xbf\x4a\xc3\x50\x14\xc6\xe7\x9b\xa7\xb8\x1c\xb8\x9b\xd0\x3d\x5b\x25\x83\x1d\xc2\x15\xeb\x26\x22\x01\x2b\x75\xa8\x05\xcd\x12\x44\x88\x68\xfe\x0c\x79\x80\xce\x0e\xa6\xc6\x40\x09\x71\x71\xe9\x73\x9c\x93\x6e\x79\x12\x49\xcc\xbd\x4d\xf3\x04\xe2\xf8\x7d\xbf\xf3\xe7\x3b\x97\xfb\x68\x30\x98\x58\x60\x72\xb8\x71\xae\x26\x67\x70\x64\x30\xb0\x1c\xef\x01\x4c\x7e\x61\x30\x06\xbb\xa4\xfa\xc0\x2f\x0a\x71\x4d\x51\x03\x19\xe0\x86\xe2\x81\x53\x52\x54\xfb\xc9\xa1\x59\x85\x14\x61\x8a\xc5\xa1\x4b\x5b\x0a\x31\x1f\xb4\xe7\x14\xe0\xb7\x56\x8a\x19\xec\xb2\x09\x33\x9d\x2f\xef\xdd\x3f\x95\xc8\x5e\xde\xb9\x73\x1d\xa7\x5a\x61\x4a\x21\xc5\xbb\x44\x4f\x7c\xa6\x18\x8b\x9e\x0e\xda\xad\x65\x37\xed\xad\xa3\xaf\x8a\xea\x7c\x2b\x8a\xf1\x9d\xc2\xbe\xda\x4f\xc1\x94\x62\xcc\xf4\x79\x5b\xcc\x30\xa5\x00\xd7\x58\x68\xfe\x82\xd9\x5e\x37\x99\x06\x15\x1b\x2c\xb5\xd3\x7f\xdd\xff\x74\xd0\xd8\x3e\xb5\xd5\x25\xbf\x55\x8a\x58\x8e\x3b\x6b\xfe\xb9\x90\xde\x48\xc8\xc5\x48\xc8\x6b\x50\xfe\xf9\xed\xa2\x65\xb5\xff\x29\xc6\x5c\xc8\x19\x17\xc7\x5c\x48\x0f\x13\x2e\xe4\x89\x29\xa4\x6d\x0a\x39\xad\xfd\xbc\xed\x50\xd5\x3d\xa4\xfd\x6e\x3f\x80\xf1\xf4\x13\x00\x00\xff\xff\x8f\x28\xf3\x3e\x5b\x03\x00\x00")
-
-func fa_irJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fa_irJson,
-		"fa_IR.json",
-	)
-}
-
-func fa_irJson() (*asset, error) {
-	bytes, err := fa_irJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fa_IR.json", size: 859, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ff_snJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x10\x85\xd7\xf2\x29\xc4\x80\x76\xff\x4f\xf6\xd9\x15\xb2\xe9\x22\xa5\x24\xdd\xa4\xa5\x94\x51\xa5\x10\x59\x72\x06\x24\x19\x63\x4a\x4f\xd1\x45\xce\xe4\x5b\x15\xd7\xd2\x34\x6d\x77\xf3\x34\xcf\xef\x7d\x03\x7e\x6b\x04\xdc\x6e\x60\x2d\xe1\x78\x7c\xd9\xdf\xc1\xbf\x46\xc0\x06\xc7\x04\x6b\xf9\xd4\x08\x01\xc6\x0e\x34\x3f\x0a\x40\x9c\x3e\xce\xc6\x2e\xa2\xc3\x41\x23\xc6\xa2\xce\xad\x4d\xe1\x4a\x22\x26\xc4\x6b\x2f\xcf\x27\xa2\x68\xff\x6b\xe7\x22\x34\xe2\x79\x2e\xdb\x9f\x28\xe6\x5f\x8d\xdf\x85\x9c\xc0\x45\x5c\x51\x56\x83\x29\xc9\xda\xd5\xc8\x2d\x9d\xf3\x89\xf3\x92\x73\xa1\x9c\xf0\x4a\x21\x57\x28\x4d\x34\x2e\x63\xb2\x76\xba\xe4\x62\x31\x7d\xdf\x62\x58\x66\x4f\x31\x55\x3b\xc5\x54\x1c\x6d\xef\xcb\x34\x27\xd7\xef\x46\x8c\x9e\xba\x4a\xd5\x52\xa8\x21\x9a\x86\x6c\x7f\x1c\xfb\x07\x8f\xe1\x18\x8d\xc1\x18\x8a\x89\x98\x87\x69\x8a\x39\x64\x26\x61\x08\x46\xa8\x00\x37\xdb\xfb\x2d\x37\xf7\x1a\x7d\x01\xf6\xce\x3b\x37\x5d\x18\x74\x83\xd9\xce\x3f\x85\x32\x2b\xd5\xad\xd4\x01\xea\xe3\x83\xeb\x96\x05\x4a\x65\xa4\xd2\x52\x1d\xa4\xda\x49\xf5\xf8\xe5\xe0\xed\x8e\x65\x69\x04\x68\xde\x3f\x03\x00\x00\xff\xff\xab\xa5\xd2\x36\x6c\x02\x00\x00")
-
-func ff_snJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ff_snJson,
-		"ff_SN.json",
-	)
-}
-
-func ff_snJson() (*asset, error) {
-	bytes, err := ff_snJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ff_SN.json", size: 620, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fi_fiJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x4f\x4a\xf4\x40\x10\xc5\xd7\x9d\x53\x34\x05\xbd\xfb\xc8\x01\x66\xf7\xc9\x20\xce\x22\x20\x8c\x9b\x41\x44\x0a\x6c\x49\x4d\xff\x93\x74\xb7\x30\x88\xb7\xf1\x26\x5e\x4c\x62\x52\xe9\x52\x5c\xbe\xfe\xd5\xbc\xf7\x63\xc8\x5b\xa7\xe0\xb0\x87\x9d\x86\x67\x7a\xbc\x3e\xc0\xbf\x4e\xc1\x1e\x2f\x19\x76\xfa\xbe\x53\x0a\x72\x8d\xb1\xc6\x82\x34\x13\x05\x01\x31\x62\x8b\x85\x28\x6f\xc1\xd9\xec\xe8\x95\xc8\xb9\xb4\xd2\x34\x35\xfa\x62\xa7\xb3\xf8\xa5\xc7\xba\xa4\x4e\x3d\xcc\x9b\xc7\x31\x4d\xe5\xe7\x30\x2f\xf2\x14\xaf\x70\x39\xf7\x72\x21\x57\x0d\x29\x96\x71\xeb\x29\x18\x02\xb9\xba\xb6\x8d\xd6\x8b\x14\x10\x3d\xe5\x06\xeb\x58\x1a\x2c\xa9\xba\xb4\x25\x67\xf3\xe7\x87\xa8\xa1\x28\xa2\xf5\xed\x30\x5f\x2e\xad\xd1\x27\x87\x62\x6d\x9a\xb0\xb1\x73\xaa\xbe\xce\x49\xfe\x03\x7f\xb8\x0b\x71\x69\x2d\x94\x85\xaf\x90\x95\xa6\x9b\x66\x73\x6c\x82\xd2\x4e\xa8\xb1\xd7\xff\xe1\x76\x60\xa1\x05\x33\xd9\x63\xb1\xf3\x97\x63\x9e\x7a\x13\x7a\x73\x02\x7e\xbc\xa3\xb0\x00\xd4\xc6\xf6\xda\x5c\x15\xd4\xe6\xa4\xcd\x4d\x6f\x86\xde\x1c\xbf\xef\xb6\x9b\xdf\x8f\xeb\x1e\x40\xf7\xfe\x15\x00\x00\xff\xff\x1e\x29\x7f\x1c\x9d\x02\x00\x00")
-
-func fi_fiJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fi_fiJson,
-		"fi_FI.json",
-	)
-}
-
-func fi_fiJson() (*asset, error) {
-	bytes, err := fi_fiJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fi_FI.json", size: 669, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fil_phJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x4b\xfc\x30\x10\xc5\xcf\xe9\xa7\x08\x03\x73\xfb\xd3\xbd\xf7\xd6\x3f\x15\x76\x61\xb3\x2e\x54\x0f\x2a\x22\x09\x8d\xdd\xb0\x6d\xb3\x4c\x53\xa4\x88\xdf\x5d\x6a\x93\x51\xd7\xdb\x7b\x33\xd3\xf7\x7e\x81\xbe\x67\x02\x76\x15\x14\x12\x5e\x5d\xf7\x72\xdc\xc2\xbf\x4c\x40\xa5\xe7\x11\x0a\xf9\x94\x09\x01\x7b\x37\xb4\xad\x5f\xc6\x02\xf6\xd3\x60\xc7\x55\x2a\x4d\x81\xb5\x9b\x2d\x9d\x7d\x97\xfc\x76\x7a\xb3\x26\x99\xff\xcb\x92\x3f\xab\xb5\xd1\x8d\x87\x4c\x3c\x2f\x3d\xf5\xc9\x53\xb8\x2a\xe3\x26\xee\xe1\x12\x4e\xe7\x64\x0e\x4d\x89\xca\x0f\xe1\xc4\x71\x37\x83\xa5\x88\x7e\xb4\x86\xd8\x28\x4d\x63\x94\xa5\x21\xd7\xa5\xe9\xec\x53\xc5\xf0\x2d\xbb\x24\xcb\xd6\x8f\x21\xea\xda\x5e\x82\x9b\x6d\x6f\xc8\xae\x83\xdb\x73\x98\xd8\x1c\xbc\xf9\xb5\xac\xdc\x18\xed\xcf\x87\xff\x61\x65\xd2\xab\xa7\x97\x86\x98\x90\x01\x19\x8f\xe1\x98\x8c\x89\x98\x86\x31\x12\x40\xa9\x8e\x2a\x35\x1f\xf2\xfb\x3c\x5e\xe6\xdb\x3c\x5d\x54\x3a\xd8\xe5\xb7\xc0\x7e\x83\xcd\x06\x67\x48\xc3\x3b\xd7\xaf\x0b\x2d\xb1\x91\x68\x24\x3e\x48\x24\x89\x8f\x5f\x17\xbc\x25\xb6\xb1\x0b\x70\x57\xa0\x2a\xb0\x96\x78\x81\xec\xe3\x33\x00\x00\xff\xff\x50\x0b\xd7\x5a\x79\x02\x00\x00")
-
-func fil_phJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fil_phJson,
-		"fil_PH.json",
-	)
-}
-
-func fil_phJson() (*asset, error) {
-	bytes, err := fil_phJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fil_PH.json", size: 633, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fo_foJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcd\x4a\xc3\x40\x14\x85\xd7\x93\xa7\x18\x2e\xcc\x4e\xeb\x3e\x3b\xa1\x88\x2e\x82\x42\xbb\xa9\x22\x72\x43\xa6\xf9\x69\x32\x53\xe6\x47\x28\xe2\xc3\xf8\x00\x5d\xb9\x76\x95\x17\x93\x38\x99\x9b\xc1\xee\xee\xb9\xe7\xe6\x9c\x2f\x30\x1f\x19\x83\x87\x35\xe4\x1c\xf6\xfa\xed\xee\x11\xae\x32\x06\x6b\x3c\x59\xc8\xf9\x4b\xc6\x18\x58\xaf\x94\xaf\xb0\xf6\x66\xb2\x18\x0c\xe3\x97\xc2\x44\xbb\xf1\xc7\xa6\x76\x7b\x48\xaf\x9b\xf1\x3b\x75\xf7\x66\x3c\xd7\x75\x97\x7e\xdf\xcb\x53\x8d\x26\x2c\x32\xf6\x3a\xd5\x6f\x1a\x6d\xdc\x3f\x86\xa5\x7c\xa9\xa5\xc6\xa5\x6b\xa9\xa1\xf4\x98\x5a\x68\xe5\x1a\x8a\xec\x50\x79\x8c\x54\xb2\x34\x24\x06\x34\x73\x0a\x1e\xcd\x78\xee\xe3\xb6\x0d\x43\xe7\x15\x4d\xfd\x3c\xa1\xaf\xbd\x75\x61\xb6\xf2\xe8\xe4\x50\xca\x39\x4d\x1f\x9c\x26\xa1\xf4\x7b\x62\x55\xd2\x06\x95\xfe\xf5\x05\x24\x11\x12\x1d\xc1\x5d\x92\x11\x18\x71\x11\x14\xe1\x10\x0a\x51\x44\x80\xdb\xe2\xa9\x88\xcd\xc1\x8c\xce\x1a\x9d\x9c\x5e\x88\xa8\x6e\xc4\x70\x2d\x76\x10\x97\xdb\x76\x08\x06\xae\xb8\xa8\x56\x5c\x94\x2b\x2e\x76\x5c\xdc\xe7\xa2\xc8\xc5\x86\x8b\xe7\xbf\x53\x3a\xdb\x92\x9c\xcb\x00\xb2\xcf\xdf\x00\x00\x00\xff\xff\xa6\x6c\x08\x08\x82\x02\x00\x00")
-
-func fo_foJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fo_foJson,
-		"fo_FO.json",
-	)
-}
-
-func fo_foJson() (*asset, error) {
-	bytes, err := fo_foJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fo_FO.json", size: 642, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fr_beJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xc3\x30\x18\x84\x67\xf9\x29\xc4\x0f\xda\x0a\xd9\xb3\xb5\xa4\x43\x07\x43\xa1\x5e\xda\x52\x8a\x62\x29\x58\xc1\x92\xca\x6f\x59\x10\x4a\x1f\x28\xcf\xd0\xd1\x2f\x56\x5c\xfb\xff\x15\x92\xed\x4e\xa7\xdc\x7d\x0a\xfe\xae\x04\x3c\xed\x60\x2b\xe1\x80\x9f\x0f\x8f\x70\x57\x09\xd8\xe9\xd3\x00\x5b\xf9\x5e\x09\x01\xc6\x79\x1d\xda\xce\xce\x81\x80\x7e\x0c\xc6\x2d\xd2\x6b\x64\x69\xb1\x45\x4b\xee\x68\x47\x92\xd9\x06\x53\x82\x41\xfb\x59\x57\xe2\x63\x1e\x79\xe9\x22\xa6\xab\x25\x1e\xe1\x09\x1e\xe0\x6e\x6e\xe6\x52\x6a\xac\x63\x48\x1d\xd7\x1d\x75\xc8\x8e\x7e\x77\x98\xce\x19\xd9\x79\x8d\xc3\xa2\x74\x46\xd7\xd3\x21\xf1\x8f\x2e\xb0\xea\x7b\x9b\xd6\xab\x71\xfa\x5d\xe5\x60\xbf\x92\xf5\x7b\x5c\xff\x94\xd8\xa6\xc8\x26\xc4\x7c\x11\x99\xe9\xdc\x2e\xf6\xf2\xd5\x37\xa0\x05\xf2\xea\xe1\x3a\xe3\x0d\x5d\x81\x2b\x60\xcc\xc5\x44\x4c\x53\x40\x88\xe1\xbe\x7e\xae\x69\x7c\x49\x29\xd9\xe9\x64\xe7\x4f\x41\x99\x8d\xf2\x1b\x75\x02\x3a\x6c\x9c\x5f\x02\x2d\x95\x91\x6a\x2f\xd5\xab\x54\x8d\x54\x6f\xff\x37\x38\x6d\xd8\xae\x1b\x00\xd5\xcf\x5f\x00\x00\x00\xff\xff\xa9\x07\xbe\x18\x62\x02\x00\x00")
-
-func fr_beJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fr_beJson,
-		"fr_BE.json",
-	)
-}
-
-func fr_beJson() (*asset, error) {
-	bytes, err := fr_beJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fr_BE.json", size: 610, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fr_caJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xc3\x30\x14\x44\xd7\xf2\x29\xc4\x07\xed\x9a\x0b\x64\x17\x9a\x4d\x17\x86\x42\xbd\x49\x4b\x29\x8a\xa5\x60\x05\x4b\x2a\xdf\xb2\xa0\x94\x1e\x28\x67\xe8\xd2\x17\x2b\xae\xfd\xbf\x42\xb2\x9b\xd1\x28\x33\x4f\xc1\xdf\x95\x80\xa7\x3d\x6c\x25\x9c\xf0\xe3\x71\x07\x0f\x95\x80\xbd\xfe\x1a\x60\x2b\xdf\x2a\x21\xc0\x38\xaf\x43\xdb\xd9\x39\x10\xd0\x8f\xc1\xb8\x45\x7a\x8d\x2c\x2d\xb6\x68\xc9\x9d\xed\x48\x32\xdb\x60\x4a\x30\x68\x3f\xeb\x4a\xbc\xcf\x23\x2f\x5d\xc4\x74\xb3\xc4\x23\x3c\xc1\x03\xdc\xcd\xcd\x5c\x4a\x8d\x75\x0c\xa9\xe3\xba\xb3\x0e\xd9\xd1\xef\x4e\xd3\x25\x23\x3b\xaf\x71\x58\x94\xce\xe8\x7a\x3a\x24\xfe\xd1\x05\x56\x7d\x6f\xd3\x7a\x35\x4e\xbf\xab\x1c\xec\x67\xb2\xfe\x88\xeb\x9f\x12\xdb\x14\xd9\x84\x98\xaf\x22\x33\x5d\xda\xc5\x5e\xbf\xfa\x0e\xb4\x40\xde\x3c\x5c\x67\xbc\xa3\x2b\x70\x05\x8c\xb9\x98\x88\x69\x0a\x08\x31\xec\xea\xe7\x9a\xc6\x97\x94\x92\xbd\x4e\x76\xfe\x14\xd4\x61\xa3\xfc\x46\x19\xa0\xc3\xc6\xf9\x25\xd0\x52\x19\xa9\x8e\x52\x1d\xa4\x6a\xa4\x7a\xfd\xbf\xc1\x69\xc3\x76\xdd\x00\xa8\x7e\xfe\x02\x00\x00\xff\xff\xef\x7e\xb7\x70\x62\x02\x00\x00")
-
-func fr_caJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fr_caJson,
-		"fr_CA.json",
-	)
-}
-
-func fr_caJson() (*asset, error) {
-	bytes, err := fr_caJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fr_CA.json", size: 610, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fr_chJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xc3\x30\x14\x44\xd7\xf2\x29\xc4\x07\xed\x4a\x0e\x90\x5d\x69\x16\xed\xc2\x50\xa8\x37\x6d\x29\x45\xb1\x14\xac\x60\x49\xe5\x5b\x16\x84\xd2\x03\xe5\x0c\x5d\xfa\x62\xc5\xb5\xff\x57\x48\x76\x33\x1a\x65\xe6\x29\xf8\xbb\x12\xf0\xb4\x83\xad\x84\x03\x7e\x3e\x3c\xc2\x5d\x25\x60\xa7\x4f\x03\x6c\xe5\x7b\x25\x04\x18\xe7\x75\x68\x3b\x3b\x07\x02\xfa\x31\x18\xb7\x48\xaf\x91\xa5\xc5\x16\x2d\xb9\xa3\x1d\x49\x66\x1b\x4c\x09\x06\xed\x67\x5d\x89\x8f\x79\xe4\xa5\x8b\x98\xae\x96\x78\x84\x27\x78\x80\xbb\xb9\x99\x4b\xa9\xb1\x8e\x21\x75\x5c\x77\xd4\x21\x3b\xfa\xdd\x61\x3a\x67\x64\xe7\x35\x0e\x8b\xd2\x19\x5d\x4f\x87\xc4\x3f\xba\xc0\xaa\xef\x6d\x5a\xaf\xc6\xe9\x77\x95\x83\xfd\x4a\xd6\xef\x71\xfd\x53\x62\x9b\x22\x9b\x10\xf3\x45\x64\xa6\x73\xbb\xd8\xcb\x57\xdf\x80\x16\xc8\xab\x87\xeb\x8c\x37\x74\x05\xae\x80\x31\x17\x13\x31\x4d\x01\x21\x86\xfb\xfa\xb9\xa6\xf1\x25\xa5\x64\xa7\x93\x9d\x3f\x05\x65\x36\x52\xf9\x8d\x54\x27\xa0\xe3\xc6\xf9\x25\xd2\x52\x19\xa9\xf6\x52\xbd\x4a\xd5\x48\xf5\xf6\x7f\x83\xd3\x86\xed\xba\x02\x50\xfd\xfc\x05\x00\x00\xff\xff\xb9\xd5\x73\xa2\x64\x02\x00\x00")
-
-func fr_chJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fr_chJson,
-		"fr_CH.json",
-	)
-}
-
-func fr_chJson() (*asset, error) {
-	bytes, err := fr_chJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fr_CH.json", size: 612, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fr_frJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x52\xc1\x4a\xc4\x30\x10\x3d\xa7\x5f\x11\x06\x72\x93\xd9\xfb\xde\x84\x45\xf0\x50\x10\xed\x65\x15\x91\x6c\x93\xa5\x59\x9a\x44\xd2\x34\x20\xe2\x07\xed\x37\x78\xec\x8f\x49\x4d\x33\x5b\xd4\x8b\xb7\xf7\xf2\x86\xf7\xde\x0c\x79\xaf\x18\xdc\xee\x60\xcb\xe1\x18\x5e\x6e\xee\xe1\xaa\x62\xb0\x93\x6f\x03\x6c\xf9\x53\xc5\x18\x28\x63\xa5\x6b\x3b\x3d\x0b\x0c\xfa\xd1\x29\x93\xa1\x95\x81\xa0\x0e\x6d\xd0\x85\x9d\xf4\x58\x60\xd2\x4e\x5d\x84\x41\xda\x19\x57\xec\x79\x0e\x79\xe8\x7c\x88\x3f\x92\x90\x52\x90\x42\x90\x32\x90\xfc\x91\xec\x91\xac\xb1\x18\xd7\xde\xc5\x8e\x5c\x4f\xd2\x25\xa3\x43\x1e\x3b\x4e\xe7\x14\x88\x59\x19\x86\x8c\x64\x0a\xa6\x2f\x8f\x65\x8d\xd1\x38\x42\x7d\xaf\xe3\x32\xea\xa7\xcf\x05\x0e\xfa\x35\x6a\x7b\x08\xcb\x6d\x7c\x1b\x3d\x11\xe7\xd3\x4a\x52\xd3\xb9\xcd\x74\xbd\xfc\xef\xa2\xb8\xaa\x89\xff\x2f\x89\x7f\x56\x44\xaa\x87\xd4\x0d\x2f\xbd\xe8\x6e\xd7\xf5\x5d\x5d\xca\x64\xb9\x28\x3b\x19\xf5\xfc\x43\x84\xda\x08\xbb\x11\x7b\x28\x8f\x8d\xb1\x59\x90\x5c\x28\x2e\x0e\x5c\xec\xb9\x68\xb8\x78\xfc\x9e\x20\xb5\x21\xba\x64\x00\x54\x1f\x5f\x01\x00\x00\xff\xff\xeb\x89\x9f\x1a\x79\x02\x00\x00")
-
-func fr_frJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fr_frJson,
-		"fr_FR.json",
-	)
-}
-
-func fr_frJson() (*asset, error) {
-	bytes, err := fr_frJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fr_FR.json", size: 633, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fr_luJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xc3\x30\x14\x44\xd7\xf2\x29\xc4\x07\xed\x4a\x0e\x90\x5d\x21\x9b\x42\x0d\x85\xba\x8b\xb4\x94\xa2\x58\x0a\x56\xb0\xa4\xf2\x2d\x0b\x4a\xe9\x81\x72\x86\x2e\x7d\xb1\xe2\xda\xff\x2b\x24\xbb\x19\x8d\x32\xf3\x14\xfc\x5d\x09\x78\xd8\xc1\x56\xc2\x11\x3f\x1e\x5f\xe0\xae\x12\xb0\xd3\x5f\x03\x6c\xe5\x5b\x25\x04\x18\xe7\x75\x68\x3b\x3b\x07\x02\xfa\x31\x18\xb7\x48\xaf\x91\xa5\xc5\x16\x2d\xb9\x93\x1d\x49\x66\x1b\x4c\x09\x06\xed\x67\x5d\x89\xf7\x79\xe4\xb9\x8b\x98\xae\x96\x78\x84\x27\x78\x80\xbb\xb9\x99\x4b\xa9\xb1\x8e\x21\x75\x5c\x77\xd2\x21\x3b\xfa\xdd\x71\x3a\x67\x64\xe7\x35\x0e\x8b\xd2\x19\x5d\x4f\x87\xc4\x3f\xba\xc0\xaa\xef\x6d\x5a\xaf\xc6\xe9\x77\x95\x83\xfd\x4c\xd6\x1f\x70\xfd\x53\x62\x9b\x22\x9b\x10\xf3\x45\x64\xa6\x73\xbb\xd8\xcb\x57\xdf\x80\x16\xc8\xab\x87\xeb\x8c\x37\x74\x05\xae\x80\x31\x17\x13\x31\x4d\x01\x21\x86\xfb\xfa\xa9\xa6\xf1\x25\xa5\x64\xa7\x93\x9d\x3f\x05\x65\x36\xca\x6f\xd4\x1e\xe8\xb0\x71\x7e\x09\xb4\x54\x46\xaa\x83\x54\x7b\xa9\x1a\xa9\x5e\xff\x6f\x70\xda\xb0\x5d\x37\x00\xaa\x9f\xbf\x00\x00\x00\xff\xff\x96\x08\x74\xcf\x62\x02\x00\x00")
-
-func fr_luJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fr_luJson,
-		"fr_LU.json",
-	)
-}
-
-func fr_luJson() (*asset, error) {
-	bytes, err := fr_luJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fr_LU.json", size: 610, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fur_itJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x91\x41\x6a\xc3\x30\x10\x45\xd7\xf2\x29\xc4\x80\x76\x25\x07\xc8\xce\x20\x0a\x09\x55\x5b\x88\x29\x34\xa5\x14\x39\x56\x1b\x41\x2d\x81\x2c\x19\x42\xe9\x65\xba\xe9\xae\x97\xf0\xc5\x8a\x23\xcd\x10\xe2\xdd\xfc\xf9\xe3\xff\x9f\xf0\x57\xc5\x60\x23\x61\xcd\xe1\x3d\x85\xb7\x4d\x03\x37\x15\x03\xa9\x4f\x03\xac\xf9\x4b\xc5\x18\x48\xdf\x1b\x67\xcd\xbc\x67\x70\x97\x9c\x1d\xf2\xa8\x74\x88\x3a\xa0\xb0\x3a\x1c\x52\x11\x5b\x6f\xdb\x72\xff\x64\x1d\xdd\xec\x74\x6b\x3b\x03\x15\x7b\x9d\x2b\x76\x47\x1f\xe2\x55\x0f\x75\x50\x03\xa5\x53\x32\xe5\x52\x28\x26\x2a\xef\xe2\x91\xe2\xf6\xc6\x4d\x3f\xe5\xfb\x5b\x33\x06\x12\x4a\x87\xe9\x37\x8f\xf5\x18\xa6\xbf\x4f\x5c\x97\xe8\x6d\xfa\x70\x08\x62\xf1\xce\x0f\xb1\xf4\x99\x68\xfa\x16\xc1\x1e\x62\xa2\xf9\xde\x8f\x17\x8e\x34\x87\xac\x2e\x9f\xbb\x20\x24\xbc\xab\x07\xd7\x63\x58\x52\x2d\xa1\x08\x89\x68\x08\x85\x28\x10\xa0\x56\x8f\x0a\x9b\xb3\x89\x8e\xd4\xd1\xcc\xff\x5f\x74\x2b\x2e\xfa\x15\x17\x27\xc0\x75\x63\xfb\x6c\x69\x2e\x3a\x2e\x5a\x2e\x9e\xb9\x68\xb8\xd8\x9f\x2f\xc8\x6d\x48\x96\x16\x80\xea\xfb\x3f\x00\x00\xff\xff\x0a\xbb\xb6\xcc\x59\x02\x00\x00")
-
-func fur_itJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fur_itJson,
-		"fur_IT.json",
-	)
-}
-
-func fur_itJson() (*asset, error) {
-	bytes, err := fur_itJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fur_IT.json", size: 601, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _fy_nlJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x51\x4b\xc4\x30\x10\x84\x9f\xd3\x5f\x11\x16\xf2\xe6\xfd\x81\x7b\x2b\x14\xc1\x62\x4f\xa1\x05\x51\x11\x49\xe9\x7a\x17\xbc\x6e\x8e\x34\x55\x8a\xf8\xdf\xa5\xa6\xbb\x3d\xbd\xb7\x99\x9d\x30\xf3\x15\xfa\x95\x29\xb8\x29\x60\xab\xe1\x6d\x7a\xdd\xdd\xc2\x55\xa6\xa0\xb0\xd3\x00\x5b\xfd\x9c\x29\x05\x35\xa1\xa3\xf9\xaa\xa0\xf2\x96\x3a\x74\xc9\x34\xce\x0d\x62\x1e\xbc\xa5\xd5\x35\x9e\xf6\x18\x56\x7f\x1d\x10\xbb\x24\x6b\x42\x4f\x90\xa9\x97\x79\xa6\x3e\xf8\x10\xff\x6e\xf1\x10\x6f\x70\x3d\x17\x73\x21\xb7\x71\x55\xe5\x29\x1e\xa4\xa7\xb4\x64\x83\x1b\x96\xc7\xd8\x06\xfc\x5c\x7d\x65\x6d\x88\x49\xe6\xa7\xe0\x8e\x72\x75\x98\x64\x39\xd2\xc4\xea\xb8\xa8\x7c\xdc\x8f\x43\x1c\x97\x8a\x1a\x4f\xd1\xf5\x2d\x2e\x18\x77\xef\xd1\x8b\xd9\xf9\x8f\xb3\xa8\xc0\x21\xb9\xf3\x4f\xbe\x80\x15\x50\x61\x11\xbe\x7f\x97\x72\x24\x41\x13\x32\x81\x12\x1c\x41\x11\x0a\x06\xc8\xab\xfb\x8a\x97\x53\xc8\x49\x61\x23\xce\xbf\x81\xe9\x36\xa6\xdf\x98\x09\xf8\xd8\xb8\x3e\x05\x56\x9b\x4e\x9b\x56\x9b\x47\x6d\x1a\x6d\x9e\x7e\x5f\x48\xda\x88\x5d\x36\x00\xb2\xef\x9f\x00\x00\x00\xff\xff\x64\xd9\xd3\x2e\x5e\x02\x00\x00")
-
-func fy_nlJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_fy_nlJson,
-		"fy_NL.json",
-	)
-}
-
-func fy_nlJson() (*asset, error) {
-	bytes, err := fy_nlJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "fy_NL.json", size: 606, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ga_ieJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xb1\x6a\xc3\x30\x10\x86\x67\xfb\x29\x84\x40\x5b\xc9\x03\x64\x4b\xeb\x04\x02\x71\x29\xd8\x4b\x5b\x4a\x39\x63\x61\x1d\xd8\x12\x28\xca\x10\x4a\x1f\x26\x63\xe6\x6e\x59\xf5\x62\x45\xb6\x24\xcb\x6d\x36\xfd\xf7\x1f\xff\xff\x9d\xf1\x57\x9e\xd1\x7d\x41\xd7\x84\x76\xf0\xb9\xdf\xd2\x87\x3c\xa3\x05\x9c\x8f\x74\x4d\xde\xf3\x2c\xa3\x85\xbd\x92\x42\x0d\x42\x02\x76\xc2\xb9\xd3\xe8\x70\x02\x94\xb3\x2c\xed\x05\xb5\x99\xf5\x93\xbd\x42\x0b\x2a\x59\x01\xbd\xd4\x44\x6c\x14\x4a\x3e\xeb\x0a\x8c\x00\xd4\x92\xe6\xd9\x87\x83\xa8\x84\xd2\x66\x41\xa2\x06\x0f\x70\x38\x81\x0f\x4a\x7b\xc7\xce\xa4\x6f\x7a\x26\x2d\xae\x21\xa4\x97\x4a\x1a\x11\xa3\xb7\x20\x5d\xd0\xb4\xb6\xe3\xd0\x08\x0d\xb1\x40\x1b\xff\xde\x60\xa3\xb9\xbd\xf8\xea\x47\x0e\xbd\x81\x18\x5e\x72\x34\x82\x43\x40\xdc\xdb\x1b\xf6\x9e\xd6\xde\x24\x1c\x43\x9e\x0b\x20\x3b\xfb\x33\xb8\x63\x3d\x2d\x47\xcd\xa1\x15\x7f\xc6\x15\x38\xe1\xdb\x9e\x55\xdf\x03\x76\x8b\x8f\xf3\xef\x86\x84\xff\x2e\xfd\x0c\x9e\x30\xdf\xe5\xf5\xfe\x08\xe4\x21\x93\x77\x15\xcf\x74\x58\x81\x69\x53\xbe\x94\x01\x66\x72\x83\x53\x80\xe1\xee\x0f\x63\xed\x8a\x0d\x2b\x76\xa6\x61\x58\xe3\x30\x19\x40\x58\x4b\x58\x43\xd8\x2b\x61\x35\x61\x6f\xe3\x46\x74\xeb\x28\x7d\x07\xa5\xf9\xf7\x6f\x00\x00\x00\xff\xff\x8e\xfe\x52\xb0\xb9\x02\x00\x00")
-
-func ga_ieJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ga_ieJson,
-		"ga_IE.json",
-	)
-}
-
-func ga_ieJson() (*asset, error) {
-	bytes, err := ga_ieJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ga_IE.json", size: 697, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _gd_gbJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x52\xb1\x6a\xe4\x30\x14\xac\xed\xaf\x78\x08\xc4\x35\x77\x6c\xbf\x9d\x0f\xb3\xcb\xc1\x89\x04\xbc\x4d\x12\x42\x78\xb6\xc4\x4a\x20\xc9\x60\xcb\xc5\x12\xf2\x2f\xfe\x80\x54\xe9\xb6\xd6\x8f\x05\xad\x25\xd9\x9b\xee\xcd\xcc\xb3\x66\xde\xe0\xf7\xb2\x20\xff\x6a\xb2\x07\x72\xe6\x6f\xc7\xbf\xe4\x77\x59\x90\x1a\x2f\x23\xd9\xc3\x4b\x59\x14\xa4\x56\xdc\x7f\x19\x69\x51\x75\x32\x88\x81\xd1\x13\x2a\x9b\x80\xf1\xb3\x1a\x5c\x42\x9d\x42\xbe\x11\x71\xe0\xd8\xaf\x50\x06\x20\x12\x1a\xd1\x49\x54\x83\x15\xa4\x2c\x5e\x83\x6f\x23\xfb\xc1\xfd\x30\xef\x57\xd3\xec\x98\xcd\x56\x9b\xd5\x61\x7d\x3d\x3d\xcb\x7a\xeb\x64\x7e\xb3\x32\x70\xc0\x5e\x69\x27\x30\x5d\x54\x59\x38\x0a\x1c\x06\x8c\x41\x2b\x03\xcc\xcf\xe9\xa8\xa0\xaa\x56\x8b\xac\xfe\x02\x26\xfd\xac\xce\xeb\xd7\xee\x0f\x93\xfe\xb3\x1f\x37\x84\x9e\x3a\x99\x62\x55\x16\xfe\xfb\xab\xc5\x91\xa3\xde\xac\x34\x93\x76\xb9\xab\xca\x42\x8d\x26\xf4\xb1\xdd\xb8\x31\x9b\x0d\x7f\x6d\xa5\xc6\x4e\xf2\xbb\xca\xee\x0f\x3c\x60\xec\xec\x28\x62\x19\xe1\x98\x48\xa9\x36\x52\xd2\xcf\xcb\xf4\x70\x66\xcb\xa0\xa7\xd8\x67\xc8\xba\x4c\xcd\x14\xf3\xd6\x18\x4b\x6f\xd2\x10\xa2\xa4\x10\x15\x7b\x64\xc9\x7d\x51\x93\x52\xa3\x13\xe1\xdf\xa2\x7c\x47\xcd\x8e\x5e\x48\x22\x4f\xca\x2c\x02\x02\xe5\x40\x5b\xa0\x4f\x40\x4f\x40\x9f\x6f\x1b\x59\x3d\x65\x18\x3d\x08\x29\x3f\xbe\x03\x00\x00\xff\xff\x99\x37\x05\x0d\xb3\x02\x00\x00")
-
-func gd_gbJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_gd_gbJson,
-		"gd_GB.json",
-	)
-}
-
-func gd_gbJson() (*asset, error) {
-	bytes, err := gd_gbJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "gd_GB.json", size: 691, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _gez_erJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x52\x4d\x4b\xc3\x40\x10\x3d\x27\xbf\xa2\x0c\xec\x4d\xe8\x3d\xb7\x4a\x3d\xf4\x10\x10\xeb\xc1\x2a\x22\x05\x8b\xf5\x50\x2b\xb5\x17\x15\xa1\xde\x66\x77\x1b\xa8\x48\xa1\x0a\x42\x8a\x14\x02\x52\x08\x68\x8f\xfe\x98\xf7\x4f\x64\x62\xb2\x89\x3f\xc0\x5e\x16\xde\x7c\xbd\xf7\x66\xe7\xde\xf7\xa8\xd5\xa4\xa0\x46\x17\xbd\xbb\xb3\xbd\x03\xda\xf1\x3d\x6a\x76\x6f\x6f\x28\xa8\x9d\xf8\x9e\x47\x30\x2b\x98\x47\xd8\x8d\x64\x3c\x02\xa7\x30\x4f\xb0\xeb\x02\xc6\x60\x0d\x76\xd9\x0f\xe8\x15\xec\xbc\x80\x33\xf0\x4b\x99\xb5\xcf\xe0\x35\x74\x9c\x43\x3d\x81\xfd\x04\xbf\x42\x6f\xc8\xf7\x4e\x85\xb9\xdd\x1f\x8e\xc6\x5b\xa6\x2f\xb8\xc3\xe1\xd5\xb8\x5f\x12\x4f\xe3\xac\x3f\xc9\xab\x4d\x02\x9d\x42\xa7\xc5\xe8\x05\xa6\x5c\xce\x32\x31\xcc\x04\x76\x91\xc3\x69\x04\x33\x87\x7e\x17\x67\x4e\xa8\x99\xc3\x26\x15\x65\x0b\x30\x17\xed\xb3\x2c\x92\x96\x5c\x9c\x80\xdd\xb4\x58\xb4\x3a\x28\x44\x69\x45\xd8\x24\xdb\x42\xfc\x67\x85\xff\xe0\x65\xeb\x46\x1a\xe1\x7e\x58\x3a\xf8\x96\x75\xb2\xfb\xd9\x37\xf0\x57\xe5\x6e\x9a\xdd\x71\x4f\x8e\x58\x9d\xd7\xd5\xa0\xae\x3a\x54\x04\x0f\x2f\x07\xbf\x89\x06\xa2\x95\xda\x45\xb4\x54\x3d\x44\x4b\x11\x2d\xe7\x10\x41\x6f\x24\xd6\x91\x67\x24\xcf\x71\xd6\xea\xda\x5a\x81\x0a\x03\xd5\x76\xc1\x5c\x14\xa9\x23\x29\xbe\x26\xff\xe1\x27\x00\x00\xff\xff\x86\xd1\x53\xbc\x42\x03\x00\x00")
-
-func gez_erJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_gez_erJson,
-		"gez_ER.json",
-	)
-}
-
-func gez_erJson() (*asset, error) {
-	bytes, err := gez_erJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "gez_ER.json", size: 834, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _gez_etJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x52\x3d\x4b\x03\x41\x14\xac\xef\x7e\x45\x58\xd8\x4e\x48\x7f\x5d\x24\x16\x29\x0e\x84\xa4\x30\x8a\x48\xc0\x60\x2c\x62\x24\xa6\x51\x11\x0c\x36\x6f\x77\x6f\x41\x91\x13\xbf\x02\x09\x1a\xf1\x2b\x10\x34\x57\xe6\xc7\xcc\x3f\x91\x8d\x77\xb7\xc9\x81\x16\x16\x36\xcb\xbd\x99\x79\x3b\x6f\xf6\xde\xb1\xeb\xb0\x52\x91\x79\x39\xb6\x53\x3f\xda\x5a\xa9\xb0\x25\xd7\x61\xc5\xda\xe1\x01\xf3\x72\x1b\xae\xe3\x30\xc8\x21\x64\x17\x2a\x32\x8c\xc3\x40\x63\xc8\x0b\xa8\x51\x52\xf6\x41\x02\x94\xb2\x6f\x10\x43\xa8\x30\x29\xcf\x41\x37\x96\x55\x97\xa0\x11\x44\x3f\x2e\xc5\x29\xd4\x27\xe8\x16\x22\x62\xae\xb3\x69\x9c\xcb\x8d\x56\xbb\xf3\xcf\xf6\x89\xb7\xdf\xda\xeb\x34\xac\x71\x70\x06\x19\x42\xbd\x40\x11\xe8\x35\xee\xd1\x81\x31\xa0\x45\x90\xee\x66\xf7\x4e\xe3\x52\x3e\x42\x87\xa0\x67\x90\x4e\x04\xf7\x76\xe2\xa0\x0b\x19\xda\x6f\x52\x96\x92\x4f\x08\x08\x14\x99\xf7\x88\x1b\x27\xe6\x2a\x31\x01\xf5\x20\xfa\xa0\x39\xa5\x1c\x41\x8c\xa1\xf4\x22\x7e\x05\xf1\x9e\x15\xab\x0f\x73\x4f\x0a\xce\xbf\xf4\x0f\x91\x33\x61\x7f\x8f\xf9\xb7\x8c\x99\x80\x99\x5c\x99\x38\x99\x20\x49\x84\x82\xbf\xea\xdb\xd9\xa7\x10\x0f\xa0\xf4\xd7\xf7\x8c\xd6\x2e\x56\xb1\xd6\xa9\x9b\x2d\xe7\xdb\x79\xde\xcc\xf3\x2a\x4b\xc0\xca\x6e\xf3\x9b\x28\x40\x0f\xf9\x32\xf4\x80\xd7\xa1\x07\xa0\xeb\xd9\xbe\x68\x88\xc8\x60\x55\x73\xb4\xcd\xb1\x3e\x6b\x4d\xdb\x4a\x1e\xf7\x3d\x5e\x4e\xc1\x78\x28\xc6\xd7\x8c\x78\x9f\xb9\x27\x5f\x01\x00\x00\xff\xff\x4a\x13\x6e\xf4\x63\x03\x00\x00")
-
-func gez_etJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_gez_etJson,
-		"gez_ET.json",
-	)
-}
-
-func gez_etJson() (*asset, error) {
-	bytes, err := gez_etJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "gez_ET.json", size: 867, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _gl_esJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x13\xf6\xbe\xb7\x42\x15\x04\xa3\x42\x8b\x54\x45\x24\xd9\x86\x6e\xa0\x4d\x20\x4d\x17\x56\xf1\x61\x3c\x7a\xf4\x19\xfa\x62\x52\x9b\xff\xef\xb2\x7b\x9b\x7f\xa6\xcc\x7c\x81\x7e\x66\x0c\x6e\x0b\xd8\x72\x68\xbb\xf7\xeb\x12\xae\x32\x06\x85\x3a\x0e\xb0\xe5\xaf\x19\x63\x50\xf8\xde\xba\xd6\xcf\x3e\x83\xbb\xd1\x0d\x8b\x92\x2a\x44\x83\x7a\xfa\x09\x3b\x1f\xf0\xac\xfd\x01\xe5\x93\x71\x64\x97\xd3\xb7\x56\x8d\x87\x8c\xbd\xcd\x1b\xe5\xde\x87\x78\x36\x44\x23\xb4\xb1\x0e\x50\x37\x35\xaf\xb5\xd8\x29\xbd\x8b\x7b\x2a\xac\x95\x33\x36\x24\xf2\x1b\xa3\xc3\x7a\x49\x15\x3e\x92\xcc\x75\xb0\x1d\xba\x36\x99\xf5\x38\xfd\x92\xec\x3a\xfc\xb4\xf5\x43\x4c\xba\x34\xd1\xf4\x1a\xfb\x1e\xc6\x38\xd2\x71\xef\x0f\x27\x51\x61\x76\xcb\x75\xfa\xee\x0b\x50\x82\x3c\x7b\x79\xae\x03\xb1\xad\x68\x44\x46\x5c\x04\x45\x3c\xc4\x42\x18\x48\x90\xcb\x47\x89\xd3\x4b\x88\x49\xa1\xa2\x99\xff\x04\xd1\x6c\x44\xbf\x11\x47\x40\xb3\xb2\xfd\x12\x28\x2e\x1a\x2e\x34\x17\xcf\x5c\x54\x5c\xbc\xfc\x7f\x41\x69\x45\x67\xda\x00\xc8\xbe\xfe\x02\x00\x00\xff\xff\x15\x85\x2b\x06\x61\x02\x00\x00")
-
-func gl_esJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_gl_esJson,
-		"gl_ES.json",
-	)
-}
-
-func gl_esJson() (*asset, error) {
-	bytes, err := gl_esJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "gl_ES.json", size: 609, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _gu_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x3f\x4b\xc3\x40\x18\xc6\xe7\xe4\x53\x1c\x07\xb7\xf9\x09\xb2\x15\xba\x64\x88\x08\x75\x51\x11\xa9\x28\xd6\x41\x2b\x1a\x07\x11\x41\x3b\x98\x41\x8a\x43\x91\x82\x14\x82\x93\xe1\xbd\x12\x43\xa5\x92\xc9\x7e\x95\xf7\xa3\xc8\xbd\xf6\x92\xcb\x5d\x04\x97\xe3\xfa\xbc\x7f\xfa\x7b\x9e\x24\xb7\xbe\xc7\xc3\x2e\x0f\x18\x3f\xb9\x3e\x08\x37\xf9\x86\xef\xf1\x6e\xff\xe6\x8a\x07\x6c\xcf\xf7\x3c\x8e\x50\x20\x2c\x11\x56\x74\x7e\x23\x14\xaa\x45\xe9\x25\xca\x27\x84\xdc\xd1\x73\x84\x11\xc2\x14\xe1\xd3\x29\xcd\x51\x3e\x20\xbc\x3b\xfa\x94\xf4\x82\x4e\xab\xf4\x45\xe2\x0b\xca\xb1\x26\x69\x56\x21\x6b\xb0\xf9\xde\xbe\x72\xd0\x1b\x0c\x2f\xe3\x56\x1b\x16\xbd\x0b\x6d\xb1\xba\x88\xad\x64\x16\x90\xe6\x88\x86\xe7\xf1\xc0\x80\x98\x11\x66\x46\x33\x1f\x34\xff\x48\x4b\xef\xf5\xbc\x44\x99\xd0\x7f\x8f\x75\x1e\x56\x43\xfe\x6b\x94\x1a\x5e\xb5\xf8\x8c\x00\x3a\xa1\x15\xc2\xa2\x6e\x96\x89\xbe\xcf\x68\x5b\xd6\xfc\xb9\xa0\x6d\x55\xcf\x84\x22\x28\x69\x55\x5a\x27\xb5\x5e\x9e\x12\x5b\x4e\xf7\xb9\xe1\x79\xa2\x53\x48\x29\x53\xb3\x94\xa9\xd0\xdb\xa7\xde\x08\xb5\xb4\xab\xe6\x03\xfc\x23\x3d\x2b\x2b\x2b\x19\x37\x93\xff\xa7\x61\xe6\xe0\xda\x77\xfd\xd6\x36\x95\xf1\xa5\x65\x4d\x7b\xe9\x44\x5b\x51\x6d\xa2\xac\x5e\x56\x03\xa7\x24\x65\x44\x20\x89\x9e\xeb\xf6\xe3\x63\xf5\x61\x8a\x0e\x13\x47\x4c\x1c\x32\xb1\xc3\xb5\xbe\x7d\x7a\x66\xd7\x98\x08\x03\x11\x05\xa2\xc7\xc4\x05\x13\xbb\xd4\x5a\xb5\x55\xa5\x5a\x5f\x63\x71\x6b\xcc\xbf\xfb\x09\x00\x00\xff\xff\x99\x0c\xc9\x1a\x13\x04\x00\x00")
-
-func gu_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_gu_inJson,
-		"gu_IN.json",
-	)
-}
-
-func gu_inJson() (*asset, error) {
-	bytes, err := gu_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "gu_IN.json", size: 1043, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _gv_gbJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x5f\x4b\xc3\x30\x14\xc5\x9f\xdb\x4f\x11\x02\x79\xb3\xdb\xfb\xde\x36\x06\xfe\xc1\x88\x60\x5f\x54\x44\xae\xeb\xb5\x0d\x76\x09\xdc\xa6\x83\x20\x7e\x77\x89\x4b\xd2\xa6\x30\x1f\xcf\xfd\x73\xce\xfd\x85\x7c\x97\x05\xbf\xdd\xf3\x0d\xe3\xed\xe9\xfd\x7a\xc7\xaf\xca\x82\xef\xc1\x0d\x7c\xc3\x5e\xcb\xa2\xe0\x77\xd8\x18\xa3\x11\x7d\xc3\xab\xbe\x43\xa5\xa3\x38\x82\x23\x1b\x05\x1d\x10\x52\x87\x9a\xd9\x98\x5f\x41\x17\xd5\x00\xa4\x79\x59\xbc\xf9\xa4\xa7\xce\x90\x5d\xc4\xa5\xa4\x94\x32\x25\x4c\xf6\xc9\x3b\xd9\x46\x4f\x69\xb4\xed\x66\x86\x44\xe8\xaa\x16\x47\x8a\x10\xb5\x19\x3a\x05\x6d\x67\x2b\xa0\x54\x94\xe0\x48\x07\x96\xed\x09\x49\x85\xf8\x9d\x81\xbe\x71\x01\x45\x22\xe8\x6a\x30\x93\x55\x70\x9f\x97\xee\x47\xd0\x6a\xb0\xd9\xca\xa7\x19\x9d\xa2\x6c\x65\x5e\x92\x88\xec\xc6\x8c\xe9\x8d\xbc\xd6\x8e\x3d\x98\xbe\x57\x87\xaf\xec\xad\x16\x70\x55\x3b\x62\x30\xa9\x2f\xd3\xd0\x05\x9a\x1c\xe5\x5f\x8a\x1c\x21\xbf\x7e\x95\xdd\xbe\x5a\x9c\xbd\x95\x8f\x32\xde\x7b\x1e\x89\x9d\x3d\x58\xf4\x1f\x4f\x34\x6b\x71\x5c\x0b\xc7\x63\xb1\x56\xc7\x73\x03\x98\x68\x98\xf8\x60\xe2\x99\x89\x9a\x89\x97\xbf\x89\xd4\xad\x93\x0c\x19\x9c\x97\x3f\xbf\x01\x00\x00\xff\xff\x64\x34\xbf\xf3\xd0\x02\x00\x00")
-
-func gv_gbJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_gv_gbJson,
-		"gv_GB.json",
-	)
-}
-
-func gv_gbJson() (*asset, error) {
-	bytes, err := gv_gbJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "gv_GB.json", size: 720, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ha_ngJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcf\x6a\xf3\x30\x10\xc4\xcf\xf2\x53\x88\x85\xe5\xbb\x04\x72\xf7\x4d\x1f\xa6\x25\x21\x4a\x03\xc9\x25\x2d\xa5\xac\xeb\x36\x16\xf1\x9f\x22\x4b\x14\x53\xfa\xee\xc5\xb6\x76\x5b\xda\xdb\xec\x8e\x98\xf9\x2d\xe8\x23\x53\xb0\x29\x20\xd7\x50\xd3\xd3\xfe\x16\x56\x99\x82\x82\xc6\x01\x72\xfd\x90\x29\x05\x3b\xaa\xa9\x72\xd3\x5a\xc1\xce\x05\xd7\x25\x7d\xa2\x86\x02\xa5\x3d\x79\x2a\x93\x36\x4d\x4d\xad\x1b\x96\x61\x1b\x5b\xfa\xc7\xc6\x40\x25\x79\xc8\xd4\xe3\x54\x71\xac\x7b\x1f\x7e\xf5\x48\x89\x34\x48\xbc\x64\x4b\xae\x84\x72\xa2\xed\xbb\x50\x4b\xdc\x96\x3a\x72\x3e\x2e\xaf\x6e\xa8\xf4\xdf\x93\x25\xcf\x7c\xe6\xd5\xbb\x46\xd6\x63\x52\xe7\xc8\x47\x9e\x63\x93\x94\xb9\xc4\x81\xcf\x3d\x52\x88\x2d\xdf\x7b\x77\x0d\x3d\xeb\x7d\x7c\x27\x31\x0a\x37\xcc\xc3\xcf\x83\xff\x30\x0a\x9f\xa0\x09\x98\x50\x09\x94\x30\x09\x92\xf0\x08\x8b\x80\x08\x04\x03\x18\x7b\xb0\xdc\x6c\xec\x62\x1f\x2c\xbb\x05\x85\x97\xe9\x13\x60\xb5\xc6\x76\x8d\x23\xf0\xf2\xe4\xda\xd9\xf0\xd4\x91\xd7\x68\x56\x1a\x2b\x7d\x21\x8d\xff\xf5\xb3\xbb\xba\x4e\xa3\xd7\x78\x3f\x3f\xe7\xa7\xe8\x65\x4c\xa5\x80\x9b\x1c\x6d\x8e\x47\x8d\x6f\x90\x7d\x7e\x05\x00\x00\xff\xff\xa8\xf9\xc9\xc6\x74\x02\x00\x00")
-
-func ha_ngJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ha_ngJson,
-		"ha_NG.json",
-	)
-}
-
-func ha_ngJson() (*asset, error) {
-	bytes, err := ha_ngJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ha_NG.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _he_ilJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\xc1\x4a\x03\x31\x14\x3c\x67\xbf\x22\x3c\x08\xbd\x08\xbd\xef\xad\xd0\x83\x05\x03\x85\x7a\x51\x11\xa9\xb4\x50\x0f\xb5\xa0\xbd\x14\xf1\x1f\xb2\xad\xc1\x06\x4a\x6d\xa5\x6a\xd6\x1f\x9a\xdf\x91\x6d\x93\xf8\x56\xec\x6d\xe6\xcd\x6c\xde\xcc\x63\x9f\x32\x41\x9d\x36\xe5\x92\x46\xc3\x9b\xce\x19\x9d\x64\x82\xda\xfd\xd9\x23\xe5\xf2\x2a\x13\x82\xe0\x61\x50\xc2\xe2\xad\x92\x04\xa1\xc4\x06\x2e\xe1\x15\x1c\xca\xc4\x3d\x0a\x38\xbc\x27\xfe\x8a\x75\x4d\x2f\xff\xb0\x02\xdf\x94\x89\xeb\x6a\x67\x6f\x34\x79\x98\xd6\x16\x9b\x46\xf0\x15\x11\xcc\x23\x58\x44\xf0\x12\x81\x8d\xa0\x6c\xc4\x17\xf5\xe4\x7e\x3a\xfa\x7d\xce\x61\x03\x0b\x03\x1f\x8c\x3b\x14\xf0\xb5\xc9\x1a\x1e\x1f\x01\x1b\xec\xe0\xe1\xb0\x4a\x9a\x49\xb9\x1d\x2c\xbb\x41\xc5\x56\x89\x19\x58\xcc\x61\xb1\xc5\x32\x4c\xb6\xd8\x61\x89\x75\xb5\x8d\x79\xbe\xb0\x84\x65\xb3\xcd\x9e\x71\xd7\x02\x9f\x81\xf3\x0b\xfd\x57\x8a\x17\x3a\x56\xe5\x58\x0d\x5e\x82\x57\xe0\xe1\x79\x6c\x1e\x97\x07\x8d\x21\x5b\xba\xab\x63\xba\x96\x3e\x38\xba\x3a\xaa\xed\xfe\x74\x58\xfd\x6a\x6a\xd0\x54\xe3\xa6\x9a\x51\x1c\x9e\xdf\x8d\x0f\xc2\xa5\x54\xa7\xb9\xd2\xb9\xea\x49\x75\x21\xd5\xad\x54\x03\xa9\xfa\x7b\x5f\xf2\x04\x43\x1a\x86\x9d\xa4\x3a\xf1\xcb\x2e\x65\xcf\x3f\x01\x00\x00\xff\xff\x83\x7c\xa2\x95\xd9\x02\x00\x00")
-
-func he_ilJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_he_ilJson,
-		"he_IL.json",
-	)
-}
-
-func he_ilJson() (*asset, error) {
-	bytes, err := he_ilJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "he_IL.json", size: 729, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _hi_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x53\xc1\x4a\xc3\x40\x14\x3c\x27\x5f\xb1\x2c\xec\xcd\x2f\xc8\xad\xd0\x4b\x0e\x11\xa1\x5e\x54\x44\x2a\x0a\xe9\x41\x2b\x9a\x8b\x88\x60\x0b\x41\x41\x3c\x4a\x41\x0a\x5e\xde\x6a\xa8\x25\x04\xa1\x12\x41\xea\xaf\xbc\x4f\x91\x7d\xc9\x36\x9b\xdd\x7c\x82\x97\x65\x33\xf3\x76\x32\x33\x9b\xdc\xf8\x1e\x0f\xfb\x3c\x60\x3c\x1e\x1d\x85\xdb\x7c\xcb\xf7\x78\x7f\x78\x7d\xc5\x03\x76\xe0\x7b\x1e\x47\x28\x10\x56\x08\xbf\xb4\xae\x11\x0a\x35\xa2\xf0\x12\xe5\x23\x42\xee\xe0\x39\xc2\x14\x61\x86\xf0\xe9\x50\x4b\x94\x13\x84\x77\x07\x9f\x11\x5e\xd0\x6a\x51\x5f\x04\x3e\xa3\x7c\xd2\x4e\xda\x2c\x64\x2d\x6f\xbe\x77\xa8\x12\x0c\xe2\xf1\x65\xd2\x19\xc3\x72\xef\x9a\xb6\xbc\xba\x16\x3b\x9d\x59\x86\xb4\x8f\x68\x7c\x9e\xc4\x86\x89\x39\xd1\x2b\x12\xba\xd3\x67\x3e\x10\x7e\xb4\x3f\x13\xcf\xab\x4c\xf4\x82\x17\x0d\xa6\x08\x8b\xba\x0c\x79\x6f\xd8\xcd\x11\x1e\xf4\x7e\x8e\x72\x8a\x90\x19\x8f\x13\xba\x8d\xb5\x31\x93\x52\xda\x92\xa4\xa0\x29\x45\x75\x09\x4a\x4d\xe1\x4b\x23\x58\xaa\xa3\xbe\x92\xb8\x49\x55\x89\xdc\x23\x6f\xa4\x56\xb6\x28\xf3\x7e\xfe\xcb\xe9\x2e\xa7\x17\xed\x44\x4d\x2b\x0b\x92\xac\x82\x56\x5f\xf9\x37\xed\xb3\x56\x68\xd5\x90\x41\xd5\x4a\xfd\x61\x72\xaa\x7e\x6d\xd1\x63\xe2\x84\x89\x63\x26\xf6\xb8\xc6\x77\x47\x67\x36\xc7\x44\x18\x88\x28\x10\x03\x26\x2e\x98\xd8\xa7\xd1\xcd\xd8\x86\x6a\xf0\xda\x28\xb7\x8e\xf9\xb7\x7f\x01\x00\x00\xff\xff\x95\x24\xe6\x19\x55\x04\x00\x00")
-
-func hi_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_hi_inJson,
-		"hi_IN.json",
-	)
-}
-
-func hi_inJson() (*asset, error) {
-	bytes, err := hi_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "hi_IN.json", size: 1109, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _hne_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\xc1\x4a\xfb\x40\x10\xc6\xcf\xc9\x53\x2c\x0b\x7b\xfb\x3f\x41\x6e\x85\x5e\x7a\xc8\x1f\xa1\x5e\x54\x44\x2a\x16\xea\xc1\x56\xb4\x17\x11\xc1\x14\x42\x05\xf1\x28\x82\x54\xbc\xcc\x6a\xd1\x12\x43\xa1\x10\x2f\xf5\x55\xe6\x51\x64\x27\xdd\x66\x9c\x14\xc4\x4b\x69\xbe\x99\x9d\xf9\x7e\x5f\x36\x97\x61\xa0\x5b\x4d\x1d\x29\xdd\xeb\x77\x0f\x5a\xff\xf5\xbf\x30\xd0\xcd\xce\xc5\xb9\x8e\xd4\x5e\x18\x04\x1a\x61\x8c\x00\x08\x0b\x84\x25\x42\xee\xea\x4e\x2c\xd0\xde\x22\x64\x35\x3d\x43\x18\x21\x3c\x20\xcc\x6b\xa5\x19\xda\x04\xe1\x75\x83\x0e\x5f\x08\xb9\x9b\x09\x6f\x08\xc0\x56\x24\x08\xf7\x54\xaa\x6d\x87\x29\x9d\xf2\x7a\x18\xec\x3b\xe3\xed\xde\xe0\x6c\x58\x77\x2f\x4c\x0b\xaf\xc2\x9f\xb0\x25\xdc\x08\x07\x7e\x71\x3c\xe8\x0f\x7b\x6c\xeb\x84\xca\x0b\xe7\xdd\x5e\xfb\x33\xef\x1e\x85\x8b\x59\x49\x80\xf6\x0e\xe1\xd1\x8b\x29\x25\x91\xa3\x1d\x23\xcc\x59\xe7\x8d\xff\x3f\x41\x3b\x42\x98\xb2\xc7\x84\x22\x5f\xb2\x9e\x94\xde\x43\x41\x93\x59\x04\x8e\x0a\x68\xda\x8c\xe1\xa5\x94\xf4\x33\x8d\xe5\x7a\x49\x21\x9a\x5f\x68\x48\x51\xe9\x3c\xfe\x4d\x51\xf0\x04\x7e\x05\xff\x13\x2f\x87\x15\x8c\x75\x34\x0e\x25\x58\x3c\x42\x23\xde\x8a\x2b\xef\xe5\x1d\xf8\xa4\xdf\xf2\xc2\x7d\x90\xeb\xe4\xe7\x15\x7a\x92\xd5\xd5\xb0\x66\x67\xd8\x75\x9f\x96\x69\x28\x73\xa4\xcc\xa1\x32\x3b\xda\xeb\xdb\xc7\x27\xb2\xa6\x4c\x2b\x32\x71\x64\xda\xca\x9c\x2a\xb3\x4b\xad\xeb\xb6\x75\xa9\xd2\x57\x5e\xb5\x38\x16\x5e\x7d\x07\x00\x00\xff\xff\x76\xca\xf3\xd4\xd5\x03\x00\x00")
-
-func hne_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_hne_inJson,
-		"hne_IN.json",
-	)
-}
-
-func hne_inJson() (*asset, error) {
-	bytes, err := hne_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "hne_IN.json", size: 981, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _hr_hrJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x10\x86\xcf\xe9\x53\x84\x81\xdc\x64\x1f\x60\x6f\x42\x0f\x8a\x56\xcb\xa6\x0a\x8b\x88\xa4\x36\xd0\xc4\xdd\xcc\x92\xa6\x05\x15\xdf\xc0\x7d\x25\x4f\xf5\xbd\xa4\x36\x99\xa2\x7b\x9b\xc9\xff\xf3\xff\xdf\x40\xde\x33\x06\x97\x39\xac\x39\xb4\xfe\xe9\x62\x03\x67\x19\x83\x5c\xbd\x76\xb0\xe6\x0f\x19\x63\x70\xa3\x1b\xab\x77\x56\x4d\x02\x83\x12\x5d\xdc\x5f\xe6\x87\xbb\x80\x3e\xcd\xd2\x1b\xab\x9b\xe8\x1c\x3f\x75\x18\x7c\x48\x5a\xa9\x69\x94\x7d\x8d\x41\x41\xc6\x1e\xa7\x2e\xd9\xa2\x0f\xff\x0a\xa9\x8b\x3a\xa8\x60\x09\xa7\x5c\x4a\x4d\x91\x05\xba\xd0\x52\x9e\x34\x56\x8f\x47\xe5\xec\xec\xbb\x9f\xe0\xc7\x63\xa4\xbc\xfd\xfe\xea\xe9\x96\xca\xab\x81\x7c\x72\x30\x35\x2d\xd7\xe6\xb0\x08\x7e\x99\xaf\x70\x87\x03\xbe\xcd\xcb\xa6\xb7\xca\x25\x7f\x17\xf0\xa0\xe2\x21\x32\xf4\x8d\x76\x11\xbd\xf4\xd8\x19\xa7\x9e\xff\xdc\x7f\x42\x4c\xac\x0b\x26\x31\x12\x1f\xb1\x11\x18\x51\x11\x11\xf1\x10\x0a\x61\x24\x82\xf3\xa2\x2c\x52\xf5\x2c\x26\x25\x57\x41\x4f\x3f\x43\x34\x2b\xb1\x5f\x89\x2d\xa4\xc7\xca\xec\x67\x41\x71\xd1\x70\x51\x73\xb1\xe5\xa2\xfa\x95\x49\x5a\xd6\x58\x00\x90\x7d\xfc\x04\x00\x00\xff\xff\xe1\x79\x83\xdf\x6e\x02\x00\x00")
-
-func hr_hrJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_hr_hrJson,
-		"hr_HR.json",
-	)
-}
-
-func hr_hrJson() (*asset, error) {
-	bytes, err := hr_hrJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "hr_HR.json", size: 622, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _hsb_deJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x14\x84\xd7\xf2\x29\xc4\x03\xed\x7e\x72\x80\xec\x0c\xfe\x0b\x0d\x38\x0d\xd8\x50\xd2\x52\x8a\x1c\x3f\xea\x28\xb6\x15\x64\xb9\x21\x94\x9e\xa4\x9b\xde\x21\xbb\x6e\xd3\xdc\xab\xb8\xd6\x7b\x75\xe9\x6e\x46\x63\xcd\x7c\x06\xbd\x44\x02\xae\x13\x98\x4b\xa8\xba\xe2\x31\xf9\x0f\xff\x22\x01\x89\x3e\x76\x30\x97\xf7\x91\x10\xb0\x34\x58\x5e\x3e\xb0\xd6\x43\x22\x60\x75\x3e\xb5\x53\x7f\xdb\x7b\xeb\x82\xce\x9c\xc1\x32\xe8\xcb\xbb\x7f\x3e\x9f\x9c\xdf\x85\x6b\x46\x93\xcc\x6c\x61\xbd\x86\x48\x3c\x0c\x53\x59\x65\x9d\xff\xbd\xc7\x43\xb4\x40\xed\xdc\x4c\x9d\x54\x48\x65\xa9\x6d\x7d\xc5\x4d\x0b\xdd\xf6\x3a\x5c\xba\xc2\xc2\xb1\x49\x3f\xdf\xdc\x66\x94\xf1\xde\x1d\xeb\x70\x8a\x26\xb0\x2f\xfa\x76\x6b\x48\xd6\x24\xe3\xc3\x53\xdf\x85\xe9\x0c\xf7\x1e\x9b\x02\x43\xe1\xcd\xce\x5b\x36\x4b\x7b\x98\x44\x09\x6e\x46\x37\xfd\xdf\x3f\x9c\x0c\xf9\x03\xc8\x7c\x4c\xc7\x70\x8c\xc6\x60\x4c\xc5\x3c\xcc\xc2\x18\x44\x10\xa7\xab\x94\xa6\xc7\x90\x92\x44\x7b\x1c\x5e\x82\x2a\x67\xaa\x99\xa9\x35\xd0\x61\xbe\x6d\xc6\x40\x4b\x55\x4a\x55\x48\xb5\x96\x2a\x97\xea\xee\xfb\x0b\x4e\x73\xb6\x61\x03\x20\x7a\xfd\x0a\x00\x00\xff\xff\x96\x89\x7b\x23\x61\x02\x00\x00")
-
-func hsb_deJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_hsb_deJson,
-		"hsb_DE.json",
-	)
-}
-
-func hsb_deJson() (*asset, error) {
-	bytes, err := hsb_deJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "hsb_DE.json", size: 609, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ht_htJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x4a\xc4\x30\x18\x84\xcf\xe9\x53\x84\x1f\x72\x93\xec\x7d\x6f\x42\x03\x7a\x28\x48\x5d\x2f\x8a\xc8\xbf\x1b\xa5\xd9\xdd\x24\x62\x43\x21\x88\xef\xb3\xef\xb1\x2f\x26\x35\xf9\xff\x8a\x7a\xf1\x36\x99\x49\xe7\x9b\x96\xbe\x37\x02\xae\x5b\x58\x4b\x18\xd2\xd3\xd5\x06\x2e\x1a\x01\x2d\xe6\x11\xd6\xf2\xa1\x11\x02\xac\xf3\x18\x76\xc3\xec\x0b\x38\x9a\x60\x5d\x91\x1e\x59\x9d\x4f\x87\xde\xd0\x69\xcf\x6a\xc2\x60\x17\x7f\x44\x6f\x1d\x34\xe2\x71\x06\xdc\x0e\xf1\x2d\xfd\xa0\x68\x46\x68\x26\xe8\x85\xa0\xb9\x5e\x73\xbd\xe6\x6a\x4d\xcd\x5d\x0c\x69\xe0\xda\x3d\x86\x29\x9b\x72\xeb\xc5\x4c\xbd\xa3\x83\xc7\xb1\x08\x9c\x7a\x77\xac\x9e\x21\x44\xa8\xc2\xe5\xf3\xa9\xc8\x78\x97\x2a\xcb\xbc\x26\x0c\xbe\xba\xbb\x14\xb7\x7d\x7d\x2c\xc4\x89\x03\x6b\xc6\x59\x7f\x7f\xdb\xdf\xc3\xf4\xb2\x4b\xff\x77\x95\xfe\x63\x16\x79\x07\x52\x21\x12\xc3\x9a\x91\xbf\xd0\x65\x77\xd3\xd1\x8c\x92\x52\xd2\x62\x7a\x9e\x7f\x04\x65\x57\xca\xaf\x54\x06\x32\x37\xce\x97\x00\xa5\xb2\x52\x6d\xa5\xca\x52\x25\xa9\xee\xbf\x6e\x70\x9a\xf8\x58\x19\x00\xcd\xc7\x67\x00\x00\x00\xff\xff\x11\x0b\x53\x2a\x60\x02\x00\x00")
-
-func ht_htJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ht_htJson,
-		"ht_HT.json",
-	)
-}
-
-func ht_htJson() (*asset, error) {
-	bytes, err := ht_htJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ht_HT.json", size: 608, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _hu_huJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x4a\xc4\x30\x18\xc5\xd7\xe9\x29\x42\x20\xbb\x99\x1e\x60\x76\x03\x5d\x8c\x8b\x82\x30\xba\x50\x11\x49\xdb\x8c\xfd\x33\x4d\x4b\x92\x0e\x58\xf1\x10\x1e\xa1\xcb\x59\x0b\xb3\x10\x77\xc1\x7b\x49\x4d\xf2\xa5\xe2\x2e\xef\x7b\x8f\xf7\x7e\x85\xbe\x46\x88\x5c\x25\x64\x83\x49\x39\x3c\xed\x6e\xc9\x2a\x42\x24\x61\x2f\x8a\x6c\xf0\x43\x84\x10\x39\x31\x65\x26\x29\x58\x3f\x3b\x88\x94\xe6\xac\x0f\xdf\xef\x56\x34\xbc\x28\xec\x4b\x8d\x5c\x16\xcc\xbe\x73\x65\xbe\xb4\xb9\x48\x6d\x2e\x8d\xbd\xf4\xe6\x2c\x34\x6f\x7c\xb4\x6b\x33\xa6\x49\x84\x1e\xe7\xad\x7d\xd9\x49\xfd\x67\xd0\x0d\xb9\x09\xe8\xf7\xe5\xae\x12\xca\x7c\x51\xda\x09\x5d\x42\x4b\xcd\xc4\x60\x26\x69\x53\x07\x9e\xc9\xa0\x5a\x33\xc9\xbc\x1a\x5c\x91\x99\x7a\x59\x1d\x2b\x05\x5e\xed\x9d\xda\x7c\x8a\x6a\x21\x8e\x20\xd8\xf0\x3c\xa8\x51\x7b\xa9\x46\xde\x6b\xde\x66\xdc\x0d\x74\x8d\x36\x1f\xa0\x44\x77\x5a\x78\x05\xcf\xad\x5a\x7e\xfe\x3f\xf4\x80\xbd\x40\x0e\xbc\x81\x35\x90\x06\x4c\x60\x5c\xd0\x01\x18\x30\x01\x8e\x27\xd9\xa6\xd7\xa9\x47\xb0\xa6\x77\x12\xa6\xf9\xfc\x83\xd0\xbb\x35\x6d\xd7\xb4\x20\xfe\x78\x53\xb5\xce\x88\x31\xcd\x62\x4c\x79\xbc\xc2\x74\xbb\xc2\x74\x17\xd3\x34\xa6\x7b\x4c\xef\x7f\xc3\x10\x74\x77\x38\xba\xd1\x60\x44\x6f\x3f\x01\x00\x00\xff\xff\xa2\xce\x8c\xdd\x91\x02\x00\x00")
-
-func hu_huJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_hu_huJson,
-		"hu_HU.json",
-	)
-}
-
-func hu_huJson() (*asset, error) {
-	bytes, err := hu_huJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "hu_HU.json", size: 657, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _hy_amJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xcf\x6a\xc2\x40\x18\xc4\xcf\xc9\x53\x2c\x0b\x7b\x2b\x78\xf7\x26\x78\xe9\x21\x50\x68\x2f\x6d\x29\xc5\x62\xc1\x1e\xac\x60\xbd\x48\x29\x2c\x22\xfd\x73\xe8\xa9\xf4\x01\x12\xd9\x83\x44\x90\x82\xda\x20\xc4\xbc\x84\xb3\xcf\x53\x76\x75\xd7\x2f\x4d\x7b\x91\x6f\x67\xd6\xcc\xef\x9b\xe4\x31\x0c\xf8\x71\x93\xd7\x19\xef\x0c\xaf\x1b\x11\x3f\x0a\x03\xde\x6c\x0d\x1f\x78\x9d\x5d\x86\x41\xc0\xb7\x05\x52\x2d\x11\x63\x8e\xd4\x98\x01\xdf\x2e\xb5\xc4\x1c\x99\x1e\xe1\x1b\x31\x12\x4c\x4b\x8e\xd2\xe3\xdf\x3a\xde\x90\xfd\xe3\x48\xa4\x58\x61\x52\xd1\x5f\xf5\x48\x4b\x24\x88\x31\xdd\x2b\x2f\xf6\x86\x39\x87\xc1\x95\xa1\x3c\xed\xf4\xfa\x83\x32\xaa\x01\xa3\x90\x87\x59\x8f\x1d\x0a\x99\xa5\x89\xa6\x81\x3e\x2a\x39\xc4\x44\xbd\xfb\x41\xc7\x67\x40\xda\xc5\x57\xd8\x20\xd6\xd2\xe3\x7e\x40\xc1\xa4\x97\xd5\xb1\x3d\x15\xbe\x9e\x2f\xac\x8d\x8b\x19\xbd\x81\x25\x52\xe4\xa4\x90\xdd\xf3\xab\xda\xac\xa4\x7d\x62\x82\x0c\x39\x0a\xfb\xeb\xd4\x77\x28\xac\x51\x40\x61\x81\x04\x8a\xb0\x48\x64\x98\xff\xe9\x3c\x23\xc3\xb2\xaa\x6f\x17\x50\x95\x7f\xd0\xea\x2b\xc5\xac\xb0\xf1\x75\x14\xbe\x4c\x5b\x02\x2d\x80\xae\x4e\x5e\x44\xee\xe7\x99\x9f\xcd\x92\x39\x5d\x8d\x2e\xe3\xf1\x17\x48\x28\xb2\x83\x6c\x44\x27\x91\xa3\xdb\xf9\xce\x69\xb6\x06\xb7\xe6\x8b\x17\xdd\x9a\x68\xd7\xc4\x90\x3b\xf1\xec\xae\xbb\x33\x5a\x4c\xb4\x99\xb8\x61\xe2\x9c\x89\x3e\x13\x17\xf6\x86\x77\xfb\xfe\xb8\xcf\xe0\x3c\x7c\xfa\x09\x00\x00\xff\xff\xb6\x06\x43\x12\x49\x03\x00\x00")
-
-func hy_amJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_hy_amJson,
-		"hy_AM.json",
-	)
-}
-
-func hy_amJson() (*asset, error) {
-	bytes, err := hy_amJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "hy_AM.json", size: 841, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _i18nJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xe6\xe2\x54\xf2\x74\x51\xb2\x52\x50\xca\x34\xb4\xc8\x53\xd2\xe1\xe2\x54\x72\x49\xac\x2c\x56\xb2\x52\x88\xe6\xe2\xe4\x54\x32\x04\x89\x70\x2a\x19\x41\x28\x63\x08\x65\x02\xa1\x4c\x21\x94\x19\x84\x32\x57\xe2\xe2\x8c\x05\x69\x0f\xce\xc8\x2f\x2a\xa1\xd0\x0c\xdf\xfc\xbc\x92\x0c\xb8\x01\x06\x50\x13\x0c\xa0\x46\x18\x40\xcd\x30\x80\x1a\x62\x00\x35\xc5\x00\x6a\x8c\x81\x39\x94\xb6\x80\xd2\x96\x10\xda\xd0\x00\x4a\x43\xcd\x33\x34\x42\x71\x33\xbd\x2d\x75\xf4\x0d\xf0\x85\xd9\x06\x91\x83\xc9\xb8\x24\x96\xa4\x82\xa2\x44\xd5\x4d\x09\xc6\x0d\xc9\xcc\x85\x0a\x29\xa8\x86\x80\x45\xe1\x22\x08\x2e\xd4\x44\x25\x25\xae\x5a\x40\x00\x00\x00\xff\xff\xa0\x31\x24\xba\xd8\x01\x00\x00")
-
-func i18nJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_i18nJson,
-		"i18n.json",
-	)
-}
-
-func i18nJson() (*asset, error) {
-	bytes, err := i18nJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "i18n.json", size: 472, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _iaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xb1\x6a\xf4\x30\x10\x84\x6b\xf9\x29\xc4\x82\xba\x1f\xae\xbf\xee\x07\x37\x29\x0c\x81\xb8\xb9\x84\x14\x6b\x5b\xc9\xc9\x58\x92\x91\x25\x43\x08\x79\xf7\xe0\x93\x35\x67\x2e\x9d\x66\x67\x99\xf9\x16\xf4\x5d\x09\x7a\xaa\xe9\x2c\xc9\x30\xfd\xab\x04\xd5\xfc\xb5\xd0\x59\xbe\x55\x42\xd0\xe0\xad\x71\xa6\xbf\x19\x82\xa6\xe4\xf4\x60\xf2\xdb\x72\x88\x77\xa1\x43\x9f\x82\x29\x72\xf4\x2b\xac\x55\x3b\x1d\x8a\x58\xb8\xeb\x38\x7a\xaa\xc4\xfb\xd6\xf4\x72\xf5\x21\x3e\xd4\xa1\x09\x35\xa8\x40\x38\x92\x91\x5a\x12\x1b\xef\xe2\x15\x71\x23\xbb\xc4\xc1\xf8\xbc\xf6\xa1\xbb\x70\x90\xdb\x01\xe5\xcd\x73\x30\x53\x19\x97\xe1\x98\xdc\xfd\x39\x61\x35\x7d\xa6\x25\xee\x62\xd1\x73\xd4\xb6\x0b\x3a\x4b\xdf\x47\x0f\xe1\xfc\x7a\xb0\x06\xdd\x67\x75\x3c\xfd\x0f\x2d\x40\x1f\x8e\xe7\x39\x80\x0e\x70\x40\x03\x18\xa0\x80\x03\x94\x9d\xc2\xf4\x05\xe0\x7f\xf3\xdc\x94\xe6\x6c\x16\xa7\xe6\xa8\xb7\xef\xa0\x86\x93\xb2\x27\x75\xa1\x32\x6c\x8d\xcd\x06\x4b\x35\x48\xd5\x49\x75\x91\xaa\x95\xea\xf5\xb6\x01\xb7\x85\xdc\x3b\x88\xaa\x9f\xdf\x00\x00\x00\xff\xff\x34\x72\xa6\x4c\x66\x02\x00\x00")
-
-func iaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_iaJson,
-		"ia.json",
-	)
-}
-
-func iaJson() (*asset, error) {
-	bytes, err := iaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ia.json", size: 614, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _id_idJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x91\xcd\x6a\xc3\x30\x10\x84\xcf\xf2\x53\x88\x05\xdd\x0a\xb9\xe7\x66\xf0\x25\x29\x6a\x43\x93\x4b\x5b\x4a\x59\x63\xe1\x88\xc6\x72\xd0\x4f\x21\x94\xbe\x7b\x71\x25\x4d\x43\x73\xdb\xd1\x2c\xdf\x7e\xc6\x5f\x8d\xa0\x4d\x47\x6b\x49\x76\x78\xdf\x74\x74\xd7\x08\xea\xf8\x12\x68\x2d\x5f\x1b\x21\x48\x5b\x37\x8e\x69\x79\x16\xb4\x37\xce\xba\x3a\x9e\x38\x70\x9e\x9f\xb8\x2f\x0b\xf7\x3c\xd9\x90\xc7\x6d\x9a\x38\x96\x5d\xee\x63\xa2\x46\xbc\x2d\xec\xfd\x71\xf6\xf1\xdf\x01\xd0\xc1\x06\x18\x5c\x50\xc1\xac\x44\x3d\xbb\x78\x04\x6e\xcb\x2e\xb1\xb7\x79\x6b\x67\x7a\xff\x97\x34\x7b\x53\x94\xda\xb3\xb7\xe5\x88\x36\xb6\xb2\x1d\xa6\x53\x99\xda\x31\x85\x98\x42\xf5\x3a\x47\x33\xf5\xc6\xe7\xf8\xf8\x11\x67\x84\x87\xf9\xf3\xaa\xea\x4c\xc8\xe9\xfa\xa3\x6f\x3c\xe1\x08\x3d\xc8\xdd\xaa\xc1\x0c\x62\x90\x82\x0e\x54\x60\x51\x05\x5a\xbd\xd3\xf5\x72\x2e\x6b\xd3\x71\x34\xcb\xdf\x57\xc3\x4a\x4d\x2b\x75\xa1\xfa\x78\xb0\x53\x2e\x58\xaa\x41\xaa\x5e\xaa\x67\xa9\xbc\x54\x2f\xbf\x1b\x68\x0f\x88\xe5\x06\x51\xf3\xfd\x13\x00\x00\xff\xff\x7a\x74\x9d\x54\x55\x02\x00\x00")
-
-func id_idJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_id_idJson,
-		"id_ID.json",
-	)
-}
-
-func id_idJson() (*asset, error) {
-	bytes, err := id_idJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "id_ID.json", size: 597, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ig_ngJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x6a\xf3\x30\x10\xc5\xd7\xf2\x29\xc4\xc0\xec\x02\xd9\x7b\x97\x8f\xc0\x47\x16\x2a\x81\x64\xd3\x96\x52\x94\x7a\x9a\x3f\x8d\x95\x20\xcb\x0d\x69\xe9\x09\x82\x8f\xe1\x53\xe8\x34\xba\x49\x71\x62\x4d\xd5\x7a\x37\x6f\xde\x78\xde\x6f\x8c\x3e\x33\x01\xb3\x29\xe4\x12\xb6\xeb\xe7\xbb\xff\x30\xca\x04\x4c\xf5\xb9\x82\x5c\x3e\x66\x42\x40\x15\x7c\x63\x0a\xea\xfa\x02\xca\x54\xb8\xfa\x23\x96\x27\x32\x5c\xbb\xe0\x9b\x2a\x8a\x57\xab\x83\xbf\x44\x55\xe9\xce\x2c\x08\x32\xf1\xd4\xe5\x2c\x36\x07\xeb\x06\x61\x49\x14\x07\x71\x4c\x92\xc1\x09\xbc\x3c\xee\x55\x07\xe3\x36\xbc\x74\x47\x26\xf8\xf6\xa4\x6d\xf0\x97\xfe\x1b\x5a\x05\xdf\xda\x3f\xdd\x52\xeb\x97\x0d\x2b\x3a\x5a\xa2\x7d\xef\x50\x7f\xc0\xae\xae\x4d\xac\xf6\x9a\x67\x83\x6f\xd6\x1d\x91\x0b\xbe\xed\x61\xe8\xe8\xa8\x5c\x69\xf6\xdf\xdc\x21\x2a\x13\x7c\xf3\xfe\x63\x16\xdb\xea\x2a\xd2\x7f\x32\x38\x80\xb9\x19\x95\x29\x87\x88\x4c\xf8\x8b\x8e\xc1\x12\xa6\x04\x88\x61\x22\xc8\x44\xcd\x55\x24\x98\xa8\x9b\x3d\x57\xd1\x9d\x6a\x47\xdd\xa3\xc1\x62\x8c\xe5\x18\xcf\x10\x9b\xcb\x6d\x79\x33\x26\x23\x89\x85\xc4\x7f\x12\xef\x25\x2e\x25\x3e\x5c\x47\xd8\xb6\x2c\xfb\x20\xc0\x59\x8e\x2a\xc7\x85\xc4\x23\x64\x5f\xdf\x01\x00\x00\xff\xff\x5f\x6c\x3e\x0e\x98\x02\x00\x00")
-
-func ig_ngJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ig_ngJson,
-		"ig_NG.json",
-	)
-}
-
-func ig_ngJson() (*asset, error) {
-	bytes, err := ig_ngJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ig_NG.json", size: 664, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ik_caJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\xc1\x6a\x83\x40\x10\x86\xcf\xfa\x14\xcb\xc2\xdc\x0a\xb9\x7b\x93\xe6\xe2\x61\x4b\x8a\x5e\xda\x52\xca\x34\x29\x75\x50\x93\xac\xbb\x06\x42\xe9\x25\x6f\xe2\x23\x78\x6a\xef\x9a\x07\xe9\x9b\x94\x4d\xb2\xa3\xc9\xf1\xff\x67\x99\xef\x1b\xf1\x2b\x0c\x64\x32\x97\x91\x90\x54\xbc\xdd\xc7\xf2\x2e\x0c\xe4\x1c\xf7\x46\x46\xe2\x25\x0c\x02\xa9\x68\x3d\xb4\x0d\x69\x43\x1b\xd2\x6e\x1a\xc8\x14\x77\x78\x3c\x1c\x0f\x3e\x27\x25\x6a\xbb\xcc\x69\x7c\xf1\x48\xd7\x39\x25\x83\xd5\x24\x67\x58\x96\x54\x4d\x1b\x85\xfa\x04\x72\x45\x18\xbc\x3a\x8b\x34\xdf\xd4\xf6\x46\x85\x05\x98\xcc\x40\x26\x31\x82\x37\xfb\x8d\x6a\xb3\xb6\x39\xaf\x4b\x49\x53\xdf\xf5\x1d\xa2\x93\x45\x56\xf5\xad\xa9\x9b\xcf\xba\x29\xce\xf5\x02\xd7\xee\x1b\x68\x43\xb4\xa3\xc2\x43\xcb\xa1\xa5\x65\x2e\x32\xb4\x9a\xd0\x1b\x34\xdb\xed\xf8\x26\x19\xda\xbe\x9b\x44\xbb\xcc\x91\x53\x76\x22\x8d\xd3\xb8\xa2\xa1\x45\x07\xe1\x2a\xa5\xa2\x68\x38\x3d\xd0\x74\xf7\x59\x75\x68\xe9\xef\xf7\x07\xaf\x3f\xdb\xcd\xa5\x7d\xe7\xe5\xfa\xce\xf0\x41\x7c\x05\x8b\x4f\x9c\xd9\x77\x54\x65\x49\x56\x63\x2b\x16\xf2\x16\xb1\x5a\x28\x8f\x8f\xd5\x05\xa9\xfc\x74\x8e\xf6\xc3\xfd\x72\xb0\x9a\x41\x35\x83\xbd\xf4\x65\x46\xd5\x79\x80\x02\x56\x02\xde\x05\x3c\x09\xa8\x05\x3c\x9f\x5e\xf0\xb4\xe6\x78\xe1\x48\x48\x22\x50\x11\xa4\x02\xb6\x32\xfc\xfe\x0f\x00\x00\xff\xff\x0e\x38\x3e\x85\xd5\x02\x00\x00")
-
-func ik_caJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ik_caJson,
-		"ik_CA.json",
-	)
-}
-
-func ik_caJson() (*asset, error) {
-	bytes, err := ik_caJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ik_CA.json", size: 725, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _is_isJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xb1\x6a\xf3\x30\x14\x85\x67\xf9\x29\x84\x40\x5b\xf8\x1f\x20\xdb\x0f\x5e\x32\x18\x0a\xf1\xd2\x96\x52\x64\xa2\xd8\x72\x2c\x39\x48\x72\xa0\x94\x3e\x4c\x1e\x20\x10\x08\x74\xf0\xd0\xe5\xbe\x58\x71\x6d\x5d\xd9\x69\xb7\x7b\xce\xb9\xbe\xe7\x33\xe8\x3d\x21\x6c\x93\xb2\x35\x65\xca\xbd\x6e\xb6\x6c\x95\x10\x96\x8a\x37\xc7\xd6\xf4\x39\x21\x84\xb9\xce\x98\x6e\x27\xca\xce\x0e\x11\x61\x1a\xce\x0b\x0d\x5f\x56\xc1\xb5\x5e\xac\x28\xb8\x9e\xd4\x61\x6e\xed\x95\xd6\x7e\x61\xc0\xa7\x5b\x18\x8d\xe8\x4a\x61\x47\x23\x21\x2f\x03\xc6\xb6\x6a\xad\xbf\x63\x89\x10\xb1\x3e\xb6\x62\x59\x2c\xc1\xeb\xe1\x6a\xd6\x1a\x5f\xe1\xc9\x5a\x18\xe8\x45\x80\x92\x85\x8d\x4a\x0b\x3b\x7d\x2d\x8e\x16\x2e\x4d\x70\xe1\x32\x4e\x35\xf4\x66\x36\x37\x61\x86\x73\x09\xbd\xf3\xa3\x70\xf2\xe8\xa5\x2e\xe4\x74\xb3\x3d\x78\xb8\xa1\x32\x70\x3b\xcd\xc2\x9d\x74\xa3\x9a\xff\xff\x2f\x5c\x44\x45\x4a\x84\xfc\x8b\x30\xf2\xcd\xe8\x90\x0d\xa9\x22\x10\xb2\x04\x8c\xff\xd9\x43\x16\xfa\xf7\xd5\x18\xcb\x2a\xa4\xa9\xf0\x72\x78\x3d\x5c\x50\x2e\xff\xf1\x82\xf2\x47\x16\xfc\x5c\xe9\xfb\x6c\x45\x79\x4e\xf9\xd3\xcf\x0a\xc6\x39\xca\xa9\x8b\xb1\xe4\xe3\x3b\x00\x00\xff\xff\x6c\xeb\x13\x59\x99\x02\x00\x00")
-
-func is_isJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_is_isJson,
-		"is_IS.json",
-	)
-}
-
-func is_isJson() (*asset, error) {
-	bytes, err := is_isJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "is_IS.json", size: 665, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _it_chJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x10\x85\xd7\xf2\x29\xc4\x80\x76\x3f\x39\x40\x76\x3f\xcd\xa2\x5d\x04\x0a\xf5\xa6\x2d\xa5\x8c\x6d\x55\x11\x58\x52\x90\x27\x86\xb4\xf4\x46\xbd\x45\x2f\x56\x5c\x5b\x2f\x26\xdd\xe9\xcd\x1b\xbf\xf7\x0d\xf8\xa3\x52\x74\xb7\xa3\xad\x26\x2f\xaf\x37\xb7\xf4\xaf\x52\xb4\xe3\xf3\x40\x5b\xfd\x5c\x29\x45\x5d\x0a\x36\xfa\x96\x27\x43\x51\x7f\x8a\xb6\xfb\xfe\x9a\x45\xe0\x2c\x2b\x65\x73\x9b\xfa\x8b\x76\x3e\x8d\x17\x35\xda\x68\x33\xd4\xc0\x0d\x4b\xa2\x4a\xbd\x4c\x75\x0f\x87\x94\xe5\xaa\x13\x75\xa8\x42\x0b\xe2\x91\x8c\xd0\x92\xb8\x4f\x51\x0e\x88\x73\x36\x46\x2e\xeb\x6f\xb6\x69\x32\x54\xe0\xfc\xbe\x3c\xf9\x98\x7d\x6f\xcb\xd8\x21\xdf\xf9\x93\x8b\xa9\xf0\xb8\xbe\xcc\xd9\xa5\x41\x96\xf7\x60\x45\x6c\x68\xf2\xf2\x79\x12\x49\x10\x31\x8d\x2b\xab\xf3\xed\xac\xd6\xb7\xff\xc1\x05\xea\xd5\xf5\x7c\xcc\x00\x04\x1d\xd0\xc0\x05\x28\xe0\x00\x05\x14\x05\xe0\xff\xfe\x7e\x5f\x9a\x67\xb3\x38\x3b\x16\x3b\xfd\x17\xa6\xdb\x68\x13\x36\xda\x9c\xa9\x8c\x6b\x1f\x66\x8b\xb5\xe9\xb4\x69\xb4\x79\xd4\xa6\xd6\xe6\xe9\x77\x03\x6e\x0d\xb9\xb4\x10\x55\x9f\x3f\x01\x00\x00\xff\xff\x10\xbc\xa6\x86\x71\x02\x00\x00")
-
-func it_chJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_it_chJson,
-		"it_CH.json",
-	)
-}
-
-func it_chJson() (*asset, error) {
-	bytes, err := it_chJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "it_CH.json", size: 625, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _it_itJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x6a\xc3\x30\x10\x44\xcf\xf2\x57\x88\x05\xdd\x0a\xb9\xe7\x56\xc8\x25\x87\x40\xa1\xbe\xa4\xa5\x94\xb5\xad\x2a\x02\x4b\x0a\xf2\xc6\xd0\x96\xfe\x51\xff\xa2\x3f\x56\x5c\x5b\x13\x93\xde\x34\x3b\xeb\x99\xb7\xe0\xcf\x4a\xd1\x7e\x47\x5b\x4d\x5e\x5e\xf7\x35\xdd\x55\x8a\x76\xfc\x3e\xd0\x56\x3f\x57\x4a\x51\x97\x82\x8d\xbe\xe5\xc9\x50\xd4\x5f\xa2\xed\x7e\xbe\x67\x11\x38\xcb\x4a\xd9\xdc\xa6\xfe\xaa\x9d\x4f\xe3\x55\x8d\x36\xda\x0c\x35\x70\xc3\x92\xa8\x52\x2f\x53\xdd\xe3\x29\x65\xb9\xe9\x44\x1d\xaa\xd0\x82\x78\x24\x23\xb4\x24\x1e\x52\x94\x13\xe2\x9c\x8d\x91\xcb\xfa\x9b\x6d\x9a\x0c\x15\x38\x7f\x2c\x4f\x3e\x67\xdf\xdb\x32\x76\xc8\x77\xfe\xe2\x62\x2a\x3c\xae\x2f\x73\x76\x69\x90\xe5\x3d\x58\x11\x1b\x9a\xbc\x7c\x9e\x44\x12\x44\x4c\xe3\xca\xea\x7c\x3b\xab\xf5\xed\xff\x70\x81\x7a\x73\x3d\x9f\x33\x00\x41\x07\x34\x70\x01\x0a\x38\x40\x01\x45\x01\xb8\x3f\x3c\x1c\x4a\xf3\x6c\x16\x67\xc7\x62\xa7\xff\xc2\x74\x1b\x13\x36\xe6\x48\x65\x58\xfb\x30\x1b\xac\x4d\xa7\x4d\xa3\xcd\x51\x9b\x5a\x9b\xa7\xbf\x0d\xb8\x35\xe4\xd2\x41\x54\x7d\xfd\x06\x00\x00\xff\xff\xc6\xb9\x18\x4f\x6f\x02\x00\x00")
-
-func it_itJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_it_itJson,
-		"it_IT.json",
-	)
-}
-
-func it_itJson() (*asset, error) {
-	bytes, err := it_itJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "it_IT.json", size: 623, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _iu_caJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x3f\x4b\x2b\x41\x14\xc5\xeb\xdd\x4f\x31\x0c\x4c\xf7\x20\xfd\x76\xe1\xa5\x49\xb1\x10\xc8\x6b\x9e\x22\x12\x89\x90\x14\x31\x12\x63\x11\xc4\xc6\x64\x31\x44\x08\x33\x3b\x3b\x1b\x23\x58\xd8\xa4\x08\xc4\xf8\xa7\xd7\xcf\x72\xbe\x89\xcc\x66\xe7\x8f\xa2\x60\x37\x73\xcf\xdd\x73\x7f\xe7\xee\x5c\x84\x01\xad\xd7\x68\x44\x68\xf7\xfc\xf0\x6f\x95\xfe\x09\x03\x5a\x6b\x8d\xce\x68\x44\xf6\xc3\x20\xa0\x90\x53\x88\x15\xc4\x1c\xb9\x40\xb6\x81\xe2\x50\x0a\xe9\x58\x37\x6a\xf5\x1a\xe9\x03\x52\x8e\xec\x4d\xab\x3c\xf9\xa9\x0e\xa9\x90\x27\x10\x1b\x88\x55\xd9\xc3\x9f\x91\x4b\xc8\x97\x52\x92\x5b\xdd\x66\x55\xf5\x5e\x0c\x4d\x20\x9e\xbc\x4f\x12\xc8\x35\xe4\x02\x6a\x0a\x79\x0b\xc1\xc1\xc7\x7a\x56\x6e\xe7\x6e\xa1\x96\x10\x79\x09\x19\x06\x07\x3a\x50\xb3\xd3\x1f\x0c\xbf\xa4\xb2\xa0\xe6\xa0\x2c\x96\x25\xb0\x53\xad\xbb\x71\x8c\xfb\x27\xc3\x8e\xb3\xcb\x1e\x21\x27\xe0\x33\xe7\xa2\x14\xd4\xe2\x53\x25\x5d\x23\xd3\x29\x8d\xe9\x18\xfc\x15\x72\xe1\x54\x6e\x96\x9a\xad\x21\xaf\xbc\xf3\x9d\x93\xf8\x0c\xe9\x8d\x33\x91\x5b\xbd\x24\xb5\x74\xaa\xfe\x57\xdc\x55\xe4\xa4\x20\x31\x57\x31\x2f\x36\x74\xaf\x2b\xfe\x6e\xbe\x8b\xe3\x07\xf1\x23\xf8\xfc\xbf\x81\xf7\xc9\x7d\x6c\x9f\xd9\xa7\xf5\x51\x0d\x64\x35\x6e\xc4\x86\xae\x1a\xef\x3a\x1a\xb1\x51\x6b\xad\xe1\xb1\x7e\xc1\xac\x57\x61\xed\x0a\x1b\x51\x53\xfc\xd7\xed\xed\x84\x16\x61\x6d\xc2\x8e\x08\xfb\x4f\xd8\x80\xb0\xbd\xa2\xc3\xaa\x03\x7b\x2d\xe7\x50\x56\x8f\x58\x1c\xb1\x26\x61\xa7\x34\xbc\xfc\x08\x00\x00\xff\xff\x8c\xc2\x36\xc8\x24\x03\x00\x00")
-
-func iu_caJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_iu_caJson,
-		"iu_CA.json",
-	)
-}
-
-func iu_caJson() (*asset, error) {
-	bytes, err := iu_caJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "iu_CA.json", size: 804, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _iw_ilJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\x41\x4b\x33\x31\x14\x3c\x67\x7f\x45\x78\x10\x7a\xf9\xa0\xf7\xbd\x15\x7a\xf8\x0a\x06\x0a\xf5\xa2\x22\x52\xa9\xd0\x1e\x6a\x41\x0b\x52\xc4\xff\x90\x6d\x0d\x36\x50\x6a\x2b\x55\xb3\xfe\xa1\xf9\x3b\xb2\x6d\x12\xdf\x8a\xbd\xcd\xbc\x99\xcd\x9b\x79\xec\x63\x26\xa8\xd3\xa6\x5c\xd2\xe8\xe1\xaa\x73\x42\xff\x32\x41\xed\xfe\xec\x9e\x72\x79\x91\x09\x41\xf0\x30\x28\x61\xf1\x5a\x49\x82\x50\x62\x03\x97\xf0\x0a\x0e\x65\xe2\x1e\x05\x1c\xde\x12\x7f\xc1\xba\xa6\x97\xbf\x58\x81\x2f\xca\xc4\x65\xb5\xb3\x37\x9c\xdc\x4d\x6b\x8b\x4d\x23\xf8\x8a\x08\xe6\x11\x2c\x22\x78\x8e\xc0\x46\x50\x36\xe2\x8b\x7a\x72\x3b\x1d\xfe\x3c\xe7\xb0\x81\x85\x81\x0f\xc6\x1d\x0a\xf8\xda\x64\x0d\x8f\xf7\x80\x0d\x76\xf0\x70\x58\x25\xcd\xa4\xdc\x0e\x96\xdd\xa0\x62\xab\xc4\x0c\x2c\xe6\xb0\xd8\x62\x19\x26\x5b\xec\xb0\xc4\xba\xda\xc6\x3c\x9f\x58\xc2\xb2\xd9\x66\xcf\xb8\x6b\x81\x8f\xc0\xf9\x85\xfe\x2a\xc5\x0b\x1d\xab\x72\xac\x06\x2f\xc1\x2b\xf0\xf0\x3c\x36\x8f\xcb\x83\xc6\x90\x2d\xdd\xd5\x31\x5d\x4b\x1f\x1c\x5d\x1d\xd5\x76\x7f\x7a\x53\xfd\x6a\x6a\xd0\x54\xe3\xa6\x9a\x51\x1c\x9e\x8e\xc6\x07\xe1\x5c\xaa\xff\xb9\xd2\xb9\xea\x49\x75\x26\xd5\xb5\x54\x03\xa9\xfa\x7b\x5f\xf2\x04\x43\x1a\x86\x9d\xa4\x3a\xf1\xcb\x2e\x65\x4f\xdf\x01\x00\x00\xff\xff\xc7\x9c\xe2\xae\xd9\x02\x00\x00")
-
-func iw_ilJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_iw_ilJson,
-		"iw_IL.json",
-	)
-}
-
-func iw_ilJson() (*asset, error) {
-	bytes, err := iw_ilJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "iw_IL.json", size: 729, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ja_jpJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xe6\xe2\x54\xf2\x74\x51\xb2\x52\x50\xca\x4a\x8c\xf7\x0a\x50\xd2\xe1\xe2\x54\x72\x49\xac\x2c\x56\xb2\x52\x88\xe6\xe2\xe4\x54\x7a\x36\x7d\xe9\xb3\xd9\x73\x9e\x4d\x5f\x0a\x92\xe1\x54\x7a\x36\xa7\x03\x99\xfb\xbc\x71\x35\x8a\xec\x86\x2d\xa8\x8a\x57\x20\x73\x5f\xb6\x4f\x44\xe6\x3e\x9d\x33\x1f\xca\xe5\xe2\x8c\x05\x59\x1b\x9c\x91\x5f\x54\x82\x66\x37\xdc\x56\xb8\x7d\x70\x9b\xe0\x76\xc0\x4d\x87\x9b\x0b\x33\xd1\x37\x3f\xaf\x24\x03\x6e\x9c\x21\xdc\x18\x23\x38\xcb\x18\xce\x32\x81\xb3\x4c\xe1\x2c\x33\x38\xcb\x1c\xce\xb2\x80\xb3\x2c\xe1\x2c\x43\x03\x04\x13\x61\x8b\x21\xd8\x1a\x64\xcf\x0d\x22\xf7\x38\xfa\x06\xf8\xc2\x1c\xf2\xb4\xb7\xe3\x69\x67\x2f\x34\xf4\x7a\x3b\x9e\xee\xeb\x81\xa9\x72\x49\x2c\x49\x05\xa5\x0d\xd5\xc8\xa7\x3b\xb7\xa8\xe6\x3e\x9b\xd3\xa1\x9a\x02\x8d\x15\xb0\x5c\x48\x66\x2e\x36\x79\x05\x55\x8f\x67\x33\x9b\x54\x7d\x9f\x76\xb4\xa9\x06\x3f\x5f\x3e\x09\xac\x1e\xae\x16\x87\x1c\xd4\x45\x4a\xaa\x05\xaa\x9e\xa8\x2a\xb8\x6a\x01\x01\x00\x00\xff\xff\x53\xb0\x43\xfa\xa6\x02\x00\x00")
-
-func ja_jpJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ja_jpJson,
-		"ja_JP.json",
-	)
-}
-
-func ja_jpJson() (*asset, error) {
-	bytes, err := ja_jpJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ja_JP.json", size: 678, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ka_geJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x53\xcd\x4a\xf3\x40\x14\x5d\x27\x4f\x31\x0c\xcc\xae\xd0\x7d\x77\xdf\x47\x45\x5c\x14\x04\xbb\xa9\x22\x52\xa8\x50\x91\x5a\xd0\x6c\x44\x5c\xdd\x17\x48\x35\xd2\xb4\x4d\x24\x51\x04\x17\xd2\x77\xba\x6f\x22\x39\x71\x86\xe1\xa6\xb3\x19\x98\x73\xcf\xcc\xf9\x99\xe4\x29\x8e\xf4\xc9\x50\x0f\x94\xbe\x9d\x5e\x1d\x1f\xe9\x5e\x1c\xe9\xe1\xf4\xf1\x41\x0f\xd4\x45\x1c\x45\x9a\x69\xc3\xf4\xc6\x94\x33\x55\x4c\x69\x33\x6f\xc0\x12\xdb\x6f\xa6\x94\x69\x85\x75\xcd\x94\xdb\x69\x0d\x64\x17\x26\x94\xd8\xee\xc3\x84\x3d\xd3\x27\x90\x10\xe1\x1d\x48\x85\xb5\x86\xc9\xac\xf5\x69\x09\x9d\x83\x71\x74\xd9\x84\x3b\x9b\x2f\xef\x93\x83\x09\x45\x36\x11\x46\x58\x17\x46\x85\x2d\x61\xc2\x6a\x8f\x96\x77\xc9\xdc\x13\xce\x31\x2e\x20\xdf\xa6\x71\x26\xd6\x08\xb4\xc2\x5a\x59\xc2\xd6\x23\xec\xec\x91\x0f\x0f\x4c\xe1\xa0\xc2\xcd\x5d\x72\x8e\x30\x0e\xcc\x71\x6d\x11\xc0\xb7\x1d\x3c\x65\x7a\xb1\xdf\x42\x0d\xdd\xd2\xeb\x28\x63\xfa\x02\x98\x41\xce\x39\xf7\x6b\x6d\x09\x65\x80\x50\x60\x14\x3a\xfe\x0a\x64\x73\x80\xe0\x3f\x6c\xa0\x61\xd1\xaa\xe8\x50\xb4\x27\x4a\x13\x75\x89\x96\x44\x39\xa2\x10\x91\x5d\x24\x15\xd1\x6c\x90\x7f\xa3\xd3\x91\x4d\xd0\x52\xec\x64\x38\x4d\xae\x9b\x5f\xd5\x2c\xfa\x66\xd6\x37\x13\x6d\xc1\xf1\xcd\xa2\x1d\x4c\x14\xd3\x8f\x7b\x3c\x65\x66\xca\xfc\xef\x29\x33\x56\xe6\x1c\x64\x47\x1c\xbb\xed\x9f\x9c\xd6\xf1\xf3\x6f\x00\x00\x00\xff\xff\x4c\x0f\xe2\x5c\x0d\x04\x00\x00")
-
-func ka_geJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ka_geJson,
-		"ka_GE.json",
-	)
-}
-
-func ka_geJson() (*asset, error) {
-	bytes, err := ka_geJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ka_GE.json", size: 1037, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _kk_kzJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x3d\x4b\x03\x41\x10\xad\xef\x7e\xc5\xb2\xb0\x9d\xe4\x07\xa4\x13\xd2\x88\x04\x04\xd3\x44\x11\x89\x44\x88\x84\x18\xd0\x34\x22\xc2\x5d\xd2\x08\x69\x14\x64\xc1\x44\x0b\xb5\xb1\x73\x03\x1e\xc1\x7c\xe8\x5f\x78\xb3\xfe\x21\xd9\x5c\x76\x33\x98\x74\x37\xef\xcd\xbc\x79\x6f\xf6\xae\xe3\x48\xee\x94\x64\x51\xc8\x66\xf3\x78\xf7\x40\x6e\xc5\x91\x2c\xd5\xae\x2e\x65\x51\x1c\xc6\x51\x24\xa1\x91\x61\x42\x29\x32\xcc\x31\x22\xed\x1a\x22\x89\x07\xfb\x81\xaf\x35\xf4\x05\xd9\x26\xf4\xf7\x91\x92\x35\xf4\x7e\x63\xaf\xb6\x23\xcc\x60\x98\x5e\xce\xc6\xd1\x91\x73\xb6\xdf\x68\x5f\x74\xfe\xd9\x9b\x78\x4b\x94\xfa\x31\xf6\x95\xf8\x75\x01\xd3\x98\x05\xf9\xb9\x17\x2e\xb7\xcf\x3b\x8d\xa0\x6a\x07\x30\xf6\x8d\xba\x30\x61\xfe\xce\x0e\xf1\x03\x83\xf9\xb2\x7e\x86\xa1\x1e\x25\xd4\xc7\x98\xc5\xec\x91\x0e\x13\x4f\x30\x98\x51\x9f\xd7\xd4\xa3\x94\xfa\x61\xff\x3b\x69\x4c\xf1\x89\x6c\x59\xbf\xe6\x13\x5e\xd1\x0e\xdc\x34\x26\xee\xd4\xee\x11\x3c\x0a\x83\xf1\xca\x89\xab\x29\x81\xa1\xdb\x70\x36\xf7\x64\x53\xea\xe2\xdb\x0e\x29\x5d\x74\xf2\xf3\x6d\x8a\xca\x43\xf2\x80\x3c\x1a\x8f\xc5\x23\xf1\x30\x3c\x08\x0f\xc1\xad\x73\xdb\xdc\xb0\x37\xb9\x5d\xde\x2b\x7b\x77\x39\xef\x99\x52\xad\x73\xea\xfe\x54\x55\x2f\xa8\x56\x41\x55\xa5\x07\x2b\x67\xad\x9c\xa8\x09\x55\x17\xea\x44\xa8\xaa\x50\x95\x05\x1d\xa8\x55\xb9\x5c\x20\x65\x7c\xf3\x17\x00\x00\xff\xff\xef\xf3\x9e\x79\xfe\x02\x00\x00")
-
-func kk_kzJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_kk_kzJson,
-		"kk_KZ.json",
-	)
-}
-
-func kk_kzJson() (*asset, error) {
-	bytes, err := kk_kzJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "kk_KZ.json", size: 766, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _kl_glJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x4b\xc4\x30\x10\xc5\xcf\xe9\xa7\x08\x03\xbd\xf9\x09\xf6\x26\x14\x44\xb0\x20\xd8\x8b\x8a\xc8\xab\x9b\xed\xa6\xdb\x24\x35\x7f\x04\x11\xbf\xbb\xd4\x36\xd3\xdd\xee\x6d\xe6\xbd\xf4\xbd\xdf\x40\x7f\x0a\x41\xf7\x15\xed\x24\x9d\x86\xf7\xbb\x07\xba\x29\x04\x55\xf8\x0e\xb4\x93\xaf\x85\x10\x14\xd0\x02\x71\x92\x05\x21\x02\x41\x5b\xdb\x39\x6f\xd5\xe7\xac\x19\xf8\x21\x5d\x4a\xa3\xb6\x1d\xc2\x46\x0c\x3a\xc0\xe0\x52\x8b\x18\x06\xbd\x15\xe1\x0f\xda\xae\x25\x85\x78\x9b\x90\x9e\x8e\xce\xc7\x0d\x17\x43\x31\x09\xf7\x73\x27\x17\x71\x78\x4e\xac\x9d\x8d\x47\x8e\xeb\x61\x13\xbc\x9e\x5f\x1d\x54\xeb\xd7\xcd\xc0\xc7\xb0\xcc\x18\xbd\x1e\x58\xef\x97\xa9\x4f\x96\xa7\xec\x22\x75\x29\xc4\x94\x3f\x0c\x6a\x8c\xca\xb4\x2a\x87\xba\x53\x74\xeb\x66\xdd\xd7\xb9\xb9\x57\x1f\xcb\x7a\x7e\xfd\x15\x30\xc3\x6e\xee\xc7\xe8\x99\x90\x01\x99\x8f\xf1\x18\x8c\x89\x18\x86\x31\x32\xc0\x6d\xfd\x58\xe7\xe6\xd9\xcc\x4e\x85\xa8\xa6\xbf\xa7\xdc\xcb\xb2\x95\xe5\x33\x65\xb1\xd1\x66\x36\x20\xb3\x27\xcb\x46\x96\x2f\xff\x2f\xd8\x6d\x78\x5d\x3a\x88\x8a\xdf\xbf\x00\x00\x00\xff\xff\x81\x7c\x43\x9e\x95\x02\x00\x00")
-
-func kl_glJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_kl_glJson,
-		"kl_GL.json",
-	)
-}
-
-func kl_glJson() (*asset, error) {
-	bytes, err := kl_glJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "kl_GL.json", size: 661, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _km_khJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x53\xcd\xaa\xd3\x50\x10\x5e\x27\x4f\x71\x38\x70\x76\xf7\x09\xb2\xbb\xd2\x45\x45\x02\x42\xdd\x5c\x44\xe4\x2e\x0a\x57\xa4\x5e\xd0\x6e\x44\x84\xb4\x36\xc5\x5a\x4a\xdb\x44\x6c\x6c\x65\xa6\x8d\x3f\xd5\x17\x68\x2b\xfa\x32\xd3\x27\x91\x33\xcd\xa4\x27\x72\xb3\xfb\xf2\xcd\x37\xf3\x4d\xbe\x73\xce\x1b\xdf\xd3\xf7\x1b\x3a\x50\xfa\x79\xe7\xe9\x83\xa6\xbe\xf0\x3d\xdd\xb8\x7e\xfd\x4a\x07\xea\xb1\xef\x79\x9a\x60\x46\x98\x10\x0c\x08\xdf\x1d\xa3\x31\x41\x4e\xb0\x23\x98\x13\xec\x09\xa6\x5c\xfa\x6c\x9b\xee\x50\xc6\x84\x33\x82\x94\xc9\x79\x8d\x26\x67\x9c\x10\xf4\x79\xec\xb2\x46\xf6\x89\xe0\x0f\x41\x52\x5b\xb5\x9f\x4b\x82\x35\x01\x32\xfe\x68\x77\x83\x3d\xe1\xa4\xa6\x05\x79\x60\x74\x6a\xac\xd5\x60\x6c\xc7\xe2\x44\xfb\xde\x13\x1b\x4c\xeb\xe6\xf6\x65\xb7\x9a\x8e\x8d\x43\xfa\x63\x01\xb9\x00\xbb\xf7\x19\x57\xcc\xd0\x29\xa1\x18\x84\xb7\x2f\xba\x37\xce\xf4\xcc\xee\x68\xff\xac\xf4\x88\x78\xf1\x8c\x67\x2d\x08\xdf\x0b\x9f\xf1\x81\xa4\x8e\x32\x23\xec\xb1\x4d\xc9\x6c\xf9\x73\x51\xd1\xd8\xae\x19\xcf\x4c\xab\x2e\xa7\x6c\x22\x82\x0f\x0e\x8f\x04\x07\x8e\xd9\x55\x8e\x58\x39\x72\xc8\x29\x0f\x5c\x39\xcc\x17\x36\x8a\x59\x39\x64\x1c\x39\xd5\x84\xf9\x94\xe0\x6f\x25\xe9\xff\xd2\xc0\x4d\xa1\x47\xc9\x17\xbf\x0a\xf8\x26\xe0\xbb\x80\x1f\x02\xb6\x02\x7e\x0a\xf8\x25\x60\x43\xb8\x76\xf0\xc6\xc1\xb9\x6c\x72\x19\x3e\x0c\xcf\x07\x52\x5e\xb5\xdf\x04\x91\xac\xbf\x2a\xee\x8d\xbd\xc1\xb1\xf4\x35\xae\xbb\x6d\xfb\xac\x4c\x5b\x99\x7b\xca\x5c\x69\x21\x1f\x3d\xeb\x9c\x0a\x97\xca\xbd\x72\xca\xb4\x15\x41\x8f\xb0\x6f\xf5\x36\xa5\x22\x93\x1d\xe1\x50\x99\xab\x0b\x65\x9a\x8a\x4f\x75\x44\x38\x20\x18\xa8\x8e\x2a\xea\xf6\x35\x1e\x94\x69\x29\xc9\xf9\xcc\x1e\xa3\x31\x1b\x97\xa6\xcd\xc0\x84\x81\x69\x95\x64\xf1\x7b\x5a\xfb\x6f\xff\x05\x00\x00\xff\xff\xc0\x83\x44\x0b\x0b\x04\x00\x00")
-
-func km_khJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_km_khJson,
-		"km_KH.json",
-	)
-}
-
-func km_khJson() (*asset, error) {
-	bytes, err := km_khJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "km_KH.json", size: 1035, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _kn_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x93\xc1\x4a\xc3\x40\x10\x86\xcf\xc9\x53\x2c\x0b\x7b\xf3\x09\x72\x2b\xf4\xd2\x43\x44\xa8\x17\x15\x91\x8a\x42\x45\x6c\x45\x73\x11\xf1\x60\xc0\x7a\x90\xe2\x49\x0a\x12\xf0\x52\xb4\x44\x89\x61\x96\x4a\xbc\x98\x57\x99\x47\x91\x9d\x74\x37\xd3\x44\x2f\x61\xf9\xff\xd9\x9d\xef\x9f\x69\xaf\x7d\x4f\xf6\xba\x32\x10\xf2\x74\x74\xd0\xdb\x94\x1b\xbe\x27\xbb\x83\xab\x4b\x19\x88\x3d\xdf\xf3\x24\x42\x8e\xb0\x44\x28\xe9\xfb\x83\x90\x9b\x12\xa3\x17\xa8\x1f\x10\xb2\x96\x9e\x21\xc4\x08\x33\x04\xdd\xb2\x3e\x50\xdf\x22\xbc\xb5\xf4\x19\xe9\x39\x7d\x1b\xd6\x17\x89\x4f\xa8\xa7\x96\x64\xdd\x85\xc5\x1a\x9b\xef\xed\x9b\x04\xfd\xe1\xf8\x22\x6a\xc4\xe0\xdc\x9c\x95\xc3\x71\x20\x4e\xe0\xce\xb6\x41\x38\x1e\x45\x43\xf6\x7a\x42\x20\x4b\x62\x2c\x6d\xf5\x3b\xea\x09\xbd\xeb\xd8\xb9\x9b\x55\xc8\xe4\x3e\x5b\xf1\x11\x21\xb5\xf5\x25\x02\xa0\x9e\xd6\xf5\xfa\xde\x9e\x13\xd4\xb1\xe9\x58\xbb\x09\xcd\x09\xe8\xcd\x4f\xd6\x65\x42\xab\x28\xe8\xcd\x39\x4b\x52\x10\x5b\xd5\xeb\x85\xce\xb1\x41\xad\xc7\x74\x67\xa7\x3e\xa7\x5e\xdc\xa2\xa4\x7f\x5c\x79\xa5\x85\x58\x91\xaf\xa2\x35\x2e\x3e\x22\x3e\x10\x37\x87\xff\x52\xf3\xbc\x2e\x23\x0f\xe5\xf8\x1d\xad\xc3\xb3\x48\x9d\x70\x2b\xac\x59\x52\xca\x57\xed\xa1\xfa\x19\x7d\xd3\x79\xc1\x26\x91\xd2\x42\x98\xb5\x7a\xa9\x3b\x88\x8e\xcd\x7f\x47\x75\x84\x3a\x12\xea\x50\xa8\x1d\x69\xf5\xed\x93\xb3\xa6\x27\x54\x2f\x50\x61\xa0\xfa\x42\x9d\x0b\xb5\x4b\xa5\xae\xcc\x59\xb5\xbe\x02\x95\x8d\x6b\xfe\xcd\x6f\x00\x00\x00\xff\xff\x1c\xf2\xb3\x07\xb6\x03\x00\x00")
-
-func kn_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_kn_inJson,
-		"kn_IN.json",
-	)
-}
-
-func kn_inJson() (*asset, error) {
-	bytes, err := kn_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "kn_IN.json", size: 950, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ko_krJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xe6\xe2\x54\xf2\x74\x51\xb2\x52\x50\xca\xce\x8f\xf7\x0e\x52\xd2\xe1\xe2\x54\x72\x49\xac\x2c\x56\xb2\x52\x88\xe6\xe2\xe4\x54\x7a\x33\x77\xcf\x9b\x59\x53\xde\xcc\xdd\x03\x92\xe1\x54\x7a\x33\x7b\x0a\x32\xf7\xed\x4c\x14\xee\x9b\x8e\x19\xc8\xdc\xd7\xab\x56\x22\x73\x5f\xed\xe8\x40\xd1\xdb\xb6\x00\xca\xe5\xe2\x8c\x05\x59\x1b\x9c\x91\x5f\x54\x82\x66\x37\xdc\x56\xb8\x7d\x70\x9b\xe0\x76\xc0\x4d\x87\x9b\x0b\x33\xd1\x37\x3f\xaf\x24\x03\x6e\x9c\x21\xdc\x18\x23\x38\xcb\x18\xce\x32\x81\xb3\x4c\xe1\x2c\x33\x38\xcb\x1c\xce\xb2\x80\xb3\x2c\xe1\x2c\x43\x03\x04\x13\x61\x8b\x21\xd8\x1a\x64\xcf\x0d\x22\xf7\x38\xfa\x06\xf8\xc2\xc3\x79\xc6\x92\x37\x0b\x5a\xa0\x01\x3b\x63\xc9\xdb\xd9\x2d\x30\x55\x2e\x89\x25\xa9\xa0\xb4\xa1\x1a\xf9\xba\xb5\x45\x41\x35\xf7\xcd\xec\x29\x0a\xaa\x29\xd0\x78\x01\xcb\x86\x64\xe6\x42\x54\x54\x28\x68\xa8\x26\x6a\x2a\xa8\x16\x81\xa5\xe0\xc2\x1e\x6f\xba\xe7\x28\xa8\xfa\xbe\xde\xd6\xa2\xa0\x1a\xfc\x66\x4b\x07\x5c\x16\xea\x00\x25\xd5\x02\x05\x55\x4f\x74\x45\x5c\xb5\x80\x00\x00\x00\xff\xff\x16\x73\xb3\x47\x98\x02\x00\x00")
-
-func ko_krJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ko_krJson,
-		"ko_KR.json",
-	)
-}
-
-func ko_krJson() (*asset, error) {
-	bytes, err := ko_krJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ko_KR.json", size: 664, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _kok_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x53\x31\x4b\xf4\x40\x10\xad\x93\x5f\xb1\x2c\x6c\xf7\x91\x1f\x90\xee\x20\x4d\x8a\x7c\x08\x67\xa3\x22\x72\xa2\x70\x72\x68\x44\xd3\x88\x08\x67\x0a\x23\x88\x58\x1c\x22\xc8\x41\x9a\x59\x0d\x7a\xc4\x70\x72\x92\x4a\xff\xca\xfc\x14\xd9\x31\x9b\x6c\xe2\x61\x2f\xd8\x04\xe6\xbd\x37\x2f\x6f\x5e\xc8\xa9\x6d\x71\xdf\xe3\x2e\xe3\xa3\x70\xb4\xe5\xff\xe7\xff\x6c\x8b\x7b\x83\x93\x63\xee\xb2\x0d\xdb\xb2\x38\xc2\x05\xc2\x0b\x02\x20\xbc\x23\x14\x8a\x57\x60\x89\xf2\x0a\x21\x6f\x83\x39\x42\x8c\x70\x87\xf0\x8a\xb0\x68\x53\x33\x94\xe7\x08\x8f\xcb\xf0\x04\xa1\xa0\x67\xf9\xed\x2d\x6f\xb4\x75\xab\x04\x5d\x3c\x41\xc8\x1a\x37\xdb\xda\x54\xc1\xfb\xc3\xf0\x28\xfa\xb5\xe9\x83\xf0\x20\x1a\x1a\xd1\xa7\x86\xae\x40\x39\xd6\xfb\xcf\x84\xcf\x50\x5e\x93\xb5\x49\x55\x27\x21\xdc\x6b\xe4\x06\xe1\xa9\x52\xca\x31\xc2\xbc\x51\x2a\x93\x58\x8f\x53\xca\x9a\xb5\xc7\x39\xca\x4b\x8d\x4c\xa8\x9a\x92\xac\x52\xa3\xc7\x44\xf9\x43\xfa\xe5\xa6\x52\x35\x77\x4e\xe8\xf8\x94\xba\x36\xf1\x8c\x90\xc5\xb2\x95\x07\x84\x0f\x6d\xab\x29\xf3\xdb\xfe\x55\xf4\x53\x45\xbd\x60\x25\x68\xba\xc9\x1d\xba\x2b\x76\xea\x7b\x1c\xaa\x2a\x76\xb4\xde\x1b\x44\xbb\xea\xd7\x17\x3d\x26\x76\x98\xd8\x66\x62\x8d\x6b\x7c\x75\x6f\xbf\xcb\x31\xe1\xbb\x22\x70\x45\x9f\x89\x43\x26\xd6\x49\x5a\xcb\x6a\xaa\xc1\xab\x38\xbc\xb3\x66\x9f\x7d\x06\x00\x00\xff\xff\xfb\x82\x93\x4e\x75\x04\x00\x00")
-
-func kok_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_kok_inJson,
-		"kok_IN.json",
-	)
-}
-
-func kok_inJson() (*asset, error) {
-	bytes, err := kok_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "kok_IN.json", size: 1141, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ks_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x92\x31\x4b\xc3\x40\x14\xc7\xe7\xcb\xa7\x38\x1e\xdc\xe6\x27\xc8\x56\xc8\x92\x21\x22\xd4\x45\x45\xa4\xa2\x50\x11\xad\xd8\x2c\x22\x0e\x42\x7b\x9e\x20\xb8\xba\xd6\xc1\xd4\xa3\x10\x42\xa6\x76\xb8\xcf\xf1\xde\xf9\x65\xe4\x62\x72\x49\xeb\xe2\x24\x74\xcb\xfb\xff\xde\x3f\xf9\x25\xb9\xfb\x80\x41\x1c\x41\xc8\xe1\x72\x7c\x12\xef\xc2\x4e\xc0\x20\x1a\xdc\x8d\x21\xe4\x47\x01\x63\x80\xef\xa8\xe9\x89\x14\x66\x58\x38\xc8\xc0\xbe\xe1\x8c\x24\xe6\x58\x74\x53\x9c\x93\xc2\x99\x5d\xfd\xca\xf2\xf5\x36\xce\xb1\xa0\x67\x2c\xd7\xb2\x05\x4d\x71\xe9\x3b\xcb\x9a\x05\xec\xd8\xd9\xf4\x87\xa3\xdb\xf4\x4f\x4a\xfa\xbf\x94\x92\xd1\x75\x3a\x6c\x7d\x16\x24\x49\x61\xf1\xf5\xf2\xb3\x4f\x8f\x95\x47\x3b\x4f\x5d\xd7\xca\xfa\x6e\x19\x19\xc7\x68\xe2\xe9\x87\x7f\xa6\x22\xe9\xaf\x5f\x69\xe2\x49\x66\x73\x2c\x51\xd7\x53\x89\x9a\xa4\xb3\xf6\xf4\x13\x35\xa9\x36\x71\x3e\x76\xd5\xd9\xc8\xb1\xac\x1b\xdd\x8f\xba\xfd\xaf\xd1\x4b\xf6\x12\xef\x9f\x93\x22\x63\x0d\x16\xd5\x0f\x55\x24\xad\x69\x7a\x0d\x21\x83\xda\x9a\xa6\x1d\x0d\xd2\x73\x77\xf2\x45\x8f\x8b\x33\x2e\x4e\xb9\x38\x80\x26\xdf\xbf\xb8\xda\x64\x5c\xc4\xa1\x48\x42\xd1\xe7\xe2\x86\x8b\xc3\x6a\xd5\xaf\x79\xd4\xe6\xb5\x1c\x6c\xd4\x82\x87\xef\x00\x00\x00\xff\xff\xfb\x7a\x5c\xae\x74\x03\x00\x00")
-
-func ks_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ks_inJson,
-		"ks_IN.json",
-	)
-}
-
-func ks_inJson() (*asset, error) {
-	bytes, err := ks_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ks_IN.json", size: 884, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ks_inDevanagariJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\xc1\x4a\xc3\x40\x14\x3c\x27\x5f\xb1\x2c\xec\xcd\x2f\xc8\xc9\x42\x2f\x3d\x44\x84\x7a\x51\x11\x89\xb4\xd8\x22\xb6\xd2\x06\x41\x44\x50\x41\x2d\x48\x8f\xa2\x94\x42\x2f\x6f\x4b\xa8\xa5\x84\x82\x90\x82\xe8\xaf\xbc\x4f\x91\xdd\x66\xd3\xb7\x69\xf2\x07\x42\x29\x61\x66\xde\xec\xcc\x5b\x92\x5b\xd7\xe1\xb5\x2a\xf7\x18\xbf\xe8\x9f\xd6\xf6\x76\x1b\xcd\xeb\xa0\x13\x9c\x07\xbd\x36\xdf\x71\x1d\x5e\x0d\x6e\xfa\xdc\x63\xc7\xae\xe3\x70\x84\x67\x04\x89\x72\xa8\x7e\xf0\x85\xf0\x83\x10\xa3\x1c\x2a\xa1\x62\x47\x08\x91\xa6\xa6\xfa\x3f\x2e\xd2\xcc\x51\xbe\x22\x2c\x6c\x87\xfb\x2d\xc1\x47\x39\xbb\x76\x4e\xca\x63\x8c\x51\x3e\xa8\x23\x14\xbe\xb2\x66\x61\x62\xe9\x5d\xe7\x44\x55\xac\xb7\xba\xbd\xb0\xb0\x67\x41\xb1\x92\x32\xb9\xf4\x65\x71\x73\x11\x69\x32\x93\xc6\xef\x76\xc2\x16\x89\x32\x56\x67\xab\xd4\x6a\x13\x66\xe0\x13\xe1\xdb\x2c\x98\xe2\x8b\xac\x1c\xc2\xc8\x80\x4f\x08\xb3\x34\x87\x7c\x41\x58\x12\xf1\x80\x04\x7a\x44\x88\xec\x7c\x4b\xed\x36\x20\x3e\xef\x69\x13\x00\x03\x26\x08\xbf\x08\x60\xae\x74\x8e\x10\x13\xfd\x9b\x06\x27\xda\x9c\x52\xeb\x46\xdb\x23\x53\xed\x96\x58\x14\xbd\xa5\xff\xe5\x14\x2f\xa7\xe2\xef\xfb\x9b\xad\xcc\xb4\x65\x4c\x5e\x8f\x95\x7e\x8e\xac\xd2\x6a\x43\x84\x4a\x9d\xaa\x41\xd8\x54\x1f\x03\x51\x61\xa2\xc1\xc4\x19\x13\x87\xdc\xe0\x07\xed\xcb\x3c\xc7\x44\xcd\x13\xbe\x27\xea\x4c\x5c\x31\x71\xa4\xa5\x99\x2c\xa3\x36\x78\x1a\x94\xe7\xc6\xdc\xbb\xbf\x00\x00\x00\xff\xff\x8e\x5f\x02\x09\x87\x04\x00\x00")
-
-func ks_inDevanagariJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ks_inDevanagariJson,
-		"ks_IN@devanagari.json",
-	)
-}
-
-func ks_inDevanagariJson() (*asset, error) {
-	bytes, err := ks_inDevanagariJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ks_IN@devanagari.json", size: 1159, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ku_trJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\xc1\x4a\xc3\x40\x10\x86\xcf\xc9\x53\x2c\x03\x7b\x13\x7a\xef\xad\x1a\x50\x91\x48\xb1\xb9\x54\x11\xd9\x34\x8b\xd9\x36\xc9\x42\xb2\x01\xad\x78\xee\xcd\x47\xb0\x47\xa1\x87\x7d\x01\x8f\x43\xdf\x4b\xe2\xec\x6e\xac\xb7\x6f\xe6\xff\xf9\xff\x9d\x90\xb7\x38\x82\xeb\x04\xa6\x0c\x36\xfd\x53\x76\x07\x67\x71\x04\x89\x78\xed\x60\xca\x1e\xe2\x28\x82\xa5\xdc\x1c\xf7\xb2\x1e\xf6\x11\x24\xfd\xc8\x0b\x3c\x8c\x03\xee\x44\x3b\x4e\x73\x3c\x34\xab\x3f\xe2\x47\x43\x70\xfc\x94\x35\x5a\x88\xa3\xc7\xa1\x65\x51\xea\xd6\x9c\x54\x1d\xf7\xae\xc6\xc3\xc2\x03\xee\x3c\xcd\xc3\x6a\x4c\xf5\x89\xa9\x6e\x4c\x19\xe2\xf0\x4b\x55\x92\x2c\x9d\xca\x85\x21\x14\x85\x68\x89\x1a\xb4\x9d\x70\x19\xcf\x7d\xe5\xb1\x94\x5b\xb4\xad\x9f\x0c\xda\xb6\x96\xa5\x1b\x64\x2e\x5e\x5c\xbb\xad\xb4\xb3\xac\xb4\x09\x8e\x5a\xad\x43\xfe\x46\x34\xf8\xdd\x9c\x5c\xfb\xef\x81\x3b\x55\xb9\x3b\x55\x4e\x30\x2b\x04\xc1\x2d\xda\x8e\xe8\xb2\x77\xa6\x2b\xb9\x25\xc8\xd0\xba\x8a\x4c\xe6\xfe\x63\x54\x9a\xe8\x42\xbb\x43\x53\xb5\x26\xb8\x11\xe1\x11\xb3\x74\x9e\xfa\x76\x12\xbd\x92\x08\x23\x87\x9f\x80\x17\x13\x5e\x4f\xf8\x12\xfc\x32\x53\x35\x09\x33\xc6\x0b\xc6\xcf\x19\x5f\x32\x9e\x31\x7e\xff\xeb\x08\x6a\x16\x46\xd7\x01\x10\xbf\xff\x04\x00\x00\xff\xff\x68\x70\xd9\x31\x5c\x02\x00\x00")
-
-func ku_trJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ku_trJson,
-		"ku_TR.json",
-	)
-}
-
-func ku_trJson() (*asset, error) {
-	bytes, err := ku_trJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ku_TR.json", size: 604, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _kw_gbJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\x41\x6b\xfc\x20\x10\xc5\xcf\xc9\xa7\x10\xc1\xdb\x1f\xf6\xbe\xb7\x7f\x49\x70\xcb\x56\x28\x64\x61\xd9\x96\x52\x0c\x0e\xb5\x34\xab\x60\x8c\x12\x4a\xbf\x7b\xc9\xc6\x31\x6e\x6f\xf3\xde\x6f\x98\x37\x93\xf8\x5d\x57\xf4\xb1\xa1\x7b\x42\xbf\xe2\x3b\x7f\xa0\xff\xea\x8a\x36\x72\x1e\xe9\x9e\xbc\xd6\x55\x45\x1b\x20\xdd\x34\x2c\xf6\xad\x7e\x9a\x4c\xae\x05\x38\xaf\x4b\xa5\xc1\x65\x79\xb1\x31\xd7\x3c\x82\x29\x50\x27\x95\x75\x86\xd6\xd5\xdb\x12\xd6\x69\xeb\x7c\x99\x98\xe3\x72\x96\xc0\x18\xa1\xd3\x94\x3c\x9d\x47\x58\x8b\x4e\x2a\x9c\x28\xac\xf1\x3a\x8f\x13\xf3\x48\x38\x98\x80\x0b\x2c\xfa\xac\x21\x38\x18\x36\xa3\x38\x65\x91\x6d\xff\x87\x16\x28\x48\xb3\x29\x6e\x9d\xd7\xe0\x20\x5b\x40\xda\xd1\x17\x3c\xce\xe6\x43\x0e\x72\x73\x0e\xa0\x5c\x21\x9b\x69\xab\x8f\x10\xa4\x53\x7a\xba\xfb\x32\xf7\xc7\x70\x4c\x3a\x6b\xdc\x09\xef\x6a\x7b\x3c\x30\x91\x36\xa4\x5e\x6e\xb1\x05\x57\xe3\x31\xa1\x03\xa8\xf4\x5b\xd2\x1e\x47\x08\x18\xff\x5f\x3c\x0b\xcc\x5d\x21\x92\x46\x7a\x58\x5e\x0c\x53\x3b\x76\xdd\xb1\x99\xa2\x79\xfa\xbc\xae\x40\x12\xa6\x08\xeb\x09\xbb\x10\x76\x22\xec\xe5\xd6\x91\xe9\x29\xcb\x94\x41\x69\xfd\xf3\x1b\x00\x00\xff\xff\xf8\xdd\x13\xed\x89\x02\x00\x00")
-
-func kw_gbJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_kw_gbJson,
-		"kw_GB.json",
-	)
-}
-
-func kw_gbJson() (*asset, error) {
-	bytes, err := kw_gbJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "kw_GB.json", size: 649, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ky_kgJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x41\x4a\xc3\x40\x18\x85\xd7\x93\x53\x0c\x03\xb3\x93\x1e\xa0\x3b\xa1\x20\x22\x05\xc1\x6e\x8a\x88\x54\x2a\x54\xa4\x16\xb4\x9b\x22\x42\xda\xba\xc8\x42\xec\x39\x5c\x75\x5a\x9b\xd2\xc4\xa4\x5e\xe1\xfd\xf5\x42\x32\x49\x26\x33\xa6\x75\xf9\xbf\xf7\xf3\xbf\xef\x25\xf3\xec\x31\x71\xda\x10\x75\x2e\xee\x47\xd7\x67\x27\xe2\xc8\x63\xa2\xd1\x19\x3d\x89\x3a\xbf\xf4\x18\x13\x58\x23\x44\x4c\x01\x42\x24\x58\x60\xa3\x17\x98\xc0\x6a\x37\x47\x44\xc1\xcf\x87\x56\x77\xf3\x5c\xcd\xb6\xa2\xea\x2e\x05\x50\xe4\xef\x5d\x58\x1c\xda\xc5\x9a\xa6\x48\xa0\x8a\x69\xe3\xf8\x1e\xbb\xd2\x6c\x17\xbd\xc1\xe3\xb0\x02\x18\x1b\x28\x0a\x2c\x88\x0d\x37\x81\xc6\xc5\x1a\x89\x0d\x30\x87\x9b\x83\x87\x61\xaf\xbc\x4a\x33\xa4\x58\x66\xe0\x6f\xc5\xa5\x57\x84\x58\x92\x0f\x85\x2f\xa3\x69\x54\xf2\x69\x52\x4c\x0a\xdf\xe4\x23\xfc\xeb\x23\x2a\xb3\xde\x91\x96\x8e\x9e\xec\x9e\xc2\x12\x9f\x34\xa5\xb1\xb9\x45\x63\x84\x48\x69\x42\x33\x2c\x2c\x03\xb6\x88\xf7\xb4\x14\xdb\x8a\xb2\xd2\xbf\x0c\xaa\xd0\xdc\x0f\x77\xa8\xa4\x5b\xcf\xad\xe5\x96\xfa\xaf\x8e\x5b\xc6\xad\xe2\x96\x70\xd1\x5d\x64\x17\xd6\x40\x1e\x37\xcf\x9b\x86\x2e\xf7\x8d\xd3\xe8\x0c\x6f\xf5\x2b\x95\xdd\x9a\xec\xd7\x64\x5b\x18\xb1\x75\xd7\xcf\x8d\x0e\x97\x5d\x2e\x6f\xb8\x6c\x73\xd9\xca\xec\xd2\xb2\x63\x11\x20\x84\xf7\xf2\x1b\x00\x00\xff\xff\x5f\x06\x62\xae\xfa\x02\x00\x00")
-
-func ky_kgJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ky_kgJson,
-		"ky_KG.json",
-	)
-}
-
-func ky_kgJson() (*asset, error) {
-	bytes, err := ky_kgJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ky_KG.json", size: 762, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _lb_luJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x4a\xc3\x30\x14\x86\xaf\xd3\xa7\x08\x07\x72\x27\x7d\x80\xdd\x15\xca\xc0\x61\x55\xe8\xbc\x18\x22\x92\xac\x87\xb5\xd8\x26\x92\xa6\x8a\x13\xdf\xc6\x1b\xe9\x63\xf4\xc5\xa4\x26\x39\xad\xec\xee\xfc\xf9\xc6\xff\x7f\x83\x7e\x26\x0c\xae\x73\xd8\x70\x68\xd5\xf3\xcd\x03\x5c\x25\x0c\x72\xf9\xd1\xc3\x86\x3f\x26\x8c\x41\x69\xb4\xae\xf0\x34\xbf\x33\x28\xa6\x9f\x66\x49\xf9\x34\xea\xfe\x58\xaf\xe8\xe8\xdc\xbb\x39\xd6\x01\x1b\xad\x71\xcd\xb7\x16\x1b\x0a\xa5\xec\x02\x4b\xd8\xd3\x3c\x5a\xd6\xc6\xba\xff\xcb\x34\x4a\x7b\x34\x14\x27\x62\x73\x2c\x8d\x6d\x85\xd1\xae\xa6\xaa\x9d\xd4\x83\x0c\xbf\xd9\xa2\xb2\x14\x8a\xe9\x1b\xed\xd9\xdf\x99\xb2\xd3\xd8\xb6\x01\x20\xfa\x63\x37\xe8\x26\x5e\x6d\xb8\xb2\xe1\x34\xf4\x2e\x4c\xe2\xab\xc3\x4e\x61\x28\xbc\x7b\x71\x86\xc2\xad\x79\x5b\xa1\x1c\xcf\x3e\xad\xff\xf0\x85\x27\x49\x2e\x82\xa4\x77\xa9\x46\x66\x24\x46\x56\xe4\x43\x2e\xa4\x11\x0d\xb2\xe2\xbe\x88\xd3\x1e\x46\x92\x4b\x87\xf3\x37\x21\xaa\x54\x74\xa9\x38\x40\x7c\xdc\x37\x9d\x07\x92\x8b\x2a\xe5\x42\x71\x71\xe0\x62\xff\xc7\x89\x2d\x31\x2c\x00\x24\x5f\xbf\x01\x00\x00\xff\xff\x7b\x7e\x93\xce\x69\x02\x00\x00")
-
-func lb_luJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_lb_luJson,
-		"lb_LU.json",
-	)
-}
-
-func lb_luJson() (*asset, error) {
-	bytes, err := lb_luJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "lb_LU.json", size: 617, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _lg_ugJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcf\x4a\xf4\x40\x10\xc4\xcf\x93\xa7\x18\x1a\xe6\xf6\xc1\xc2\xe7\x9f\xc3\xde\x56\x82\xe2\x62\x54\x48\x3c\xa8\x88\xf4\xb0\x21\xdb\x24\x99\x48\x32\xe3\x12\xc5\x77\x97\x6c\xa6\xdb\xa0\xb7\xaa\xa9\x4e\xd7\xaf\x21\x9f\x89\x82\xeb\x14\xd6\x1a\x9a\xea\xf5\xe1\x0a\xfe\x25\x0a\x52\x1c\x07\x58\xeb\xe7\x44\x29\xc8\xd1\x12\x79\x9a\xde\x15\x5c\x60\x83\x1f\x38\xeb\x9b\x03\xd6\xc1\x52\x4f\x0b\x3b\xa0\x0f\x0b\xeb\x96\xa3\x1e\xd1\x75\xe2\xdb\x50\x23\x56\x08\x89\x7a\x99\x1a\xf3\x7d\xd7\xfb\x5f\xb5\x52\xc9\x1f\xfd\x67\x71\xc2\xe2\x94\xc5\x19\x8b\x73\xde\x98\x75\xce\xef\x65\xdd\x16\xdd\x01\x1b\x1a\x23\xc0\x65\x69\x97\x36\xc3\x9e\x86\x78\xc7\xe6\x2d\x34\xc4\xcf\x38\x46\xb9\x0d\xc1\x89\x6c\x10\x79\xb8\x0a\x03\xf9\xb8\x25\x2f\x6d\xf0\xbe\x6c\x6d\xbc\xfa\xae\x26\xdf\x59\x76\xb7\xdd\xfb\x4f\x94\x96\xc3\xd1\x2c\xaf\xff\x03\x2c\xa8\x02\x29\x84\xc2\xc7\x48\x4e\xd8\x04\x4c\xa0\x84\x46\x40\x04\x82\x01\x36\xd9\x7d\xc6\xcd\x73\xc8\x49\x8a\xbe\x9c\x7e\x0e\xb3\x5b\x99\x76\x65\x46\xe0\xc7\x82\xda\x39\x40\x6d\x76\xda\x58\x6d\x1e\xb5\x29\xb4\x79\x3a\x4e\x48\x5a\x88\x8d\x1d\x00\xc9\xd7\x77\x00\x00\x00\xff\xff\x25\x81\x49\x74\x74\x02\x00\x00")
-
-func lg_ugJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_lg_ugJson,
-		"lg_UG.json",
-	)
-}
-
-func lg_ugJson() (*asset, error) {
-	bytes, err := lg_ugJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "lg_UG.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _lij_itJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x4b\xfc\x30\x10\xc5\xcf\xe9\xa7\x08\x03\xb9\xfd\x61\xef\x7b\xfb\xc3\x5e\xf6\xb0\x20\x58\x0f\xab\x88\xa4\x76\xcc\x56\x36\x09\xa4\x63\xd1\x88\x5f\xc6\xa3\x88\x47\x2f\x7b\xcc\x17\x93\x9a\x66\x5a\xd6\xdb\xbc\x79\xd3\xf7\x7e\x81\xbe\x56\x02\xb6\x1b\x58\x4b\x38\x76\x8f\x77\xdb\x1a\xfe\x55\x02\x36\xfa\xa5\x87\xb5\xbc\xa9\x84\x80\xd6\x5b\x74\x68\xf4\x68\x08\x38\xa6\x93\xc3\x36\x7d\x66\x65\x75\xa0\x85\xc2\x70\x7f\x15\x58\xc6\xf4\x61\x4c\x37\x7d\x37\xa0\xd3\xb3\xd5\xeb\xa6\xf1\x50\x89\xdb\xb1\xec\xf2\xe0\x03\x9d\x35\xce\x65\x5c\xc4\x1d\x9c\xfe\xc4\xd1\x9c\x5a\x32\x77\xde\xd1\x81\x03\x23\xba\xf4\x9e\x6f\x1e\x70\x08\x65\xb6\x3a\x44\x9f\x47\x3d\x84\xf4\x55\xb6\xb1\x6c\x63\x3a\x19\xe7\x99\xc5\x98\xae\x9c\x1b\xdf\xd3\x34\xf7\x48\x84\xb6\x09\x98\xa5\x27\xf2\x2c\x9c\x1f\x16\x56\xdb\x3d\x67\xb5\x7c\xf8\x1f\x52\xe6\x3c\x7b\xb9\x0e\xbc\x89\x33\xde\x0c\xc7\x64\x8c\x95\x87\xf4\x4d\xc4\x34\x0c\x52\x18\xfe\xef\x2e\x76\xa5\x3c\x9b\xc5\xd9\x68\xc2\xf1\xb7\x50\xed\x4a\xd9\x95\xda\x43\x59\xd6\x9d\xcd\x86\x96\xaa\x95\xaa\x91\x6a\x2f\x55\x2d\xd5\xf5\xef\x05\xbb\x35\xcb\xa9\x03\xa0\x7a\xfb\x09\x00\x00\xff\xff\x03\xc1\x69\x18\x6e\x02\x00\x00")
-
-func lij_itJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_lij_itJson,
-		"lij_IT.json",
-	)
-}
-
-func lij_itJson() (*asset, error) {
-	bytes, err := lij_itJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "lij_IT.json", size: 622, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _lo_laJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\xcd\x6a\x32\x41\x10\x3c\xef\x3e\xc5\x30\x30\x37\x59\xef\x7b\x13\x14\x3e\xe1\x5b\x10\xcc\x2d\x84\x60\x88\x60\x20\xc6\x90\x78\x91\x10\xc8\x80\x90\x5c\xbc\xf9\x83\x17\x83\x07\x11\x14\xc1\xc8\x26\x84\xf6\x65\xfa\x51\xc2\xf4\xba\x6d\x8f\xde\xb6\xab\x6b\x6a\x6a\xaa\xf6\x25\x0c\x74\xb5\xac\x63\xa5\xef\x3b\xd7\xff\x4b\xba\x10\x06\xba\xdc\xe8\x3d\xeb\x58\x5d\x86\x41\xa0\x11\x36\x08\x3b\x84\x09\x42\x8a\x30\x74\x7b\x07\x7e\x20\x7c\x21\x4c\xf3\x71\x43\xe3\x3b\x42\x9f\xc8\x8c\xcf\x10\x7e\xc5\xa9\x19\xc2\x16\x61\x4d\x64\x06\x57\xc4\xb1\x87\x71\xff\x46\xc8\x1e\x61\xa7\xc3\xe0\xca\xd9\xa9\xb7\x3a\x4f\xdd\x73\x4f\x11\x7b\xe1\xaf\x3e\x7f\xcd\x22\x71\xe7\x9a\x87\x95\xfc\x3a\xc8\x27\x9d\x87\x6e\x4b\x68\xcf\xf3\xb7\x58\xba\x87\xdf\x62\xc9\xe7\x1c\xe1\xd3\x79\x2b\x30\xf9\x1b\x61\x7a\x44\x9c\xff\x39\x3d\x61\x27\x1c\xfc\x20\x0c\x09\xdc\xd2\x28\x8f\xa7\x08\x63\x52\x16\x22\x60\x71\x3f\x40\x58\x10\xdf\x52\x1a\x32\xae\x94\xec\xad\x3d\x3e\xd5\x81\x30\x10\xe0\x88\x64\x17\xbe\x93\x2d\x75\x97\x1e\x03\x77\xcd\x66\x67\x97\xa7\x99\x9f\x25\x13\x21\x58\x4e\xd0\x46\x32\x66\xb7\x9b\x7a\xd3\x4a\x94\xe1\x31\x11\xd2\x08\x61\xec\x09\x2d\x44\x31\xb2\x30\xb7\x1b\xf0\x34\xf2\x98\x4e\xf6\xd8\xfd\x24\x42\x58\x72\xa9\xa5\xa4\x96\xe4\xc6\x4b\x49\xc6\xa9\x25\xf9\xb6\xdc\xe8\x36\xdd\x2f\x6f\x6e\x8b\xa6\x5d\x34\x95\x9e\xce\xd1\x8b\xbb\x76\xb6\x69\x28\xd3\x54\xe6\x46\x99\x4a\xaf\xa0\xcc\xbf\xd8\x24\xb1\xa9\x13\x8d\x29\xa7\xe0\xe1\x4e\x6d\xaa\xd9\x42\x99\x47\x1d\xbe\xfe\x05\x00\x00\xff\xff\x0a\xdd\xf0\x34\x61\x03\x00\x00")
-
-func lo_laJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_lo_laJson,
-		"lo_LA.json",
-	)
-}
-
-func lo_laJson() (*asset, error) {
-	bytes, err := lo_laJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "lo_LA.json", size: 865, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _lt_ltJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x92\x4f\x6a\xf3\x30\x10\xc5\xd7\xf6\x29\x84\xc0\xbb\x0f\x1f\x20\xbb\x84\x40\xf8\x68\x02\xa1\x0e\x85\x52\x4a\x99\x60\xa1\x28\xfe\x23\x33\x92\x0d\xa5\xf4\x08\x59\x67\xdb\xae\x7b\x87\xac\xec\x7b\x95\xa0\x99\xa4\xda\x8d\xde\x6f\x66\xde\x63\xd0\x47\x9a\xc8\xff\x4b\x39\x13\xb2\xf6\x6f\xeb\x9d\xfc\x97\x26\x72\x09\xef\x4e\xce\xc4\x4b\x9a\x24\xb2\x50\x55\x03\xa5\x51\xad\x71\x57\x96\xc8\xad\xc1\x58\x98\xb7\x1e\x23\x61\x87\x6a\x3c\x99\x48\x7a\x50\x7e\x30\xe8\xe3\x45\xaa\xad\x62\x65\xfa\x52\xd3\xf7\x4d\x4a\x93\xd7\x6b\x98\xe2\x60\xd1\x47\x89\x2a\x9a\x47\xf6\x67\x5b\xf2\xf2\xc4\x5b\xde\xea\x79\xd7\xc6\xb6\xfe\x70\x5b\xe4\xa0\x77\xc6\x86\xa6\x01\x1c\x20\x3f\x2a\x3b\x50\xb5\x87\x1a\xda\x72\xba\x30\xd1\x4a\xf7\xd3\x65\x3c\x53\xe0\xbd\xc1\xe9\xa2\x6a\xa6\xb5\x51\x9d\x25\x84\xbd\xee\x8e\xd3\xcf\x78\x62\x88\xbd\x76\xe3\xf9\x48\x2f\xd7\xc1\x7d\x0c\xba\x0a\xcd\xbd\x53\x63\x6f\x83\xe7\xdf\x13\xc4\xd9\x0b\xe8\x43\xf3\x13\xf0\x89\xed\x10\x8a\x05\xd4\xa1\x58\x29\x4d\x8a\xa1\xd3\xac\x8d\x0a\xc5\xa3\xee\xb8\xa0\xf1\xa2\x03\xea\x01\x42\x2b\x2c\x39\xc0\x7c\xb3\xdd\xb0\x73\x80\x4c\x96\xe0\xd5\xf5\xf3\x64\xcf\x79\xd6\xe4\x59\x29\x59\xdc\x99\x86\x80\x68\x72\x91\x2d\x44\x56\x8a\x32\x17\x59\xf8\x61\x37\x7a\x7f\x92\x87\x94\xe9\xe7\x6f\x00\x00\x00\xff\xff\x41\xdd\x38\xa6\x94\x02\x00\x00")
-
-func lt_ltJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_lt_ltJson,
-		"lt_LT.json",
-	)
-}
-
-func lt_ltJson() (*asset, error) {
-	bytes, err := lt_ltJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "lt_LT.json", size: 660, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _lv_lvJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x6a\xeb\x30\x10\x87\xd7\xf2\x29\x84\x40\x3b\xa3\x03\x64\x97\xf7\xbc\x68\xa1\xa6\x01\x9b\x42\x28\xa5\x28\xb1\x9a\xf8\x9f\x64\x24\xd9\x50\x4a\x17\x3d\x43\x4f\xd0\x75\xae\xe1\xdc\xab\xb8\x92\xc6\x2a\x5d\x8d\x3e\xff\x34\x33\x9f\x41\x6f\x09\x22\xb7\x19\xd9\x60\xd2\x4d\xcf\x77\x0f\x24\x4d\x10\xc9\xf8\xab\x21\x1b\xfc\x98\x20\x44\xcc\x34\x7f\xda\xaa\x16\x92\x2f\x11\x22\x43\xad\xfb\x08\x95\xd5\x11\x59\x2d\xae\x5f\x11\x1f\x85\x1d\xf5\xef\x6e\xd1\xc6\x6c\x84\xf1\x98\xa0\xa7\x65\x75\x71\x56\xda\xc6\xfb\x8b\xc9\xdd\xdc\xb9\x72\xef\x4a\xe9\xca\x7f\x9f\xb5\xae\x16\x61\x4a\xae\xa4\x3d\xc3\x88\x86\xcb\x69\xfe\xd0\xb5\x71\xb7\x5e\xc4\x41\x8f\x11\xf7\x5c\x5b\x7f\xe4\x83\x9e\x2f\xdd\x1a\xd4\x8d\x3f\x36\xd7\x8b\x8c\xa1\x03\xe0\xe3\x69\x34\xa1\xdf\x88\xc1\x8a\xfe\x00\xa3\x55\x6b\xd5\x4a\x52\x4d\x71\x58\x89\xa3\xc7\xf8\xdf\xff\xa8\x83\x34\xd8\x82\x2b\x68\xae\x92\xab\x21\xe8\x81\x1a\x38\x81\x0e\x88\x04\x85\x6d\xbe\xcb\xc3\x6e\x17\x86\x24\xe3\x56\x2c\xaf\x84\xee\x19\xed\x19\xad\x18\x09\x5f\xcb\xba\x77\xc9\x36\xc5\x74\xcf\xf0\x89\x57\x1c\x53\xc1\x30\xfd\x97\xe2\xa1\x6b\x8d\x65\x98\xde\xe0\x51\x62\x9a\xff\x34\x41\x43\x09\xe8\xf7\x12\x92\xbc\x7f\x07\x00\x00\xff\xff\x15\x93\x51\x7f\x91\x02\x00\x00")
-
-func lv_lvJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_lv_lvJson,
-		"lv_LV.json",
-	)
-}
-
-func lv_lvJson() (*asset, error) {
-	bytes, err := lv_lvJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "lv_LV.json", size: 657, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mai_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x53\xc1\x4a\xc3\x40\x14\x3c\x27\x5f\xb1\x2c\xec\xcd\x2f\xc8\xad\x90\x4b\x0e\x11\xa1\x5e\x54\x44\x22\x0a\xed\xa1\x56\x34\x17\x11\xc1\x16\x82\x82\x78\x94\x82\x14\xbc\xbc\x68\xa8\x25\x04\xa1\x12\x41\xea\xaf\xbc\x4f\x91\x7d\xc9\x36\x9b\xdd\x7c\x42\x2f\xcb\x66\xe6\xed\x64\x66\x36\xb9\x75\x1d\x1e\xf8\xdc\x63\x7c\x14\x0d\x4f\x82\x5d\xbe\xe3\x3a\xdc\x8f\x6e\xae\xb9\xc7\x8e\x5c\xc7\xe1\x08\x05\xc2\x0a\xe1\x8f\xd6\x35\x42\x21\x47\x24\x5e\x62\xfa\x84\x90\x5b\x78\x8e\x30\x45\x98\x21\x7c\x59\xd4\x12\xd3\x09\xc2\x87\x85\xcf\x08\x2f\x68\x35\xa8\x6f\x02\x5f\x30\x7d\x56\x4e\xda\x2c\x64\x2d\x6f\xae\x73\x2c\x13\xf4\x07\xe3\xab\xb8\x33\x86\xe1\xde\x36\x6d\x78\xb5\x2d\x76\x3a\x33\x0c\x29\x1f\xe1\xf8\x22\x1e\x68\x26\xe6\x44\xaf\x48\xe8\x5e\x9d\xf9\x44\xf8\x55\xfe\x74\x3c\xaf\x32\xd1\x0b\x5e\x15\x98\x20\x2c\xea\x32\xd2\x07\xcd\x6e\x8e\xf0\xa8\xf6\x73\x4c\xa7\x08\x99\xf6\x38\xa1\xdb\x58\x6b\x33\x09\xa5\x2d\x49\x0a\x9a\x52\x64\x97\x20\xd5\x24\xbe\xd4\x82\x25\x2a\xea\x1b\x89\xeb\x54\x95\xc8\x3e\xf2\x4e\x6a\x65\x8b\xd2\xef\x67\x5b\x4e\x77\x39\xbd\x70\x2f\x6c\x5a\x59\x90\x64\x15\xb4\xfa\xca\x7f\x68\x9f\xb5\x42\xcb\x86\x34\xaa\x56\xf2\xa3\xf8\x5c\xfe\xdb\xa2\xc7\xc4\x19\x13\xa7\x4c\x1c\x70\x85\xef\x0f\x47\x26\xc7\x44\xe0\x89\xd0\x13\x7d\x26\x2e\x99\x38\xa4\xd1\xcd\xd8\x86\x6a\xf0\xda\x28\x37\x8e\xb9\x77\xff\x01\x00\x00\xff\xff\x70\x8c\x8c\xd1\x56\x04\x00\x00")
-
-func mai_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_mai_inJson,
-		"mai_IN.json",
-	)
-}
-
-func mai_inJson() (*asset, error) {
-	bytes, err := mai_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mai_IN.json", size: 1110, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mg_mgJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xc3\x30\x0c\x85\xcf\xc9\xaf\x30\x02\xdf\x46\x7f\x40\x6f\x83\xc2\xd8\x21\x30\x58\x2f\xdd\x18\x43\x26\x59\xe3\xd6\xb6\x8a\x2d\x06\xd9\xd8\x7f\x1f\x69\x2c\x35\xd0\x9b\xde\x7b\x42\xef\x73\xc8\x6f\xdb\xc0\xf3\x0e\xb6\x06\xe2\xf1\xb3\x7b\x82\x87\xb6\x81\x1d\x4e\x05\xb6\xe6\xbd\x6d\x1a\xc0\x80\x23\xf6\xd3\xec\x5f\x05\x17\x9f\xd0\xa7\x6a\xf0\xec\xa0\x86\x99\x9c\xbf\xa9\x33\x46\x5f\xea\xe2\x0f\xc5\x1a\x14\x74\xc4\x65\x82\xb6\xf9\x98\xbb\x5e\x47\xca\xbc\x2e\x0c\x63\xbf\x2c\x06\x2e\xb5\x24\x70\x75\xb2\xab\xc3\x39\xea\x59\xbd\x2a\x17\x3b\x4a\x3c\xea\xb9\x13\x26\xc2\x5c\x29\xbe\x06\x97\x6f\x2a\x62\xe6\x22\xb8\x97\xec\x83\xf8\x43\x1d\x4e\x94\x50\xa6\x80\xf2\x0d\xe8\x48\xc5\x73\x96\xe7\x0c\x17\xc6\xe8\x44\xd2\x99\x49\x45\xa2\xef\x55\xd4\x0f\x65\x51\xeb\x97\xdf\xc1\x2a\xa8\x32\x2a\xe0\x3d\x9d\xc2\x29\x9a\x42\x29\x8e\xa2\x28\x85\x00\x3c\x76\x2f\x9d\x34\x2f\xa1\x24\x3b\xe4\x61\xfe\x25\x6c\xbf\xb1\x71\x63\x0f\x20\xe6\xde\xc7\x25\x40\x63\x7b\x63\x9d\xb1\x07\x63\xf7\xc6\xbe\x5d\x37\x34\xdd\xab\xac\x1d\x00\xed\xdf\x7f\x00\x00\x00\xff\xff\x57\x8b\x30\xdf\x6a\x02\x00\x00")
-
-func mg_mgJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_mg_mgJson,
-		"mg_MG.json",
-	)
-}
-
-func mg_mgJson() (*asset, error) {
-	bytes, err := mg_mgJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mg_MG.json", size: 618, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mhr_ruJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\xcd\x6a\xea\x40\x1c\xc5\xd7\xc9\x53\x84\x81\xd9\x5d\x7c\x00\x77\x5e\xdc\xdc\x85\x70\xb9\xd7\x2e\xa4\x94\x22\x58\xb0\x0b\x2b\x58\x37\xa5\x14\x06\xb3\x48\x20\x0b\x8b\x2b\x71\xd3\xda\x95\xcb\x48\x4c\x4b\x93\xe8\x33\x9c\x3f\xbe\x50\x99\x64\x3e\x4c\x96\x39\x67\xce\xcc\xef\x1c\xf2\xec\x3a\xec\x4f\x97\xb5\x3d\x36\x19\xcf\x6e\xff\x5d\xb1\x5f\xae\xc3\xba\xc3\xa7\x47\xd6\xf6\xae\x5d\xc7\x61\x78\x27\x9f\x42\xc4\x24\x70\xa4\xa5\xb4\x1d\x86\x1d\x4e\x14\xa0\xc0\x49\x7d\x6f\xca\x33\x19\x45\xf8\xbc\x50\x57\xe7\x3d\x09\x24\x48\x29\x40\xaa\xb4\x35\xbe\x1a\x77\x6d\xc8\x47\xd2\xbc\x9f\x7c\x14\x88\x69\x81\x4c\xa5\x5d\xe7\x46\x92\xfd\x1f\x4f\x67\xf3\x06\x5e\x48\xc2\xc4\x02\x14\xfa\x5a\x89\xa2\x41\x90\x50\x60\x01\xcc\xf9\x0d\x12\x9b\x45\x41\x0b\xfd\x4c\x6f\xfa\x30\x1f\xdb\x37\x64\x5d\x41\x11\x32\x7c\xe3\x84\x5c\x25\xde\xc8\x97\xf5\x64\xe9\x4a\x39\xc7\xb4\xac\xd5\x58\x9d\xf7\x38\x50\x28\xd3\x4a\x79\x95\x55\x75\x1e\x29\x72\xa4\x38\x50\x44\xa1\xd2\xb6\xe5\x64\xa9\x21\xdf\xca\x2c\x72\x93\x59\xcb\xd3\xc6\xdd\x95\x83\xc7\x66\xc8\x08\x39\x0a\x33\xf5\x47\x75\x7f\x6d\xba\x66\x31\x12\xc8\x0c\x4e\x72\x51\x44\xe0\xa8\x4b\x5c\x0c\x5c\xc7\x3f\x58\x6c\x12\x16\x59\x02\x5b\xdc\x3a\xac\x19\x3e\x37\x7a\x89\xa9\x21\x3b\xbd\xbf\x3d\x4d\x57\xf9\xda\xe9\x0e\xe7\x77\xf2\x37\xe5\x83\x16\x9f\xb4\xf8\x88\x69\xb1\x7f\x3f\xa9\x8c\x8e\xc7\x07\x1e\xff\xed\xf1\x91\xc7\xfb\xa5\x6d\x2c\xfb\xa9\x1e\x60\xcc\x7d\xf9\x09\x00\x00\xff\xff\xa2\x6b\x18\x66\xfb\x02\x00\x00")
-
-func mhr_ruJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_mhr_ruJson,
-		"mhr_RU.json",
-	)
-}
-
-func mhr_ruJson() (*asset, error) {
-	bytes, err := mhr_ruJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mhr_RU.json", size: 763, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mi_nzJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x4f\x6a\xeb\x30\x10\xc6\xd7\xf6\x29\x84\x40\xbb\x88\xec\xb3\xcb\x23\x8b\x3c\x8a\x8b\x69\x05\xa1\x2d\xa5\x0c\x44\x58\x83\x89\x1c\xd4\x11\x25\x94\x2e\x7c\x80\x9c\x24\xd7\xb0\xef\x55\x54\x4b\x72\x42\xbb\x10\xcc\x3f\x7d\xdf\x6f\x84\x3e\xcb\x82\xff\xdf\xf0\x15\xe3\x07\x7c\xbb\x7f\xe6\x8b\xb2\xe0\x1b\x38\xbd\xf3\x15\x7b\x29\x8b\x82\x3f\x0c\x3d\xc1\xd1\x87\x7a\xc1\x2b\xb0\x7a\x8a\xd4\x78\x71\x1a\xa7\x78\xa7\xad\xce\x89\x1a\x7a\xa4\x38\x54\x83\x03\x74\x31\xd9\x06\xa1\x30\x56\x16\xaf\xc1\xe4\xd1\x74\x8e\xae\x9d\x14\x24\x93\x6c\x91\xf4\x67\xe9\x24\x9c\x35\x93\x5c\xd5\x59\x32\x59\xeb\xae\x33\x28\x69\xe8\x49\xa7\x49\x8f\x92\xc0\x36\xde\xc5\x55\xea\xce\xd3\x78\x91\xa4\xa5\x03\xdb\x64\x5d\x6d\x1b\x90\x1f\x66\xe8\xc3\x89\x57\xc1\x01\xf9\xa8\x53\xe3\x11\x5d\x9c\xde\x8e\x67\xdb\x74\x18\x4e\x2c\x68\xa7\x25\x79\x87\xb2\x1d\xcf\x6d\xba\x5f\x81\xc9\xae\x3b\x83\x0e\x83\xc5\xd0\x4b\xeb\xdb\x3f\xaa\x57\x34\x5b\x68\xd1\x68\xb8\x79\xb0\xdf\x6b\xe6\xfd\xf2\x5e\x79\x97\x99\x7f\x86\xbf\x41\x9f\xb1\x67\xd4\x8c\x24\xed\x55\x9c\x24\x02\x53\x02\x5a\x57\x75\x95\x48\xa6\x6e\xea\x6c\x80\x74\xf8\x52\x62\xbf\x14\x87\xa5\x38\xf1\x54\x54\x78\xf8\x69\x28\xcd\xc4\x7a\xc1\x48\x33\xb1\x67\x1d\x13\xff\x16\x4c\x3c\x31\xa1\x98\x98\x7e\x60\x9a\x13\x2a\xa7\xd1\x8d\xf3\xf2\xeb\x3b\x00\x00\xff\xff\xb1\xc2\xed\xe2\xb4\x02\x00\x00")
-
-func mi_nzJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_mi_nzJson,
-		"mi_NZ.json",
-	)
-}
-
-func mi_nzJson() (*asset, error) {
-	bytes, err := mi_nzJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mi_NZ.json", size: 692, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mk_mkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x52\xc1\x4a\xc3\x40\x14\x3c\x6f\xbe\x62\x79\xb0\xb7\xd2\x0f\xe8\x4d\xe8\x45\x24\x20\xd8\x4b\x15\x91\x4a\x85\x8a\xd4\x82\xf6\x22\x22\xa4\xa9\xe0\x2f\xf4\x33\xd6\xea\x42\x69\x9a\xcd\x2f\xcc\xfb\x23\xd9\x34\x59\x5f\xa9\x1e\xdf\xcc\xbc\x9d\x99\x97\xbc\x26\x8a\x4e\xfb\xd4\xd3\x34\x7d\xb8\x49\xcf\xa8\x93\x28\xea\x8f\x5e\x9e\xa9\xa7\xaf\x12\xa5\x08\x25\x1c\xbe\xe1\x50\xc0\x06\x52\x11\x2a\x78\x81\x96\xd8\x60\xdb\x30\x6b\xce\xe1\x39\x93\x18\x2f\x38\xab\xb5\xcd\x36\x7f\xc0\x71\x8e\x35\x67\x41\x1b\x37\xab\x1a\xf5\xbf\x5b\xb0\xf8\x84\xe7\x1c\x96\x12\x75\x1d\x52\x5d\x4c\x66\x4f\xf3\xe3\x68\x32\x94\x8c\x21\xed\xa5\xb5\x34\x94\x66\xad\x4d\x3a\x7b\x9c\x4f\xa2\x07\xaf\x60\x51\xf2\x12\x96\x33\x6c\x1a\xfd\x3b\x5c\x5d\xe0\x00\xc5\x2e\x4c\xf1\x7d\x8b\x2a\x70\x28\x04\xbb\x6a\xf6\x57\xbc\x0c\x27\x12\x53\x11\x5f\xb1\x58\xe3\x8b\x97\xbc\x10\xe9\x1c\x2a\xce\xe1\xb0\x0b\xae\x51\xe9\xb1\xad\x4f\x76\x88\x96\xf0\x47\xca\xf0\xa1\xb6\x02\x95\x07\xfd\xab\xae\xac\x29\xcb\xc9\x6a\xff\xd5\x92\xa5\x64\x25\x59\x46\x16\x90\xb1\x65\xdc\x36\xe4\x49\x7a\x9e\xb6\xe9\xf6\x7c\xcb\xf4\x47\xf3\xbb\xf0\xdf\x9a\x71\xd7\x4c\xbb\x66\x48\x2d\x38\xb8\x9f\xee\x89\x51\x47\x9b\xb1\x36\xb7\xda\x0c\xb5\x19\x68\x73\x59\x4b\x22\x3d\x88\x63\x63\x42\x94\xbc\xfd\x04\x00\x00\xff\xff\x27\xcc\x15\xc2\x10\x03\x00\x00")
-
-func mk_mkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_mk_mkJson,
-		"mk_MK.json",
-	)
-}
-
-func mk_mkJson() (*asset, error) {
-	bytes, err := mk_mkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mk_MK.json", size: 784, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ml_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\x41\x4b\xf3\x40\x10\x3d\x27\xbf\x62\x59\xd8\xdb\xf7\x0b\x72\xeb\x47\x2f\x3d\x44\x84\x7a\x51\x11\x29\x28\x54\xb0\x56\xb4\x17\x11\xc1\xf6\x50\x8a\x12\x04\x41\x44\x29\x8a\x28\x58\x6a\x69\x62\x9d\x95\x9c\xcc\x5f\x19\xfc\x25\xb2\x93\x6c\xba\x49\xd6\x8b\x97\x3d\xbc\x79\x33\xf3\xde\x9b\xe4\xd4\x75\x78\xa3\xce\x3d\xc6\x3b\xfb\xdb\x8d\x15\xfe\xcf\x75\x78\xbd\x75\x72\xcc\x3d\xb6\xe9\x3a\x0e\x47\x78\x40\xf8\x42\x08\x11\x22\x94\xc1\xf7\x79\xa0\x28\x0a\x7f\x41\x48\x10\xee\x50\x06\x08\x37\x08\x1f\xc5\xea\x3d\xca\x0b\x04\x49\x55\xa9\xc1\x19\xca\x3e\xc2\x2b\xc2\xa4\x48\x4e\x69\x21\x2d\x02\x84\x81\x81\x0f\xd3\xc9\xea\x85\x44\xe3\x9f\x6a\x02\x24\xdc\x75\xb6\x94\xde\x66\xbb\x7b\xd4\xab\x8a\x36\x85\x9a\xb2\x4c\x35\x55\x05\xe6\xee\x7c\x9f\xde\xe4\x77\x0f\x7a\x6d\x63\xcd\x98\xac\xf4\x15\x1b\x22\x63\xcd\x1b\x09\x9f\xd1\xd0\xc8\x46\x98\x93\xd7\x2c\x51\x92\x95\xbd\x9a\x70\x85\x30\xcd\xda\x55\xcc\x8b\x62\x60\x73\x9a\x1f\x1a\xfc\x31\xca\x01\xc2\x73\x91\x96\x82\x0b\x94\x23\x8d\x0c\x11\x6e\x11\x62\x9a\xfc\x9e\xbf\xba\x1a\xd3\xd8\x69\xa1\x0a\x03\x65\xa4\x7c\xfc\x6b\x75\x73\x45\x78\x44\x79\x69\x23\x4c\xc8\xb2\xb5\xf7\x89\x1c\xc5\xe5\xaa\x79\xcc\x5f\x72\xae\xc6\x6b\xcd\xb3\x9a\xa1\x99\xdb\x5f\x12\xb3\x45\x64\x8d\xa2\x64\xbf\x6a\x59\xdb\xac\xf9\xab\xfe\xd2\x5f\x44\xf2\xa5\x3e\xf5\xd0\xf8\x08\x47\x34\xbe\xaf\xfb\xea\xad\xde\xae\xfa\x5b\x45\x8d\x89\x1d\x26\xfe\x33\xb1\xce\x35\xbe\xb6\xd7\x29\xd7\x98\x68\x78\xc2\xf7\x44\x93\x89\x43\x26\x36\x88\x9a\xd3\xf2\xd2\x12\xcf\x64\xf1\x52\x9b\x7b\xf6\x13\x00\x00\xff\xff\x6a\x6b\xc3\x5a\x28\x04\x00\x00")
-
-func ml_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ml_inJson,
-		"ml_IN.json",
-	)
-}
-
-func ml_inJson() (*asset, error) {
-	bytes, err := ml_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ml_IN.json", size: 1064, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mn_mnJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\xbd\x4a\x33\x41\x14\xad\x77\x9f\x62\x18\x98\x2e\xe4\x01\xd2\x7d\x90\xe6\x2b\x56\x04\xd3\x88\x88\x44\x22\xc4\x22\x09\x68\x1a\x11\x61\x25\x2c\x16\x1a\x42\xd0\x2e\x09\x82\x90\x56\xc7\x6c\x13\x31\xd9\x67\x38\x37\x4f\x24\xb3\xce\x38\x97\xec\x94\xf7\x9c\xbd\xf7\xfc\xec\xdc\xc6\x91\xfc\xdf\x94\x0d\x21\x7b\xfd\xb3\xe4\x40\xd6\xe2\x48\x36\xdb\x37\xd7\xb2\x21\x4e\xe2\x28\x92\x58\xd0\x04\x1b\x03\x47\x12\x2f\xd0\x58\x41\x43\xdb\x79\x4e\x13\xe4\xd8\x40\x53\x6a\x91\x19\x65\xd0\xc8\xcd\x57\x16\x79\xdd\x7d\x50\x4a\x63\xac\xec\x3c\x35\xfb\x74\x0f\x8d\xad\x43\x8c\x02\x3e\xa1\x65\x1c\x9d\x1a\xfd\xa3\xee\xe0\x6a\xb8\x6f\xc2\x5b\xf0\xe2\x5e\xd4\x8b\x79\x19\x7f\xde\x1d\x4e\x06\xfd\x61\xd7\x5f\x5d\xd2\x08\xdf\xc8\x8d\x15\x68\x61\x3c\x51\x4a\x8f\xce\xd7\xee\x9d\x32\x1a\x53\x5a\x21\xcc\xed\x10\xfc\x46\xa3\xf2\xa0\xc6\x57\x95\x9c\x19\xb2\x0a\xcf\x51\x20\x47\x11\xda\x98\xa3\xa0\x94\x9e\xaa\xc4\x12\x05\xb6\x21\x62\x8a\x35\x3d\x04\x7c\x41\x53\x86\x75\x20\x22\x16\x28\x28\x0b\xcb\x3f\x97\x5b\x7b\x59\xf8\x0f\x0a\x95\xc9\x9b\xe3\x65\xf1\x86\x78\x21\xbc\x05\x1e\x9b\x27\xe5\xe1\x78\x22\x1e\x81\x7b\x76\x26\xff\x25\x87\x89\x73\xf7\xcb\x3b\xa6\xd9\x1e\x5e\x98\x17\xaf\x8e\xeb\xaa\x57\x57\x1d\xe9\xc0\xd6\x65\xcf\x12\x42\x9d\x0b\xd5\xa9\x09\xd5\x16\xaa\x55\xf2\x7f\x9c\x1f\xad\x82\x94\xf1\xdd\x4f\x00\x00\x00\xff\xff\xcc\x8d\xf5\xd9\x47\x03\x00\x00")
-
-func mn_mnJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_mn_mnJson,
-		"mn_MN.json",
-	)
-}
-
-func mn_mnJson() (*asset, error) {
-	bytes, err := mn_mnJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mn_MN.json", size: 839, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mr_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x93\x4f\x4b\xf3\x40\x10\xc6\xcf\xc9\xa7\x58\x16\xf6\xf6\x92\x0f\x90\x5b\xa1\x97\x1e\xf2\x22\xd4\x8b\x8a\x48\x45\xa1\x1e\x6a\xa5\xe6\x22\x22\xd8\x78\x88\x20\xc5\x43\x91\x82\x14\x82\x30\x8b\x41\x4b\x28\x95\x4a\xbc\xd8\xaf\x32\x1f\x45\x76\x70\xf2\xff\xd2\xbb\x97\x65\xf3\x3c\x33\xbb\xbf\x67\x92\x5c\xdb\x96\xec\xb4\xa5\x2b\xe4\x60\x74\xd4\xf9\x2f\xff\xd9\x96\x6c\xf7\xae\x2e\xa5\x2b\x0e\x6c\xcb\x92\x08\x4b\x84\x35\xc2\x86\xd6\x6f\x84\xa5\x29\x31\x7a\x8a\xfa\x01\x21\xa9\xe9\x09\x42\x80\x30\x43\xf8\xd8\xc6\x9a\xa1\x1e\x9b\xbb\xcc\x5a\xb1\x3e\x49\x7c\x42\x3d\x61\x98\xb2\x0b\x71\x09\xcf\xb6\x0e\x4d\x88\x6e\x7f\x38\xf2\x1b\x93\x54\x02\xd4\xe1\x58\x59\xd0\xc5\xaf\x75\xc4\x46\xb2\x0a\x10\x73\x78\xc3\x73\xbf\x5f\x80\x98\x13\x66\x8c\x3a\xcc\x90\x51\xdf\x72\xf3\x3b\xe9\x0b\xd4\x77\x4d\x6e\xc2\xca\x04\xe1\x99\xc5\x47\x84\x37\x9e\xcd\x06\x61\x95\x17\xeb\x90\xf7\x73\xd4\x01\x42\x5c\x78\x1c\x23\xac\x50\xdf\xb3\x32\xa5\xe4\x29\x9d\x13\x15\x06\x14\xf2\xe1\x11\xed\x03\xc3\x96\x47\x9d\x72\xf8\x88\x46\x59\xb4\x62\x52\xd6\xe4\x7e\x35\xf5\xbe\x10\x6d\x5a\xb2\x8a\xaf\xee\x6f\x6e\x5b\xcf\xad\xe5\xed\x78\xf9\xc0\x12\x87\x18\x02\x27\x0b\xe6\xd0\x97\x19\x38\x5c\xdf\xee\xf9\xa7\xe6\xcf\x57\x2d\xa1\x4e\x84\x3a\x16\x6a\x4f\xb2\xbe\x7b\x36\xa8\x7a\x42\x75\x5c\xe5\xb9\xaa\x2b\xd4\x85\x50\xfb\x54\x9a\x95\x65\x56\xae\xff\xe2\xc8\x4a\x9b\x7d\xf3\x13\x00\x00\xff\xff\x4b\x19\x8b\xa5\x74\x04\x00\x00")
-
-func mr_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_mr_inJson,
-		"mr_IN.json",
-	)
-}
-
-func mr_inJson() (*asset, error) {
-	bytes, err := mr_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mr_IN.json", size: 1140, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ms_myJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x41\x4b\xf4\x30\x10\x86\xcf\xe9\xaf\x08\x03\xb9\x7d\xbf\xa0\xb7\x42\xf9\xa0\x2b\x51\xb1\x5e\x54\x44\x26\x6e\xb0\xc1\x4d\x52\x92\x54\x10\xf1\xbf\x4b\x4d\x32\x5d\x77\xc5\xdb\x9b\xbc\xd3\x67\x9e\x92\x8f\x86\xc1\xd0\x43\xcb\xc1\xc6\x27\x79\x07\xff\x1a\x06\x3d\xbe\x47\x68\xf9\x43\xc3\x18\x74\x13\xee\xd7\x4b\x06\x43\x74\xc6\xe5\x38\xea\x03\x46\xcc\xf9\x06\xd5\x92\xd3\xc5\x84\xd6\xc4\x9c\x77\x8b\x45\x4c\x65\x1a\x55\x5a\xa0\x61\x8f\x2b\x7b\x9c\x7c\x48\x3f\x17\x6c\x7c\xa2\x13\x9a\xc8\x84\x25\x66\x25\x4a\xef\xd2\x44\xb8\x1d\xba\x05\x83\xc9\x53\xff\xb5\x0a\xdb\x49\xe2\x73\x0e\xdd\x1c\x4c\x59\x21\xb5\xa9\x64\x57\xc3\x01\xcb\xdd\xd5\x8b\x8f\x55\x69\x4e\xda\x2a\x1d\x4a\xf1\x9a\x3c\x1d\x2e\xfd\xdb\x51\xd5\x9b\x98\x4f\xc7\xff\x7b\xa6\x48\x7a\xe7\x66\x7f\x78\xfd\x62\x45\x3e\xe4\x42\x1a\xd5\xa0\x93\xd7\xb2\xae\xce\x65\x6d\x7a\x4c\x7a\x7d\x79\xd1\x71\xb1\xe7\x42\x71\x51\xdf\x3f\xe9\x5b\x63\x4f\x3b\x2e\x86\x56\xc8\x56\x8c\x5c\xcc\x5c\xdc\x7f\x8f\xd2\x18\x55\xdb\x7d\x59\x0c\x27\x9f\x35\x9f\x5f\x01\x00\x00\xff\xff\xa6\x9c\xe4\x4e\x74\x02\x00\x00")
-
-func ms_myJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ms_myJson,
-		"ms_MY.json",
-	)
-}
-
-func ms_myJson() (*asset, error) {
-	bytes, err := ms_myJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ms_MY.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _mt_mtJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x4a\xfc\x30\x18\xc5\xd7\xed\x29\x42\x20\xbb\xe9\x05\xba\x2b\x74\xfe\x30\x7f\x8c\x0a\xed\x46\x45\x24\x61\x82\xf3\x0d\x69\x66\x48\xbf\x3a\xa8\x78\x02\x2f\x31\x2b\xbd\x48\x0f\x26\x35\xc9\xd7\xa2\xee\xde\xcb\x7b\xc9\xfb\x15\xfa\x9a\x67\x7c\x53\xf3\x92\xf1\x0e\x1f\x64\xcb\x57\x79\xc6\x6b\xf5\xdc\xf3\x92\xdd\xe5\x59\xc6\xc1\x16\xe3\x87\xda\x6e\xa7\x20\xe3\x80\x45\xeb\xcc\xde\xcd\xce\x82\x41\x15\xac\x2d\xd6\x5e\x3f\x8e\x9f\xd1\x86\x9b\x1d\xf4\xb3\x3d\x43\xb7\xc8\xfb\xa2\x01\x8d\x3c\xcf\xee\xa7\xd1\x66\x77\xf0\xb8\x5c\x9e\x66\x43\xb3\x75\x26\x0a\x0b\x41\xac\xbd\x0e\x62\x5a\x88\xea\x0c\x51\x35\xa0\xd3\xa3\xf2\xe0\x70\x47\x2f\xfe\x57\xce\x29\x1f\x4a\xff\x7c\x52\x52\xf9\x97\x21\xc8\xea\xe8\xc1\xc6\x53\xb3\xdf\x0f\xe9\xe5\xc1\x25\x7d\x31\xd8\x24\xab\xd3\x09\xfa\x3e\x9a\xc6\x20\x9a\x4e\xfb\x68\xaf\x10\x07\x32\x97\x87\xa7\x45\x54\xc3\xf8\x1e\xec\xf2\xc3\x7f\x81\x12\x25\x41\x12\x22\x01\xce\x78\x04\x47\x68\x84\x45\x40\x04\x33\x73\x24\x84\x4a\x5e\xcb\xb4\x1d\xd2\x94\xd4\x0a\xcd\xf4\x73\x88\x6a\xc5\xc4\x96\xa1\x62\x42\xaf\x98\xb8\xe1\x29\x6c\xa1\xfb\xb3\xc0\xc4\xa6\x14\xb2\x14\x0d\x13\x47\x26\x6e\xbf\xfb\xd4\xa5\x68\x3e\x8f\x08\xfc\xc7\xb5\xfc\xed\x2b\x00\x00\xff\xff\x0d\xf8\x02\xe4\xa1\x02\x00\x00")
-
-func mt_mtJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_mt_mtJson,
-		"mt_MT.json",
-	)
-}
-
-func mt_mtJson() (*asset, error) {
-	bytes, err := mt_mtJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "mt_MT.json", size: 673, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _my_mmJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xb1\x4a\x03\x41\x10\xad\xef\xbe\x62\x59\xd8\xce\x2f\xb8\x2e\x98\x26\xc5\xb2\x42\xac\x14\x91\x88\x42\x2c\x62\x82\xa6\x09\x22\x0c\x92\x10\xb0\x92\x18\x63\xc4\x24\x62\x25\x92\x98\x78\x8d\xe8\x11\x3f\x66\xfe\x44\x6e\xce\x3d\x67\x8f\xb5\xb3\x39\xd8\x79\x6f\x66\xde\x7b\xbb\x77\x1e\x06\xb2\x52\x96\x91\x90\x8d\xce\xbe\xd6\x72\x23\x0c\x64\xb9\xd6\x39\x93\x91\xd8\x0d\x83\x40\x22\x5c\x23\x0c\x11\xba\x08\x09\xc2\x27\xc2\x25\x1d\xbf\x10\xe2\x94\xeb\x21\x4c\x10\x16\x16\x7a\x72\x1b\xe7\xb6\x7e\x87\xb0\x42\x18\x50\xfd\x06\xe1\x11\xe1\x0d\xe1\xc3\xa2\x80\xb0\x46\x58\x20\xcc\x10\x6e\x69\x7e\xcc\xd0\x19\x1d\x17\x39\xcd\xd6\x7b\x24\x23\x96\x61\xb0\x97\x9a\xa8\xd6\x9b\xa7\x6d\xd7\x49\x41\x37\x17\xca\xc5\x15\xd4\xf8\xd7\xdb\x23\x5f\xa9\x9b\x27\xed\x3a\xdb\xd7\x27\x38\xa1\xef\x14\x61\x8e\xf0\x80\xb0\xb4\x9d\x23\x1a\x34\xb2\x6e\x12\x1f\xe7\x9e\xd6\x27\xf6\xf8\x4c\x79\xac\x5d\x42\xee\xa7\x4f\xf6\x86\x8c\xdf\x27\x27\x13\x84\x57\xca\xbb\xcb\xa0\x17\xf2\xbc\x72\xe7\xf7\x28\xd4\x84\x8a\xd9\xbd\x8d\xdd\xcb\xcc\x83\xcf\x38\xd9\xd8\xb1\x1b\x47\x56\x9c\xfa\x26\x0c\x10\x96\xa4\x8a\x41\xfc\xba\xfe\x08\x90\x27\xf6\x8f\xc9\xe4\x51\x14\xec\x7b\xfd\x16\x0c\x72\x47\xd6\x42\x49\x6f\x69\xe7\xb1\xbd\xd3\x97\x77\x5f\xb9\x0f\xa6\x5c\x6b\x1f\xa5\xbf\x9e\x32\x9b\xca\x74\x84\x3a\x10\xca\x1c\x0a\x55\x92\x16\xdc\x3e\x6e\x78\x09\x42\x99\x4a\xa4\x8c\x8e\x94\xa9\x0a\x65\x5a\x42\xed\x50\xcf\x2f\x9f\xc1\xad\x1c\xfa\x51\x58\x84\xc3\x8b\xef\x00\x00\x00\xff\xff\xa3\x43\x59\x3e\x06\x04\x00\x00")
-
-func my_mmJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_my_mmJson,
-		"my_MM.json",
-	)
-}
-
-func my_mmJson() (*asset, error) {
-	bytes, err := my_mmJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "my_MM.json", size: 1030, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nan_twLatinJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\xbd\x4a\xc4\x40\x14\x85\xeb\xc9\x53\x0c\x17\x06\x14\x0c\x98\xfd\x53\x53\x29\x04\x71\x8b\x80\xb0\x0b\xa2\x22\x32\xe2\x92\xcc\x1a\x27\x61\x77\x1a\x11\x0b\x1f\x20\x95\x8d\xa5\xed\xa2\xef\x60\x91\xb5\x8b\x2f\xe1\x93\x48\x36\x73\x87\x9b\xad\x0e\x5f\x72\x98\xf3\x85\xcc\xb3\xc7\x60\x1c\x41\xc8\x41\x4b\x7d\x3b\xbd\x38\xce\xa4\x51\x1a\xf6\x3c\x06\x91\x7c\x5a\x42\xc8\xaf\x3d\xc6\x20\xab\x56\x7e\x51\x7d\x28\x7f\xae\xea\xd2\x34\xaf\x19\x6c\x58\x51\x98\xaf\x3f\x09\x2d\xe5\xdf\xeb\x37\xe5\xea\x8b\x50\xf2\x53\xfe\xbe\x13\xce\x64\x5d\x3e\x80\xc7\x6e\x9a\xe9\x49\x9a\x2f\x4c\x67\xbf\xb0\xd5\xc0\x66\xcf\x66\xdf\xe6\xc0\xe6\xd0\xe6\x08\x8f\x8a\x73\x6d\x52\x77\x4e\x90\xe4\xb3\xba\x4c\xdb\x52\x8f\x42\x9f\xc2\x80\xc2\x90\xc2\x88\xc2\x01\x85\x43\x0a\x47\x14\x82\xfd\x0e\x75\x1c\x02\x94\xa0\x5f\xbe\xed\x8c\xba\x68\x8a\x92\xe8\x87\x6a\x68\x85\x42\xe8\xe2\x34\x9c\x81\x1b\xc7\xdd\x93\xf8\x3c\xc6\x41\x53\xad\x74\xe2\x17\x39\xfe\x9f\xf5\x5b\x0b\xb6\x1a\x49\x33\x6b\x2e\x8c\x38\x05\xc4\xa9\x7a\x6c\x1f\x5d\x72\x71\xc7\xc5\x3d\xdf\x11\x72\x97\x8b\xb3\x50\xc4\xa1\x98\x70\x71\xb5\x69\xba\xd6\xc2\xa1\x9d\x05\x31\xc6\x6a\x01\xde\xcb\x7f\x00\x00\x00\xff\xff\x67\x12\x63\xaf\x95\x02\x00\x00")
-
-func nan_twLatinJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_nan_twLatinJson,
-		"nan_TW@latin.json",
-	)
-}
-
-func nan_twLatinJson() (*asset, error) {
-	bytes, err := nan_twLatinJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nan_TW@latin.json", size: 661, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nb_noJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\xcd\x4a\xc4\x30\x10\x3e\x27\x4f\x11\x06\x72\x93\x79\x80\xbd\x09\x3d\xe8\xa1\x2a\xe8\x45\x44\x64\x4a\xe3\x6e\x77\xdb\x64\x49\x52\x41\xc5\xf7\xda\xfb\xbe\x98\xd4\x74\x66\x8b\x7a\xdb\xdb\xf7\x33\x7c\xdf\xd7\x92\x4f\xad\xe0\xba\x82\x95\x01\xdf\xbc\xdc\xdc\xc2\x85\x56\x50\xd1\x7b\x82\x95\x79\xd2\x4a\x41\x3a\x1e\x7c\x4b\xeb\x49\x57\x30\xd0\x09\xe7\x2e\x26\x21\xc1\x9f\x70\x0e\x0b\xe3\x35\x3a\xc1\xfd\xf1\x10\x27\xa2\xd5\xf3\xd4\x72\xbf\x09\x31\xff\xaa\x42\xee\x41\x2e\x41\x2e\x60\x25\x20\x27\xa3\xc4\x22\x67\xd6\xc1\xe7\x8d\x04\x6e\xc9\x8f\x14\xe7\x73\xd7\x44\x21\x03\xc5\x54\x10\xed\x63\xd7\xb3\xd8\x15\xb0\x1d\xbd\xa0\x7e\x46\x34\xae\xc7\x94\x0b\x4e\x6e\x9f\xdd\xd0\xb8\x39\x2c\xec\x72\x10\xe2\xc3\xdb\xc2\x6a\x5d\x2a\x6c\xf9\xc9\x7f\x36\xa2\x2c\xc4\x33\xe6\xa1\x8c\x43\xd9\x85\x32\x0a\x65\x90\xfc\xab\xcb\xfa\xae\xe6\x15\xc5\x65\xa7\xa2\xec\xa6\x17\x61\x5b\x34\xb6\x31\xf6\x11\x58\x7d\xe8\x86\xe2\x90\x11\xd3\xec\x7a\x34\xf6\x0a\x6d\x6d\xec\xc7\xcf\x25\x5f\xfd\x6b\xcc\xb5\x00\xfa\xeb\x3b\x00\x00\xff\xff\xbe\x69\xb9\x40\x7c\x02\x00\x00")
-
-func nb_noJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_nb_noJson,
-		"nb_NO.json",
-	)
-}
-
-func nb_noJson() (*asset, error) {
-	bytes, err := nb_noJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nb_NO.json", size: 636, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ne_npJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x93\xc1\x4a\xc3\x40\x10\x86\xcf\xc9\x53\x2c\x0b\x7b\xf3\x09\x72\x2b\xe4\xd2\x43\xa4\x50\x2f\x2a\x22\x15\x0b\xf5\x60\x2b\x9a\x8b\x88\x60\x0b\xb1\x82\x78\x14\x41\x0a\x5e\x66\x35\xd4\x10\x8a\x50\x49\x41\xea\xab\xcc\xa3\xc8\x4e\xb2\xed\x66\xb3\x8f\xe0\x25\x6c\xfe\x7f\x66\xf2\x7f\xb3\xe4\xc6\xf7\x78\x3b\xe4\x01\xe3\xc3\xfe\xf1\x6e\x87\xef\xf8\x1e\x0f\x7b\xd7\x57\x3c\x60\x87\xbe\xe7\x71\x84\x7b\x84\x29\x02\x20\x64\x08\x6b\x84\x85\x2a\x51\x7a\x81\xf2\x11\x21\x6f\xe8\x39\xc2\x04\xe1\x05\xe1\xab\x61\x65\x28\xc7\x08\x1f\x0e\x1d\x7e\x11\x56\xf4\xb4\xac\x6f\x6a\x79\x46\xf9\x84\xb0\x70\xb8\x90\xd6\xba\x7c\xef\x48\x11\x74\x07\xa3\xcb\xd8\x89\x61\xa5\x6f\x86\xb6\xb2\x36\x23\x3a\x93\x59\x81\x74\x8e\x68\x34\x8c\x07\x46\x88\x19\xd9\x4b\x85\x22\xef\x74\xcf\x27\xc2\x0f\xc1\x59\x7a\x5e\x32\xd1\x07\x5e\xb5\x98\x20\xcc\xab\x65\xc8\xa9\x11\x37\x47\x78\xd0\xe7\x19\xca\x09\x42\x6a\xbc\x8e\xe9\x36\xd6\x46\x4d\x42\xb4\x05\x8d\x32\x96\xa2\x20\x41\x4d\x53\x7a\x66\x80\x25\x1a\xf5\x8d\x86\x9b\x56\x49\xd4\x6c\x79\xa7\x69\x45\xcd\x32\xef\xe7\x7f\x39\xee\xe5\xb4\xa2\x4e\xb4\xdd\xca\x9c\x46\x96\xa0\x4b\x8a\xb9\xa2\x73\x5a\x83\x56\x1b\x32\xac\x6a\x52\xd8\x8b\xfb\xea\xd7\x16\x2d\x26\x4e\x99\x38\x61\x62\x9f\x6b\x7d\xef\xec\xdc\xf6\x98\x68\x07\x22\x0a\x44\x97\x89\x0b\x26\x0e\xa8\x74\x53\xb6\xb1\xb6\x7a\x15\x94\x5b\x6d\xfe\xed\x5f\x00\x00\x00\xff\xff\x91\xf0\xa7\xa0\x55\x04\x00\x00")
-
-func ne_npJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ne_npJson,
-		"ne_NP.json",
-	)
-}
-
-func ne_npJson() (*asset, error) {
-	bytes, err := ne_npJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ne_NP.json", size: 1109, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nl_awJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcd\x6a\xeb\x30\x10\x85\xd7\xf2\x53\x88\x01\xed\x6e\x5e\x20\xbb\x80\x37\x77\x61\x28\xd4\x50\xda\x52\xca\xb8\x52\x13\xbb\x96\x14\xf4\x93\x92\x94\xbe\x7b\x71\xa5\x99\xb8\x64\x37\xdf\x1c\x7b\xce\x67\xf0\x57\x23\xe0\x7f\x0b\x5b\x09\x6e\x7e\xdd\x3d\xc0\xbf\x46\x40\x8b\xe7\x08\x5b\xf9\xdc\x08\x01\x17\xef\x34\xee\x97\xb5\x00\x8b\x78\x05\x3d\xba\xc8\xf0\xe9\xcd\x8a\xb4\x77\xda\x04\xc6\x53\x18\x27\x86\x0b\xa6\x12\x35\xe2\x65\xe9\xba\x3f\xf8\x90\xfe\x16\x52\x19\xf5\x50\x05\x1d\xa7\xab\x74\x90\x4e\x75\xde\xa5\x03\xdf\x99\xd0\x65\x0c\xf5\xe5\x77\x33\x84\x2b\x59\xc4\x90\xca\x88\xc7\x30\xce\x75\x6b\x6a\x3c\x65\xc7\xd3\x5c\x27\xcc\xfb\x1c\x53\x8e\x85\xa2\x39\x26\x63\x07\x53\x15\xfc\x47\xf2\x0c\xce\x9f\x56\x91\x36\x6f\x85\xd6\x9f\x7b\x23\xca\x92\xd5\x64\x65\x77\xeb\xc6\x6a\x6c\xc6\x52\xac\xc3\x2a\x6c\x41\x02\xbb\xee\xae\xa3\xe6\x12\x52\xd2\x62\x32\xcb\x7f\xa0\xf4\x46\xd9\x8d\x3a\x03\x2d\xfb\xd1\x96\x00\xa5\xd2\x52\x0d\x52\x3d\x4a\xd5\x4b\xf5\xf4\xfb\x04\xa7\x3d\x63\xed\x00\x68\xbe\x7f\x02\x00\x00\xff\xff\x52\x88\x1b\xf7\x5f\x02\x00\x00")
-
-func nl_awJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_nl_awJson,
-		"nl_AW.json",
-	)
-}
-
-func nl_awJson() (*asset, error) {
-	bytes, err := nl_awJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nl_AW.json", size: 607, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nl_beJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcd\x4e\xeb\x30\x10\x85\xd7\xce\x53\x58\x23\x79\x77\xfb\x02\xdd\x5d\x14\x16\x2c\x22\x21\x91\x0d\x20\x84\x26\xd8\xb4\x09\xb1\x5d\xf9\xa7\xa8\x45\xbc\x3b\x0a\xf6\x4c\x83\xba\x9b\x6f\x4e\x32\xe7\x8b\x94\xaf\x46\xc0\x5d\x0b\x5b\x09\x6e\x7e\xbd\xb9\x85\x7f\x8d\x80\x16\x4f\x11\xb6\xf2\xb9\x11\x02\xce\xde\x69\xdc\x2d\x6b\x01\x16\xf1\x02\x7a\x74\x91\xe1\xd3\x9b\x15\x69\xef\xb4\x09\x8c\xc7\x30\x4e\x0c\x67\x4c\x25\x6a\xc4\xcb\xd2\xf5\xb0\xf7\x21\xfd\x2d\xa4\x32\xea\xa1\x0a\x3a\x4e\x57\xe9\x20\x9d\xea\xbc\x4b\x7b\xbe\x33\xa1\xcb\x18\xea\xcb\xef\x66\x08\x17\xb2\x88\x21\x95\x11\x0f\x61\x9c\xeb\xd6\xd4\x78\xca\x8e\xa7\xb9\x4e\x98\x77\x39\xa6\x1c\x0b\x45\x73\x48\xc6\x0e\xa6\x2a\xf8\x8f\xe4\x19\x9c\x3f\xae\x22\x6d\xde\x0a\xad\x3f\xf7\x4a\x94\x25\xab\xc9\xca\xee\xda\x8d\xd5\xd8\x8c\xa5\x58\x87\x55\xd8\x82\x04\xfe\x77\xf7\x1d\x35\x97\x90\x92\x16\x93\x59\xfe\x03\xa5\x37\xca\x6e\xd4\x09\x68\xd9\x8f\xb6\x04\x28\x95\x96\x6a\x90\xea\x51\xaa\x5e\xaa\xa7\xdf\x27\x38\xed\x19\x6b\x07\x40\xf3\xfd\x13\x00\x00\xff\xff\x82\xa5\x37\xed\x5f\x02\x00\x00")
-
-func nl_beJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_nl_beJson,
-		"nl_BE.json",
-	)
-}
-
-func nl_beJson() (*asset, error) {
-	bytes, err := nl_beJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nl_BE.json", size: 607, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nl_nlJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcd\x6a\xeb\x30\x10\x85\xd7\xf2\x53\x88\x01\xed\x6e\x5e\x20\xbb\x0b\xde\x14\xea\x52\xa8\x37\x6d\x29\x65\x5c\xa9\x89\x5d\x4b\x0a\xfa\x49\x49\x4a\xdf\xbd\xb8\xd2\x4c\x5c\xb2\x9b\x6f\x8e\x3d\xe7\x33\xf8\xab\x11\x70\xd3\xc2\x56\x82\x9b\x5f\xef\x6e\xe1\x5f\x23\xa0\xc5\x53\x84\xad\x7c\x6e\x84\x80\xb3\x77\x1a\x77\xcb\x5a\x80\x45\xbc\x80\x1e\x5d\x64\xf8\xf4\x66\x45\xda\x3b\x6d\x02\xe3\x31\x8c\x13\xc3\x19\x53\x89\x1a\xf1\xb2\x74\x3d\xec\x7d\x48\x7f\x0b\xa9\x8c\x7a\xa8\x82\x8e\xd3\x55\x3a\x48\xa7\x3a\xef\xd2\x9e\xef\x4c\xe8\x32\x86\xfa\xf2\xbb\x19\xc2\x85\x2c\x62\x48\x65\xc4\x43\x18\xe7\xba\x35\x35\x9e\xb2\xe3\x69\xae\x13\xe6\x5d\x8e\x29\xc7\x42\xd1\x1c\x92\xb1\x83\xa9\x0a\xfe\x23\x79\x06\xe7\x8f\xab\x48\x9b\xb7\x42\xeb\xcf\xbd\x12\x65\xc9\x6a\xb2\xb2\xbb\x76\x63\x35\x36\x63\x29\xd6\x61\x15\xb6\x20\x81\xff\xdd\x7d\x47\xcd\x25\xa4\xa4\xc5\x64\x96\xff\x40\xe9\x8d\xb2\x1b\x75\x02\x5a\xf6\xa3\x2d\x01\x4a\xa5\xa5\x1a\xa4\x7a\x94\xaa\x97\xea\xe9\xf7\x09\x4e\x7b\xc6\xda\x01\xd0\x7c\xff\x04\x00\x00\xff\xff\xc8\x91\xb2\xd2\x5f\x02\x00\x00")
-
-func nl_nlJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_nl_nlJson,
-		"nl_NL.json",
-	)
-}
-
-func nl_nlJson() (*asset, error) {
-	bytes, err := nl_nlJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nl_NL.json", size: 607, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nn_noJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x52\x4b\x4a\xc4\x40\x10\x5d\x77\x9f\xa2\x29\xe8\x9d\xd4\x01\x66\x27\xcc\x42\x17\x51\x41\x37\x22\x22\x15\xd2\xce\x64\x26\xe9\x1e\xfa\x23\x8c\xe2\x71\x3c\x89\x17\x93\xd8\xa9\xca\x88\xee\xdc\xbd\x4f\xf1\xde\x4b\xe8\x37\xad\xe0\x72\x0d\x2b\x03\xde\x3f\x5d\x5d\xc3\x99\x56\xb0\xa6\x63\x82\x95\x79\xd0\x4a\x41\x2a\xbe\xa3\xcd\x24\x2b\x18\x3f\x3f\x16\x92\x8f\x49\x70\xf0\x0b\xce\x21\x2e\xe4\x39\x3a\xc1\x03\x95\x38\x11\xad\x1e\xa7\x92\xdb\x6d\x88\xf9\x67\x13\x4a\x0d\x72\x07\x72\x01\x2b\x01\x39\x19\x39\x16\x39\xb2\x09\x3e\x6f\x25\x6f\x47\xbe\x50\x9c\xaf\x5d\x1b\x85\x8c\x14\x53\x45\x74\x88\xfd\xc0\x62\x5f\xc1\xae\x78\x41\xc3\x8c\xa8\x6c\x4a\xca\x15\x27\x77\xc8\x6e\x6c\xdd\x1c\x16\xf6\x39\x08\xf1\xe1\xe5\xc4\xea\x5c\xaa\xec\xf4\x8b\x7f\x6d\x44\x59\x88\xff\x98\x87\x32\x0e\x65\x17\xca\x28\x94\x41\xf2\xaf\xce\x9b\x9b\x86\x57\x54\x97\x9d\x35\x65\x37\xbd\x07\xdb\xa1\xb1\xad\xb1\xf7\xc0\xea\x5d\x3f\x56\x87\x8c\x98\x66\x3f\xa0\xb1\x17\x68\x1b\x63\x5f\xbf\x2f\xf9\xea\x4f\x63\xae\x05\xd0\xef\x5f\x01\x00\x00\xff\xff\x40\xd4\x82\xea\x7a\x02\x00\x00")
-
-func nn_noJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_nn_noJson,
-		"nn_NO.json",
-	)
-}
-
-func nn_noJson() (*asset, error) {
-	bytes, err := nn_noJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nn_NO.json", size: 634, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nr_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcf\x6a\xf3\x30\x10\xc4\xcf\xf6\x53\x08\x81\x6e\x5f\xc8\x3d\x37\x7f\x98\xa4\x0d\x55\x5b\xb0\x7b\x48\x4a\x29\x2b\xba\x54\x6a\x64\x29\x91\xe4\x80\x29\x7d\xf7\xe2\xe8\x0f\x86\xde\x66\x76\xd6\x3b\x3f\x1b\x7f\xd7\x15\xbd\x6f\xe9\x86\x50\xe3\xde\x8f\x0d\xfd\x57\x57\xb4\x85\xc9\xd3\x0d\x79\xad\xab\x8a\x8e\x9d\x35\xc1\xce\xe3\x8a\x8e\xfc\x3a\xea\xac\x1f\xd0\x2b\xa1\xb4\x8a\x56\xa3\x57\x41\x42\x90\xe3\x22\x36\x18\x8d\xf9\xb4\xb3\x95\x1a\x4c\x8e\x87\xdd\x45\x09\xd4\x96\xd6\xd5\xdb\x5c\xd9\x49\xeb\xc2\xb2\xb7\xb3\x26\xae\xf2\x6b\x7a\xe6\xbf\xd2\x51\xf4\x12\xa2\x78\x4c\xf7\xef\x74\x1a\xec\x2e\x2a\x1f\xe4\xd6\x04\x59\xae\xed\xc1\x80\x00\x97\x60\xc7\x2d\x0a\x74\x0b\xcf\x21\x7c\xc9\x6c\x56\xcd\xd9\x61\x7e\x2f\x8e\x53\x52\xfb\xd1\x14\xa5\x21\x4f\x1b\x27\xad\x0f\xd6\x27\xdb\xe1\x39\xe0\x20\x12\xce\xd3\x29\xd8\xac\x5f\xbc\x32\x93\x3a\x49\xc8\x83\x56\xf9\xdb\xe6\xf2\x03\xfc\x81\x8e\xab\x5b\x14\x89\x06\x42\xaa\x3d\xbb\xc2\x57\xf0\x0a\x5d\x41\x2b\x50\x85\xa7\xc0\x14\x88\x0c\xd0\xf0\x67\x9e\x9b\x63\x98\x93\x16\x02\xce\x3f\x08\xfb\x58\xb3\x61\xcd\x0e\x34\x0f\x7b\x35\xc4\x00\x08\x5b\x21\x61\x82\xb0\x03\x61\x3d\x61\xc7\xdb\x4a\x89\xfb\x62\x53\x09\xa5\xf5\xcf\x6f\x00\x00\x00\xff\xff\x06\x3d\x55\x93\x79\x02\x00\x00")
-
-func nr_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_nr_zaJson,
-		"nr_ZA.json",
-	)
-}
-
-func nr_zaJson() (*asset, error) {
-	bytes, err := nr_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nr_ZA.json", size: 633, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _nso_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x6a\x02\x31\x10\x86\xcf\xbb\x4f\x11\x06\x72\x2b\x78\xf7\x66\x59\x4a\x2b\xae\x2d\xd5\x8b\x96\x52\x26\xec\xd4\x5d\x8c\x19\xc9\x46\x4a\x29\x7d\x18\x1f\xc6\xf7\x2a\x36\xc9\xec\x82\xb7\xf9\xf3\x0f\xff\xff\x0d\xe4\xa7\x2c\xe0\xa9\x82\xa9\x02\xd7\xf3\xc7\x76\x06\x77\x65\x01\x15\x7e\xf7\x30\x55\x6f\x65\x51\xc0\x02\x6b\xf6\xe4\xf0\x6a\x14\x50\xf3\xe5\x7c\x3a\xb2\xe5\x1d\xc7\x87\x05\x1a\x36\xd4\x74\x83\xf2\xe8\x47\x9e\xa3\x61\x6e\x2d\x3a\xce\x39\xfb\xce\x90\x65\x28\x8b\xf7\x6b\xe3\xaa\x65\x1f\xc6\xb5\x2b\x76\x43\x63\x9c\xee\xa9\x89\xc3\x2b\xfa\x38\x2c\x53\xf8\xa3\x15\xbc\x7d\x4e\xac\xd9\x85\x56\xe2\xe6\xe8\xf0\x0b\x7d\xda\x7f\x20\x43\x9e\x06\x5d\x63\xb8\x9c\xdb\x24\x66\x47\x4f\x36\x1b\x94\x0e\x9b\x9f\xf2\x21\xf3\x93\xc5\xbc\xb9\xe3\x3e\x70\x9f\xd4\x8a\x02\x1d\x28\x47\x3e\xef\x03\x1b\x51\x4b\xfe\x1c\x79\x55\xd7\x47\x35\x3e\xfe\x86\x57\x50\x85\x51\xf8\x6e\xe1\x84\x4d\xc8\x04\x4a\x78\x04\x45\x28\x32\xc0\xac\x7e\xa9\x73\x73\x34\xb3\x53\x61\xa0\xeb\xe7\xd0\xcd\x44\x1f\x26\x7a\x03\xf9\x71\xdd\x1d\xa2\x81\x4a\x37\x4a\x1b\xa5\x37\x4a\xaf\x95\xde\xfe\x6f\x88\xbb\x16\x99\x3a\x00\xca\xdf\xbf\x00\x00\x00\xff\xff\x77\xf0\x2c\x1d\x74\x02\x00\x00")
-
-func nso_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_nso_zaJson,
-		"nso_ZA.json",
-	)
-}
-
-func nso_zaJson() (*asset, error) {
-	bytes, err := nso_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "nso_ZA.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _oc_frJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x10\x85\xd7\xf6\x29\xc4\x80\x76\x3f\x39\x40\x76\x3f\x84\x42\x17\x86\xd2\x7a\x93\x96\x52\x46\xf6\xd4\x76\xb0\x24\x90\x64\x43\x29\x3d\x4f\xbb\x6e\x8f\xe0\x8b\x15\x57\xd2\xc4\x24\xbb\xf7\xe6\x0d\xf3\x3e\x19\xbf\x97\x05\xdc\x1e\x60\x2f\xc0\x36\x2f\x37\xf7\xf0\xaf\x2c\xe0\x80\x6f\x1e\xf6\xe2\xa9\x2c\x0a\x68\x07\x4d\xa6\xa3\x75\xbe\x9a\x71\x32\x3e\x6b\x8d\xee\xac\xa9\x71\xc4\xee\xb4\xfc\x4c\x6c\x66\x32\xed\x26\xf3\xa8\x02\x41\x59\x3c\xaf\x4d\x0f\xbd\x75\xe1\xa2\x2e\x2e\x8e\x93\x89\x42\xa3\x4b\x82\x9a\x28\x4e\xcb\xf7\x14\xd5\x4c\x69\xc9\xa3\xca\x27\x2b\x6b\x42\xcf\xf7\x3a\x32\xc3\xf2\x95\x2e\xbc\x92\x72\x67\xa7\xd1\x2d\x9f\x51\xa2\x72\x03\x8e\x79\x3c\xa4\x9a\xc9\xf4\x59\x8d\x3d\x85\xb4\xda\x59\x9f\xa4\xa7\x40\x5a\xb9\xf4\x6d\x6c\x13\x2c\x1b\x63\xe7\x4d\xd4\x52\x13\xdd\xf6\xd5\x57\x9c\xcc\x78\xf1\x6e\x54\xee\x9a\x8c\xc1\x98\x8a\x99\x18\x87\x51\x98\x22\x03\xfc\xaf\xee\xaa\xdc\x1c\xc3\x9c\x1c\x30\xd0\xfa\x33\xc8\x76\x27\xf5\x4e\x1e\x21\x0f\xeb\x41\xc7\x00\x85\x6c\x85\x54\x42\x1e\x85\xac\x85\x7c\xfc\xdb\xe0\xb4\x66\x9b\x3a\x00\xca\x8f\xdf\x00\x00\x00\xff\xff\xba\xd0\xe0\x36\x64\x02\x00\x00")
-
-func oc_frJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_oc_frJson,
-		"oc_FR.json",
-	)
-}
-
-func oc_frJson() (*asset, error) {
-	bytes, err := oc_frJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "oc_FR.json", size: 612, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _om_etJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x4b\x03\x31\x10\xc5\xcf\xd9\x4f\x11\x02\x73\x2b\xf4\xbe\xb7\xad\x5b\xb5\xca\xe2\x9f\x2d\xd4\x2a\x22\x13\x12\x6c\x6a\xb2\x91\x36\x0b\x2d\xe2\x77\x97\x6d\x33\x63\xab\xb7\x79\xf3\x66\xdf\xfb\xb1\xe4\xab\x10\x6a\x56\xab\x52\xaa\x18\xde\xa6\x73\x35\x2a\x84\xaa\x71\xbf\x55\xa5\x7c\x29\x84\x50\xb5\xf3\x1a\x13\x0e\x7b\xa1\x16\xce\xed\x58\x3c\x38\xfd\x2b\x1e\x63\xd4\xce\x1d\xe7\x5b\x0c\xce\x6d\xb3\x71\xe3\x02\xf2\x55\x8b\xdd\x21\xac\x10\xaf\x43\x4f\xbb\x8a\x9b\xf4\xa7\x8c\x8a\x76\x5c\x42\x05\x9a\xd3\x39\x99\x53\x29\xb1\x89\x5d\x5a\x71\x5c\x15\x70\xbd\x26\xaa\xab\x7e\x83\xd8\x99\x15\xfa\x0c\x33\x71\x29\xc6\x64\xed\x96\x50\xa7\x5e\xe7\xe9\x02\x31\xd0\x76\x81\x3b\xd4\x27\x39\x95\x89\xd1\x9f\x7c\x75\x8d\xef\xb8\xdf\x67\x71\xd9\xf7\x5e\x63\x97\xd5\x5d\xf7\x11\xfd\xd9\x71\x8b\x06\x91\x44\xd3\x1b\x63\xed\xd9\xcf\xf8\xc7\xcf\xec\xcc\xcc\xac\x84\x1a\x98\x93\x01\x99\x8c\xa8\x3c\x03\x31\x07\x33\x10\x40\xd5\xdc\x37\xd4\xbc\xa8\x73\xe8\x84\xdc\x1a\x93\x1d\x5e\x09\x98\x31\x84\x31\x2c\x15\x2d\xe7\x2e\x1c\x8d\x6a\x24\x61\x22\xc1\x8e\x24\x2c\x25\x6c\x24\x3c\x1f\x6e\xd8\x9f\x95\xd0\x94\xd0\xf2\x32\xf7\x29\x78\x92\xf0\xa9\x8a\xef\x9f\x00\x00\x00\xff\xff\xe9\x64\x7b\x4d\x8a\x02\x00\x00")
-
-func om_etJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_om_etJson,
-		"om_ET.json",
-	)
-}
-
-func om_etJson() (*asset, error) {
-	bytes, err := om_etJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "om_ET.json", size: 650, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _om_keJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x4b\x03\x31\x10\xc5\xcf\xd9\x4f\x11\x02\x73\x2b\xf4\xbe\xb7\xad\x5b\xb5\x96\xc5\x3f\x2b\xd4\x2a\x22\x13\x12\x6c\x6a\xb2\x91\x36\x0b\x2d\xe2\x77\x97\x6d\x33\x63\xab\xb7\x79\xf3\x66\xdf\xfb\xb1\xe4\xab\x10\x6a\x56\xab\x52\xaa\x18\xde\xe6\x53\x35\x2a\x84\xaa\x71\xbf\x55\xa5\x7c\x29\x84\x50\xb5\xf3\x1a\x13\x0e\x7b\xa1\x16\xce\xed\x58\xdc\x3b\xfd\x2b\x1e\x62\xd4\xce\x1d\xe7\x39\x06\xe7\xb6\xd9\xb8\x71\x01\xf9\xaa\xc5\xee\x10\x56\x88\xd7\xa1\xa7\x5d\xc5\x4d\xfa\x53\x46\x45\x3b\x2e\xa1\x02\xcd\xe9\x9c\xcc\xa9\x94\xd8\xc4\x2e\xad\x38\xae\x0a\xb8\x5e\x13\xd5\x55\xbf\x41\xec\xcc\x0a\x7d\x86\x99\xb8\x14\x63\xb2\x76\x4b\xa8\x53\xaf\xf3\x74\x81\x18\x68\xbb\xc0\x1d\xea\x93\x9c\xca\xc4\xe8\x4f\xbe\xba\xc6\x77\xdc\xef\xb3\xb8\xec\x7b\xaf\xb1\xcb\xea\xb6\xfb\x88\xfe\xec\xb8\x45\x83\x48\xa2\xe9\x8d\xb1\xf6\xec\x67\xfc\xe3\x67\x76\x66\x66\x56\x42\x0d\xcc\xc9\x80\x4c\x46\x54\x9e\x81\x98\x83\x19\x08\xa0\x6a\xee\x1a\x6a\x5e\xd4\x39\x74\x42\x6e\x8d\xc9\x0e\xaf\x04\xcc\x18\xc2\x18\x96\x8a\x96\x8f\x2e\x1c\x8d\x6a\x24\x61\x22\xc1\x8e\x24\x2c\x25\x6c\x24\x3c\x1f\x6e\xd8\x9f\x95\xd0\x94\xd0\xf2\x32\xf7\x29\x78\x92\xf0\xa9\x8a\xef\x9f\x00\x00\x00\xff\xff\x14\x68\x69\xef\x8a\x02\x00\x00")
-
-func om_keJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_om_keJson,
-		"om_KE.json",
-	)
-}
-
-func om_keJson() (*asset, error) {
-	bytes, err := om_keJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "om_KE.json", size: 650, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _or_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x53\x4f\x4b\xfb\x40\x10\x3d\x27\x9f\x62\x59\xd8\xdb\xaf\xf0\x6b\xfd\x9f\x5b\xa5\x97\x1e\xe2\x16\xea\x49\x11\x29\x58\xa8\x87\x5a\xa9\xbd\x14\x11\x14\xa9\x39\x88\x47\x29\x4a\xa1\x78\x32\xe8\x24\x84\x0a\xda\x93\x7e\x95\xf9\x28\x92\xd9\xac\x6e\x36\xf1\xb2\x6f\x33\x6f\xe6\xcd\x9b\x59\x72\xee\x3a\xbc\xd9\xe0\x1e\xe3\x83\xe1\x61\x73\x87\xff\x73\x1d\xde\xe8\x8c\xcf\xb8\xc7\xf6\x5d\xc7\xe1\x08\x09\x02\x20\x7c\xd1\xf9\x89\x90\xa4\x29\x69\x7c\x89\xd1\x2d\x42\x5c\x88\xc7\x08\x0f\x18\xdd\x21\x4c\x11\xde\x0a\x2c\x60\x74\x85\xf0\x5c\x88\x4f\x29\x9e\xd0\x69\x51\xef\x14\xbc\x27\xcd\xa4\x84\x85\x30\x67\xcf\x75\x0e\xd2\x21\xda\xbd\xc1\x70\x54\x3a\x89\x35\x40\xa9\x6f\xcb\x6e\xd1\x65\xa9\x39\xcb\x93\xb6\xe2\x0f\x4e\x46\x3d\xc3\xc7\x8c\x9c\x86\x54\x79\x43\x72\x97\xba\xf2\x15\xa3\x80\xba\x5e\x17\xa8\x58\xcd\x47\x9d\x1e\xd5\xa9\xa9\x09\xc2\x4b\xb6\x9e\xb4\x7c\x61\x94\x04\xfa\x3e\xa3\x76\x61\xfe\x73\x41\x9a\x81\xa1\x33\x45\xf8\x20\xa9\xb9\xb1\xa6\x40\xeb\xcf\xe9\x1e\xd3\x1d\x8c\x81\x27\x7a\x05\x73\xda\xa9\x49\x85\x08\xd1\x1f\x55\x4f\xf4\x6e\x4b\x9b\x35\x1f\x30\xbf\xba\xaa\xaa\xac\x29\x58\x51\xb0\xaa\x60\x4d\xc1\xba\x82\x0d\x05\x9b\x0a\xb6\x14\x54\xff\x67\x98\xe9\x54\x6b\xba\x57\xdd\x6f\xf9\xba\x49\xdd\x57\x6c\xcb\xd7\x6c\xa3\x33\xea\xa6\x7f\x88\x90\x47\x15\x21\xfb\x15\x21\xc7\x5c\xc7\x77\x8f\xfb\x19\xd7\x65\x62\x9b\x09\x39\x66\x42\x36\x3d\x21\x7d\x4f\xc8\x36\x13\xa7\x4c\xec\x51\xf2\x6f\xa2\xc9\xfe\x50\x99\x03\x9b\x76\x2f\xbe\x03\x00\x00\xff\xff\x9f\xab\x8c\x06\xa1\x03\x00\x00")
-
-func or_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_or_inJson,
-		"or_IN.json",
-	)
-}
-
-func or_inJson() (*asset, error) {
-	bytes, err := or_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "or_IN.json", size: 929, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _os_ruJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\x3f\x4b\xc3\x40\x18\xc6\xe7\xe4\x53\x84\x83\xdb\xa4\x1f\xa0\x9b\xd0\xc5\xa1\x20\x5a\x87\x22\x22\x95\x0a\x75\xa8\x05\xdb\x45\x44\xb8\xa6\x90\xa1\x15\xed\xd0\x41\xa4\x0a\x05\xcd\x6a\xd2\x12\x68\xab\x69\x87\x7c\x81\xe7\xfd\x46\x72\xf9\x73\xb9\x46\xdd\x72\xbf\xe7\x3d\xde\xdf\x73\xe4\xce\x34\xd8\x41\x85\x95\x2d\xd6\xe9\x9e\x1f\x9d\xb0\x3d\xd3\x60\x95\xc6\x6d\x97\x95\xad\x53\xd3\x30\x18\x3e\x68\x40\x23\x72\xe0\xd1\x00\x3e\x36\x08\xe5\x88\xc1\xf0\x42\xc3\x38\x11\x58\x52\x3f\x72\x49\xa4\x7c\x22\xa7\xc9\x89\x5c\x2c\x12\x12\x39\x24\xc8\x2e\x52\xb8\x34\xc2\x16\x5b\x79\x33\x72\xf1\x9d\xd2\x29\x3c\xac\x12\xb2\xb3\x6d\x06\x0f\x3e\x3c\xb2\x99\x69\x9c\x49\xc7\xe3\x56\xe7\xa6\x57\x10\x75\xe0\x2b\x39\x41\x7d\x25\xe4\xec\xaa\x64\x02\xd8\x2a\xe9\x29\x09\x75\x77\x06\x3f\x5f\x53\xed\x5c\xf7\x5a\xf9\x8e\x4f\x84\x98\xc3\x23\x41\x0f\xe9\xf4\x3b\x02\xcc\x49\xc0\xc3\x97\x62\xd3\x78\xc2\xa6\x21\x96\x29\x19\xcb\x5d\x08\x76\x67\xb0\x4a\xbf\x9f\xe9\x11\xa1\x4a\xe4\x29\x9f\x1b\x63\x8e\x05\x0d\xa8\xaf\xbc\x67\x08\x10\x92\x4d\x4f\xf0\x35\x8f\x37\xac\x7f\xb1\x57\x6c\x0a\x64\x82\x00\x6b\xf9\x94\x31\xd3\x9f\xf2\xaf\xa2\x7a\x45\xbd\x9a\x5e\xea\xbf\x3a\x7a\x19\xbd\x8a\x5e\x42\x57\xd7\x95\x75\xd9\x4c\x72\xbf\x7a\x58\xcd\xec\x92\x3c\x4b\x2a\x8d\xde\xa5\xfc\x7f\x79\xb3\xc4\xdb\x25\x5e\x67\x19\xac\x5d\xb5\x93\xa0\x61\xf1\xa6\xc5\x2f\x2c\x5e\xb7\x78\x2d\x8e\x55\x94\x1f\xd3\x05\x8c\x99\xf7\x3f\x01\x00\x00\xff\xff\x03\x22\xf2\x2f\x14\x03\x00\x00")
-
-func os_ruJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_os_ruJson,
-		"os_RU.json",
-	)
-}
-
-func os_ruJson() (*asset, error) {
-	bytes, err := os_ruJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "os_RU.json", size: 788, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _pa_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x93\x41\x4a\xc3\x40\x14\x86\xd7\x99\x53\x0c\x03\xb3\xf3\x04\xd9\x15\xb2\xc9\x22\x22\xd4\x8d\x8a\x48\x44\xa1\x2e\xb4\x45\xb3\x11\x11\x5a\x21\xb4\xe0\xc6\x8d\x08\xa5\xb8\x0a\xfa\x46\x4a\x88\x42\x24\xab\x78\x95\x77\x14\x99\x47\xa6\x9d\xce\xd4\x13\xb8\x4b\xfe\xff\x7f\xe1\xfb\xdf\x4c\xee\x58\x20\xe2\x48\x84\x5c\x8c\xd2\x93\x78\x57\xec\xb0\x40\x44\xe9\xed\x8d\x08\xf9\x11\x0b\x02\x81\xf0\x84\x50\x20\xd4\x08\x2d\x42\xa5\x7d\x2d\x36\xa8\x1e\x11\x4a\x4f\x2f\x51\x55\x08\x2f\x08\x5f\x9e\xb5\x44\x35\x41\xf5\x89\xf0\xee\x59\x35\xaa\x31\x42\xe5\xe9\xdf\x66\xe4\x79\xbb\x0b\x80\xf0\x43\x81\xf9\x46\x80\x05\xc7\xba\x47\x7f\x30\xbc\xce\xfc\x32\x4e\x07\x1f\xdd\x27\x76\x40\xb7\xf2\xfd\x85\x65\x68\x92\xe1\x55\x36\xb0\x50\x16\x94\xab\x35\xb8\x1a\x77\xc3\xea\xd5\xf4\xb0\x44\xbd\xe6\x96\xf4\xb9\x51\x72\x84\x0f\xca\xcc\x2c\xdc\x12\x61\x66\x9e\x17\xa8\x1e\x10\xc0\x7a\x9d\xd0\x99\xb4\x56\x26\xa7\xb6\xcd\xc6\x46\xa0\xa0\x2d\x2c\xad\x3e\x39\xd5\x2b\xe8\x83\xb6\x4e\xf0\x6e\xf8\x8d\xd6\x6a\x44\xfb\x1c\xfe\x6f\xfd\x5e\xb2\x97\xac\x7b\x37\x34\x37\x25\xfc\xa9\x75\x67\x5a\x7d\x15\xbb\x89\x28\xcd\xce\xf5\x2f\x29\x7b\x5c\x9e\x71\x79\xca\xe5\x81\x30\xfa\xfe\xc5\xa5\xeb\x71\x19\x87\x32\x09\x65\x9f\xcb\x11\x97\x87\x14\x5d\xc5\x56\xd6\x5a\xef\x80\x84\x33\xc6\xee\x7f\x03\x00\x00\xff\xff\xcc\x8f\x7a\xc6\x0d\x04\x00\x00")
-
-func pa_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_pa_inJson,
-		"pa_IN.json",
-	)
-}
-
-func pa_inJson() (*asset, error) {
-	bytes, err := pa_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "pa_IN.json", size: 1037, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _pa_pkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x52\xb1\x4a\xc4\x40\x10\xad\x37\x5f\xb1\x0c\x4c\x27\x5c\x9f\x4e\x49\xe1\x21\x81\xc0\xd9\xa8\x88\x04\x4e\x38\x8b\xf3\x44\xd3\x88\x58\x1c\xde\x26\xe1\xb0\xf3\x23\xf6\x08\x07\x21\x44\x0b\x9b\x7c\xc7\x4c\xfc\x19\xd9\x73\x77\xcd\xfd\x80\x85\xdd\xcc\x7b\x6f\xe7\xbd\x17\xf2\x14\x08\x18\x47\x10\x4a\xb8\x4b\xaf\x92\x13\x38\x08\x04\x44\xe9\xe3\x03\x84\xf2\x22\x10\x02\x48\x53\xc5\x25\x69\x6a\x0c\x25\x80\x3b\x5e\xfb\x59\x71\xde\xd7\xbc\xfa\xd9\x68\x43\x75\xdf\xd9\x79\xcb\x8a\x3e\xa9\x31\xaf\x87\x08\x17\xf6\x65\xc1\x4b\xaa\xb8\x80\x40\x5c\x1a\xc7\xc9\x6c\x71\x9f\xfd\xbd\x6d\xbc\xb8\xcd\x66\xbf\x9e\x5b\xce\xb9\xa4\x86\xd7\x56\xbd\xa4\x66\x6f\x57\x26\x4f\x9f\xdb\xcb\x9a\x3b\xc3\xb9\x1c\xac\xf8\xed\xeb\xd5\xbb\x96\x9c\x0f\xe6\x15\x69\x77\x85\x74\x5f\x53\xeb\xf3\xb5\x54\xb1\xa2\x8d\xeb\x46\x9a\x5f\x76\xcd\x3d\x62\x32\xed\x29\x6a\x6a\xed\x3e\xfc\x78\xff\xa3\xca\x61\x9c\xc4\xbe\xc3\x87\x55\xbd\x3b\x36\x4a\xb3\x6b\xf3\xa7\xe2\x74\x84\xf3\x11\x9e\x81\x03\x4f\x6f\xe6\x3b\x82\x4b\x89\xc7\x21\xc6\x21\x4e\x24\x9e\x4b\xaa\x24\x4e\x25\x1e\x49\x2b\x75\x32\xa7\xf1\xa0\xb5\x05\x4c\x24\x8e\x2d\x17\x3c\x7f\x07\x00\x00\xff\xff\x6e\x93\xc8\xab\x1b\x03\x00\x00")
-
-func pa_pkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_pa_pkJson,
-		"pa_PK.json",
-	)
-}
-
-func pa_pkJson() (*asset, error) {
-	bytes, err := pa_pkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "pa_PK.json", size: 795, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _pap_anJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x41\x6a\xeb\x30\x10\x86\xd7\xf6\x29\x84\x40\xbb\x97\x0b\x64\x17\x30\x0f\xba\x70\x5a\x48\x36\x6e\x29\x65\x84\x45\xe2\xd6\x92\xcc\x48\x6e\x08\xa5\xf7\xc9\x15\x02\xd9\xe9\x62\xc5\xf1\x78\x6c\x68\x57\xd6\xa7\x7f\x98\xff\x13\xf8\x2b\xcf\xe4\x43\x21\xd7\x42\x76\xd0\xbd\x6d\xb6\xf2\x5f\x9e\xc9\x02\xce\x41\xae\xc5\x4b\x9e\x65\xb2\x78\x87\xda\xdb\xc6\x1d\xfc\x10\xdd\xb9\xed\x1d\x30\x58\xc0\xc0\x70\x32\x7a\x06\x84\xe0\x1d\x93\x6e\x0c\x3a\xc3\x18\x40\x23\xc8\x3c\x7b\x1d\xfa\x76\x47\x8f\x71\x59\x5a\x53\x59\xdb\x8f\x5f\x4b\x7d\x27\x5a\x80\xc4\xba\x19\xbf\x81\x57\x95\xde\xc5\x23\xef\xa9\xc0\xa5\x1b\x20\x4d\xfd\x37\x1a\x17\x58\x02\xc6\xf1\xb4\xe9\xd0\xb4\x74\x69\x28\xad\xd2\xcd\xcd\xc7\x96\x8e\x8f\xfd\x21\x5d\x43\x4c\x57\x7a\xe6\x2e\x5d\xba\x98\x2e\x56\x1b\xa4\x81\x8f\xe8\x19\xb6\xfe\x73\x99\x15\x26\x10\x2e\xdf\xfd\xcb\x98\x65\x59\x93\x2d\xff\x70\x9c\x15\xd9\x70\x56\x63\x27\xf6\x61\x93\xc9\x61\x53\x3e\x95\x53\xf9\x18\x4e\x49\x01\xd1\x0c\x3f\x86\xaa\x57\xca\xae\xd4\x59\x4e\x97\xfb\xc6\x8e\x01\x08\x55\x0b\xa5\x85\xaa\x84\xda\x0b\xf5\x7c\x9f\xe0\x74\xcf\x48\x1d\x52\xe6\xdf\x3f\x01\x00\x00\xff\xff\x95\x3b\x2a\x50\x70\x02\x00\x00")
-
-func pap_anJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_pap_anJson,
-		"pap_AN.json",
-	)
-}
-
-func pap_anJson() (*asset, error) {
-	bytes, err := pap_anJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "pap_AN.json", size: 624, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _pl_plJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x6a\xf3\x30\x10\xc5\xd7\xf2\x29\xc4\x80\x76\xf9\x72\x80\xec\x3e\xc8\xa6\x50\x43\xa0\xd9\x84\x52\xca\xc4\x16\x8d\xea\x3f\x12\x92\x82\xb1\x4b\x37\x25\x3d\x45\x6f\xd2\x75\x7d\xaf\xa2\xca\x1a\xd1\x66\x37\x33\x6f\xe6\xbd\x1f\x42\x2f\x05\x83\x9b\x2d\x6c\x38\x98\xf6\x71\x77\x0b\xab\x82\xc1\x16\x47\x07\x1b\x7e\x5f\x30\x06\xbd\x92\xf5\xa4\x64\x8b\x41\x61\x60\x74\x1c\xe0\xfc\x26\x9b\x38\x1a\xbc\xb6\xa9\x9e\x3f\xac\xae\x97\xd5\x6a\x1a\xd0\xfa\xa4\x18\xf5\xf5\x4e\x8d\xd3\x47\xed\x11\x0a\xf6\x10\xe2\xee\x4e\xda\xfa\x3f\x99\x94\x46\x19\x39\x80\xec\xb3\x33\xd9\x26\xcf\x52\xf7\xfe\x44\x86\xce\x8f\xd5\x24\xe7\x4b\x5c\x6b\xcf\x7e\x8c\x55\x87\x76\x92\x55\xac\x9b\x41\xc9\x4a\xd1\x52\x87\xcf\x29\x47\xda\x20\x2d\xb7\xca\x50\xed\x94\xb4\x26\x5f\x0c\x76\x92\x2e\xb7\x06\xe7\xcf\xf0\x72\xb6\x57\x4d\xba\x75\x5e\x1b\xac\x63\xf7\x64\xcf\x41\x9e\x2f\xbf\x9e\xe1\x8a\x9b\x90\x89\x98\x70\xaf\x41\x89\x91\x00\x09\x2d\x43\x11\x0c\x71\x24\x82\xff\xe5\xae\x4c\xd1\x51\x4c\xca\x16\xbd\x0c\x7f\x44\xd4\x6b\xd1\xad\xc5\x01\xd2\x70\xaf\xba\x28\xe0\x8a\x8b\x7f\x35\x17\x47\x2e\x0e\x2b\x2e\xf6\x3f\x1b\xa4\xe6\x76\xc9\x00\x28\x5e\xbf\x03\x00\x00\xff\xff\x2c\x6d\xc4\xd4\x7b\x02\x00\x00")
-
-func pl_plJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_pl_plJson,
-		"pl_PL.json",
-	)
-}
-
-func pl_plJson() (*asset, error) {
-	bytes, err := pl_plJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "pl_PL.json", size: 635, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ps_afJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xbf\x6a\xf3\x30\x14\xc5\x67\xf9\x29\x84\x40\xdb\x87\x1e\xc0\x5b\x3e\x42\x69\x06\x43\x21\x5d\xda\x52\x4a\x86\x40\x3a\xb4\x09\x4d\x96\x52\x0a\x2e\xc4\x8a\x29\x1e\xfa\x16\xce\x1f\x43\x30\xce\xd2\x0c\x79\x8e\x7b\x9d\x2d\x4f\x52\x94\xfa\xaa\x52\x68\x17\x23\x9d\x73\x75\xef\xef\x48\x7e\x09\x98\xe8\xb4\x45\xc8\xc5\x68\x7c\xd7\x3a\x13\xff\x02\x26\xda\xbd\xe7\xb1\x08\xf9\x4d\xc0\x98\xd8\x67\xf5\x02\x36\xa8\x61\x8e\x33\x63\x32\x01\x6b\x4c\x4f\x94\x0a\x67\x87\x38\xf3\xc5\x5a\x43\x0e\xa5\xaf\xe1\x0e\x35\x14\x27\x87\x0b\x4c\xe0\xd3\xee\xc8\x0b\xd8\xad\x41\xe9\x0e\x86\x4f\x13\x9f\x47\x11\x06\x2d\x2a\x45\x13\x15\x8d\x21\xab\xa0\xc5\x46\x51\xc7\x68\xf8\x38\x19\xd8\x76\x50\xa0\xc6\x14\x4a\x7c\x6f\x8e\xbe\xc1\x1c\x4a\x4f\x49\x4c\x8e\x5a\x37\x8d\x72\xdc\x41\xb9\xcf\x70\x4a\xee\xfe\xc3\xe6\x48\x51\x3b\xeb\x29\xe4\xd4\x03\xf2\x7a\x05\x15\x2c\xed\x75\xed\x60\x89\x89\x99\x64\xfd\x05\x2c\x31\xfd\x51\x0c\x95\x57\xb1\x86\xaa\xd9\xbb\x37\xf3\x5b\x18\x37\x88\x1b\xc1\x0d\x40\x3a\xc6\x7f\xe3\xbb\xe8\x2e\xb8\x8b\xec\xc2\xba\xa0\x04\xd9\x8a\x2e\x22\x4b\xb7\x55\x98\xd0\x7b\x6c\x15\xa6\xf6\x49\xda\xbd\x49\xdf\xfc\x81\xb0\xe6\xf2\x8a\x9b\xef\x7f\x2e\xfb\x82\xac\xcb\xfb\x87\xa3\x2d\x5b\xdc\xaf\x80\x8c\xcb\x6b\x2e\xcf\x43\x19\x85\xb2\x7b\xac\xb7\xb5\xa7\x62\x43\x22\x0e\xf1\x4a\x76\xbe\x3d\x2e\x47\x87\xb8\x10\xc1\xeb\x57\x00\x00\x00\xff\xff\xd4\xa3\x1a\x76\x04\x03\x00\x00")
-
-func ps_afJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ps_afJson,
-		"ps_AF.json",
-	)
-}
-
-func ps_afJson() (*asset, error) {
-	bytes, err := ps_afJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ps_AF.json", size: 772, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _pt_brJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x92\x4d\x6a\xfb\x30\x10\xc5\xd7\xf6\x29\xc4\x80\x76\xff\x5c\x20\xbb\xfc\x31\x85\x06\xdc\xaf\x78\x93\x96\x52\xc6\x78\xea\x28\x44\x52\x2b\x4b\x21\x4d\xe9\x61\xb2\xca\x41\x7c\xb1\x92\x4a\xa3\xba\xbb\xdf\x7b\x6f\x98\x0f\x5b\x9f\x65\x01\xd7\x15\xcc\x05\xbc\xf9\x97\xff\x0f\xf0\xaf\x2c\xa0\xc2\x8f\x01\xe6\xe2\xa9\x2c\x0a\xe8\xac\x56\xa6\xb7\x17\xbf\x80\x81\xfa\x60\x3a\x8c\xc2\x93\x1b\xcf\x89\xdf\x03\x3a\x9f\x59\x19\xe6\x81\x0e\x19\xc7\x53\x8b\x9d\x85\xb2\x78\xbe\xcc\x58\x6d\xac\xf3\xd3\x41\x95\xd5\xb1\x70\x45\x7d\x84\x86\x5c\x84\xfb\x80\x0c\x8a\x6b\x0e\x09\xc6\x53\xcb\x2d\x6b\x6b\xfc\x26\xf7\xdb\xa2\x21\xe5\xd2\xe2\xaf\xb4\x27\xf7\x2b\x35\xba\xf1\x9c\x18\x5b\xa7\x76\x6c\xab\x64\x6e\x83\xd9\x64\xdc\x31\x62\x6f\x07\x9f\x3f\x85\x27\xdd\x72\x43\x1b\x7c\xc8\xc2\xd8\xfd\x24\xea\xe8\x18\xd5\xf4\xf0\xbf\xab\x2e\xd1\xc4\xda\x2b\xda\x47\xa8\x31\x9d\xbe\x68\x1d\x3b\xe9\xf4\x65\x30\x0c\x69\xed\x05\xff\x9e\x15\xf9\x08\xb7\x21\xc1\x8d\x4d\x0d\x2b\x3a\xf2\x02\x8b\xfa\xae\xe6\xc9\x31\xe4\xa4\x42\x4f\x97\x97\x20\xbb\x99\xd4\x33\xb9\x06\x36\x1b\xa5\x63\x80\x42\x76\x42\xb6\x42\xae\x85\x6c\x84\x7c\xfc\xa9\xc8\x69\x93\x65\x9a\x01\x50\x7e\x7d\x07\x00\x00\xff\xff\x3f\xcc\x98\xf5\x61\x02\x00\x00")
-
-func pt_brJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_pt_brJson,
-		"pt_BR.json",
-	)
-}
-
-func pt_brJson() (*asset, error) {
-	bytes, err := pt_brJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "pt_BR.json", size: 609, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _pt_ptJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4d\x6a\xc3\x30\x14\x84\xd7\xf2\x29\xc4\x03\xed\x9a\x0b\x64\x67\x30\x85\x06\xdc\xa6\x58\x9b\xb4\x94\x22\x63\x61\x0b\x62\xa9\x28\x52\xe8\x0f\x3d\x4c\x57\x39\x88\x2f\x56\x5c\x4b\x93\xd0\xec\x66\xde\x98\x99\xcf\xc6\x5f\x05\xa3\xbb\x8a\xd6\x9c\xde\xc2\xeb\x56\xd2\x4d\xc1\xa8\x52\x1f\x07\x5a\xf3\xe7\x82\x31\xaa\xdc\x68\x6c\xef\xe6\x3b\xa3\x46\xf7\xd1\x76\x6a\x31\x52\xfb\xe9\x94\xf4\x63\x54\x3e\x40\x1b\x9b\x75\xa3\xdf\x21\xa7\x9f\x56\x75\x8e\x0a\xf6\x32\x6f\x34\x83\xf3\xe1\xdf\x10\x46\x30\x80\x76\x54\xa3\xf7\xdc\x9a\x2b\x6b\x67\xc3\x80\xbe\x8d\xb2\xda\xf8\x04\x7e\xab\x8f\xda\x9f\x6d\xad\xfc\x74\x4a\xba\x6c\xbd\xd9\xe7\xb3\x49\xc7\x4d\xb4\x03\xe4\x3e\xcb\xb2\x77\x87\x80\x4f\x11\xf4\xd8\xe6\xc2\x87\x18\x22\xcc\xbd\x3b\x5e\x44\x95\xfe\x5c\xdc\xe5\x8b\x5f\xa1\x02\x13\x80\xa0\x03\x1b\xd0\x00\x06\x2c\x30\x01\x07\x28\xa0\xc8\x00\x65\xbd\xad\xf3\xf2\x12\xe6\xa4\x52\x41\xcf\x7f\x82\xe8\x56\x62\x5c\x89\x1d\xe5\xa3\x34\xe3\x12\x28\x2e\x3a\x2e\x5a\x2e\x76\x5c\x48\x2e\x9e\xfe\x9e\x40\x2a\x61\xd3\x06\x51\xf1\xfd\x1b\x00\x00\xff\xff\xb1\xbe\x93\x8c\x61\x02\x00\x00")
-
-func pt_ptJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_pt_ptJson,
-		"pt_PT.json",
-	)
-}
-
-func pt_ptJson() (*asset, error) {
-	bytes, err := pt_ptJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "pt_PT.json", size: 609, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ro_roJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x10\x86\xcf\xe9\x53\x84\x81\xdc\x64\x1f\x60\x6f\x42\x2f\x8a\x45\x71\x8b\xb0\x88\x48\xda\x46\x77\x64\x93\x2c\x69\xb2\xa0\xe2\xa9\x6f\xa2\x8f\x62\x7d\x2f\xa9\x49\xc6\xc0\x9e\x66\xfe\xf9\x87\x7f\xbe\x40\xde\x2b\x06\x17\x35\xac\x39\x38\xfb\x78\x7b\x0d\x67\x15\x83\x5a\xbe\x8e\xb0\xe6\xf7\x15\x63\x30\x04\x8d\x06\xfb\x79\x5a\x1c\x06\xfb\x60\x30\x76\x5a\xba\x9f\xaf\xdc\xa3\x72\x7d\x70\x49\xbd\xd8\xd4\x1c\xd1\xa8\x3c\x1c\xbf\x3f\x75\x37\x4f\x7e\x9e\xa0\x62\x0f\xcb\x95\xcd\xce\x3a\x5f\x9e\xaa\x43\x5c\xbd\x4a\xb5\x91\xa9\xa6\x88\x4b\x1b\xeb\x5d\xd2\x9b\x2e\x47\x35\xd6\xf8\x1d\xe5\xa0\x34\x41\x3a\x54\x71\xeb\x49\x75\xae\x90\x5a\x3a\x9f\x7b\x79\x70\xb8\xff\x37\x52\x2c\x06\x93\x67\x18\xc8\x96\xe1\x39\x8c\x3e\xbd\x45\x1d\xbc\xd2\x1d\x65\xda\xde\xdb\x42\x1a\x8b\xa5\x3b\xa8\x3e\xc9\xf2\xe1\x27\xc8\x44\x4b\x9c\x04\x79\x0a\x48\x78\x04\x47\x64\x84\x94\x61\x8e\x84\x91\x01\xce\x9b\x9b\x26\x5f\x8e\x66\x76\x6a\xe9\xd5\xf2\x17\xc4\xb0\x12\x7a\x25\xb6\x90\x87\x2d\xea\x68\x48\x2e\x06\x2e\x3a\x2e\xb6\x5c\xb4\x5c\xbc\xfd\x6d\x90\xdb\x92\x4c\x37\x00\xaa\x8f\xdf\x00\x00\x00\xff\xff\x52\x6d\x61\xef\x63\x02\x00\x00")
-
-func ro_roJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ro_roJson,
-		"ro_RO.json",
-	)
-}
-
-func ro_roJson() (*asset, error) {
-	bytes, err := ro_roJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ro_RO.json", size: 611, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ru_ruJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\xcd\x4a\xf3\x40\x14\x5d\x27\x4f\x11\x06\xb2\xfb\xc8\x03\x74\xf7\x41\x37\x2e\x0a\xa2\x75\x51\x44\xa4\x52\xa1\x2e\x6a\xa1\xd6\x85\x88\x90\xa4\xe2\xaa\x98\x52\x5c\xb8\x50\x4b\x41\xe8\xd2\x24\xed\x40\x4d\x4d\x7c\x85\x73\xdf\x48\x26\x99\x99\x24\xba\x9b\xf3\x93\x3b\xf7\x9c\xcc\xad\x69\xb0\xbd\x26\x6b\x58\x6c\x74\x7d\x7a\x70\xc4\xfe\x99\x06\x6b\x76\x6f\xae\x58\xc3\x3a\x36\x0d\x83\x61\x8e\x8c\x3c\x24\xe4\x82\x93\x07\x8e\x94\xa6\xe0\xc2\x66\x30\x2c\x90\x21\x05\xc7\x06\x1c\x3b\x9a\x22\xc5\x16\x89\xd4\xe6\xe4\x23\x23\xb7\xc6\x2d\xc5\x14\x6c\x10\x4a\xbc\x02\x27\x1f\x31\x38\xb9\x58\xab\x99\x14\x90\x2f\xbe\xa2\x07\xed\x5b\xd2\x04\x11\x22\x64\xe4\x23\x64\xa6\x71\x22\xb6\x3c\xec\x0f\x47\xe3\xfa\xaa\xe4\x39\x7a\xb3\xd4\x29\x17\x71\xca\xfb\xd5\x71\x55\xb2\x8b\x8a\x01\x91\xa3\xe6\xb7\x86\x97\xe3\x7e\x39\xfc\x03\x29\x62\x84\xe4\xd2\x54\x9a\xdf\xc1\x11\x93\x8b\x50\x64\x97\xdc\x4b\xee\xf0\x25\x9a\xe1\x3b\x4f\x5c\xd3\xf1\x29\xcf\xcf\xf4\x28\xda\xac\xa0\xd2\x37\x43\x8c\x35\x4d\xc8\xd3\xb3\x96\x79\xf7\x3e\x05\x88\x2a\x3b\xbc\x21\xf9\xc3\xbd\x22\xfb\xc5\x3c\x81\x23\x41\x28\xb9\x6a\x7f\xf5\x90\x14\x88\x90\xb2\x0c\xba\x2f\xf2\xa9\x6e\xbe\x8a\x68\xfa\xa7\x84\x22\x5c\x55\x0c\xe4\x79\x9b\xe7\xaa\xa2\x9d\x46\xa1\xc8\xa5\x2e\x28\x9e\x93\x2e\x3f\x13\x59\x14\x48\x8b\x10\x0a\x8a\x17\x96\xe8\x5f\xf3\xbf\xb5\xdf\x52\x3b\x17\x06\xa5\x34\xbb\xe3\x73\xf1\x98\xed\x9e\x63\x0f\x1c\xbb\xc3\x14\xd9\xbe\x18\x14\x42\xd7\xb2\x7b\x96\x7d\x66\xd9\x1d\xcb\x6e\xe7\xb2\x96\x4a\x28\x2f\x60\xcc\xbc\xfb\x09\x00\x00\xff\xff\x57\x94\xd2\x18\x21\x03\x00\x00")
-
-func ru_ruJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ru_ruJson,
-		"ru_RU.json",
-	)
-}
-
-func ru_ruJson() (*asset, error) {
-	bytes, err := ru_ruJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ru_RU.json", size: 801, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ru_uaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xcd\x4a\xc3\x40\x14\x85\xd7\xc9\x53\x84\x81\xd9\x49\x1f\xa0\xbb\x42\x37\x2e\x0a\x82\x75\x51\x44\xa4\x52\xa1\x2e\x6a\xa1\xd6\x85\x88\x90\x4e\xc1\x55\xb1\xa5\xb8\x70\xe1\x0f\x05\xa1\x4b\xd3\xb4\x03\x1a\x49\x7c\x85\x73\xdf\x48\x26\x9d\x49\x6f\xfd\xd9\xe5\x9e\x73\x33\xe7\x3b\x93\x5c\xfb\x9e\xd8\xad\x8a\x72\x20\x7a\x97\xc7\x07\x15\xb1\xe3\x7b\xa2\xda\xbc\xba\x10\xe5\xe0\xd0\xf7\x3c\x81\x29\x32\x1a\x20\xa1\x10\x9a\x06\xd0\x48\x69\x04\x6d\xd6\x3c\x81\x17\x64\x48\xa1\xb1\x82\xc6\x27\x8d\x90\xe2\x1d\x89\xf5\xa6\xa4\x90\x51\xb8\xa5\xcd\xcc\x29\x58\x21\xb2\xf3\x1c\x9a\x14\x62\x68\x0a\xb1\x74\x67\xd2\x98\x94\x79\x8b\x6e\x8b\xbd\x19\x0d\xb1\xc0\x02\x19\x29\x44\xc2\xf7\x8e\x0c\xe5\x7e\xbb\xdb\xeb\x6f\xa3\x1a\xd0\x02\x2d\xc5\x8a\xa3\x30\x04\xa7\xcf\x4d\x78\x11\xab\x90\xf2\x38\x17\x53\xeb\x9e\xf7\xdb\x9b\x8c\x37\xa4\x88\x11\x51\x48\x23\xbb\xfd\x0a\x8d\x98\x42\x44\xe6\x0a\xac\xf6\x98\x6f\x28\x3b\x4d\xf0\x95\x17\xdf\xf2\xf1\x61\x9f\x1f\xe8\xce\x5c\x2a\x9b\x36\x7b\x13\xc4\x58\xd2\x90\x06\xc5\x59\xb3\xfc\x13\x28\x1a\x63\xc1\x18\x9e\x91\xfc\xd2\x9e\x90\xfd\x50\xee\xa1\x91\x20\xb2\x1a\xbf\xc6\xbf\x4a\xf2\x7a\xbc\x16\x2f\xf5\x5f\x1d\x5e\x86\x57\xe1\x25\x38\x3a\x47\xe6\xb0\x0e\xb2\x52\xdb\xab\x39\xba\xb5\xef\x9c\x6a\xb3\x7f\x6a\xfe\x5e\xd9\x2a\xc9\x4e\x49\x36\x84\x13\xeb\x67\x9d\xb5\xd1\x0c\x64\x2b\x90\x27\x81\x6c\x04\xb2\x9e\xdb\x85\xb5\x19\x6d\x80\x10\xfe\xcd\x77\x00\x00\x00\xff\xff\x69\x11\xf3\x48\x12\x03\x00\x00")
-
-func ru_uaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ru_uaJson,
-		"ru_UA.json",
-	)
-}
-
-func ru_uaJson() (*asset, error) {
-	bytes, err := ru_uaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ru_UA.json", size: 786, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _rw_rwJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x4a\xc3\x30\x14\x86\xaf\xdb\xa7\x08\x81\xdc\xc9\x1e\x60\x77\xc2\x60\x88\x74\x88\x6e\x8c\x29\x22\x7f\xd6\xd0\x86\x9a\x14\xd2\x84\xd2\x8a\xef\x2e\xb5\xc9\xb1\xab\x77\xcd\xf9\x4e\xfe\xf3\xe5\xd0\xaf\x3c\xe3\x0f\x3b\xbe\x65\xdc\xf5\x1f\xcf\x67\x7e\x97\x67\x7c\x87\xa1\xe3\x5b\xf6\x96\x67\x19\x7f\x0c\xec\x3a\x04\xd3\x2b\x17\x26\x36\x15\x7a\x30\x23\x95\x53\x8b\x73\x03\xa9\x9d\x5e\x14\x2a\x78\xf8\x70\xd3\x61\xd5\x8a\xdb\xb0\x2e\x94\xd3\x9d\x3c\x7b\x9f\x24\x5e\xea\xd6\xf9\xa5\x49\xd1\xc7\x80\x42\xa6\x24\xc8\xf9\x63\x4f\xa3\x60\x63\x25\x85\xef\x6d\x99\x12\x8b\xd6\xfa\xfa\x2f\x2e\x78\x38\x18\xc4\x36\x74\xf5\x00\xeb\x91\x9e\x75\x56\x2e\x38\x9a\x08\x9f\xfa\xf4\x35\x38\xd8\x2e\xbd\x15\x46\xd9\x88\x0e\x03\x1a\xd8\x0a\x64\x42\xe1\x87\x51\x7d\xc6\x0b\xa7\xa6\x47\xa3\x5d\x04\xa7\x2a\x74\xf5\xa0\x6d\xd5\x26\x1a\x64\x3b\xe2\x66\x05\xff\xac\x49\x98\x44\x49\x92\x1c\xc9\x8e\xd4\x56\xfb\x39\x8c\x8a\x84\xc8\x85\x24\x92\xc0\x7d\xf1\x54\xa4\xc9\x33\x4c\x64\x07\xaf\xa6\x9f\x46\x94\x1b\x61\x36\xe2\xc2\x53\xf1\xa8\xcd\x0c\xc0\x44\xc9\x84\x64\xe2\xc2\xc4\x91\x89\xd7\xdf\x0e\xa2\x47\x3a\xc6\x19\x9c\xe7\xdf\x3f\x01\x00\x00\xff\xff\xfd\xb4\x59\xd4\x8c\x02\x00\x00")
-
-func rw_rwJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_rw_rwJson,
-		"rw_RW.json",
-	)
-}
-
-func rw_rwJson() (*asset, error) {
-	bytes, err := rw_rwJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "rw_RW.json", size: 652, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sa_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\x41\x4a\xc3\x40\x14\x86\xd7\xc9\x29\x86\x81\xd9\x79\x82\xec\x0a\xdd\x64\x11\x11\xea\x46\x45\x24\xa2\x50\x17\x5a\xb1\xd9\x88\x08\xb6\x21\x28\x88\x4b\x11\xa4\xe0\xe6\x45\x43\x2d\x21\x14\x2a\x29\x48\xbd\xca\x3b\x8a\xcc\x4b\x27\x99\x4e\xa7\x78\x01\x37\x25\x7d\xff\x3f\x7f\xff\xef\x4d\xc9\x8d\xeb\x70\xbf\xcd\x3d\xc6\xfb\xe1\x91\xbf\xcd\xb7\x5c\x87\xb7\xc3\xeb\x3e\xf7\xd8\x81\xeb\x38\x1c\xa1\x40\x98\x21\xfc\xd0\xe7\x02\xa1\x44\x28\x3c\x69\x93\x5a\x89\xe9\x23\x42\x6e\xd5\x72\x84\x21\xc2\x0b\xc2\xd4\x2a\x4f\x30\x1d\x20\x7c\x6c\xd2\x62\x84\x39\xe5\x3f\x21\x8c\x11\xc0\x68\x80\x10\x2b\xf3\x17\x05\x3d\x93\xb3\xd0\x3d\x8d\x01\xb2\x35\x00\xd7\x39\x94\xa8\x9d\x6e\xef\x2a\xda\xc0\x1b\x1b\x94\x16\x32\x93\xe6\x4f\x02\x7b\x6b\xa3\x69\xdd\x2e\xe8\x5d\x44\x5d\xad\xda\x88\xf4\x99\xec\x98\xde\xa9\x43\x9f\x08\xdf\xaa\xb5\x3e\xcf\x89\xb6\xa0\x5f\x78\x55\xc3\x44\x76\xa9\x36\x95\xde\x23\x4c\x35\xf3\x83\x7a\x1e\x61\x3a\x44\xc8\xb4\xaf\x03\xba\xc4\x85\xe6\x49\x88\xbf\x82\x83\x66\x4f\x12\x11\x64\x9a\x9c\x4f\x34\xb2\x44\xb1\xbe\x51\xb8\x2e\x55\x44\xeb\x47\xde\x29\xad\x5c\x91\xf4\x5b\xfb\x5f\x8e\x7d\x39\xad\x60\x27\x68\xb6\x32\xa6\xc8\x0a\xb4\xfa\xff\xcf\xe9\x39\x5b\x81\x96\x1b\xd2\xa4\x65\x52\x3b\x8c\x4e\xe5\x9b\x41\xb4\x98\x38\x61\xe2\x98\x89\x3d\xae\xe6\xbb\x67\xe7\xa6\xc6\x84\xef\x89\xc0\x13\x1d\x26\x2e\x99\xd8\x27\x6b\x6d\xab\xa5\x66\xbe\x2c\xca\x8d\x63\xee\xed\x6f\x00\x00\x00\xff\xff\xf1\x60\x9a\xd8\x94\x04\x00\x00")
-
-func sa_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sa_inJson,
-		"sa_IN.json",
-	)
-}
-
-func sa_inJson() (*asset, error) {
-	bytes, err := sa_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sa_IN.json", size: 1172, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sc_itJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x4e\xc3\x30\x10\x45\xd7\xce\x29\xac\x91\xbc\x43\x3d\x40\x77\x51\x23\x50\x51\x23\x21\x35\x1b\x40\x08\x8d\xeb\x8a\xba\x6a\x26\xc8\x89\x17\x01\x71\x97\x2e\x59\xb0\xe2\x0a\xbe\x18\x32\xb1\x27\xa8\xec\xe6\xfb\x3b\xff\xbf\xb1\xf2\x5e\x08\x58\x57\xb0\x94\xd0\xef\x9e\xd7\x0d\x5c\x15\x02\x2a\x1c\x7b\x58\xca\xc7\x42\x08\xa8\xba\x36\x7c\xd1\x8b\x8f\x86\x80\x8d\x27\xdb\x4f\x63\x8d\x6e\xe0\x39\x7c\xba\x9d\x77\x59\xde\x86\x6f\x6d\x71\x9a\x57\x7b\x0a\x67\x8d\x2e\xc9\x6d\x14\xc6\x43\x21\x9e\x62\xd3\xf6\xd0\xb9\xe1\xa2\x8e\x9b\xb8\x67\x2e\x99\xf3\x39\x7d\xce\xcd\xa1\x75\x47\xc3\x81\x13\x6f\xf6\x44\xe8\x8e\x69\x81\x6b\x67\x67\x11\x57\x78\x4b\x73\xa9\x9d\x3d\xd9\x7c\x3e\xe6\x7d\xc3\xb9\x7d\x45\x83\x69\xb1\xd2\x1d\xbb\x13\x0b\xdf\x0f\xe9\xda\x0a\xb5\x37\x48\x94\xbe\xdf\xa0\x09\xe7\xd6\x66\x59\x75\x44\x23\xf6\x48\xf9\xfa\x3a\x06\xfe\x7d\x81\x7f\xc4\x0c\x7b\xf1\x06\xa5\x76\x8c\x38\x13\x32\x1c\x83\x31\x16\x03\x31\x0a\x23\x64\x82\xb2\xbe\xab\x73\xf5\x64\x66\xa7\xc2\x61\x1f\xff\x0c\x65\x16\x52\xb5\x0b\xa9\x46\xc8\xc7\x8d\x6d\x27\x0b\xa5\x32\x52\x69\xa9\xee\xa5\x6a\xa4\x7a\xf8\xbd\xc1\x6e\xc3\x32\xb5\x00\x14\x1f\x3f\x01\x00\x00\xff\xff\x60\x06\xf2\x42\x73\x02\x00\x00")
-
-func sc_itJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sc_itJson,
-		"sc_IT.json",
-	)
-}
-
-func sc_itJson() (*asset, error) {
-	bytes, err := sc_itJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sc_IT.json", size: 627, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sd_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x52\xcd\x4a\xf3\x40\x14\x5d\x4f\x9e\x62\x18\x98\xdd\xf7\x04\xd9\x15\xba\xe9\x62\x3e\x0a\x75\xa3\x22\x52\xa9\x50\x17\x5a\xb1\xd9\x88\xb8\x10\x92\x74\x08\x42\x7c\x05\xbb\x89\x84\x42\x09\x59\x4a\xf2\x1c\xf7\xcc\xd3\xc8\xd8\xc9\x24\xf6\x05\x04\x77\x39\x3f\xf7\x9e\x73\xc3\x3c\x05\x4c\x4c\xc6\x22\xe4\x62\xbd\xb8\x9c\xfc\x17\xff\x02\x26\xc6\xf3\xc7\xb5\x08\xf9\x79\xc0\x98\xa0\x2d\x55\x54\x42\x53\x41\x15\x72\x2b\x33\x41\x35\x34\x92\x1e\x23\x41\x6a\xf6\x88\x3d\xfe\x44\x6e\x5e\x07\xba\xa6\x1a\x2d\x95\x6e\x7a\x87\x1c\x09\xf4\x01\x99\x0d\x52\xb3\xa1\x4a\x04\xec\xc2\x66\xcf\x96\xab\x87\xe8\x37\x0b\xa8\xd5\x5d\xb4\xec\xd3\x77\x48\xa1\xa9\x42\xe6\x36\xbd\xd0\x07\x55\x3f\x98\xc4\x36\x33\xa9\xdb\x5d\xa0\xb5\x1a\x62\xdf\x2c\xf3\xa9\x1a\xe9\xe0\x3b\xa6\x82\xde\xf1\xe6\x98\xad\xd9\x53\x8d\xc6\xdf\x97\xa1\x45\x83\x0c\x89\xcd\xf3\x9e\x12\x0d\x74\xcf\xd8\x6e\x43\x87\xc9\xa8\x76\x78\xf8\x3b\xff\xd6\x49\x23\x35\x55\xdd\x2d\x23\x75\x70\x4d\x55\xa7\x8e\xe7\xd1\xb5\x7d\xcd\x72\xc4\xe5\x82\xcb\x2b\x2e\x4f\x45\xc7\x9f\xdc\xdc\x1e\x6b\x5c\x4e\x42\xa9\x42\x39\xe3\xf2\x9e\xcb\xb3\x6f\xab\xb7\x79\xa9\xe7\x5d\xb8\x38\x1a\x0b\x9e\xbf\x02\x00\x00\xff\xff\x5e\x5b\xd2\xff\x48\x03\x00\x00")
-
-func sd_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sd_inJson,
-		"sd_IN.json",
-	)
-}
-
-func sd_inJson() (*asset, error) {
-	bytes, err := sd_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sd_IN.json", size: 840, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sd_inDevanagariJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x54\x41\x4b\x32\x51\x14\x5d\xcf\xfc\x8a\xc7\x83\xb7\xfb\x98\x1f\x30\xab\x4f\x70\xe3\x62\x22\xb0\x4d\x45\xc4\x84\x92\x2e\xd2\x50\x09\x22\x82\x7c\x90\x2e\xc2\x65\x04\x29\xb8\xb9\x8f\xa2\x62\x10\x41\x70\xe5\xfc\x95\xf3\x53\xe2\x5d\x7d\x33\xa3\xae\x5a\x06\xed\x66\xce\x39\xf7\xdc\x73\xcf\xc0\xdc\xf9\x9e\xac\x94\x65\x28\x64\xb7\x76\x5e\x39\xf8\x5f\xab\xdf\xc4\xad\xf8\x32\xee\x34\xe5\x3f\xdf\x93\xe5\xf8\xb6\x2b\x43\x71\xea\x7b\x9e\x04\x0d\x40\x33\x98\x11\x88\x40\x0b\xd0\x8a\x5f\xb5\x15\x5a\x76\x09\xa3\x41\xc9\x36\x98\x80\x34\xe8\x05\x34\xcf\x41\x93\xc2\xf4\x41\x6f\xdb\xca\x05\x28\x65\x93\x11\xe8\x83\x57\xa4\x8e\x9a\xb0\x3e\x81\x79\x72\xc8\x98\x6d\xc7\xa0\xa5\xf4\xbd\x33\x9b\xb4\xda\x68\x77\x7a\xbf\x27\x6e\xd4\x6e\xf5\x1a\x85\xac\x13\xd0\x3b\x9b\xce\x60\x1e\xdc\xd8\x27\xe8\xcb\x22\xbb\x78\xe2\x8e\x19\x81\x5e\x0b\x8b\x1f\x6d\x14\x0b\xce\x60\x86\xa0\x79\xae\x37\xc3\x42\x38\xcd\xbb\xd2\x6d\x64\xce\x9e\x99\x6c\xc0\x2d\xac\xcf\x9b\xc2\xf4\x0b\xad\x0d\xdd\x96\x29\x3f\xeb\x4d\xc8\xbc\x9a\x01\xe8\xd9\x09\xf6\xd9\xf5\x99\x7b\xb8\x59\x6d\x0a\x2d\x52\xc5\x6f\xfb\xd7\xd8\x0f\x1a\x2b\x45\x87\x51\x5e\x55\x12\x70\x00\x1d\x64\xe7\x05\xec\xaa\x03\xa7\x2f\xc7\xbd\xba\xfd\x09\xa8\x92\x50\x35\xa1\x2e\x84\x3a\x96\x0e\x3f\x6a\x5e\xed\x72\x42\x55\x42\x15\x85\xaa\x2a\xd4\xb5\x50\x27\x2c\xcd\x64\x19\x95\xe3\x9b\x38\x72\x67\xcc\xbf\xff\x0e\x00\x00\xff\xff\x83\x24\x88\xdf\x7f\x04\x00\x00")
-
-func sd_inDevanagariJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sd_inDevanagariJson,
-		"sd_IN@devanagari.json",
-	)
-}
-
-func sd_inDevanagariJson() (*asset, error) {
-	bytes, err := sd_inDevanagariJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sd_IN@devanagari.json", size: 1151, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sd_pkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x92\x31\x4a\x03\x41\x14\x86\xeb\xdd\x53\x0c\x0f\xa6\x13\xd2\x6f\x17\x49\x61\x90\x85\x40\x6c\x44\x44\x02\x11\x62\x11\x23\x26\x4d\x10\x0b\x21\xbb\x59\x06\x0b\xaf\x60\x0a\x37\x48\x54\x86\xd5\x22\x45\x72\x8e\xf7\xcf\x69\x64\xcc\xcc\x64\xf1\x00\x16\x76\xfb\xff\xef\x63\xbf\x79\xc3\xdc\xc5\x11\xb5\x5b\x94\x08\x1a\xf7\x2f\x3a\xc7\x74\x10\x47\xd4\xea\x4d\xc7\x94\x88\xb3\x38\x8a\x88\x17\x26\x67\x6d\xeb\x88\xb8\x42\x81\x2c\xa4\xd2\x68\x2e\x59\xa3\xf0\x99\x35\x2f\x79\xed\xd2\x3b\x32\x28\xae\x5c\x5a\x21\xe3\xb5\x27\xcd\x1c\xb9\x99\xb3\xa6\x38\x3a\xb7\xbe\xee\x60\x74\x3b\xf9\x6b\x69\x3a\xba\x9e\x0c\xf6\xc6\x15\x72\x14\xac\xa1\x76\x34\x1e\xa0\x78\x69\x3d\xb5\x2e\xb3\x36\x93\x7b\x33\xb6\x76\x86\x59\x98\xbe\x78\x92\x57\x28\x90\xd7\xbe\x67\x5c\xf2\x33\x9e\x5c\xb3\x30\x1f\x5c\x61\x13\xf6\x53\xd8\x62\x03\x85\xcc\x1a\x03\xf3\x8a\x0d\x8a\x7d\x63\xcf\x57\x27\x8c\xe2\xca\xe5\xfa\x35\xfe\xbf\xb5\x9a\x69\x27\x0d\xfb\x7c\xf1\x92\xdf\xdc\xbf\x3e\xb9\x44\xe6\xa9\x56\x6f\x72\x69\x5f\xb1\xec\x37\xe4\xb0\x21\xa7\xe4\xcb\x93\xab\xe1\x6e\xd0\x14\xb2\x2f\xe4\xa1\x90\xa7\xfc\x28\xe4\x51\x22\xd3\x44\x76\x7f\xb0\x80\xfc\x2e\x9d\x9a\x64\x7b\x37\x10\xf2\x86\xe2\xfb\xef\x00\x00\x00\xff\xff\x84\x1a\x3d\xf2\x33\x03\x00\x00")
-
-func sd_pkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sd_pkJson,
-		"sd_PK.json",
-	)
-}
-
-func sd_pkJson() (*asset, error) {
-	bytes, err := sd_pkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sd_PK.json", size: 819, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _se_noJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x4f\x6a\xf3\x30\x14\xc4\xd7\xf6\x29\x84\x40\xbb\x24\x07\xc8\xee\x83\x6c\xbe\x45\xda\x42\xb3\x49\x4b\x29\x4f\xb5\x50\x6c\xc7\x7e\xa0\x7f\x50\x4a\x4f\x10\xb2\xc8\x11\x7c\x16\x3b\xf7\x2a\xae\x63\x3f\x39\x74\x39\xf3\xc4\xcc\x6f\x8c\xbf\xd2\x84\xff\xdf\xf0\x35\xe3\x56\xbd\x3f\x3c\xf2\x45\x9a\xf0\x0d\x7c\x5a\xbe\x66\xaf\x69\x92\x70\x8b\xae\x06\xa9\x20\x0f\x79\x7f\x4b\x78\xf0\x68\x6d\xdb\x18\x0d\x83\xae\xe0\x7a\xba\x9e\x94\x84\xc9\xd1\x60\x4b\x08\x70\x28\x4b\x3f\x18\x99\x47\x03\x36\x03\x37\x48\xa9\xc0\x14\x30\xc9\x63\xdb\x84\x00\xa6\xd7\x69\xf2\xd6\xf7\x3f\x1f\xd0\xb8\x7b\x08\x6a\xa7\x5e\xea\xa3\x22\xea\xa0\xf8\x31\x78\x8b\xb5\x3b\x4c\xa9\xd8\x5d\xba\x0b\x14\xa0\x55\xd5\x36\xf5\x8d\x55\x7b\x0c\x01\x22\xa3\x2e\x7c\xd9\x9d\x63\xe7\xc3\xe3\xf5\x84\x91\x51\xe5\xca\xda\x59\x88\x82\xb9\x61\x3d\xe6\x59\x1d\x3b\x12\xcd\xac\xb7\x3b\xc3\x5d\x8d\xc6\xa3\xd6\xe8\xe2\x94\xb2\x6d\x64\x15\xbf\x29\x3c\x86\xe3\xcd\x88\x3f\xde\x1f\x43\x0b\xda\x47\xc3\xa2\x41\x34\x85\x36\x10\x3c\x41\xc7\xb8\x04\x1a\x01\x12\xd9\x88\xf4\x6f\xfb\xb4\x1d\x59\x86\xeb\x78\xd9\x80\x53\xfd\xbf\x27\xf6\x4b\x51\x2d\x45\xc6\x47\x73\x97\x57\xc3\x01\x16\x4c\x48\x26\xd4\x8a\xc9\x15\x13\x7b\x26\x76\x4c\xbc\xfc\x3e\x9b\x9e\xec\x26\x79\x2b\xe2\x3c\xfd\xfe\x09\x00\x00\xff\xff\x3b\x40\x48\xba\xd8\x02\x00\x00")
-
-func se_noJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_se_noJson,
-		"se_NO.json",
-	)
-}
-
-func se_noJson() (*asset, error) {
-	bytes, err := se_noJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "se_NO.json", size: 728, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _shs_caJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\xc1\x6a\xdc\x30\x10\x86\xcf\xf6\x53\x08\x81\x6e\x85\x1c\x17\xf6\xb6\x64\x7b\x08\xc5\xb0\xc5\x29\xa5\x2d\xa5\x6c\x9d\x81\x35\x1e\x3b\xd6\xce\x80\x12\x4a\x1f\xa0\x67\x3d\x85\x9e\x43\x57\x3d\x54\x71\x2c\x69\xad\x1c\x0c\x33\xdf\x8c\xe6\xff\x3d\xd2\x9f\xba\x92\x0f\x47\xb9\x17\x92\x2e\xf4\xeb\xfe\x20\x3f\xd4\x95\x3c\x9e\x5f\x49\xee\xc5\x8f\xba\xaa\x64\xfb\x02\x4c\x33\x90\x0e\x96\x97\x62\x25\xdb\x19\x78\x28\x00\x60\x91\x0e\x80\x25\x18\x81\x8a\x9c\x09\x70\x20\x2e\x99\x1e\xe1\xc6\xea\xea\xe7\x62\xa4\xbd\x3c\x5f\xf9\x9d\x9b\x6c\x22\x8b\x67\xd9\x2c\x97\x75\xf2\xf0\x34\xb1\x79\x9e\xf8\x92\xc7\x9d\x00\x71\x30\xc0\xc1\x8e\xfd\xb4\xf6\x9e\x00\x3b\xa6\x7e\x36\x30\x05\xcb\x70\xa3\x48\xda\xbb\x39\x8d\x3c\x01\x21\x7a\x67\x8a\x8e\xdd\xf2\x79\xb7\x43\xd4\x05\x67\x9a\xbd\x9b\x98\x86\x0d\xd2\x06\x50\x07\x6b\xbc\xc3\xbc\x84\xa5\xd0\x71\xb0\xde\xbd\x00\x06\xdb\x6d\x86\x90\x06\xd4\xe8\x1d\x6e\x19\xe2\x72\x9c\x0a\xad\xee\x66\xa2\x5b\xdc\x05\xbb\x11\x05\xf6\x8e\x75\xb0\x30\x16\x1b\x2e\x97\xf2\xc9\xc4\xfd\x3d\x52\x1f\xf7\xa7\x23\xf1\xff\x4c\xb4\xfa\x11\x31\x35\xcd\x31\xd0\x66\x0d\xee\xdf\xfe\x60\x8d\x3f\xa7\xdb\xf9\xea\x5d\x8c\xbe\xec\xd2\x49\xc8\xf7\x7c\x68\x4e\x4d\x92\x3f\x34\xd1\x70\x93\xaa\xc7\x33\xc3\xf2\x42\xd5\xd3\x9d\x1a\xef\xd4\xab\x4c\xf0\xb1\x1f\xd7\xc2\x59\xa8\x27\xa1\x7e\x0b\xf5\x4d\xa8\xab\x50\xdf\xdf\x3a\x72\xf5\x9a\xd3\xa8\x23\xd5\xc3\x5e\x35\x7b\xd5\x0a\x35\xcb\xfa\xef\xff\x00\x00\x00\xff\xff\x91\xac\x62\x7c\x04\x03\x00\x00")
-
-func shs_caJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_shs_caJson,
-		"shs_CA.json",
-	)
-}
-
-func shs_caJson() (*asset, error) {
-	bytes, err := shs_caJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "shs_CA.json", size: 772, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _si_lkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x53\xcf\x4a\x3a\x51\x14\x5e\xcf\x3c\xc5\x70\xe1\xee\x7e\xfa\x00\xb3\xfb\x81\x8b\xa4\x06\x02\xdb\x44\x44\x08\x05\xba\x30\x23\xdd\x54\x04\x82\xba\x68\x20\x14\x19\x02\x43\x09\xdc\x87\x48\x72\x4e\x24\xbe\xcb\xa1\x27\x89\x7b\x66\xe6\x7a\xe7\xde\xdc\xb5\x9c\xef\x7c\xe7\x7c\x7f\x2e\xf3\xe0\x7b\xa2\x5a\x11\x61\x20\x3a\xcd\x8b\xa3\x43\xf1\xcf\xf7\x44\xa5\x7e\xd7\x11\x61\x70\xe6\x7b\x9e\x20\x78\x22\xd8\x10\x4e\x08\x96\x84\x23\x35\xf7\x04\x61\x9f\xe0\x83\x30\x29\x80\x30\x24\x78\x23\x1c\x30\x3f\x21\xec\x11\x8e\x8a\x04\x48\x3f\x6d\x10\xe3\xef\xde\x33\x6f\x0d\xd4\x65\x8c\x09\xd6\x04\xef\x2c\xba\xe2\xcf\xa2\xb4\xc2\x5f\x59\x7d\x63\x5f\x53\xd3\x29\x6f\xf5\x6d\x82\xef\x9d\xab\x70\xb5\x46\xfb\xb6\x6b\x25\xd4\xcb\x3a\x89\x36\xe7\xba\x34\x7d\x98\xaa\xb9\x40\xd4\xbe\xee\x36\x8c\xeb\x0b\xb6\x93\x96\xb1\xd9\xed\xc0\x9a\x9d\x02\x83\xee\xf4\x33\x47\x62\x6e\x22\x31\x3a\x5e\x1b\x85\x4d\x08\xbe\xf8\xce\x96\x30\x36\x76\xc7\x8c\xeb\x6b\x0b\xc2\x17\xb6\x61\x21\x5b\x03\x19\x12\xcc\x08\xe7\xf9\x0b\x18\xa2\x0a\x19\xa7\xba\x8c\x8f\x59\x22\xce\xcd\x6b\xdd\x84\x9f\x25\xe5\xcc\x9d\xe9\x8a\x70\xc6\x49\xf7\xad\x2f\x55\x90\x4c\xab\x48\x30\x9f\xee\xb7\x7a\xad\x4a\xdd\x0e\xf7\xb5\xf7\x27\x8d\xb9\x2d\x59\x85\x18\x0d\x4c\xd3\x06\xdc\xc8\x79\xc6\xff\xd1\x71\xb4\x0b\xa7\x02\x95\x09\x7b\x65\x9d\x30\xfd\xca\xc8\x95\x7a\xf7\x4a\xfd\xba\xf2\xb4\x24\x5b\x25\x79\x29\x72\xf0\xa4\xd9\x2a\x0c\x02\x79\x10\xca\x28\x94\xb5\x40\xde\x33\x49\x13\x32\x5c\x83\x99\xbe\x90\x37\x81\xac\x66\x33\xff\xf1\x27\x00\x00\xff\xff\x86\x72\x66\xe7\x26\x04\x00\x00")
-
-func si_lkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_si_lkJson,
-		"si_LK.json",
-	)
-}
-
-func si_lkJson() (*asset, error) {
-	bytes, err := si_lkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "si_LK.json", size: 1062, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sid_etJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcf\x6b\xbc\x30\x10\xc5\xcf\xfa\x57\x84\xc0\xdc\x84\xbd\x7b\xf3\x8b\xdf\xd2\x5d\x48\x7f\xb9\x87\x6e\x4b\x29\xa3\x86\x2a\xbb\x31\x92\xc4\x8a\x94\xfe\xef\xc5\x26\x99\x5d\xba\xb7\x79\x6f\x86\xf7\x3e\x62\xbe\xd2\x84\x6f\x4b\x9e\x33\x6e\xfb\xf6\xfd\xff\x9e\x67\x69\xc2\x4b\x5c\x2c\xcf\xd9\x6b\x9a\x24\xbc\x42\x55\xa3\xc3\xd5\x5f\xc5\xb0\x68\x3f\x0a\xc4\x63\x6f\xcf\xfa\x49\xeb\x59\xfa\xf1\x16\xd5\x64\xc3\x5c\x98\x3a\x4c\x8f\x7d\x8b\xa8\x24\x4f\x93\xb7\xb5\xa3\xea\xb4\x71\x7f\x8a\xa8\x24\x56\x1c\x63\xf6\x4c\xc9\x14\x4b\xa9\x31\x51\xe8\xc1\x75\x14\xb7\xc3\x61\x42\xb3\xf8\xab\x1b\x59\x9b\xb3\x12\x68\x9a\x2e\xe4\x8c\xa6\x3f\x45\x37\xac\x77\xd3\x20\xe3\x74\x0a\x5e\x31\x7d\x4c\xd6\x05\x3e\x39\x3a\xa9\x6a\x69\xbc\xbc\x6f\x9c\x26\x71\xa7\x3f\x2f\x56\xa5\x6c\xbc\xba\xfc\xe6\x2b\x4c\x42\x24\x3a\x62\xbb\x26\x23\x30\xe2\x22\x28\xc2\x21\x14\xa2\x88\x00\x85\x78\x10\xb1\xd9\x6a\xdd\x86\x7f\xd7\xe1\x3c\xa3\xd1\xf1\xaa\x44\x27\xd7\x17\x01\xed\x06\xd4\x06\x0e\x3c\x9a\xfb\x5e\xf9\x45\x91\x31\xf8\xc7\x40\x66\x0c\x0e\x0c\x0c\x83\x97\xdf\x1b\xda\x6f\x73\x10\x39\x54\x64\x86\x5e\x0e\xcf\x0c\x46\x9e\x7e\xff\x04\x00\x00\xff\xff\xe5\x17\x13\x8d\x76\x02\x00\x00")
-
-func sid_etJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sid_etJson,
-		"sid_ET.json",
-	)
-}
-
-func sid_etJson() (*asset, error) {
-	bytes, err := sid_etJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sid_ET.json", size: 630, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sk_skJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\xc1\x4a\xc3\x40\x10\x86\xcf\x9b\xa7\x58\x16\xe6\x56\xf2\x00\xb9\x29\x39\x58\x24\x12\x88\x1e\xaa\x88\x6c\x9a\xd1\x36\x69\xb2\x65\xb3\x29\x88\xf8\x2e\xf6\x01\x3c\x79\xf3\xe0\x25\xfa\x5e\x12\x77\x67\xac\x78\xe9\x6d\xe6\xff\x87\xff\xff\x16\xf6\x29\x12\x6a\x9e\xaa\x44\xaa\xbe\xb9\x2b\xce\xd5\x2c\x12\x2a\xd5\x8f\xbd\x4a\xe4\x4d\x24\x84\xba\xc0\x0a\x3f\x3f\xf4\xa4\x0b\x95\x9b\xae\xc2\x8d\x69\xfc\x76\xe5\x8c\xa5\xb9\x70\x16\xab\x70\xf5\xf5\xe2\x76\xd6\x91\x93\xaf\x35\xcf\x85\x29\x8d\xd3\x2a\x12\xb7\x53\x4d\xb1\x32\xd6\xfd\xed\xa2\x1a\x2a\xa0\x70\x0e\xa6\x4c\xca\xa3\xac\xcc\x74\x6e\xc5\x41\xb5\xee\x86\x71\x6f\xfd\xd1\x3d\x96\xf6\x77\x6b\xb5\xc5\xa5\x1f\xf5\xd6\x8e\xaf\x9b\x20\x8f\xfb\xda\x4f\xf5\xf8\xde\xf1\x14\x5c\x3d\x3c\x0c\x7d\xe8\xee\x71\xeb\xb0\x2d\x31\xe4\x99\xc6\x8d\x6f\xbc\x75\x66\x77\xe0\x55\xb8\xf4\xdb\xe1\x83\xff\x91\x32\x25\x03\x32\xde\x91\x6c\x0c\xc6\x48\x4c\xc3\x20\xc4\x70\x92\xe5\x19\x95\x7b\x93\x9c\x54\x3b\x9c\xfe\x01\x54\x31\xb4\x31\x2c\x14\x89\x97\xeb\xd6\x1b\x5a\x02\xc6\x12\x4e\x25\x2c\x66\x12\xce\x12\xc8\x12\x28\x24\x5c\xff\x5c\xf2\x55\xd0\x59\x0c\x8d\x0a\xe6\xc1\x88\x9e\xbf\x03\x00\x00\xff\xff\x0f\x5a\xb1\x7a\x75\x02\x00\x00")
-
-func sk_skJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sk_skJson,
-		"sk_SK.json",
-	)
-}
-
-func sk_skJson() (*asset, error) {
-	bytes, err := sk_skJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sk_SK.json", size: 629, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sl_siJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xc3\x30\x14\x44\xd7\xf2\x29\xc4\x07\xed\x4a\x0e\x90\x5d\xc1\x9b\x2c\x0c\x85\x78\x93\x96\x52\xbe\xe3\xdf\xc6\x8e\x6d\x19\x59\x0e\x94\xd2\x23\xf4\x56\x3d\x58\x71\xe5\x3f\x0d\xcd\x6e\xe6\x8f\x98\x79\x06\x7f\x64\x86\x76\x39\x6d\x2d\x4d\xdd\xcb\x7e\x47\x77\x99\xa1\x9c\xdf\x27\xda\xda\xa7\xcc\x18\x1a\xa4\x96\xae\xe5\xe5\x6e\x68\xf4\xc9\xca\x39\xf9\xe8\x83\xca\x29\x48\xbd\xbe\xfa\xfe\x92\x18\xa2\x06\xa3\x40\x4e\xbe\xf2\x91\x29\x33\xcf\xcb\xca\xfe\xe4\x43\xfc\x37\x85\x19\xf4\xa3\xfd\xaf\x1b\xbd\x68\xd5\xca\xc2\x0f\xf1\x84\xbe\x96\x87\x99\xd7\x82\x57\xa9\x02\x4c\xcf\x41\x8e\x49\xf2\x18\x9a\x4e\xaf\x6d\x12\xed\x3c\x34\x90\x9d\x4a\xbe\xbc\xcd\x93\x4e\xca\x18\xa5\xaf\x64\xed\xf3\xe7\xe8\x61\x06\x7f\xb9\x8a\x6a\x39\x26\x77\xfd\xd1\x37\x98\x60\x04\x1f\xe8\x6e\xd9\x40\x06\x2e\x40\x01\x07\x28\xa0\x50\x80\xfb\xe2\xa1\xd0\xe5\x14\x6a\x92\x73\x94\xe5\x3f\x70\xf5\xc6\xba\x7e\x63\xdd\x81\xf4\x5c\x36\x7d\x8a\xd8\xba\xda\xba\xca\xba\x83\x75\xa5\x75\x8f\xbf\x2f\x90\x96\xb0\xeb\x0a\x51\xf6\xf9\x13\x00\x00\xff\xff\x0a\x89\xb4\x62\x61\x02\x00\x00")
-
-func sl_siJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sl_siJson,
-		"sl_SI.json",
-	)
-}
-
-func sl_siJson() (*asset, error) {
-	bytes, err := sl_siJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sl_SI.json", size: 609, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _so_djJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\xc1\x4e\xc3\x30\x14\x3b\xb7\x5f\x11\x45\xca\x0d\xed\x03\x7a\x2b\xea\x65\x1b\x95\x90\xba\x03\x03\x21\xe4\x90\x6e\x7d\xac\x6d\x50\x53\x44\x27\xc4\xbf\xa3\x2e\x79\xd3\x96\x71\x73\xfc\xfc\xec\xd4\xcd\x4f\x9a\xc8\x65\x21\x33\x21\x9d\x7d\x2b\x56\xf2\x2e\x4d\x64\x81\xa3\x93\x99\x78\x49\x93\x44\xe6\x13\xcc\x4c\x26\x72\xe9\x7a\xa2\xde\xe3\x0a\x2d\xe0\xac\x3f\xe4\x83\xc6\x7b\xc0\xeb\x06\x1d\x91\xf3\x87\x15\x75\xcc\x57\xd0\x23\xc9\x34\x79\x9d\xfd\xab\xc6\x0e\xe3\x65\x08\x26\x78\x19\xb9\x10\xe0\xd0\x7a\x80\x41\x7b\x70\x68\x82\xe6\x83\x3a\xd6\x68\x76\x2c\x6d\x3f\x36\x67\xbb\x7b\x72\x0d\xc4\xda\x5a\x0d\xbe\xbc\xa7\x1e\x10\x33\x15\x8c\xa9\xa7\x88\xcc\x77\x43\x2c\x6b\xd0\xc7\x5e\x14\xaf\x6d\xac\xb9\x49\xac\xc8\xd4\xb5\xb9\x09\xdd\x63\x2e\x30\xda\xd6\x71\xc4\xda\x7e\x0b\x3a\xda\xff\x46\xf3\x97\x5c\xcd\x2e\xab\xbd\x6e\xe3\x60\x43\x83\x2d\x34\x17\x17\xbc\xb0\x1b\x02\xc3\xe5\xb6\x34\x79\x30\xda\xa0\x71\xc4\x00\x7b\x1e\xf1\x2f\xa1\x91\xb7\x46\xbe\x40\x5e\x3e\x96\x9c\xec\xbe\x34\xa6\x9e\xba\xf0\x06\xf6\x68\xa1\x4f\xc7\xa0\x2d\x30\xd6\xf3\xcb\x53\x66\xa1\xba\x85\xda\x4a\x26\x37\xd4\xf9\x01\x84\x32\x42\x69\xa1\xb6\x42\x0d\x42\x3d\x9f\x14\xe7\xe9\x32\x53\x65\xa6\xaa\x33\x19\xb2\xa5\x7a\x12\xea\x53\xa6\xbf\x7f\x01\x00\x00\xff\xff\x52\xaa\xda\x0d\xdc\x02\x00\x00")
-
-func so_djJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_so_djJson,
-		"so_DJ.json",
-	)
-}
-
-func so_djJson() (*asset, error) {
-	bytes, err := so_djJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "so_DJ.json", size: 732, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _so_etJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x41\x6b\xb3\x40\x10\x3d\xeb\xaf\x58\x06\xe6\x16\xc8\xdd\x9b\x21\xdf\xc1\xcf\x08\x85\xf5\xd0\xb4\x94\x32\xdb\x35\x3a\x10\xdd\xe2\x5a\x6a\x28\xfd\xef\xc5\xb8\xbb\x34\x9b\xde\x66\xde\x9b\x79\x6f\x7d\xce\x57\x9a\x40\xb1\x87\x4c\x80\x35\xaf\xff\x6a\xd8\xa4\x09\xec\xe9\x62\x21\x13\xcf\x69\x92\x40\x3e\x93\x5e\xc0\x04\x0a\x3b\x30\x0f\x6b\x2d\xe9\x4c\x64\xcd\xda\xe4\xa3\xa2\x37\x57\x97\x1d\xf5\xcc\x76\x6d\xfe\x73\xef\x71\x49\x6a\x62\x48\x93\x97\x45\x5f\x76\x66\x9c\x22\x93\xe0\x11\x0c\x82\x78\x50\x0e\xaa\x41\xd3\x2b\x56\x66\x98\xba\x20\xb7\x63\xdb\x91\x28\x8d\x51\xe4\x1f\xbf\x42\x07\x8a\x11\x49\x5a\x37\x73\x04\xe6\xa7\x31\x1e\xeb\x68\x88\xb5\x38\x5e\xab\x8d\xbe\x73\x94\xac\x9b\x46\xdf\x99\xb6\xb4\x04\x18\x6d\xab\xd8\xa2\x34\x9f\x82\x2f\xe6\x2f\x6a\xf9\x92\x1b\xee\x77\xb4\xb7\x69\x94\xc6\x25\x78\x20\xe5\x83\x73\x5a\xf9\x69\x74\x88\x0f\xf7\xc0\xf3\x5a\xd4\xc6\xcd\x48\xf6\x05\xb5\x9e\xf2\xbf\xa4\xa8\xdd\x56\x51\xfb\x07\xe4\xd5\x43\xe5\x9d\xed\x87\xa2\x79\xe0\xde\xdd\x40\x4b\x67\x52\xd7\xd6\xcd\xee\x69\x6a\x96\xcb\x43\xbd\xc5\x7e\x8b\x47\xf0\x60\xcd\xfd\x4a\xe4\x1b\x81\x3b\x81\xcd\x46\xe0\x51\xe0\x28\xf0\xe9\x3a\x13\xf8\x22\xc3\x2a\x43\x19\x40\xe7\x0e\xf8\x28\xf0\x1d\xd2\xef\x9f\x00\x00\x00\xff\xff\x7a\xb0\xbe\x62\xde\x02\x00\x00")
-
-func so_etJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_so_etJson,
-		"so_ET.json",
-	)
-}
-
-func so_etJson() (*asset, error) {
-	bytes, err := so_etJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "so_ET.json", size: 734, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _so_keJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x41\x6b\xb3\x40\x10\x3d\xeb\xaf\x58\x06\xe6\x16\xc8\xdd\x9b\x21\xdf\xc1\xcf\x08\x85\xf5\xd0\xb4\x94\x32\xdb\x35\x3a\x10\xdd\xe2\x5a\x6a\x28\xfd\xef\xc5\xb8\xbb\x34\x9b\xde\x66\xde\x9b\x79\x6f\x7d\xce\x57\x9a\x40\xb1\x87\x4c\x80\x35\xaf\xe5\x3f\xd8\xa4\x09\xec\xe9\x62\x21\x13\xcf\x69\x92\x40\x3e\x93\x5e\xc0\x04\x0a\x3b\x30\x0f\x6b\x2d\xe9\x4c\x64\xcd\xda\xe4\xa3\xa2\x37\x57\x97\x1d\xf5\xcc\x76\x6d\xfe\x73\xef\x71\x49\x6a\x62\x48\x93\x97\x45\x5f\x76\x66\x9c\x22\x93\xe0\x11\x0c\x82\x78\x50\x0e\xaa\x41\xd3\x2b\x56\x66\x98\xba\x20\xb7\x63\xdb\x91\x28\x8d\x51\xe4\x1f\xbf\x42\x07\x8a\x11\x49\x5a\x37\x73\x04\xe6\xa7\x31\x1e\xeb\x68\x88\xb5\x38\x5e\xab\x8d\xbe\x73\x94\xac\x9b\x46\xdf\x99\xb6\xb4\x04\x18\x6d\xab\xd8\xa2\x34\x9f\x82\x2f\xe6\x2f\x6a\xf9\x92\x1b\xee\x77\xb4\xb7\x69\x94\xc6\x25\x78\x20\xe5\x83\x73\x5a\xf9\x69\x74\x88\x0f\xf7\xc0\xf3\x5a\xd4\xc6\xcd\x48\xf6\x05\xb5\x9e\xf2\xbf\xa4\xa8\xdd\x56\x51\xfb\x07\xe4\xd5\x43\xe5\x9d\xed\x87\xa2\x79\xe0\xde\xdd\x40\x4b\x67\x52\xd7\xd6\xcd\xee\x69\x6a\x96\xcb\x43\xbd\xc5\x7e\x8b\x47\xf0\x60\xcd\xfd\x4a\xe4\x1b\x81\x3b\x81\xcd\x46\xe0\x51\xe0\x28\xf0\xe9\x3a\x13\xf8\x22\xc3\x2a\x43\x19\x40\xe7\x0e\xf8\x28\xf0\x1d\xd2\xef\x9f\x00\x00\x00\xff\xff\x75\xa7\x5e\x4c\xde\x02\x00\x00")
-
-func so_keJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_so_keJson,
-		"so_KE.json",
-	)
-}
-
-func so_keJson() (*asset, error) {
-	bytes, err := so_keJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "so_KE.json", size: 734, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _so_soJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x41\x6b\xb3\x40\x10\x3d\xeb\xaf\x58\x06\xe6\x16\xc8\xdd\x9b\x21\x17\x3f\x23\x5f\x61\x3d\x34\x2d\xa5\xcc\x76\x8d\x0e\x44\xb7\xb8\x96\x1a\x4a\xff\x7b\x31\xee\x2e\xcd\xa6\xb7\x99\xf7\x66\xde\x5b\x9f\xf3\x95\x26\x50\xec\x21\x13\x60\xcd\xab\xfc\x0f\x9b\x34\x81\x3d\x5d\x2c\x64\xe2\x39\x4d\x12\xc8\x67\xd2\x0b\x98\x40\x61\x07\xe6\x61\xad\x25\x9d\x89\xac\x59\x9b\x7c\x54\xf4\xe6\xea\xb2\xa3\x9e\xd9\xae\xcd\x3f\xee\x3d\x2e\x49\x4d\x0c\x69\xf2\xb2\xe8\xcb\xce\x8c\x53\x64\x12\x3c\x82\x41\x10\x0f\xca\x41\x35\x68\x7a\xc5\xca\x0c\x53\x17\xe4\x76\x6c\x3b\x12\xa5\x31\x8a\xfc\xe3\x57\xe8\x40\x31\x22\x49\xeb\x66\x8e\xc0\xfc\x34\xc6\x63\x1d\x0d\xb1\x16\xc7\x6b\xb5\xd1\x77\x8e\x92\x75\xd3\xe8\x3b\xd3\x96\x96\x00\xa3\x6d\x15\x5b\x94\xe6\x53\xf0\xc5\xfc\x45\x2d\x5f\x72\xc3\xfd\x8e\xf6\x36\x8d\xd2\xb8\x04\x0f\xa4\x7c\x70\x4e\x2b\x3f\x8d\x0e\xf1\xe1\x1e\x78\x5e\x8b\xda\xb8\x19\xc9\xbe\xa0\xd6\x53\xfe\x97\x14\xb5\xdb\x2a\x6a\xff\x80\xbc\x7a\xa8\xbc\xb3\xfd\x50\x34\x0f\xdc\xbb\x1b\x68\xe9\x4c\xea\xda\xba\xd9\x3d\x4d\xcd\x72\x79\xa8\xb7\xd8\x6f\xf1\x08\x1e\xac\xb9\x5f\x89\x7c\x23\x70\x27\xb0\xd9\x08\x3c\x0a\x1c\x05\x3e\x5d\x67\x02\x5f\x64\x58\x65\x28\x03\xe8\xdc\x01\x1f\x05\xbe\x43\xfa\xfd\x13\x00\x00\xff\xff\x5a\xcf\xec\xb3\xde\x02\x00\x00")
-
-func so_soJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_so_soJson,
-		"so_SO.json",
-	)
-}
-
-func so_soJson() (*asset, error) {
-	bytes, err := so_soJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "so_SO.json", size: 734, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sq_alJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\xc1\x4e\xc3\x30\x0c\x86\xcf\xed\x53\x54\x91\x72\x63\x7b\x80\xdd\x26\x05\xc1\x80\xa2\xa2\xed\x02\x08\xa1\xa0\x45\x24\xed\x9a\x76\x8e\x7b\x40\x88\x27\xca\x63\xe4\xc5\x50\xdb\xb8\xed\x76\xfb\xec\xdf\xfe\x6d\x47\xf9\x4d\x13\xb6\x13\x6c\x93\x31\x77\xfe\xdc\x3e\xb1\x9b\x34\x61\x42\xfe\x38\xb6\xc9\xde\xd3\x24\x61\x2a\x3b\x1a\x75\xea\xd3\x3d\xeb\xe0\x6d\xf0\x14\xd5\x12\x70\x11\x05\x0f\x55\x07\x73\x42\xd9\x12\x15\x05\x2d\xa8\x7a\x8e\x9c\xc6\xae\x37\x4a\x93\x8f\x7e\xe0\x5e\x37\x80\xcb\xa9\xc2\xc4\xd2\xfb\xe0\xed\x48\xb9\x84\x08\xc1\x47\xba\xb5\xe5\x08\x05\xc4\xf2\xbd\x46\xf2\xcc\x1b\x8b\x7a\x32\x2c\xa5\xa5\x7e\xa7\xab\x0e\x70\xe4\x5a\x82\x1b\xa9\x05\x73\x3a\x51\x32\xda\x9e\x15\x38\xdd\xc4\xb6\xaa\x01\x30\xd5\xc8\xdf\x9d\xd3\x48\x6e\x28\x91\x6a\x50\x4d\x68\x83\xb7\x53\x70\xd4\xe5\xa0\x2c\xcf\xbd\xdc\xef\x41\x5a\xba\xa0\xba\xba\xb7\x00\x43\x99\xb8\xd7\x8b\x8a\xd2\x23\x0d\xb8\x73\x7a\x7e\x80\x01\x0e\x2a\xc2\xf3\xf4\x82\x42\x97\xb4\xc1\x36\x2f\x72\x1a\x5d\x88\x68\x2f\x48\x15\x12\x55\xff\x23\xf8\xeb\x8a\x7f\xad\xf8\x91\x51\xf2\x60\xea\x0b\x21\xe3\xbb\x35\xcf\xd7\x7c\xbf\xe6\x6d\xc6\xdf\x86\xba\xa9\x86\xa4\xa5\x10\xe7\xb2\xab\xbe\xf4\xef\x3f\x00\x00\xff\xff\xc9\x3c\x2f\xa5\x87\x02\x00\x00")
-
-func sq_alJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sq_alJson,
-		"sq_AL.json",
-	)
-}
-
-func sq_alJson() (*asset, error) {
-	bytes, err := sq_alJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sq_AL.json", size: 647, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sq_mkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\xc1\x6a\xf3\x30\x0c\xc7\xcf\xc9\x53\x04\x83\x6f\x5f\xfb\x00\xbd\x7d\xe0\xb1\x75\xc5\x23\xa3\xbd\x6c\x63\x0c\x8f\x9a\xd9\x49\xe3\xb4\xb2\x72\x18\x63\x4f\xe4\xc7\xf0\x8b\x8d\x24\x56\x92\xf6\xf6\x93\xfe\xd2\x5f\x92\xf1\x4f\x9e\xb1\xad\x60\x9b\x82\xf9\xcb\x87\xdc\xb1\x7f\x79\xc6\x84\xfa\xf6\x6c\x53\xbc\xe5\x59\xc6\x74\x71\xb4\xfa\xd4\xa7\x7b\x36\x31\xb8\x18\x28\x6a\x14\xe0\x22\x8a\x01\xea\x0e\xe6\x84\x76\x15\x6a\x0a\xce\xa0\x9b\x39\xf2\x06\xbb\xde\x28\xcf\xde\xfb\x81\x7b\xd3\x02\x2e\xa7\x0a\x9b\x4a\x1f\x62\x70\x23\x49\x05\x09\x62\x48\x74\xe7\xaa\x11\x4a\x48\xe5\x7b\x83\xe4\x29\x5b\x87\x66\x32\xac\x94\xa3\x7e\x6f\xea\x0e\x70\xe4\x46\x81\x1f\xe9\x0c\xf6\x74\xa2\x64\xb2\xbd\x68\xf0\xa6\x4d\x6d\x75\x0b\x60\xeb\x91\xbf\x3a\x6f\x90\xdc\x50\x21\xd5\xa0\x9e\xd0\xc5\xe0\xa6\xe0\x68\xaa\x41\x59\x9e\x7b\xbd\xdf\xa3\x72\x74\x41\x7d\x73\x6f\x09\x96\x32\x69\xaf\x67\x9d\xa4\x1d\x0d\xb8\xf7\x66\x7e\x80\x01\x0e\x3a\xc1\xd3\xf4\x82\xc2\x54\xb4\xc1\x7f\x59\x4a\x1a\x5d\x8a\x64\x2f\x48\x15\x0a\x75\xff\x23\xf8\xcb\x8a\x7f\xae\xf8\x91\x51\xf2\x60\x9b\x2b\xa1\xe0\xdb\x35\x97\x6b\xbe\x5f\xf3\x73\xc1\x5f\x87\xba\xa9\x86\xa4\xa5\x90\xe6\xb2\x9b\xbe\xfc\xf7\x2f\x00\x00\xff\xff\x09\x61\x59\x9a\x87\x02\x00\x00")
-
-func sq_mkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sq_mkJson,
-		"sq_MK.json",
-	)
-}
-
-func sq_mkJson() (*asset, error) {
-	bytes, err := sq_mkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sq_MK.json", size: 647, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sr_meJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xc1\x4a\xf3\x40\x14\x85\xd7\x93\xa7\x18\x06\x66\x57\xf2\x00\xdd\xf5\xa7\xff\xc2\x45\x40\xb0\x9b\x2a\x22\x85\x0a\x75\x51\x0b\x6d\x37\x22\x42\x9a\x88\xbe\x42\x7c\x8b\x24\x76\x40\xda\x26\x79\x85\x73\xdf\x48\xa6\xc9\x4c\xaf\xd4\x8d\xbb\xc9\xb9\xf7\xe4\x7c\x27\x99\xe7\x40\xa8\x8b\xa1\xea\x4b\xb5\x5a\xde\x45\xff\x55\x2f\x10\x6a\x38\x79\x5a\xa9\xbe\xbc\x09\x84\x50\xa8\x60\xb0\xa5\x0c\x86\x3e\x90\xdb\xb1\x50\x68\x50\xb7\x7a\xab\x62\xd7\xea\x94\x52\x82\x9a\x62\xa6\x6c\x28\xc6\x97\x75\x63\xeb\xdc\xf4\x0e\x43\x09\x4a\x8a\x29\x39\x6d\xa2\x39\xaa\xdc\x99\xa2\x40\x6d\x35\x15\x88\x5b\xcb\x75\x35\x5b\x2c\xd7\xe7\x70\x1c\x8a\x83\x70\x04\x1e\xcd\x03\x79\x98\x8b\x89\x16\x8f\xeb\x99\xcf\xa0\x0c\x39\x2a\x4a\x91\x53\xdc\x6d\xbf\xc2\xa0\xa0\x98\x6b\x38\xd8\xb3\x7f\x77\x8e\xc6\xa6\x62\xcf\xa6\x59\xe7\xce\x28\xf5\x9c\xf6\xbc\xf7\x9e\x12\x9f\x94\xd2\xe6\x44\x05\x83\x86\x12\x18\x1c\x50\xb0\xac\x1a\x3b\xdb\xef\x87\x56\xa1\x46\x79\xb6\x69\x7f\xd0\x1b\x53\xf9\x87\xfc\xad\x26\x2f\xc8\x8b\xf1\x5a\x7f\xad\xc4\xcb\xf0\x02\x1c\x9c\xe3\x3a\xc8\x41\x74\x19\x39\xba\x76\xee\x26\xc3\xc9\xfa\xde\xde\x58\x3d\x0d\xf5\x3c\xd4\xe3\x50\x39\x75\xf4\x30\x6f\x27\x83\x9e\xd4\xd3\x50\xea\x7f\x52\x8f\x43\xa9\x47\x52\x5f\x1f\x97\xfc\xc2\xc8\x3f\x76\x39\x56\x0a\x5e\xbe\x03\x00\x00\xff\xff\x44\x6f\x8b\xf7\x0f\x03\x00\x00")
-
-func sr_meJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sr_meJson,
-		"sr_ME.json",
-	)
-}
-
-func sr_meJson() (*asset, error) {
-	bytes, err := sr_meJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sr_ME.json", size: 783, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sr_rsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\x93\xa7\x18\x06\x66\x57\xf2\x00\xdd\xf5\xa3\x9b\x6f\x51\x10\xdb\x4d\x15\x91\x42\x85\xba\xa8\x85\xb6\x1b\x11\x61\x9a\x88\xbe\x42\x7c\x8b\x24\x3a\x20\x6d\x93\xbc\xc2\xb9\x6f\x24\x93\x3f\xd3\x2b\x75\xe3\x6e\x72\xee\xb9\x39\xbf\x93\xcc\x53\x20\xd4\xff\xa1\xea\x4b\xb5\x59\xdf\x5e\x8e\x55\x2f\x10\x6a\x38\x7b\xdc\xa8\xbe\xbc\x0e\x84\x50\x28\x60\xf1\x09\x4b\xef\x48\xdd\x50\x28\x54\x28\xb9\x8a\x7d\xa3\x53\x4c\x11\x4a\x32\x4c\xd9\x91\xa9\x7d\xed\x26\xbd\xc1\x52\x84\x9c\x0c\x45\x27\x17\xaa\x5a\xe5\x5b\x31\x32\x94\x4e\x53\x81\xb8\x71\x44\xe3\xc5\x6a\xbd\x3d\xc7\xe2\x40\x1c\x82\xc7\xf3\x68\x1e\xc8\xc3\xba\x98\xd1\xea\x61\xbb\xf0\x19\x94\x20\x45\x41\x31\x52\x32\xad\xfb\x05\x16\x19\x19\xae\xe1\xe8\xce\xfe\xdd\x29\x2a\x32\xf8\xc2\x81\x4d\x93\x76\x3b\xa1\xd8\x73\xba\xf3\xc1\xef\xe4\xf8\xa0\x98\x76\x27\x2a\x58\x54\x14\xc1\xe2\x88\x8c\x65\x95\xd8\xbb\x7e\x3f\xb4\x02\x25\xf2\x33\xa7\xfb\x39\xaf\x4c\xe5\x1f\xf2\xb7\x9a\xbc\x20\x2f\xc6\x6b\xfd\xb5\x12\x2f\xc3\x0b\x70\x70\x8e\xdb\x41\x0e\x46\x17\xa3\x8e\xae\x99\x77\x93\xe1\x6c\x7b\xe7\xee\xaa\x9e\x87\x7a\x19\xea\x69\xa8\x3a\x75\x72\xbf\x6c\x26\x83\x9e\xd4\xf3\x50\xea\x7f\x52\x4f\x43\xa9\x27\x52\x5f\xd5\x26\x6f\x98\xf8\xc7\x36\xc7\x49\xc1\xf3\x77\x00\x00\x00\xff\xff\xd1\x45\x25\x8f\x09\x03\x00\x00")
-
-func sr_rsJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sr_rsJson,
-		"sr_RS.json",
-	)
-}
-
-func sr_rsJson() (*asset, error) {
-	bytes, err := sr_rsJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sr_RS.json", size: 777, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sr_rsLatinJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc4\x30\x14\x45\xd7\xe9\x57\x84\x40\x76\x43\x3f\x60\x56\x8e\x74\xe3\xa2\x20\x4e\x37\xa3\x88\xa4\x36\x3a\xed\xb4\x49\x49\x5f\x0b\x22\x7e\x82\x7f\xe5\x87\x49\x4d\xde\x75\x98\xd9\xbd\xfb\xee\xeb\xbd\xa7\x90\xcf\x4c\xa8\xbb\x42\x6d\xa5\x9a\xc2\xcb\xc3\xfe\xa6\x37\xd4\x3a\xb5\xc9\x84\x2a\xcc\xc7\xa4\xb6\xf2\x29\x13\x42\x39\xdb\xd8\xbe\x33\xeb\x5e\xa8\xd1\x27\x79\x8a\x7a\x26\x1f\x78\x9e\x82\x6d\xd2\xd9\xcf\xb7\xa5\x25\x10\x3b\xa3\xc5\x38\xcd\xb5\x27\xa3\x32\xf1\xbc\xf6\xec\x8f\x3e\xd0\x45\x19\x8a\xd0\x80\xf8\xff\x70\xe4\x22\x95\x23\x4b\xef\xe8\x88\xbc\xce\xb8\xd9\x84\x78\xf4\x66\xeb\x00\x31\x98\x90\xbe\x35\x63\x68\x7b\x5e\x76\x71\xe8\x66\xd7\xf2\xd4\xa7\xc9\x2c\xef\xf3\xc4\x7d\x76\x24\x3b\xd4\x1c\xe6\x4f\xe4\x21\x9c\x5f\xce\xac\xc6\xbe\x46\x75\xfe\xc7\x57\x8c\x00\x04\x1c\xd8\xae\xc9\x00\x06\x2e\x40\x01\x07\x28\xa0\x60\x80\x5d\x79\x5f\x72\x73\x34\xd9\x29\x0c\xd9\xf5\x31\xe8\x26\xd7\x43\xae\x0f\xb9\xe2\x6d\xd5\x0e\xd1\xd9\x6d\xa4\x6e\x72\xa9\x6f\xa5\x3e\xe4\x52\x57\x52\x3f\xfe\x1d\xe1\xa0\x82\x4c\x3d\xeb\x2a\xfb\xfa\x0d\x00\x00\xff\xff\x05\xea\x55\x32\x6a\x02\x00\x00")
-
-func sr_rsLatinJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sr_rsLatinJson,
-		"sr_RS@latin.json",
-	)
-}
-
-func sr_rsLatinJson() (*asset, error) {
-	bytes, err := sr_rsLatinJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sr_RS@latin.json", size: 618, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ss_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\x51\x6b\xf2\x30\x14\x7d\x6e\x7f\x45\x08\xe4\xed\xfb\xf0\xdd\x37\xc5\x87\xc9\x8c\x0c\x2c\x82\x8e\x31\x92\x1a\x9b\xd0\xdb\x64\x2c\x89\x22\x63\xff\x7d\xd4\xdc\xc4\xb2\xbd\xc8\xb9\xf7\x1c\xcf\x39\xbd\xed\x57\x5d\xd1\xf5\x8a\xce\x09\xf5\xfe\xfd\xb8\xa0\xff\xea\x8a\xae\xc4\xcd\xd3\x39\x79\xad\xab\x8a\x6e\x8c\x77\x36\x9c\xdd\x48\x54\x34\x72\xef\x06\x19\x21\xf6\xb8\xd8\x28\x6f\xa4\x01\xf3\x98\x82\x17\xe1\x1c\x1f\xb3\x55\x0f\xac\x41\xd8\x98\x9d\xba\xd6\x48\x05\x8e\xd6\xd5\xdb\x18\xba\xd3\xee\x33\x4c\x93\x77\xce\x26\x29\xf7\x18\xb6\x34\x90\x40\xe3\x45\x02\x5b\x34\x7f\x02\x5c\xf0\xae\xcd\x86\xdc\xd9\xa0\x8b\xdb\x52\x9b\x41\x9a\xd3\xe5\x2a\x72\x21\xb3\x3d\x81\xbb\xfc\x1a\x23\x28\xdb\xeb\x08\xd8\x92\x0b\x29\x72\xd6\xda\xf6\xfa\xaa\xee\x3f\xc1\xe4\xff\x68\x10\x12\xf9\x67\xed\x60\xea\xde\xb5\x45\x75\x73\x16\xf1\x7a\xf8\x10\xb9\xeb\x66\x6a\xd4\x39\xdb\x8d\xaa\xe9\x35\xfe\x3c\x41\x12\xef\x05\x1e\x66\x1f\xa1\xd4\xcc\x1d\x7a\x3c\x8c\x86\xd2\x0a\x37\x5d\x8b\xe0\xe6\x4a\x97\x52\x24\x6b\xca\xeb\x58\xf0\x17\x9e\x93\x13\x99\x99\x95\x08\x6a\xfc\x5e\xd8\x69\xc6\x86\x19\x3b\xd0\xbc\x6c\xcc\x90\x08\x41\xd8\x7f\x45\x98\x24\xec\x40\x58\x43\xd8\xf1\x2e\x29\x74\x53\x46\x0c\xa1\xb4\xfe\xfe\x09\x00\x00\xff\xff\xbd\xb1\x87\x82\x88\x02\x00\x00")
-
-func ss_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ss_zaJson,
-		"ss_ZA.json",
-	)
-}
-
-func ss_zaJson() (*asset, error) {
-	bytes, err := ss_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ss_ZA.json", size: 648, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _st_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x52\xc1\x4e\xeb\x30\x10\x3c\x3b\x5f\x61\xad\xe4\xdb\x7b\xea\xbd\xb7\xa2\x1e\x0a\x22\xa8\xa2\xe1\xd0\x22\x84\x36\xca\xaa\x0e\x71\xec\x92\x38\xaa\x10\xe2\xdf\x51\x88\x77\x53\xca\x6d\x26\xb3\x3b\x33\x6b\xe5\x33\x53\x70\xbb\x86\xa5\x86\x3e\xbe\x1e\x56\xf0\x2f\x53\xb0\xc6\x8f\x1e\x96\xfa\x39\x53\x0a\x76\xc1\x47\xb4\x38\x7e\x57\x90\xe3\x05\xb9\xc7\x32\x94\x54\xd5\x33\xeb\xb0\x0b\x33\xf3\x34\x63\xeb\xd0\x27\x29\x0f\xef\x54\x92\x0b\x90\xa9\x97\x31\x6c\x67\x43\x17\xaf\x12\xd3\x64\x9b\x92\x6e\xa8\x9a\xc0\x23\x76\x13\x78\x48\xde\x1b\x87\xe2\xca\x86\x79\xf0\xd1\x8a\xdb\xd6\x52\x47\xcd\x31\xf8\xa3\x6c\x34\x81\xb7\x0a\x67\xb1\x19\x4a\x72\xc4\x89\xd4\x33\x0c\xb1\xb7\x84\x5e\x16\xb7\x76\x38\xbd\x21\x1f\x35\xb2\x81\x21\x46\x3e\x9b\x42\x14\x83\x93\x45\x37\xcf\x0f\xd5\xe0\x8f\x67\xf4\x9c\xdc\xdb\x3a\x9e\xe9\xd7\x23\xfc\x29\x7e\x75\x63\xe1\x36\x52\x53\x4a\x4e\xe0\x0e\xd3\x9b\x3d\x5d\xb4\x92\x4e\x52\x48\xba\x48\x09\x2e\xb0\xca\xb7\x39\x27\x4f\x22\x2b\x6b\x8c\x34\xfe\x1e\xa6\x5a\x98\x76\x61\xf6\xc0\x1f\x8b\xba\x9d\x04\xd4\xe6\x3f\x69\x53\x6a\xb3\xd7\xa6\xd0\xe6\xf0\x33\x22\x72\x21\x34\x85\x00\x64\x5f\xdf\x01\x00\x00\xff\xff\xda\x43\x87\x51\x77\x02\x00\x00")
-
-func st_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_st_zaJson,
-		"st_ZA.json",
-	)
-}
-
-func st_zaJson() (*asset, error) {
-	bytes, err := st_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "st_ZA.json", size: 631, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sv_fiJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x10\x85\xd7\xd2\x29\xc4\x80\x76\x3f\x3a\x40\x76\x7f\x31\xa5\x59\x18\x0a\xe9\x26\x94\x52\x26\xb5\x9a\xc8\xb5\xa4\x20\xc9\x81\x52\x7a\x9c\x5e\x21\x17\xf0\xc5\x8a\x6b\x69\x14\x92\xdd\x7b\x33\xc3\x7b\x9f\x40\x5f\x9c\xc1\xba\x81\x95\x80\x78\x7a\xbd\x5f\xc3\x3f\xce\xa0\xc1\xcf\x08\x2b\xf1\xcc\x19\x83\x38\x9d\x5d\x87\xfb\x79\xce\xc0\x4e\x3f\xd5\x24\x13\x49\x7b\x57\x75\xf2\xa1\x9a\xf7\xa0\x49\x0f\xd3\x39\xcc\x86\xb3\x97\xb9\x65\x73\xf0\x21\x5d\x57\xd5\x1e\x2a\xa1\x06\x8a\xa7\xe8\x9a\x5b\x42\x5b\xef\xd2\x81\x12\x7b\x74\x23\x06\x93\xef\xf5\x2e\x54\x67\x31\xe4\x40\x3c\x06\x33\x94\x61\xbf\x88\x7e\x74\xa6\xa8\x21\x2b\x1c\xf7\x63\x4c\xd9\x44\x7d\x4c\xda\xee\x74\x66\xf1\x1f\xc9\x93\x71\xfe\x74\xb1\xea\xf4\xdb\xe2\x2e\x9f\x7d\x83\x49\x88\x44\x47\x70\xb7\x68\x44\x46\x60\x04\x45\x38\x84\x42\x14\x05\xe0\x7f\xfb\xd8\x96\xe6\x65\x59\x36\x0d\x26\x3d\xff\x04\xd9\x29\x69\x95\xdc\x42\x19\x3e\x19\xbb\x2c\x50\x48\xad\x84\xbc\x13\x72\x2b\xe4\x83\x92\xad\x92\x9b\xbf\x2b\xba\xb8\x1e\xe6\x36\x00\xfe\xfd\x1b\x00\x00\xff\xff\x7c\x30\x45\xab\x6b\x02\x00\x00")
-
-func sv_fiJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sv_fiJson,
-		"sv_FI.json",
-	)
-}
-
-func sv_fiJson() (*asset, error) {
-	bytes, err := sv_fiJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sv_FI.json", size: 619, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sv_seJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xc3\x30\x10\x45\xd7\xd2\x29\xc4\x80\x76\xcd\x05\xbc\x2b\xb8\xd0\x2e\x0c\x05\x77\x13\x4a\x29\xe3\x5a\x4d\xe4\x5a\x52\x90\xe4\x40\x29\x3d\x4e\xaf\x90\x0b\xf8\x62\xc5\xb1\x34\x0a\xc9\xee\xff\x99\xe1\xff\x27\xd0\x0f\x67\xf0\x54\x43\x25\x20\x1c\xdf\xdb\x07\xb8\xe3\x0c\x6a\xfc\x0e\x50\x89\x57\xce\x18\x84\xf9\x64\x7b\xdc\x2d\x73\x06\x66\xfe\x2b\x26\xea\x40\xda\xd9\xa2\xa3\xf3\xc5\x7c\x7a\x45\x7a\x9c\x4f\x7e\x31\x9c\xbd\x2d\x2d\xed\xde\xf9\x78\x5d\x55\x7a\xa8\x84\x1a\x28\x9e\xa2\x4b\x6e\x0e\x6d\x9c\x8d\x7b\x4a\x1c\xd0\x4e\xe8\x75\xba\x57\x9d\x2f\xce\xa0\x4f\x81\x78\xf0\x7a\xcc\xc3\x61\x15\xc3\x64\x75\x56\x63\x52\x38\xed\xa6\x10\x93\x09\xea\x10\x95\xe9\x54\x62\x71\x5f\xd1\x91\xb1\xee\x78\xb1\xea\xd5\xc7\xea\x2e\x9f\x7d\x83\x49\x88\x44\x47\x70\xb7\x68\x44\x46\x60\x04\x45\x38\x84\x42\x14\x19\xe0\xbe\x79\x6e\x72\xf3\xba\xcc\x9b\x1a\xa3\x5a\x7e\x82\xdc\x6e\xa4\xd9\xc8\x1e\xf2\xf0\x45\x9b\x75\x81\x42\x2a\x21\x3b\x21\xb7\x42\x3e\x56\xb2\xa9\x64\x7b\x3e\xa2\x83\xeb\x61\x2a\x03\xe0\xbf\xff\x01\x00\x00\xff\xff\xeb\x76\x0a\x22\x6a\x02\x00\x00")
-
-func sv_seJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sv_seJson,
-		"sv_SE.json",
-	)
-}
-
-func sv_seJson() (*asset, error) {
-	bytes, err := sv_seJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sv_SE.json", size: 618, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sw_keJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x92\x41\x4b\xfc\x30\x10\xc5\xcf\xcd\xa7\x08\x81\xb9\xfd\x61\xef\xbd\xf5\x4f\x15\xaa\x44\x85\x7a\x59\x45\x64\xaa\xc1\x46\x9b\xa4\x34\x89\x22\xe2\x77\x97\x6e\x93\xc9\xca\xde\xbd\xcd\xcb\x7b\xc3\xfb\x31\xe4\x8b\x55\xa2\x6b\x45\xcd\x85\xff\x78\xbc\x3c\x13\xff\x58\x25\x5a\xfc\xf4\xa2\xe6\xf7\xac\xaa\xc4\x45\x34\x38\xeb\x49\xaf\xc6\xa6\x02\x86\x58\x94\xb5\xea\xd8\xb2\x6e\x53\xcd\x34\xa2\xd1\x3e\xad\x75\xaf\xd1\x20\x96\x9c\x71\x5e\x0b\x56\x3d\xac\x65\xfd\xe8\x96\xf0\xa7\x8d\xd2\xd9\x30\x96\x3a\xb4\x11\x97\xb4\x76\xae\x86\xa5\x28\x89\x4f\x63\x1a\x9b\x79\x21\x24\xa9\x88\xcd\xd2\x34\x61\x4e\xbe\x38\x1f\xd2\xdc\xab\x39\x28\x33\x24\x8e\xeb\xb7\xe0\xf2\x7c\xe5\xde\x8b\xd1\x2a\x7f\x10\xc7\x17\x39\x81\x24\x40\x62\x23\xb2\x53\x2c\xa2\x22\x26\x02\x22\x16\x02\x21\x88\x0c\xd0\xc8\x1b\x99\x9b\xd1\xc7\x21\xe6\x2b\xe0\x84\x5e\x2f\x74\xc8\x16\x83\x5a\x7f\x0e\x3c\xef\xc0\xec\x60\x2f\xf2\xe3\xad\x36\x9b\xa1\x38\xfc\xe7\xb0\xe7\xd0\xd5\x20\x6b\xe8\x39\xcc\x1c\xee\x0e\x39\xca\x14\x8b\xde\x13\xc0\x2f\x8f\x7d\xb3\x9f\x00\x00\x00\xff\xff\x34\x3b\x32\x89\xac\x02\x00\x00")
-
-func sw_keJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sw_keJson,
-		"sw_KE.json",
-	)
-}
-
-func sw_keJson() (*asset, error) {
-	bytes, err := sw_keJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sw_KE.json", size: 684, mode: os.FileMode(420), modTime: time.Unix(1487356151, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _sw_tzJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xcf\x4a\xc4\x30\x10\xc6\xcf\xed\x53\x84\x40\x6e\xc2\xe2\xbf\x4b\x6f\x95\x22\x54\x88\x0a\xdd\xcb\x2a\x22\x53\x0d\x36\xda\x24\xa5\x49\x14\x11\xdf\x5d\x6a\x27\x93\x5d\xf6\x94\xf9\xf2\x4d\x67\x7e\xd3\xcc\x4f\x59\xf0\xb6\xe1\x15\xe3\xfe\xeb\x79\xfb\xc0\x4f\xca\x82\x37\xf0\xed\x79\xc5\x1e\xcb\xa2\xe0\x37\xd1\xc0\xa4\x47\xbd\x18\xab\x0a\x10\x62\x56\xd6\xaa\x7d\xcb\xba\x55\xd5\xe3\x00\x46\x7b\xfc\xac\x7d\x8f\x06\x20\xe7\x19\xe7\x35\x2f\x8b\xa7\xa5\x59\x37\xb8\x39\x1c\x74\x3c\xc3\xc4\x73\x3c\x2f\xf0\xbc\xa4\xd2\xa9\x2a\x1a\xa7\xa9\x96\x74\x36\x0c\xb9\x10\xd8\x08\x33\x22\x5c\xab\x7e\xce\x4a\xc2\xcb\x80\x61\x3d\xcd\x34\x9e\x54\x34\xa7\xa5\x68\x84\x94\xf9\xe6\x7c\xc0\xb8\x53\x53\x50\xa6\xc7\x99\xee\x3e\x82\x4b\xf1\xad\xfb\xcc\x46\xa3\xfc\xbf\xd8\x9f\xf5\x08\x92\x00\x89\x8d\xc8\x8e\xb1\x88\x8a\x98\x08\x88\x58\x08\x84\x20\x12\x40\x2d\xef\x65\xea\x0c\x3e\xf6\x31\xfd\x05\x18\xc1\xeb\x99\x1e\xa5\x81\xa0\x96\x9d\x10\xaf\x1b\x61\x36\x62\xc7\xd3\xe5\x56\x9b\xd5\x50\x4c\x5c\x31\xb1\x63\xa2\xad\x84\xac\x44\xc7\xc4\xc4\xc4\xba\x3e\x94\x93\x2d\xba\x47\x80\x03\xaf\xfc\xfd\x0b\x00\x00\xff\xff\x5b\x10\x37\xae\x85\x02\x00\x00")
-
-func sw_tzJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_sw_tzJson,
-		"sw_TZ.json",
-	)
-}
-
-func sw_tzJson() (*asset, error) {
-	bytes, err := sw_tzJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "sw_TZ.json", size: 645, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ta_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\x31\x4b\xf3\x50\x14\x9d\x93\x5f\xf1\x78\xf0\xb6\xef\x17\x64\xeb\x47\x97\x0e\x11\xa1\x2e\x2a\x22\x01\x85\x3a\x68\x45\xb3\x88\x38\x54\xb0\x0a\x12\x9c\x3a\x18\x8a\x5d\x1c\x6c\x86\xfb\x62\x63\xc0\xc9\xfe\x95\xfb\x53\xe4\x5d\xf3\x92\xeb\x7b\xdd\xdc\xda\x73\xee\xb9\xf7\x9c\xf3\xc8\x75\x18\xc8\x41\x5f\x46\x42\xa6\xc9\xe1\x60\x4b\xfe\x0b\x03\xd9\x4f\xae\x2e\x65\x24\xf6\xc3\x20\x90\x08\x2f\x08\x5f\x08\x1a\x61\x8d\xf0\x8e\x7a\x62\x46\x0c\xfe\x4a\xc8\x33\xea\x0c\x61\x86\x50\xa1\xce\x2c\x95\xa3\x9e\x22\xd4\x44\xd5\x8d\xbc\x63\x0b\xd4\x13\x92\x2f\x19\x58\xd3\x36\x4d\xc3\x1f\x2e\x65\xb6\x55\xb4\xad\x42\x58\x77\x57\x60\x69\xfe\x86\xc1\x81\x71\x3d\x1c\x8d\x2f\x52\xdf\x3a\xb7\xcb\xfd\x71\x37\xdc\x04\xbf\xda\xce\xdb\x1b\xf1\xf8\x2c\x1d\xb1\x03\x73\xb2\x50\x23\x94\x4c\x59\x50\x94\x82\xfc\x96\x1e\x0b\x14\xb1\x24\x36\x67\x29\x9f\x98\x64\xc5\x70\x40\x7d\x6f\x7f\xcf\x51\xdf\xfe\xee\xe6\x07\x59\xa1\x7e\xb0\xc8\x94\x5e\xe3\x93\x56\x2d\xbc\x37\x29\x1a\xdc\xac\xcd\xc8\x6a\xc9\x66\xee\x8c\xb6\x11\x3e\x7a\xec\x1b\x65\xd9\x28\x5c\x50\xe4\xdc\x65\xf9\xcb\x6c\xaa\xce\x6f\xcc\x6f\xc9\xe9\xe7\x2f\xb5\xf8\x55\x38\xc1\x79\x52\x27\x9a\xcd\xd2\x8b\xb7\xe3\x2e\xc4\x8c\x6c\xf2\x3b\xd0\x21\x8d\xa2\x9f\xa4\xc7\xe6\xfb\x52\x3d\xa1\x8e\x84\xfa\x2f\xd4\xae\xb4\xf8\xce\xc9\xa9\xcb\x09\x35\x88\x54\x1c\xa9\xa1\x50\xe7\x42\xed\xd1\x68\x3b\xd6\x52\x1d\xde\x18\x92\x8e\x2c\xbc\xf9\x0e\x00\x00\xff\xff\x83\x27\xae\xec\xda\x03\x00\x00")
-
-func ta_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ta_inJson,
-		"ta_IN.json",
-	)
-}
-
-func ta_inJson() (*asset, error) {
-	bytes, err := ta_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ta_IN.json", size: 986, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ta_lkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x53\xb1\x4a\x03\x41\x10\xad\xef\xbe\x62\x59\xd8\xce\x2f\xb8\x2e\x92\x26\xe8\x81\x10\x1b\x15\x91\x80\x42\x2c\x34\xa2\xd7\x88\x58\x44\x30\x0a\x72\x58\xa5\xf0\x08\xa6\xb1\x30\x57\xcc\x9e\x39\x0f\xac\xcc\xaf\xcc\xa7\xc8\x8e\xb7\x77\xe3\x6e\x3a\xbb\xe4\xbd\x79\x33\xef\xbd\xe5\x6e\xc2\x40\xf6\xba\x32\x12\x32\x19\x1c\x6d\x6f\xc9\x8d\x30\x90\xdd\xc1\xf5\x95\x8c\xc4\x41\x18\x04\x12\xe1\x15\xe1\x1b\x41\x23\xac\x10\x3e\x50\x8f\xcd\x88\xc1\xdf\x08\x79\x41\x9d\x22\x4c\x11\x4a\xd4\xa9\xa5\x32\xd4\x13\x84\x8a\xa8\xaa\x96\xb7\x6c\x8e\x7a\x4c\xf2\x05\x03\x2b\xda\xa6\x69\xf8\xd3\xa5\xcc\xb6\x92\xb6\x95\x08\xab\xf6\x0a\x2c\xcc\xdf\x30\x38\x34\xae\xfb\xc3\xd1\x65\xe2\x5b\xe7\x76\xb9\x3f\xee\x86\x9b\xe0\x57\x9b\x79\x7b\x23\x1e\x9d\x27\x43\x76\x60\x46\x16\x2a\x84\x82\x29\x73\x8a\x92\x93\xdf\xc2\x63\x81\x22\x16\xc4\x66\x2c\xe5\x33\x93\x2c\x19\x0e\xa8\x1f\xec\xef\x19\xea\xbb\xbf\xdd\xfc\x22\x4b\xd4\x8f\x16\x99\xd0\x6b\x7c\xd1\xaa\xb9\xf7\x26\x79\x8d\x9b\xb5\x29\x59\x2d\xd8\xcc\xbd\xd1\xd6\xc2\x27\x8f\x7d\xa7\x2c\x6b\x85\x73\x8a\x9c\xb9\x2c\x7f\x99\x75\xd5\xf9\x8d\xf9\x2d\x39\xfd\xfc\xa7\x16\xbf\x0a\x27\x38\x4f\xea\x44\xb3\x59\x3a\xf1\x4e\xdc\x86\x98\x92\x4d\x7e\x07\x5a\xa4\x56\x74\x07\xc9\x89\xf9\xbe\x54\x47\xa8\x63\xa1\x36\x85\xda\x93\x16\xdf\x3d\x3d\x73\x39\xa1\x7a\x91\x8a\x23\xd5\x17\xea\x42\xa8\x7d\x1a\x6d\xc6\x1a\xaa\xc5\x6b\x43\xd2\x91\x85\xb7\x3f\x01\x00\x00\xff\xff\x23\xf1\x9e\xd8\xda\x03\x00\x00")
-
-func ta_lkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ta_lkJson,
-		"ta_LK.json",
-	)
-}
-
-func ta_lkJson() (*asset, error) {
-	bytes, err := ta_lkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ta_LK.json", size: 986, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _te_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x94\xb1\x4a\x33\x41\x10\xc7\xeb\xdb\xa7\x58\x16\xb6\xfb\xb8\x07\x48\x97\x8f\x6b\xae\x38\x11\x62\xa3\x22\x12\x30\x10\x0b\x8d\xe8\x35\x22\x16\x1e\x78\x2a\x12\xac\x24\x20\x81\xc3\x46\x45\xc3\x31\x1b\x89\x5c\x95\xbc\xca\x3c\x8a\xec\x24\x9b\x5b\xf7\xb6\xb2\xb6\x09\xcb\xfc\x66\x76\x7f\xff\x09\xdc\x05\x0b\x44\x1c\x89\x16\x17\x69\x6f\x3f\xde\x10\xff\x58\x20\xa2\xee\xf9\x99\x68\xf1\x5d\x16\x04\x02\x21\x47\x78\x41\x58\x20\xcc\x10\xe6\x08\x80\x90\xe9\x2e\x8d\x2a\x54\xf7\x08\xa5\x0f\x95\x08\x19\xc2\x08\xe1\xd3\x47\x27\xa8\xae\x10\x5e\x7d\x68\x44\x08\xe8\xb7\x49\xbf\xa8\xfe\x88\x6a\x48\x75\x5f\x03\xbc\xb9\xb6\x2c\xd8\xd3\xb1\x3a\xfd\xc1\x69\xea\xcd\xe6\xe4\x69\x66\x70\xbc\x9b\xae\x5e\x3f\xc7\xc9\x78\x24\x83\xe3\xb4\x6f\x49\x8c\x09\xcf\x48\x76\xad\xf2\x41\x21\x26\x56\x50\x9b\x96\xab\x70\x9a\x3e\x59\xf5\x07\x84\x77\x33\xb2\x40\x98\xa2\x1a\xd6\x23\xea\xc6\x9c\xc7\xa8\x32\xfd\x68\x4d\x97\x95\x29\xaa\x5b\x53\xc9\x29\x79\x45\xb7\x15\x56\xc2\x0a\x55\x6e\x5e\x29\xe8\x9c\x69\xcf\x1f\x5b\xb8\x36\x2b\x28\x68\xa1\x0e\x5d\x86\x6d\x4e\x3d\x93\x73\xe5\xde\x69\xff\x7b\x7f\xab\xfb\xcd\xea\xda\xc9\x66\x52\xef\xec\x2e\xac\x7d\x60\x1e\x9a\xa6\xa8\x9b\xf6\xf4\x67\x40\xfe\xe7\xf2\x80\xcb\x36\x97\xdb\xc2\xd4\xb7\x0e\x8f\x5c\xc6\xe5\x89\x8c\x43\x99\x84\xb2\xc3\xe5\x0e\x75\xae\xbb\xbc\x64\x25\xe1\x50\x76\xf9\x1d\x00\x00\xff\xff\xc4\x8b\xb6\x15\x81\x04\x00\x00")
-
-func te_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_te_inJson,
-		"te_IN.json",
-	)
-}
-
-func te_inJson() (*asset, error) {
-	bytes, err := te_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "te_IN.json", size: 1153, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tg_tjJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\xf3\x40\x14\xc5\xd7\xc9\x53\x84\x81\xec\x3e\xfa\x00\xdd\x7d\xd0\xcd\xf7\x41\x41\xb0\x9b\x22\x22\x95\x8a\x75\x51\x0b\x9a\x8d\x88\x90\x4e\xc1\x55\xb1\xa5\xb8\x70\xe1\x1f\x0a\x42\x97\x26\x69\x07\x34\x65\xe2\x2b\x9c\xfb\x46\x32\x69\x26\xb9\x51\x77\xbd\xe7\x9c\xde\xfb\x3b\x49\xae\x5d\x47\xfc\x6b\x89\xa6\x27\x82\xd3\xa3\xce\x7f\xf1\xc7\x75\x44\xab\x77\x75\x29\x9a\xde\x81\xeb\x38\x02\x0b\x64\x34\x46\x4a\x21\x14\x8d\xa1\xa0\x69\x0a\x65\x62\x8e\xc0\x0b\x32\x68\x28\x6c\xa0\xb0\xa5\x29\x34\xde\x91\x16\xde\x82\x24\x32\x0a\x6b\xda\xd2\x6c\xc1\x06\x51\x31\xaf\xa0\x48\x22\x81\xa2\x10\x6b\xbb\x93\x66\x24\xcd\xbf\xe8\xb6\xcc\x2d\x69\x82\x18\x31\x32\x92\x88\x84\xeb\x1c\x1a\xca\xfd\xc1\xe8\x22\xa8\xa3\x1a\xd0\x12\x4d\x63\x53\xa1\x50\xc8\x10\xac\xbe\x32\xc7\xcb\xb3\x12\xda\x66\x10\x93\xb4\x67\xda\xa3\xf3\x60\x50\xdd\x78\x83\x46\x82\x88\x42\x9a\x15\xe9\x57\x28\x24\x14\x22\xc2\xb6\xd4\x1e\xf3\x84\x2c\x0b\xcc\xf1\x99\x57\xaf\x27\xec\xef\x07\xba\x83\xae\x4d\x55\x6e\x8e\x04\x6b\x9a\xd0\x98\x6d\x5b\xe6\xaf\x41\xd2\x0c\x31\xe3\x78\x46\xfa\x43\x7b\x42\xf6\x4d\xb9\x87\x42\x8a\xa8\xd0\xf8\xa3\xfc\xad\x28\xaf\xc8\xab\xf1\x5a\x95\x8e\x0f\x5e\x88\xd7\xe1\x65\x78\x09\x8e\xce\x91\x39\xac\x85\xfc\xdb\xde\x6b\x5b\xba\x9d\x6f\x9d\x56\x2f\x38\x31\x5f\xb0\xdf\x6f\xf8\xc3\x86\xdf\x15\x56\xec\x9c\x0d\x77\x46\xcf\xf3\xfb\x9e\x7f\xec\xf9\x5d\xcf\xef\xe4\x76\x69\x55\x63\x71\x40\x08\xf7\xe6\x2b\x00\x00\xff\xff\xb9\x9c\xe1\xdd\x16\x03\x00\x00")
-
-func tg_tjJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tg_tjJson,
-		"tg_TJ.json",
-	)
-}
-
-func tg_tjJson() (*asset, error) {
-	bytes, err := tg_tjJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tg_TJ.json", size: 790, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _th_thJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x53\xc1\x6a\xea\x40\x14\x5d\x27\x5f\x11\x06\x66\x27\x71\x9f\x9d\xa0\xa0\x8b\x80\xa0\xbb\xc7\xe3\xe1\xe3\x09\xbe\x85\xb5\xb4\xd9\x48\x29\x34\x20\xd8\x45\xe9\xaa\xb6\xb1\x8b\xd0\xc4\x96\x6a\x8a\x8b\x8a\xc2\xf5\x6f\xee\xa7\x94\xb9\xc9\x8c\x33\xce\x6e\xe6\x9e\x73\xee\x3d\x73\x6e\x72\xe3\x3a\xac\xd3\x64\x81\xc7\xa2\xd1\x9f\x7e\x9b\xd5\x5c\x87\x35\x07\xd3\x6b\x16\x78\xbf\x5c\xc7\x61\x08\x5b\x84\x1d\xc2\x0b\xc2\x1e\x61\x81\x90\xe3\xf1\x41\xb0\x04\x74\x8f\xf0\x8d\xb0\x24\x74\xa5\xd5\xb7\x54\x9f\x23\xcc\x48\xbb\x92\xf5\x14\x01\x10\x12\xed\xfa\x8e\xf0\x45\xe4\x02\xe1\x15\xe1\x09\xe1\x20\xd1\x35\x91\x63\xa3\xf3\xf1\x8e\x98\xbb\xaa\xe8\x3a\xbf\x85\xdf\xde\x68\x72\x15\xd9\xa6\x7d\x65\x53\x9d\xb6\xea\x94\xfa\xba\x0b\x75\x59\xab\x53\xe1\xcb\xf6\xe1\xe4\x22\x1a\x69\xbd\xb3\xca\x95\xb0\x31\x43\xc8\xa4\x22\x26\xc3\x19\xc2\x1b\x41\xa9\x0c\x27\xd1\x92\xc9\x10\x0e\x54\x34\xb5\xe2\x5d\x19\xc2\x86\xea\x39\xc2\xd2\x8c\x68\x23\x7b\xea\xe3\x32\xda\xc8\x33\x0d\x5d\x5a\xc2\xd2\x61\x8c\xf0\x68\x09\x0b\x12\xce\x29\x79\xfb\x09\xa5\xe7\xdc\x6a\xb8\xa0\x41\x1f\x96\xa4\x74\xb8\xa6\x8f\x61\x4f\x1d\xce\x84\x89\xec\xf9\x79\xd2\xea\x8b\xb3\xe2\xf5\x11\x66\x6a\x0d\xb1\xaf\xef\x4a\xc4\xa7\xc3\x94\x9b\x8f\x90\x6b\x7b\xd5\xd5\x22\x23\x03\x8e\x0d\xb8\xb0\x46\x9d\x98\x0b\x03\x4b\x0d\x2c\x29\xb1\xea\x15\x8d\xb0\x1b\x4a\xfb\x8d\xb0\xe4\x74\x43\x89\x36\x07\xd1\x50\xfc\x5e\xfc\x5f\x9d\x8f\xeb\xbc\x35\x65\xb2\xda\xff\x3f\x2e\x91\x81\xc7\x87\x1e\xff\xeb\xf1\xd6\xb4\xe6\xf1\x76\xc0\xc3\x80\xf7\x88\xa6\x28\xe7\xc5\x6a\x26\xe3\x9d\x12\xf0\xf8\x25\x73\x6f\x7f\x02\x00\x00\xff\xff\xb9\xf9\x05\xf3\xcd\x03\x00\x00")
-
-func th_thJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_th_thJson,
-		"th_TH.json",
-	)
-}
-
-func th_thJson() (*asset, error) {
-	bytes, err := th_thJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "th_TH.json", size: 973, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ti_erJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x92\xc1\x4b\xe3\x40\x14\xc6\xcf\xc9\x5f\x31\x0c\xcc\x6d\xa1\xf7\xdc\xba\x74\x0f\x3d\x04\x96\xed\x1e\xb6\x2b\x22\x05\x0b\xed\xa1\x56\x6a\x2e\x22\x82\x17\xe5\xbd\x4c\x0a\x95\x12\x50\x21\x60\x0b\x95\x62\x29\x94\x96\xfe\x41\xdf\x7f\x22\x13\x33\x49\x0c\x22\x22\x78\x7c\xdf\x9b\xf7\xbe\xf7\xfb\x98\x0b\xd7\x91\xcd\x86\xf4\x84\x0c\xfa\x47\xbf\xfe\xc8\x1f\xae\x23\x1b\x9d\xf3\x33\xe9\x89\x03\xd7\x71\x24\x68\x83\x30\x06\x3f\x82\xf7\xa6\x99\x29\xb7\xd0\xeb\xa2\x24\x06\xe5\xdd\x25\x78\x06\x1d\xdb\x72\x0a\xba\x2f\xba\x7a\x0a\x5a\x83\xe7\x59\xc9\x57\xd0\x3b\x50\x22\x5d\xe7\xd0\x38\xb7\x7a\xc3\x51\xf0\xae\xfd\xf7\x7b\xfb\xc3\x93\xa0\x57\x18\x47\x0b\xd0\xb3\x5d\x44\x08\x57\xe0\x6d\x29\x83\x3b\x44\x1a\x3c\x2f\x29\x0f\xd0\x2b\xe8\x04\x7a\x95\x29\xd1\x38\x3d\xfd\xe9\x6d\x72\x93\xd2\x71\x09\x88\xb2\x32\x9c\xa4\xca\xa6\xd8\x4f\x7b\x84\x4b\x83\x44\x89\x5d\xb8\x00\x5f\x9b\x29\xde\xda\x67\x71\xca\x60\xe3\xe0\x9d\x51\x68\x67\x98\xcb\x89\x7e\x02\xad\xc2\x55\x81\xaa\x10\x7d\x19\xa7\x02\xf2\x31\x85\x45\xa8\xfb\xbf\xfd\xfc\xf6\x30\x46\xc4\xa0\x1b\x61\xdc\xf5\x14\x6c\x2d\xf4\x3e\x9d\x5a\xe7\x8d\x3c\x82\x46\x27\xe8\x9a\x0f\xae\x8e\x6b\x6a\x50\x53\x6d\x69\xc5\xbf\xfd\xc1\x6b\xa3\x8e\xf1\x4c\xa8\x9f\x42\x75\x85\x39\xd5\x7c\x94\x31\x78\x2b\x54\x5b\xa8\x91\x50\xff\xd3\x89\xfc\x75\xd3\x53\xbe\xa7\x5a\xb9\x98\xdd\x27\xd5\x3f\xa1\x4e\xa5\x7b\xf9\x12\x00\x00\xff\xff\x80\xc2\xa5\x27\x53\x03\x00\x00")
-
-func ti_erJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ti_erJson,
-		"ti_ER.json",
-	)
-}
-
-func ti_erJson() (*asset, error) {
-	bytes, err := ti_erJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ti_ER.json", size: 851, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ti_etJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x92\x3f\x4b\xc3\x40\x18\xc6\xe7\xe4\x53\x1c\x07\xb7\x09\xdd\xb3\x55\xea\xd0\x21\x20\xb4\x83\x55\x44\x0a\x16\xda\xa1\x56\x6a\x16\x11\x41\x11\xe4\xbd\x5c\x32\x48\x89\x28\x5a\xf0\x3f\x2a\x06\x4a\x6d\x46\x3f\xcc\xf3\x4d\xe4\x6a\x92\x4b\x83\x3a\x08\x6e\x79\x9f\xe7\xfd\xf7\xbb\xbc\x07\xb6\xc5\xeb\x35\xee\x30\xee\xf5\xb6\x56\x9a\x7c\xc9\xb6\x78\xad\xbd\xbf\xc7\x1d\xb6\x61\x5b\x16\x07\x4d\xe0\x47\x90\x37\x90\x89\x36\x53\xe5\x0c\x2a\x36\x21\x49\x50\xee\x3e\x43\xde\x42\x45\x59\x38\x02\x5d\x1a\x57\x8d\x40\x31\xe4\x5d\x1a\xca\x23\xa8\x77\xd0\x98\xdb\xd6\xa6\x9e\xdc\xe8\x0e\x86\xde\xb7\xe3\xff\x7f\xb6\x3b\xd8\xf1\xba\x66\x70\x70\xa2\x07\xab\x17\x28\x02\xbd\xa6\x35\x61\x00\xf9\x08\x5a\x14\xe9\x6a\xde\xf7\x23\x0d\xfd\x07\x84\x91\x5e\x85\xc2\x2c\xe1\xda\x6c\x1c\x1c\xc3\x8f\xcc\x37\x29\x63\xf9\x4f\x08\x08\x94\x14\x5e\x7a\xa6\x5b\xc9\x19\x68\xac\xff\x00\x15\x32\xfd\x18\x72\x02\x15\x2e\xea\xe7\x90\x6f\xe5\x64\x35\xd5\x7d\x72\xb1\xf8\xd2\x3f\x20\x97\x60\x7f\xc7\xfc\x1b\x63\x09\xb0\xc4\x55\xc2\x29\x81\x64\x08\x55\x77\xd5\xcd\x77\xf7\x23\x04\x12\x74\xca\xf4\x4d\xa8\x91\x69\xa2\x12\x50\x04\x8a\x73\x23\xc9\xea\x6b\x6d\xaf\xa3\x0f\x5f\x6c\x57\x44\xbf\x22\x5a\x3c\x13\x9b\xbd\xfe\x97\x51\x45\x78\xcf\xc4\x32\x13\x1d\x06\xba\x98\x1f\x50\x08\x39\x65\xa2\xc5\xc4\x90\x89\xf5\x79\x45\x9e\x5d\x77\x84\xeb\x88\x46\x2e\xa6\xfb\x71\xb1\xc6\xc4\x2e\xb7\x0f\x3f\x03\x00\x00\xff\xff\x57\xe8\xd3\x5f\x6b\x03\x00\x00")
-
-func ti_etJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ti_etJson,
-		"ti_ET.json",
-	)
-}
-
-func ti_etJson() (*asset, error) {
-	bytes, err := ti_etJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ti_ET.json", size: 875, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tig_erJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x93\x41\x6b\xe2\x40\x14\xc7\xcf\xc9\xa7\x18\x06\xe6\xb6\x90\x7b\x6e\x2e\xee\xc1\x43\x60\x59\xf7\xb0\xee\xb2\x2c\xc2\xca\xea\xc1\xb5\xd8\x5c\x4a\x29\xf4\xd2\xf2\x26\xa3\x20\x48\x4a\x11\x02\xad\x62\x91\x8a\x45\x94\x40\x2f\xfd\x30\xff\x6f\x52\x66\x9a\x49\x34\x2d\x52\x7a\x9b\xbc\xf7\xf2\x7f\xbf\xdf\x84\x9c\xba\x0e\xaf\x55\xb9\xcf\x78\xd8\xf9\xf7\xe7\xcb\x37\xfe\xc9\x75\x78\xb5\x79\x72\xcc\x7d\xf6\xcb\x75\x1c\x0e\x5a\x23\x8a\x21\x6f\x20\x53\x06\x35\x86\x9c\x41\xad\xf4\x58\xd6\xbb\xca\xce\x72\x0b\x52\xa0\xc7\xa2\x12\xcd\x40\x0b\xd0\xca\xbc\x32\xb6\xc5\x05\x68\x02\x7a\xca\x1e\x07\x17\xa0\xc4\xc4\xa6\x3b\x99\xf9\xbe\x28\x46\x34\xd5\xd3\xae\xf3\x5b\x93\xd5\xdb\xbd\x7e\x58\xc2\xf3\x8a\xf0\xb7\x78\x4a\x30\x87\x31\xf2\x1c\x0f\x51\x6c\x97\x06\xbd\xff\x61\xbb\xd8\x38\x98\x83\xee\xed\x20\x21\x5a\x42\x6e\x76\xf0\xaf\x31\x50\x90\xd3\x9d\xca\x04\x6a\x09\x95\x40\x2d\xed\xb6\xa1\x51\xbc\xdb\x97\x1e\xd9\xf3\x58\xb3\x10\x59\xd2\x91\xa9\xac\x8b\x7c\x4a\x0d\xfe\x02\x94\xd8\xc0\x39\xa4\x31\x90\x1b\x3b\x16\x43\x6d\x0b\x5d\x7d\x19\x31\x68\x0b\x4a\xf7\xae\xf2\x1d\x6a\x25\xaf\x92\x54\xc9\xe8\xc3\x3a\x25\x91\xc3\x16\x56\xa1\x12\x7c\x0d\x72\x76\x79\x0e\xb5\x06\x25\x4c\x6f\xa7\x15\x33\x1f\x34\x06\x0d\x0b\x0e\x79\xf9\xba\x99\x45\x55\x9b\x61\x4b\xff\x05\xe2\xaf\x27\xba\x9e\x68\x70\x5b\xfc\xde\xe9\xbe\x34\x2a\x18\xde\x32\xf1\x99\x89\x16\x83\x7a\xd0\x8b\x44\x83\x89\x3e\x13\x3f\xcd\x6c\x3e\x57\xf3\x45\xe0\x8b\x7a\x5e\xcc\x20\xb9\xf8\xc1\xc4\x11\x77\xcf\x9e\x03\x00\x00\xff\xff\x6a\x77\x36\xfd\x72\x03\x00\x00")
-
-func tig_erJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tig_erJson,
-		"tig_ER.json",
-	)
-}
-
-func tig_erJson() (*asset, error) {
-	bytes, err := tig_erJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tig_ER.json", size: 882, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tk_tmJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\x4d\x6a\xc3\x30\x10\x85\xd7\xd2\x29\xcc\x80\x77\x25\x07\xc8\xce\x20\x0a\x59\xa8\x0d\xd8\x9b\x52\x4a\x51\xe8\xd0\x04\x37\x72\x51\x64\x4c\x29\x5d\xf7\x14\x69\x4f\xe2\x95\xf0\xbd\x8a\x6b\x69\x46\xfd\xd9\xbd\x37\x2f\x7a\xef\x0b\xf8\x55\x0a\xd8\x28\x58\x17\xe0\xdb\xfb\x46\xc3\x85\x14\xa0\xcc\xcb\x09\xd6\xc5\xad\x14\x02\x54\x3f\x7d\xa2\xdd\xe1\x1c\x08\xa8\x0f\xb9\x0b\xef\xc6\xe5\x7e\x8b\x36\xb7\x95\xb5\x66\x51\xd3\x47\xf6\xe8\x8c\x6d\xfc\x95\x14\x77\xf3\x5c\xbd\xef\x9c\xff\xbd\xc9\x7b\xbc\x45\x2b\xd4\xcf\xf5\x5c\x9e\x6a\x75\x67\xfd\x9e\x3a\xc3\xd9\xd8\x21\x55\x5c\xe2\xe0\xcc\xd3\xa2\xb5\x71\x3e\xf6\x3d\x3b\xa4\x63\x18\x17\xb5\x09\x63\x6f\x59\xc6\xbc\x1a\x1e\xfb\x53\x7c\x56\xa3\xf5\x61\x34\xbb\xd8\x7d\xdd\xe6\xee\xaa\xcb\x8c\xc2\x76\xd6\xf9\xdf\xfe\x0b\x49\x84\x84\x47\x74\xff\xb0\x31\x1a\x93\x11\x16\x01\x31\x0b\x81\x24\x8a\x4a\x6f\x75\x9a\x5f\xc2\x94\x28\xe3\x71\xfe\x2e\xca\x87\x55\x79\x5c\x95\x37\x90\x8e\xcd\xe1\xf8\x23\x28\xca\xe6\x3b\xa3\x3b\xdb\xd8\x0e\x20\xdf\xbe\x02\x00\x00\xff\xff\x0b\xbe\xda\x0b\x69\x02\x00\x00")
-
-func tk_tmJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tk_tmJson,
-		"tk_TM.json",
-	)
-}
-
-func tk_tmJson() (*asset, error) {
-	bytes, err := tk_tmJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tk_TM.json", size: 617, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tl_phJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4b\xc3\x30\x18\xc5\xcf\xe9\x5f\x11\x3e\xf8\x6e\xc2\xee\xbd\x55\x2a\x6c\xb0\xe8\xa0\x5e\x54\x44\x12\x16\xba\xb0\xb6\x19\x69\x8a\x14\xf1\x7f\x97\xda\xe4\xa9\xf3\xf6\xde\xf7\xb6\xf7\x7e\x81\x7e\x14\x82\x76\x35\x95\x92\x62\xf7\x76\xd8\xd2\x4d\x21\xa8\xd6\xf3\x48\xa5\x7c\x29\x84\xa0\xbd\x1b\xda\xd6\x2f\x67\x41\xfb\x69\xb0\xe3\x2a\x95\x0e\x11\xda\xcd\x36\x9c\x7d\x97\xfd\x76\x7a\xb7\x26\x9b\xdb\x25\xc4\xdf\x1a\x6d\xf4\xd1\x53\x21\x5e\x97\x9d\xe6\xe4\x43\xbc\x1a\xc3\x12\x76\x30\x82\x76\x34\xa3\x34\x37\x2a\x3f\xc4\x13\xea\xee\x06\x1b\x12\xfa\xc1\x9a\x00\xa3\x74\x18\x93\xac\x4c\x70\x5d\xbe\xce\x3e\x4f\x0c\x3f\xb2\xcb\xb2\x6a\xfd\x18\x93\x6e\xec\x25\xba\xd9\xf6\x26\xd8\xf5\xf0\x70\x8e\x13\xcc\xbd\x37\x7f\xc2\xda\x8d\xc9\xfe\x7e\xf8\x3f\x56\x90\x5e\x3d\xbd\x32\x01\x84\x00\x04\x1e\xe0\x40\x06\x22\xd0\x00\x23\x03\x54\xea\xa0\xf2\x72\xa5\xd2\xb0\xca\x69\xad\xa3\x5d\xbe\x08\xee\x37\x7c\xdc\xf0\x4c\xf9\xf8\xe8\xfa\x35\xd0\x92\x8f\x92\x8d\xe4\x27\xc9\x41\xf2\xf3\xf7\x2f\x90\x06\xd8\xb4\x43\xbc\x2b\x59\x95\xdc\x48\xbe\x50\xf1\xf9\x15\x00\x00\xff\xff\x55\x60\x0b\xca\x74\x02\x00\x00")
-
-func tl_phJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tl_phJson,
-		"tl_PH.json",
-	)
-}
-
-func tl_phJson() (*asset, error) {
-	bytes, err := tl_phJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tl_PH.json", size: 628, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tn_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xc3\x30\x0c\x85\xcf\xc9\xaf\x08\x06\xdf\x36\x7a\xef\xad\x23\x0c\x06\x4d\x29\x6b\x2e\xed\x18\x43\x21\x22\x36\x75\xac\x10\xab\x84\x31\xf6\xdf\x47\x96\x4a\x2d\xdb\xed\xbd\x3c\x5b\xef\xb3\xc8\x57\x9e\x99\x97\xd2\xac\x0b\xc3\xf1\xe3\xb4\x31\x0f\x79\x66\x4a\xf8\x4c\x66\x5d\xbc\xe5\x59\x66\x02\xd4\xc9\xf9\xc1\xcf\x41\x66\x2a\x4a\x97\x81\x02\x75\xb4\xf8\x2d\x34\xd4\x60\xeb\x6f\x6e\x84\xf1\x2e\x8b\x78\xd3\x1c\x1c\x44\xcd\x7a\x98\x3d\x27\x32\x79\xf6\x3e\x97\x1e\x1c\x8d\x7c\xdf\x5c\x27\xa7\xa5\x8b\x78\xc2\x76\x11\xaf\x30\x2e\x62\x77\x9d\x5f\x07\x39\x0b\x2c\x03\x2b\x8a\xec\x74\xda\x33\x8e\xfe\xdc\x51\xec\xf4\x02\x9c\x29\xa0\x34\x0c\x9e\xc3\xa4\x6e\x84\x08\x72\xb2\x22\x4e\x0e\x3b\x88\x7a\xf7\x80\xc8\xd8\x50\xf2\xb2\x85\xbd\xbb\x9c\x27\x2f\x1a\x58\x06\x6d\x27\xe4\x74\xd5\xa5\x1f\x1c\x04\x90\x85\xec\xba\x09\x22\x70\x42\x41\x38\x60\xeb\xfb\x99\x78\x86\xba\x5f\xc9\xbf\x67\xfc\x7d\x31\x0d\x8a\xad\xc4\x0a\xaa\x80\x4a\xa7\x68\xca\xa5\x44\x4a\x22\x00\x9b\x6a\x5f\x49\xf3\x12\x4a\x52\x02\xe3\xfc\xd3\xd8\x76\x65\xfb\x95\x3d\x1a\xf9\x58\xfb\x7e\x09\xa0\xb0\x8f\x58\xd8\xa6\xb0\xc7\xc2\xd6\x85\x3d\xfd\x1e\xd1\xb8\x56\x7b\x2d\x31\x26\xff\xfe\x09\x00\x00\xff\xff\x15\xe1\x0e\x5e\x8d\x02\x00\x00")
-
-func tn_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tn_zaJson,
-		"tn_ZA.json",
-	)
-}
-
-func tn_zaJson() (*asset, error) {
-	bytes, err := tn_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tn_ZA.json", size: 653, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tr_cyJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x10\x85\xd7\xf6\x29\x84\x60\x76\x7f\x2e\xe0\x5d\x48\x02\x7f\x28\x6e\x03\xf6\x26\x2d\xa5\x8c\x1b\x41\x4c\xa2\xa4\x48\xf2\x22\x29\x5d\xf7\x06\x5e\xd6\x67\xf0\xbe\x3b\xdb\xf7\x2a\xae\x46\x63\xda\xee\xde\x68\x1e\xef\x7d\x12\x7a\x8d\x23\xb9\x5e\xca\x44\x48\x67\x9e\x16\x5b\xf9\x2f\x8e\xe4\x12\x2f\x56\x26\xe2\x21\x8e\x22\xb9\xc1\x2b\x9a\xf1\x94\xa4\x53\xb6\xf4\x63\x86\xc7\xbe\xf5\xb2\x7b\x47\x33\x34\xa8\x0b\x24\xa7\x32\x43\xa3\x74\xa1\xfc\xb8\xa8\x34\x4e\xca\x47\xc4\xd1\xe3\x58\x95\xed\xcf\xc6\xfd\xea\xa3\x8c\xab\xe3\x9e\xd0\x62\x86\x86\x96\xac\x16\x95\x26\xe1\x6c\x08\x4d\xcf\x27\xb7\xe7\xc4\xbb\x67\x3c\x78\xcb\xf0\x51\x15\x48\xa9\x29\x1a\x52\xb7\xa5\xc5\x53\x38\xbc\xf4\xad\xf5\xfa\x3f\x5e\x4b\x13\x16\xb9\xd2\xba\x22\xb0\x79\xdf\x54\xd6\x9d\xc9\xb6\xba\x1c\xbb\x4f\x02\x5c\x1d\x4a\x82\xb9\x41\xdb\xb7\xa4\xe7\x66\x7c\xa8\xc3\x8f\x1b\xff\x21\x9c\x00\x19\x8f\xe9\x98\x8d\xc1\x18\x6a\x22\x62\x1a\x46\x61\x12\xc6\x08\x08\xf3\x74\x93\x86\xee\xae\xee\x6a\x7a\xde\x3a\x0b\x86\x25\x3a\x35\x7e\x09\xd8\xcd\x40\xcf\x20\xfc\x0a\xa7\xf2\x52\xfb\x05\x0a\xd8\x09\x28\x04\x6c\x05\xe4\x02\xee\xbf\x1d\xbc\xcd\x79\xa4\x2a\x09\xeb\x04\xd2\x04\x32\x01\x2f\x32\x7e\xfb\x0a\x00\x00\xff\xff\xb4\x76\x00\x5c\x75\x02\x00\x00")
-
-func tr_cyJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tr_cyJson,
-		"tr_CY.json",
-	)
-}
-
-func tr_cyJson() (*asset, error) {
-	bytes, err := tr_cyJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tr_CY.json", size: 629, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tr_trJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x4a\xc3\x40\x10\x86\xcf\xc9\x53\x2c\x0b\x73\xb3\x2f\x90\x5b\x69\x0b\x16\x89\x16\x93\x4b\x15\x91\x89\x5d\x68\x68\xb7\x95\xdd\xcd\xa1\x15\xcf\xbe\x41\x8f\xe6\x19\x72\xf7\x96\xe4\xbd\x24\xee\xec\x04\xf5\xf6\xcf\xcc\xcf\xff\x7f\x59\xf2\x16\x47\x72\x39\x97\x89\x90\xce\x3c\xe7\xf7\xf2\x2a\x8e\xe4\x1c\x4f\x56\x26\xe2\x31\x8e\x22\xb9\xc2\x33\x9a\x61\x4b\xd2\x29\x5b\xfa\x31\xc3\x7d\xd7\x78\xd9\x7e\xa0\xe9\x6b\xd4\x05\x92\x53\x99\xbe\x56\xba\x50\x7e\x9c\x55\x1a\x47\xe5\x23\xe2\xe8\x69\xa8\xca\xb6\x47\xe3\xfe\xf4\x51\xc6\xd9\x71\x4f\x68\x31\x7d\x4d\x47\x56\xb3\x4a\x93\x70\x36\x84\xa6\xc7\x83\xdb\x72\xe2\xdd\x0b\xee\xbc\xa5\xff\xac\x0a\xa4\xd4\x14\x0d\xa9\xdb\xd2\xe2\x21\x2c\x4f\x5d\x63\xbd\xbe\xc6\x73\x69\xc2\x21\x57\x5a\x57\x04\x36\xed\xea\xca\xba\x23\xd9\x16\xa7\x7d\xfb\x45\x80\x8b\x5d\x49\x30\x37\x68\xbb\x86\xf4\xd4\x0c\x0f\xb5\xfb\xf5\xc5\xff\x08\x47\x40\xc6\x63\x3a\x66\x63\x30\x86\x1a\x89\x98\x86\x51\x98\x84\x31\x02\xc2\x34\x5d\xa5\xa1\xbb\xbd\xb4\x17\x7a\xde\x4b\x16\x0c\x73\x74\x6a\xf8\x25\x60\x33\x01\x3d\x81\xb5\x0c\xcb\xbc\xd4\xfe\x80\x02\x36\x02\x0a\x01\x6b\x01\xb9\x80\x87\x1f\x07\x5f\x73\x1e\xa9\x4a\xc2\x32\x81\x34\x81\x4c\xc0\xab\x8c\xdf\xbf\x03\x00\x00\xff\xff\xde\x3d\xe6\x71\x75\x02\x00\x00")
-
-func tr_trJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tr_trJson,
-		"tr_TR.json",
-	)
-}
-
-func tr_trJson() (*asset, error) {
-	bytes, err := tr_trJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tr_TR.json", size: 629, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ts_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x31\x6b\xc3\x30\x10\x85\x67\xfb\x57\x08\x81\xe8\xd2\x92\x3d\x5b\x8a\x87\x94\xe0\x50\x1a\x2f\x49\x29\xe5\x82\x4d\x4e\x58\x96\xa9\xad\xb3\x93\x94\xfe\xf7\xe2\x4a\xba\x98\x14\x3c\x9c\xde\x93\xde\xfb\x24\xfc\x9d\x26\xf2\x25\x93\x4b\x21\x5d\xff\x79\x58\xc9\xc7\x34\x91\x19\x5c\x7a\xb9\x14\xef\x69\x92\xc8\x5d\x6b\x5d\x3b\xa9\x89\xcc\xa9\xa7\xe6\x88\x64\xa9\x26\xaf\xbc\xc1\x40\xcd\x51\x77\xa8\x6f\x6b\x8b\xd0\xe1\xdc\x27\x5b\xcd\x5c\x67\x10\x2c\xc5\xc0\xd3\x97\x1e\x2a\x03\x32\x4d\x3e\xa6\xe2\x1d\xb6\x9d\xbb\x6b\xe7\x6e\x3f\x3c\xeb\xce\x0f\x6b\x08\xc3\x36\xc4\x17\x06\x39\x36\x06\xe6\xad\x75\x78\x4b\x23\x7b\x22\x17\x58\xb7\x97\xca\x4e\x1f\xd8\xb9\x00\xb6\x26\x13\xf0\xb2\xab\x1e\xa0\xd1\x7d\x5b\xf3\x03\x94\x17\x38\xeb\x78\xdb\x0d\xb6\x0e\x06\x3a\xeb\x1a\x82\x0f\x23\x8d\x9c\x97\x23\x8c\xd4\xc5\xf0\xf2\x8a\xc0\xd5\x68\xc0\x9e\xc8\x84\x63\x6b\xaa\x6f\xfb\x1e\xc6\xca\x96\x57\x68\x10\xee\x9e\xe5\xdf\x55\xfc\x81\x3d\x84\x61\x43\x86\xb1\x19\x97\x41\x19\x90\xd9\x98\x8b\x99\x98\x86\x51\x22\xc0\x2a\x7f\xcd\x63\xb3\x37\xa3\x93\x81\xab\xa6\x9f\x47\x95\x0b\xd5\x2c\xd4\x5e\x46\xb1\xd0\x8d\x37\x40\xa8\xa7\x4a\xa8\xa3\x50\x7b\xa1\x0a\xa1\x0e\x7f\x5b\xd8\x2e\x78\x19\x4a\xa4\x4c\x7f\x7e\x03\x00\x00\xff\xff\xd3\x8d\xc3\x0e\x95\x02\x00\x00")
-
-func ts_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ts_zaJson,
-		"ts_ZA.json",
-	)
-}
-
-func ts_zaJson() (*asset, error) {
-	bytes, err := ts_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ts_ZA.json", size: 661, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tt_ruJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xcf\x4a\x32\x51\x18\xc6\xd7\x33\x57\x71\x78\xe1\xec\x3e\xbc\x00\x77\x1f\xb8\x69\x21\x44\xd9\x42\x22\xc2\x30\xb0\x85\x09\x35\x9b\x88\x60\xb2\x8d\x10\xa4\x0b\xa9\x06\xfa\x83\x10\xd4\xae\x51\x39\x60\xe6\xd8\x2d\x3c\xaf\x73\x43\x31\x3a\x47\x5f\x9d\x76\x9e\xdf\xf3\xe2\xf3\x7b\xc4\x4b\xd7\xa1\xad\x02\xe5\x15\x79\xde\xe1\xce\x1e\xfd\x73\x1d\x2a\x54\x2e\xce\x29\xaf\xf6\x5d\xc7\x21\x7c\x62\xcc\xad\x38\xc0\x04\x7d\x98\x24\x76\x08\x5d\xbe\xcd\xb0\x1e\x46\x19\xf6\x1e\x07\xec\x27\x74\x83\xbf\xc6\x01\xa2\xd9\x03\x0c\xb7\x24\x9f\xdd\x63\x8a\x09\x86\x08\xd3\xbb\x0f\x8c\x92\x3c\x0e\xc8\x75\x0e\x12\xb3\xdd\x5a\xe3\xcc\xcb\xea\x49\x2d\xa9\xb3\xae\xb1\x5e\x2e\x2b\x65\x9d\xad\x2a\x36\x4e\xbd\x9a\xec\x89\x30\x40\xc8\x3e\xb7\xd3\xeb\x37\x18\x0c\xd8\x47\x88\xef\x25\x7b\x9a\x5f\x34\x97\x03\x3a\xf8\x61\x1f\x66\xe3\xc2\x7e\x7e\xe4\x3b\x44\x6b\xaf\xd5\x5d\x07\x03\x0c\xf9\x86\xaf\xc5\xb7\xf5\x60\x10\x71\x93\xdb\xe8\x0b\x8f\x17\x8c\x33\xec\x19\xd3\x0d\xd2\x85\xc1\x18\x61\xca\xe4\xcf\xf9\xd7\x50\x39\x51\x4e\x93\xb3\x56\x1c\x5f\x72\x90\x9c\x23\xc7\xc8\x11\x52\x5d\x2a\x4b\x59\x2b\xf9\xbf\xb8\x5d\xb4\x76\x8b\xdc\x26\x85\x8a\x77\x9c\xfc\x73\x75\x35\xa7\xeb\x39\x5d\x26\x0b\x4b\x27\xf5\x45\x50\x51\xba\xaa\xf4\x91\xd2\x65\xa5\x4b\xf3\x78\x19\xad\x9e\x69\x01\x91\x7b\xf5\x1b\x00\x00\xff\xff\x99\x4e\xbd\x26\x0e\x03\x00\x00")
-
-func tt_ruJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tt_ruJson,
-		"tt_RU.json",
-	)
-}
-
-func tt_ruJson() (*asset, error) {
-	bytes, err := tt_ruJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tt_RU.json", size: 782, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _tt_ruIqtelifJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x4f\x4a\xc3\x40\x18\xc5\xd7\x93\x53\x0c\x1f\x0c\x6e\xa4\x07\xc8\xca\x60\x10\xba\x88\x14\x53\x17\x41\x44\x26\x76\xb4\x43\xfe\xd5\x74\x24\x04\x71\xed\x0d\xba\x92\xf6\x04\x59\xb8\xef\x6e\xd2\x7b\x49\x9c\xcc\x97\x44\x5c\xb8\xfb\xde\x7b\xcc\x7b\xbf\x40\xde\x1c\x02\x73\x1f\x5c\x0a\x4a\x3d\xdc\xdc\x5e\xc8\x17\x25\x52\xf9\x04\xe7\x0e\x01\x9f\xd7\x5b\x70\xe9\x9d\x43\x08\x44\x22\x39\x1d\x44\x16\xeb\xa6\x8b\x08\xf8\xfa\x38\xd1\xa1\x9c\x48\xfd\x21\xca\x89\xb1\x10\xf9\xa3\x6e\x26\xd6\x65\x91\xb5\x07\x6e\xee\xd3\x5e\x66\xb1\x00\x87\xdc\x77\xc3\xe1\xba\x28\xd5\xaf\xf5\xd1\xec\xb0\x38\x8c\xe1\x0a\x76\x0f\xc5\xb6\x36\x28\x72\xb5\xc6\xce\x76\xdf\x7e\xe5\x15\xef\x5f\x5e\xc9\xaa\xe4\xe9\x99\x11\x01\x2f\x95\xb9\xbc\x4d\x29\x53\x6b\xd6\xe6\x88\xf4\x31\xc7\xab\x0f\xbd\xea\xf9\x75\xab\x2c\x58\xae\x44\xac\x9b\xbe\x59\x7f\x26\x63\x79\x5d\xd4\x23\xe5\xcb\xc4\xa8\xf1\x97\xff\xc1\x89\x90\x08\x88\x7c\xff\xa3\x43\xb4\x01\x0a\x79\x10\xc5\x52\x78\xc1\x22\xb0\xf3\x7a\xe7\xf5\x4f\x76\xa1\xcd\x7d\xae\x44\xf7\xcb\xb0\xd5\x8c\x65\x33\x16\x81\x35\x97\x32\x33\x01\xa7\x6c\x45\x59\x4c\x59\x44\xd9\xf2\x27\xc6\x68\x90\xfd\x0c\xb0\xb9\xcb\x02\x97\x85\x94\x6d\xc0\x79\xff\x0e\x00\x00\xff\xff\x06\x3a\x54\x5a\x92\x02\x00\x00")
-
-func tt_ruIqtelifJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_tt_ruIqtelifJson,
-		"tt_RU@iqtelif.json",
-	)
-}
-
-func tt_ruIqtelifJson() (*asset, error) {
-	bytes, err := tt_ruIqtelifJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "tt_RU@iqtelif.json", size: 658, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ug_cnJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x52\x3d\x4e\xf3\x40\x10\xad\xd7\xa7\xb0\x46\x72\xf7\xe5\x02\xe9\x3e\xe1\x86\xc2\x08\x89\x34\x08\x21\x64\x09\x44\x28\x42\x24\x30\x05\x42\x34\x89\xd9\xd8\x11\xed\x5e\x21\x8e\x2c\x24\xe4\x40\x45\xe1\x73\xcc\x78\x2e\x83\x36\x78\xd6\xf9\xe1\x04\x74\x9e\x79\x6f\xdf\xcf\x7a\x9f\x3c\x05\x87\x21\xf4\x7d\x78\xb8\xbe\x38\x38\x82\x7f\x9e\x82\x30\x7e\xbc\x87\xbe\x7f\xe6\x29\x05\x34\x67\x43\x53\xfc\x64\x43\x1a\x97\x6c\x2c\x41\x01\xbe\x73\xb6\xb7\xfb\x60\x43\xf3\xdd\x6d\xa3\xb1\xc0\x6a\x77\x4b\xf5\x6f\x5c\x7c\xe3\x8c\x5e\xdc\xd4\xa1\x9e\x3a\xb7\xb9\x4e\x86\xe3\xbb\x64\x3b\x9c\xc4\x91\x08\x62\x2a\x36\x22\x2c\x92\x22\x15\x8d\x6f\x93\x61\xa7\x33\x61\xd3\xd4\x58\x51\x8e\x25\x16\xd4\x9e\x6e\x6a\x9e\x61\xd9\x2a\x69\xca\xb0\xe2\x19\xae\x5a\xa5\x85\x8d\x4a\xb9\x73\x5e\x62\xd1\xd4\xb6\xea\xc6\x65\xd4\x6c\x64\x6e\x34\xe5\x94\x52\xea\xca\x95\x64\xab\x3a\xbd\xb5\xd6\xaa\xf3\xc6\x05\x65\xf8\xb5\xe9\x67\x67\x4a\xb1\xa0\x49\x7b\x62\xca\x9a\xb4\xfd\x39\x5b\xd7\xf3\x17\x8b\xfd\x8f\x8e\x23\x69\xf4\xc3\x11\x24\x8c\x93\x2b\xfb\x76\x83\x18\x5f\xfd\xe0\xb2\x17\x8c\x7a\xc1\x29\x08\x32\xb8\x19\xed\xa3\xf6\x73\xb0\xa6\x38\xb8\x1b\x5b\x27\x00\xef\xf9\x3b\x00\x00\xff\xff\x96\x09\xfc\x34\x19\x03\x00\x00")
-
-func ug_cnJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ug_cnJson,
-		"ug_CN.json",
-	)
-}
-
-func ug_cnJson() (*asset, error) {
-	bytes, err := ug_cnJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ug_CN.json", size: 793, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ug_cnLatinJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x4f\x4e\xf3\x30\x14\xc4\xd7\xce\x29\xa2\x27\x65\x97\x5e\xa0\xab\xef\xa3\x59\xf0\x47\x69\x8b\x9a\x4d\x85\x10\x32\xaa\x15\x5b\x8d\x6d\x70\x9d\x45\x8b\xb8\x09\xe7\x60\xd5\x5d\x2e\x86\x82\x33\x6e\x02\xec\xde\x78\xec\xf9\xcd\x93\xdf\x12\x46\x37\x05\xcd\x53\x6a\xeb\xa7\xc5\xf2\x5f\xc3\xbd\x32\x94\x27\x8c\x0a\x7e\x3c\xd0\x3c\x7d\x48\x18\xa3\xad\xd8\x1f\xa4\x30\xcf\xa2\x77\x18\x15\xdd\x79\x2c\x37\xe2\x38\x96\x0b\xc9\xdd\x58\xaf\xa7\xf6\x6d\x77\xd6\x78\x18\x8e\x13\xf6\xd8\x03\x37\xd2\x3a\x3f\xa5\x46\x1c\x40\x20\x20\x39\x46\x22\x10\x61\xa5\x35\x5e\xc6\xa4\x7b\x21\x9d\xf2\xdc\x84\x5b\xd7\xad\x0f\xc3\xd2\xba\xf6\x14\xc6\xee\x43\xab\x5d\x18\xaf\xb8\xe4\x0e\xc0\x17\xe1\xc0\x54\x4d\x33\xf0\x2a\xab\xf1\xae\x54\x27\xc4\xae\x6a\x89\xd3\x55\x2d\x1b\xfe\x1a\xe6\xbb\xee\xd3\x88\xfd\x64\xc9\x9f\xe5\x50\x0b\xad\x62\x25\x14\xfa\x7b\xfd\xca\xa2\xc3\x40\x05\xfd\x42\x06\xf6\x7f\xb9\x2e\xc1\x0b\x26\x9c\x82\x7b\xd1\x7f\x7f\xc6\xf3\x34\xdb\xce\x32\x3d\xcb\x76\x04\xa3\x52\xfa\x97\x99\xa7\x59\xf5\x7d\x21\x9a\x17\x39\x60\x88\x92\xf7\xaf\x00\x00\x00\xff\xff\xfd\xfc\x7a\xff\x59\x02\x00\x00")
-
-func ug_cnLatinJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ug_cnLatinJson,
-		"ug_CN@latin.json",
-	)
-}
-
-func ug_cnLatinJson() (*asset, error) {
-	bytes, err := ug_cnLatinJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ug_CN@latin.json", size: 601, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _uk_uaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x92\xc1\x4a\x02\x51\x14\x86\xd7\x33\x4f\x31\x5c\xb8\xb4\x51\x1f\xc0\x9d\xe0\xa6\x85\x10\x64\x50\x44\x84\x61\x60\x84\x09\x65\x0b\x8b\x60\xe6\x0a\xb9\x89\xdc\xf9\x1c\xe3\xe4\x94\x29\x33\xf3\x0a\xff\x79\xa3\xb8\xce\xbd\xd7\x33\xd2\x72\xbe\xff\xdc\x73\xfe\xff\xd7\x57\xdf\x13\xc7\x6d\xd1\x0c\xc4\xf3\xfd\xf5\x59\x4b\xd4\x7c\x4f\xb4\x7b\x93\x27\xd1\x0c\x2e\x7d\xcf\x13\xc8\x90\x62\x45\x0b\x6c\x69\xae\x45\x4f\xa0\x40\xbe\xa7\xc8\xb1\x31\x3c\xa1\x05\x12\x52\xc8\x29\xdc\x53\x8a\x90\x52\xa8\xa7\x11\x1b\x32\x43\x4a\x0a\x89\xe6\x76\xe3\x11\xcd\x49\x21\xc3\x9a\xde\xed\x19\x8a\x68\x8a\x25\x72\x52\x88\x85\xef\x5d\x69\x63\xa7\x83\xd1\xe3\xf8\xc0\xdd\xca\xb9\xca\x9c\x0f\xe5\x56\x84\xf6\xa6\x65\x28\xf6\x2a\x96\x76\x71\x67\xf4\x30\x1e\xb8\xad\x14\xd1\x42\xbb\x44\x46\x1f\xe6\xd5\x96\x3e\x49\x61\x8d\x5f\xf3\xbd\x34\xa9\x7e\x2a\x53\x1b\xdd\x01\x29\xce\x48\x51\x88\x58\xa7\x65\x6c\xb6\x7b\x9d\x54\x2f\x60\x8d\xa2\x32\x55\x36\x57\x61\x65\x6b\x48\xb5\xc6\xe8\x37\xf2\x5d\xf3\x07\xfb\x28\xd2\xbf\x06\x0a\xc4\xae\xa5\x2f\x0a\x69\x8a\x95\x99\xe4\xb5\xfe\x57\x01\x0f\xcf\x83\xf3\xb8\x3c\x26\x8f\xc7\x63\xf1\x40\x3c\x08\xb7\xcf\x6d\x73\xb3\xd6\x64\xab\x73\xd2\xb1\xee\x4a\xdd\x2a\xed\xde\xf8\x56\xff\x81\x65\xbf\x21\x87\x0d\x39\x11\x16\x76\xef\x86\xa5\xd0\xab\x05\xb2\x5f\x97\x37\x75\x79\x11\xc8\xf3\x40\xbe\xec\x46\x9c\xdc\x75\x9f\xe6\x88\x10\xfe\xdb\x5f\x00\x00\x00\xff\xff\x8c\xbc\x98\x75\x19\x03\x00\x00")
-
-func uk_uaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_uk_uaJson,
-		"uk_UA.json",
-	)
-}
-
-func uk_uaJson() (*asset, error) {
-	bytes, err := uk_uaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "uk_UA.json", size: 793, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _unm_usJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xfc\x20\x10\xc5\xcf\xe6\x53\x88\xe0\xed\x0f\x7b\xdf\xdb\x1f\x72\xe9\x21\x50\xd8\xf4\xd0\x96\x52\xa6\xbb\x82\x32\x51\x43\x33\x62\x42\xe9\x77\x2f\x49\x74\x76\xb7\xbd\xe5\xcd\x7b\x99\xf7\x53\xfc\x6a\x84\x7a\x68\xd5\x51\xaa\x14\xfc\xfb\xd3\x49\xfd\x6b\x84\x6a\x61\x99\xd4\x51\xbe\x36\x42\x28\x34\x81\x52\x36\x6e\x35\x84\xf2\x10\xc8\xa0\xd9\x05\xa5\xe9\x2a\x06\x33\x40\x09\x11\xdc\xcc\xc7\x75\x7e\x95\x13\x90\xd9\x54\x23\xde\xd6\xaa\x93\x8d\x9f\xf4\xab\x8f\xab\xb8\x86\x2b\xb8\x80\x97\xf3\xda\xba\xb1\x8b\x81\x2c\xaf\x33\xc1\x61\x9e\x0a\xd8\xd9\x62\x86\xa1\x88\x19\x7c\x44\x9b\x1d\x15\x32\xcc\x86\x60\x89\x73\x3d\x1c\xb8\xe0\xc6\x0a\x83\xee\x6c\x6f\xf5\x70\xe7\x66\x17\xc0\xbb\x50\xcf\xb8\x85\x09\x2c\x46\x2c\x98\x09\x27\x47\x9c\xad\x77\x99\x66\x8c\x04\xf7\x57\xf1\x87\x9e\xc9\x99\x9a\x71\x19\x74\xff\x08\x6e\x64\x38\xee\xaa\x19\xcb\x24\xe5\xf7\x14\x98\xa2\x02\xfc\xef\x1e\xbb\xda\xbc\x9b\xd5\x69\x81\xcc\xfa\x46\xf4\xe5\xa0\xfd\x41\x2f\xaa\x0e\x7b\xe7\x77\x03\xa4\xbe\x48\xfd\x21\xf5\xb3\xd4\xbd\xd4\x2f\x5b\x82\xdd\x9e\x65\xe9\x50\xaa\xf9\xfe\x09\x00\x00\xff\xff\xe3\x6f\x2a\x23\x7b\x02\x00\x00")
-
-func unm_usJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_unm_usJson,
-		"unm_US.json",
-	)
-}
-
-func unm_usJson() (*asset, error) {
-	bytes, err := unm_usJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "unm_US.json", size: 635, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ur_inJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x52\x41\x4a\xc3\x40\x14\x5d\x4f\x4e\x31\x7c\x98\x9d\x27\xc8\x2e\x90\x4d\x16\x23\x85\xba\x51\x11\xa9\x28\xd4\x85\x56\x6a\x5c\x88\xb8\x28\x36\x43\x16\x39\x87\x53\x4a\x20\x84\xac\xdc\xe4\x1c\xff\x4f\x2e\x23\xd3\xce\x4c\xd3\x5e\x40\x70\xf7\xff\x7b\xef\xe7\xbd\x17\xe6\x23\x62\x90\xa5\x10\x73\x78\x5b\xde\x66\xe7\x70\x16\x31\x48\x67\xef\xaf\x10\xf3\xeb\x88\x31\x40\x8d\x5b\x2a\x51\x63\x6b\x29\x06\xd4\x0f\x55\x98\x0b\x52\xa6\xa1\xf5\x7e\xc3\x0d\x36\xa6\x77\x73\x4d\x05\xfe\x60\x6b\xaf\xc7\xc8\xb0\x72\x5b\x47\x6a\xa8\x8c\xc2\x16\x22\x76\x63\x3d\xa7\xf3\xc5\x32\xff\x0b\x63\xb9\x78\xce\xe7\x07\xd7\x9a\x14\x95\xd8\x0e\x95\x73\x5a\x61\x7b\xb4\x17\x36\x91\x51\xee\x6b\x9a\x7a\xcb\xf9\x24\x54\xe0\xb7\x57\x62\x4d\x25\xa9\xd1\xbc\x46\x3d\x62\xb5\x69\xb0\x0b\x19\x3b\xdc\x52\x81\x1b\xdf\x0f\x35\x7d\xed\xda\x07\xc4\xa6\x3a\x52\x34\xd8\xb9\x7d\xfc\x03\xff\x4b\x99\x44\x4e\xa4\x6f\x91\xc8\xbd\x6a\x22\x3d\x9b\xce\xf2\x07\xfb\x62\x45\xc2\xc5\x3d\x17\x77\x5c\x5c\x82\xc7\x2f\x1e\x9f\x4e\x39\x2e\xb2\x58\xc8\x58\x4c\xb9\x78\xe1\xe2\x6a\x27\x0d\xb2\x40\x1d\x70\x67\x0e\x27\x67\xd1\xe7\x6f\x00\x00\x00\xff\xff\x8c\x66\x22\x93\x2c\x03\x00\x00")
-
-func ur_inJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ur_inJson,
-		"ur_IN.json",
-	)
-}
-
-func ur_inJson() (*asset, error) {
-	bytes, err := ur_inJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ur_IN.json", size: 812, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ur_pkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xdc\x52\xc1\x4a\xc3\x40\x10\x3d\x6f\xbe\x62\x19\x98\x9b\xd0\x7b\x6e\x4a\x0e\x16\x09\x04\xea\x45\x45\xa4\x50\xa1\x1e\x6a\xa1\xc6\x83\x88\x87\x62\x37\x09\xc5\x9b\x1f\xb1\x25\x14\x42\x88\x1e\xbc\xe4\x3b\x66\xe2\xcf\xc8\xd6\xdd\x35\xfd\x01\x0f\xbd\xcd\xbc\xf7\x76\xde\x7b\x21\xcf\x81\x80\x61\x04\xa1\x84\xc7\xc5\x4d\x72\x06\x47\x81\x80\x68\xfc\xf4\x00\xa1\xbc\x0a\x84\x00\xd2\x54\x72\x41\x9a\x6a\x43\x09\xe0\x96\xd7\x7e\x56\x9c\x75\x15\xaf\x7e\x37\xda\x50\xd5\xb5\x76\xde\xb2\xa2\x2f\xaa\xcd\xeb\x3e\xc2\xb9\x7d\x99\xf3\x92\x4a\xce\x21\x10\xd7\xc6\x71\x34\x9d\x2f\xd2\xff\xb7\x8d\xe7\xf7\xe9\xf4\xcf\x73\xcb\x19\x17\x54\xf3\xda\xaa\x97\x54\xef\xed\xca\xe4\xe9\x32\x7b\x59\x73\x6b\x38\x97\x83\x15\xbf\x7f\xbf\x79\xd7\x82\xb3\xde\xbc\x22\xed\xae\x90\xee\x2a\x6a\x7c\xbe\x86\x4a\x56\xb4\x71\xdd\x48\xf3\xeb\xae\xb9\x47\x4c\xa6\x3d\x45\x45\x8d\xdd\xfb\x1f\xef\x30\xaa\x1c\xc7\x49\xec\x3b\x7c\x5a\xd5\x87\x63\xa3\x71\x7a\x6b\xfe\x54\x9c\x0c\x70\x36\xc0\x0b\x70\xe0\xf9\xdd\x6c\x47\x70\x21\xf1\x34\xc4\x38\xc4\x91\xc4\x4b\x49\xa5\xc4\x89\xc4\x13\x69\xa5\x4e\xe6\x34\x1e\xb4\xb6\x80\x89\xc4\xa1\xe5\x82\x97\x9f\x00\x00\x00\xff\xff\x61\xd6\x4d\xe5\x1b\x03\x00\x00")
-
-func ur_pkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ur_pkJson,
-		"ur_PK.json",
-	)
-}
-
-func ur_pkJson() (*asset, error) {
-	bytes, err := ur_pkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ur_PK.json", size: 795, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _uz_uzJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6b\xc3\x30\x0c\x85\xcf\xce\xaf\x30\x02\xdf\x02\xbd\xf7\x96\xcd\x0c\x3a\xc8\x56\x68\x77\xe8\xc6\x18\x2a\x35\x4b\x49\xe3\x0c\xc7\x0e\x78\x63\xff\x7d\x64\x8e\x14\xd3\xde\xf4\xf4\x94\xf7\xbe\x80\x7f\x0a\x01\x1b\x0d\x6b\x09\xe1\xfb\xe3\xe5\x15\xca\x42\x80\xc6\x38\xc0\x5a\xbe\x15\x42\xc0\x01\xdb\xa1\x41\x7b\xc4\xc9\x11\xa0\x43\xae\x76\x26\x57\xf7\x4d\xef\x72\xbd\xc5\x98\xcb\xc7\xd0\xd1\x67\x69\x5b\x88\xf7\xa9\x6d\xd7\xf4\xce\x5f\x55\x52\x19\xd5\x70\x01\x27\x53\x26\x27\x52\x5c\xdd\x5b\xdf\x64\x59\x76\x44\x97\x8e\x1e\xcc\xe8\xf0\x92\xe6\x1a\x9d\x4f\x53\xf5\xe5\x0c\x2f\xe7\xd8\x4d\x0c\x96\xa7\xd9\xac\xc6\xcf\x30\x78\x02\xb2\x3e\xe2\x71\xce\x7d\x6e\x33\xf1\xd4\x2f\xb3\x36\xed\x34\xe7\xff\x79\x43\xc7\x68\xcc\xc5\x58\x37\x50\xcc\xb4\x20\x31\x0f\xa3\x30\x06\x33\x10\x40\x55\x6f\x6b\x6a\x4e\x26\x39\x1a\xbd\x99\x9e\x80\x3a\xad\x54\xb7\x52\x11\x68\xb9\x3f\x77\xc9\xd8\x97\x52\x9d\xa4\xba\x2b\xa5\x3a\xc8\x78\xbe\x94\x52\x55\xff\x57\xcb\x05\xcb\xb9\x07\xa0\xf8\xfd\x0b\x00\x00\xff\xff\xd5\xdb\x25\xe1\x5e\x02\x00\x00")
-
-func uz_uzJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_uz_uzJson,
-		"uz_UZ.json",
-	)
-}
-
-func uz_uzJson() (*asset, error) {
-	bytes, err := uz_uzJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "uz_UZ.json", size: 606, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _uz_uzCyrillicJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xcf\x4a\x02\x51\x18\xc5\xd7\x77\x9e\xe2\x72\xe1\xee\x06\xdc\xbb\xca\x70\xd3\x42\x08\xb2\x45\x45\x84\x68\xa0\xa0\x09\x66\x0b\x8b\x60\xd2\x4d\x8b\x28\x17\x12\x2d\xfa\x83\x10\xd4\xae\xab\x31\x90\xda\xd8\x2b\x9c\xef\x8d\x62\x74\xe6\xfa\xd5\xad\xe5\x77\xce\x61\xce\xef\x0c\xf7\xcc\x13\x6a\x23\xaf\xb2\x52\x9d\x9c\x1e\x6c\xef\xae\x95\x3b\xad\x5a\xbd\x5e\x2b\x2b\xdf\x13\x2a\x5f\xea\x1c\xab\xac\xdc\xf3\x84\x50\x78\xc3\x94\x2e\x61\x10\x61\x04\x13\xdb\x42\x61\x40\x3d\x47\x1b\x22\x74\xb4\x17\xcc\x29\x70\xd4\x27\x18\x4c\x1c\xf5\x96\x7a\xf8\xb4\xd7\xab\x75\x3d\xb1\x1f\x33\x6d\x55\x9b\xad\xb6\x0b\xc6\x81\x38\x08\x07\xe0\xb5\xbc\x8c\x57\xa5\x35\x85\xe6\x51\xbb\xca\x3b\x22\x8c\x61\x28\xa0\xab\x24\xfd\x8c\x10\x63\x0a\x60\x30\xb3\xda\xfd\x22\xd1\x4d\xae\x3e\xbe\x28\x40\xf8\xd3\xb7\xcd\x77\x74\x8d\xc8\x3a\xf1\xb5\xca\xf5\x31\xc6\x3b\xf5\xe8\xc2\x7e\x6b\x88\x10\x11\x75\xe9\x06\x23\xc6\xf0\x88\xa9\xa3\x3d\x60\xfe\x4b\x19\x20\xc4\x14\x26\xd1\xf8\x6f\xfc\x6b\x24\x9f\xc7\x67\xf1\x51\xff\xcd\xe1\x63\xf8\x14\x3e\x82\xa3\x73\x64\x0e\x9b\x42\xe6\x0a\x9b\x85\x94\x6e\xe9\xa7\x4e\xbe\xd4\x3e\x8c\x1f\xad\xae\x64\x74\x23\xa3\x3b\x2a\x15\x8b\xb5\xc6\xd2\x28\xfa\x52\x57\xa4\x5e\xf7\xa5\xde\x91\x98\xe0\x03\x33\x5f\xea\xdc\x22\xb8\x0a\xd9\x33\xa9\x52\xca\x3b\xff\x0e\x00\x00\xff\xff\x6e\xdd\xad\x79\x13\x03\x00\x00")
-
-func uz_uzCyrillicJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_uz_uzCyrillicJson,
-		"uz_UZ@cyrillic.json",
-	)
-}
-
-func uz_uzCyrillicJson() (*asset, error) {
-	bytes, err := uz_uzCyrillicJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "uz_UZ@cyrillic.json", size: 787, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _ve_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xd1\x4a\xc3\x30\x14\x86\xaf\xdb\xa7\x08\x81\xdc\x09\xbb\xdf\xdd\x60\x08\xe2\x2a\xc3\x15\x61\x13\x91\x53\x52\x77\x82\x4d\x0a\x6d\x4f\xcb\x14\x9f\xc0\x97\x99\x77\x0e\x7c\x99\xbd\x89\xd4\x26\x67\xeb\xbc\x4b\xfe\xff\xf4\xff\xbf\x1c\xfa\x1e\x47\xf2\x66\x2e\xa7\x42\xb6\xf9\xf3\x66\x26\xaf\xe2\x48\xce\x61\x57\xcb\xa9\x78\x8c\xa3\x48\xae\xba\xd2\x69\x40\xe8\x8d\x48\x26\x54\x93\xcd\xa8\xa0\xae\x1c\x84\xe3\xf7\x0f\xb4\x48\x2d\x9a\xc2\x8c\x94\x0a\x2a\x1a\x09\xc7\xc3\x27\x5c\x08\x5f\xe0\x28\xe4\x6e\x4d\x8b\x79\x01\x32\x8e\x9e\x7a\x84\x15\x96\x55\x73\xc1\xc1\x08\xc3\xe1\x01\x7d\xe3\x3d\x54\x3e\xf8\x54\x71\xd8\x83\xe3\xe8\x10\x9a\x94\xae\x41\x4e\x5c\x22\x38\xed\x43\x17\x84\x14\xe2\x8e\x87\x3d\xc2\x0b\x58\xc2\x2e\xf7\x2e\xd8\x0c\x2c\x78\x7f\x85\xe4\x34\xb9\x06\xa9\xf0\xfe\x35\x15\x9d\x39\x1d\xc1\xc1\x59\x92\xdb\xf2\xdc\x2d\x52\xd6\x92\xcd\xf5\x9b\x9f\x4e\x6b\x34\x67\xd7\x7e\x31\x95\xff\xf6\x6e\x97\x3b\xdd\xaf\xa9\x36\xaf\x34\xda\xca\xbf\x57\xf0\x13\x3c\x02\x58\xc6\x66\x62\xaf\x04\xcc\x45\x17\x6a\xb6\xc4\x6c\xcc\x74\x46\xc3\x30\x01\x61\x96\x2c\x93\xd0\x3d\x98\xc1\x99\x43\x93\xf7\xff\x91\xd2\x13\x65\x27\x6a\x2d\x83\x98\x1a\x3b\x18\x20\x94\x16\x2a\x13\x6a\x2d\x54\x2a\xd4\xe6\x6f\x82\xdd\x94\xaf\xbe\x43\xca\xf8\xe3\x37\x00\x00\xff\xff\x2d\xfe\x83\x7a\x9f\x02\x00\x00")
-
-func ve_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_ve_zaJson,
-		"ve_ZA.json",
-	)
-}
-
-func ve_zaJson() (*asset, error) {
-	bytes, err := ve_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "ve_ZA.json", size: 671, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _vi_vnJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x3d\x4b\x03\x41\x10\xad\xf7\x7e\xc5\x30\xb0\x5d\x20\xdf\x89\xa6\x8b\xa6\x49\xb1\x21\xe0\x21\x44\x11\x39\x8d\xb8\x57\xec\x45\xcc\x29\x04\xb1\xca\xaf\xb0\xb0\x08\x16\x62\x11\x48\x9d\x2b\x2f\xe4\x7f\xec\x3f\x91\xdb\xec\x8e\x7b\x92\x6a\xde\x9b\xb7\x33\xf3\xde\x71\x6f\x01\xc3\xe1\x00\x7b\x80\xaf\xf1\xed\xe5\x08\x2b\x01\xc3\x41\xb4\x98\x63\x0f\xae\x03\xc6\xf0\x5c\xea\xec\x1b\x12\xa9\xb7\xeb\xb4\x10\x19\x86\x52\x67\x3f\x20\xa3\xd8\xa7\x77\x91\xcf\xd2\xfd\xc6\xa7\xc9\x6e\xa9\x7c\x3e\xcf\x57\x2f\xa5\x61\xbd\xfd\x5a\x60\xc0\x6e\x8a\xe3\x17\x72\xf6\x9c\x96\x1c\x8c\xec\xdb\x86\xad\x4d\x5b\x5b\xb6\xb6\x6d\xed\xd8\xda\x75\xab\xc4\x2c\x49\x25\xed\x09\x65\xbe\x4a\x1e\x41\xe9\xec\x83\x92\x98\x8e\x17\xc5\xf0\xbf\x2c\x86\x7a\x61\x0c\xf7\xd3\x98\x86\x1f\xe7\xb0\xc0\xe4\x29\xed\xc8\x57\xe5\x99\x7b\x99\xaf\x93\x52\x47\xed\x37\x3a\xfb\x8c\x8f\xf5\x8e\x78\x76\x4a\xe1\xdd\xff\x70\xff\x23\xd7\xea\x6e\xac\xd6\x20\xd4\x24\xd4\x22\xd4\x26\xd4\x21\xd4\x25\x74\x42\xe8\xd4\xa1\x7a\x8d\x10\xdd\xa8\x37\x9c\x99\xbe\x18\x0b\xe7\xa2\x2f\x0e\xfa\x58\x38\x75\x10\xa5\x0f\xc5\x2f\xc7\xa7\x55\xae\xaa\x7c\x82\xae\x19\xc6\xea\x20\xf4\x2b\xc0\xa7\xc0\xcf\x60\xb4\x5b\x2a\xe0\x13\xe0\x21\xf0\x2b\xf3\x8e\xde\x84\x44\xed\x35\xe4\xc3\x1e\x17\xc0\x9f\x30\x78\xff\x0d\x00\x00\xff\xff\x2c\x75\x58\xce\xd8\x02\x00\x00")
-
-func vi_vnJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_vi_vnJson,
-		"vi_VN.json",
-	)
-}
-
-func vi_vnJson() (*asset, error) {
-	bytes, err := vi_vnJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "vi_VN.json", size: 728, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wa_beJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\xc1\x6a\x02\x31\x10\x86\xcf\xd9\xa7\x08\x03\x73\x2b\x78\xdf\x9b\x62\xa1\x42\x03\x82\x5e\x6c\x29\x25\x35\x69\x8d\x35\xd9\xb2\x89\x2b\x52\xfa\x38\x1e\x7a\xe9\xc9\x37\xc8\x8b\x15\x5d\x67\x52\xea\xed\x9f\xf9\x87\xff\xff\x02\xf9\xac\x04\x4c\xc6\x50\x4b\xd8\xe9\xe7\xd1\x2d\xdc\x54\x02\xc6\x7a\x1f\xa1\x96\x8f\x95\x10\x60\x9c\xb7\x6f\xc1\x9e\xf6\x02\x36\x4d\x30\xae\x97\x3e\x1f\x5a\xd6\xce\xb6\xef\x8e\x26\xb3\xde\x92\xec\xf2\x77\xe0\xab\x68\xbd\x71\x50\x89\xa7\x53\xc5\x6c\xd5\xb4\xe9\x5f\x0f\x77\x94\x06\xce\xe7\xe8\x12\xcc\xa9\x94\xa9\x9a\x90\x56\x25\x70\xad\x43\x97\x7f\xfa\xab\x57\xdb\xb5\xa4\x7d\x3e\xc4\xd8\x4b\xdd\xb5\xf4\x04\xbd\xe7\x8a\xc0\x6a\x63\xd3\xa5\x59\xef\x9a\x6d\x8c\x96\x3a\x93\xf3\x2f\x97\xa1\x59\xa6\x7c\xa4\x21\xe4\x63\x57\x2c\x63\x97\xe7\xe1\xef\x9b\xaf\x20\x19\xb0\xd0\x31\xdc\x15\x1b\xa3\x6d\x18\x8b\x91\x18\xa7\xa0\x30\x06\x21\x0c\xd5\x54\x51\xf7\x50\xf5\xf6\x54\x91\x3b\xd6\xc9\x9e\x7e\x02\x9a\x01\xfa\x01\x2e\x80\x96\x73\xe7\xcf\xc6\xbd\x93\x38\x94\x68\xa4\x71\x12\x47\x12\x17\x12\xe7\x12\x1f\xce\x77\x74\x83\x77\x35\xaa\x1a\x67\xbc\xbc\x74\x02\x4e\x7a\x43\xe2\x07\x54\x5f\xbf\x01\x00\x00\xff\xff\x6e\xb9\xff\x17\x78\x02\x00\x00")
-
-func wa_beJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_wa_beJson,
-		"wa_BE.json",
-	)
-}
-
-func wa_beJson() (*asset, error) {
-	bytes, err := wa_beJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wa_BE.json", size: 632, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wae_chJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x41\x6a\xf3\x30\x10\x85\xd7\xf2\x29\xc4\x80\x76\x7f\xfe\x03\x64\x97\xc6\x04\xb7\x60\x28\xd8\x50\x92\x52\x8a\xec\x88\x68\x4a\x2c\xc3\x44\xc1\xb4\xa5\xd7\xe8\x3e\xd0\x6d\x4f\xe1\x8b\x15\xd7\xd6\xc8\x6d\x77\xf3\xe6\x8d\xe6\x7d\x63\xfc\x9a\x08\xb8\x4e\x61\x29\xa1\xd3\xe6\x71\x9d\xc1\xbf\x44\x40\xaa\x9f\x4f\xb0\x94\xf7\x89\x10\x50\x9c\x9d\xd7\x87\xa1\x2d\x20\xef\x3f\xa2\xd8\xe1\xa9\xb6\xd1\x42\xef\xbb\x73\x6d\x47\xb5\xa1\x36\x0e\x6e\x08\xb9\x2e\x74\x33\xbd\x4a\xc4\xc3\x10\x55\xd8\x96\xfc\xaf\xbc\x18\xc6\x49\x1c\xc2\xfb\x79\x37\x2f\x0e\x2b\xf3\xd6\x79\xcb\xfb\x6e\x8c\x33\x34\xce\x64\x2d\x39\x8c\xa7\xd0\x8b\x19\xeb\x55\x45\x78\x3c\x4e\x22\x37\xf8\x34\x95\x57\xd4\x5f\x6a\x6b\xa6\xcc\xcc\x60\x17\xea\xfe\x1d\x0f\xc3\x1d\x26\x58\x54\x0d\xb2\xe9\x2f\x2e\x8c\xdc\xf5\x9f\x3f\x24\x3a\x6f\x68\xde\x59\x5b\xc2\xd9\xa3\xf9\xf7\xf8\x73\x01\xf3\x47\x78\x46\x67\xec\x08\xcd\xc0\x91\x96\x41\x23\x1d\x83\x31\x4f\x80\x58\xe5\xb7\x79\x48\x1f\xcd\xe0\xa4\xda\x9b\xe1\x67\x51\xdb\x85\x6a\x16\x6a\x0f\xa1\x59\x62\x33\x1a\x5a\xaa\xfd\x7f\xa9\x2a\xa9\xb6\x52\x95\x52\xed\xbe\x47\xd8\x2e\x59\x4e\x21\x00\xc9\xdb\x57\x00\x00\x00\xff\xff\x0b\xb6\xda\x27\x85\x02\x00\x00")
-
-func wae_chJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_wae_chJson,
-		"wae_CH.json",
-	)
-}
-
-func wae_chJson() (*asset, error) {
-	bytes, err := wae_chJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wae_CH.json", size: 645, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wal_etJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x92\x41\x4b\x3a\x41\x18\xc6\xcf\xbb\x9f\x62\x18\x98\xdb\x1f\xbc\xef\xcd\x3f\x76\xf0\xb0\x10\xe8\x21\x8b\x08\x21\xc1\x20\x33\x4c\x88\x88\x0e\x61\xc4\x3b\x33\xbb\x47\x85\xd8\x82\xc0\x8a\xac\x16\x22\xb5\x5b\x1f\xe6\xf9\x26\x31\xb6\xb3\x6b\x03\x05\xd5\x6d\xe6\x79\x9f\x79\x9f\xe7\xa7\x7b\xec\x7b\xbc\x5a\xe1\x01\xe3\x87\xcd\xdd\xad\x95\x3a\xff\xe7\x7b\xbc\xd2\x3c\x3a\xe0\x01\xdb\xf0\x3d\x8f\x43\x13\x22\x6d\x64\x8f\x83\xa6\xd0\x29\xd4\xc8\x5e\x13\xc8\x73\x23\xaa\x24\x53\xd4\x35\x68\x02\x9d\xfb\x07\xa0\x0b\xd0\x74\x69\x9a\x42\x8e\xb3\xab\x3c\x03\x3d\x72\xdf\xdb\x34\x99\xb5\x76\xb7\xd7\xff\x69\xf0\x9f\x53\xc3\xee\x5e\xbf\x5d\x44\x46\x03\xa8\x21\xf4\xc4\x64\xd3\x43\xf6\x20\x8e\x20\x6f\x17\x01\x4b\x22\x25\x8b\xa5\x6f\x36\xe3\x06\xf1\x10\x74\x0f\x8a\xad\xe1\x12\x3a\xcd\xce\xd1\x29\xd4\xb0\x38\x93\x2e\x46\xea\x0e\x11\x81\xe6\x90\x73\xfb\x70\x66\x56\xc9\x19\xe8\x0a\xd2\x54\x2f\x9c\x2a\x85\x7c\x86\x8e\x3f\xeb\x23\xc8\x27\xd7\xac\x5f\xcc\x9e\x5c\x5c\xfe\x8d\xbf\x40\x76\x60\xbf\xc7\xfc\x1d\xa3\x03\xe8\x70\x39\x38\x0e\x88\x45\x28\x87\xab\x61\xde\xdd\xb4\x23\xe8\x57\xfb\xcf\x0e\x40\x89\x35\x56\x9a\xfd\x96\xf9\xac\xc5\x76\x49\x74\x4a\xa2\xc1\xad\x58\xdf\xe9\x7c\x0c\xca\x88\xc7\x4c\xfc\x67\xa2\xc5\x10\x69\xd3\x98\xa6\x4c\x34\x98\xe8\x31\xb1\xbe\xb0\xe7\xd6\x6a\x20\xc2\x40\xd4\x72\x31\x6b\xc1\xc5\x1a\x13\xfb\xdc\x3f\x79\x0f\x00\x00\xff\xff\x7d\x43\x8a\xbd\x46\x03\x00\x00")
-
-func wal_etJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_wal_etJson,
-		"wal_ET.json",
-	)
-}
-
-func wal_etJson() (*asset, error) {
-	bytes, err := wal_etJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wal_ET.json", size: 838, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _wo_snJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x92\x4f\x4b\x03\x31\x10\xc5\xcf\xd9\x4f\x11\x06\x42\x2f\xd2\x0f\xd0\x9b\xd0\x8b\x87\x15\xa1\x7b\xa9\x22\x32\xcb\x8e\x76\xeb\x26\x91\xfc\x21\x16\xf1\xbb\xcb\xba\x93\xb1\xb8\xb7\x79\xf3\xc2\x7b\xbf\x84\x7c\x35\x0a\xee\xf6\xb0\xd3\x50\xfc\xcb\xe1\x1e\x6e\x1a\x05\x7b\xbc\x44\xd8\xe9\xa7\x46\x29\x18\xc6\x7e\x43\x14\xe6\xbd\x02\x9c\xd2\xe8\x68\x99\x13\x4e\x88\x09\xab\x31\x61\xe8\x45\x7c\xa2\xa5\xc8\xe2\x7c\xce\x96\x8d\x37\xc4\x92\xa1\x51\xcf\x73\xc9\xe1\xe4\x43\xfa\xd7\x24\x2d\x52\x21\xf1\x12\x2d\xb1\x92\x59\x13\x5b\xef\xd2\x49\xe2\x22\xba\x32\x5e\x36\x4c\xfb\x4a\xd4\x87\x3f\x69\x31\x54\xbe\x12\x46\x0e\xb7\x6c\xc6\x5c\xc8\xd5\x71\x22\x86\xc9\x99\x87\x48\x1f\x09\xd1\xf6\xc8\x8f\xe2\xdf\x93\xf7\xa2\x9c\x2f\xd7\xe6\x40\x91\xe5\xf5\xb5\x57\xa4\x02\x29\x78\x42\xb7\x62\x13\xb2\x35\x97\x00\x09\x8b\x50\xd4\xfe\xdb\xf6\xa1\xad\xc5\x8b\x59\x9d\x3d\x26\x9a\xff\x81\x19\xb6\xc6\x6e\xcd\x11\xea\xb2\x1b\xed\x62\xa0\x36\x83\x36\xbd\x36\x47\x6d\x3a\x6d\x1e\x7f\x4f\x88\xdb\x89\xe4\x0e\x80\xe6\xfb\x27\x00\x00\xff\xff\x55\xcd\xdd\x0f\x5f\x02\x00\x00")
-
-func wo_snJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_wo_snJson,
-		"wo_SN.json",
-	)
-}
-
-func wo_snJson() (*asset, error) {
-	bytes, err := wo_snJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "wo_SN.json", size: 607, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _xh_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x52\xc1\x6e\xab\x30\x10\x3c\xc3\x57\x58\x96\x7c\x7b\x4f\xb9\xe7\x96\xc0\x21\x55\xe4\xa8\x12\xbe\x24\x55\x55\x2d\xc2\xc2\xab\x18\x13\x02\x8e\x9b\x54\xfd\xf7\x8a\xe2\x25\xf4\xb6\xb3\x33\xcc\xec\xae\xf9\x4a\x13\xfe\x92\xf3\x35\xe3\x9f\xe6\xe3\xb4\xe1\xff\xd2\x84\xe7\x70\xef\xf9\x9a\xbd\xa5\x49\xc2\x31\x83\x00\x63\x37\xe1\x5e\xde\xbc\x6d\xa7\xda\x06\xdd\xe3\x16\x1d\x2e\xa0\x32\x30\x18\x1f\xb5\x36\xe8\x02\x9d\x5e\xd0\x3b\x0b\x8e\x58\x59\x77\x58\x6a\xdb\xf2\x34\x79\x1f\x13\x0b\xd3\x5e\x87\x65\x6c\x06\x61\x92\xca\x5b\xfc\x66\x8b\x6e\x2a\x94\x89\xf3\x14\xd4\xd9\xd9\xd8\x91\x75\x47\x8e\xb2\x75\x83\x99\xed\xf4\xbd\x95\x9d\x77\x75\xf4\x1a\x61\xe5\x9b\x12\x66\xb8\x0f\xe8\x2a\xb2\xf1\xaa\x37\xf0\xc0\xe6\xe2\x1f\x71\x3f\x9f\x81\x7b\x60\xa9\x49\xaf\x0b\xb4\xd8\x68\x3b\x1b\xe8\xbd\x81\x05\x52\xc6\x5f\x9e\xe6\xb2\x47\x37\xf4\x38\xb3\x79\x80\xab\x79\x8a\x0f\x67\x70\xf5\x42\x3d\x82\xe5\x5d\xfe\xae\x22\xbb\xb8\x84\xac\x62\xb1\x0f\xd1\x5a\xf5\x66\x2a\x32\x70\x74\x22\x1b\x35\x94\xa7\xe8\x85\x24\x0d\x94\xd3\xfb\x1e\xce\x74\x46\x57\xd3\x00\x1b\xf9\x2a\x29\x79\x22\x89\xc9\x61\xd0\xe3\x6f\x23\xaa\x95\x68\x56\xe2\xc8\xa9\xa9\xb0\x99\x08\x60\xe2\xbf\x66\xa2\x64\xe2\xc8\x84\x62\xe2\xf4\x2b\x99\x69\x35\xc3\x18\xc2\x79\xfa\xfd\x13\x00\x00\xff\xff\x27\x95\x05\x8b\x8f\x02\x00\x00")
-
-func xh_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_xh_zaJson,
-		"xh_ZA.json",
-	)
-}
-
-func xh_zaJson() (*asset, error) {
-	bytes, err := xh_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "xh_ZA.json", size: 655, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _yi_usJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x92\xc1\x6a\xc2\x40\x10\x86\xcf\xc9\x53\x2c\x03\x4b\x2e\x05\xef\xb9\x09\x1e\xea\x21\x20\xd8\x1e\xda\x52\x8a\xc5\x82\x1e\xac\xd0\x7a\x91\xd2\x77\xd0\x8a\x25\x5b\x44\x62\xb0\x35\xf6\x64\xbd\xe8\xa1\xd0\xb7\xc8\xff\x3a\x65\x37\xc9\x76\x37\xda\xd3\x66\xfe\xf9\x76\xe7\xff\x87\x3c\xb9\x0e\xd5\x6b\xe4\x33\x1a\x76\x6f\xce\x9b\x74\xe2\x3a\x54\x6b\x0d\x1f\xc9\x67\x57\xae\xe3\x10\x5e\x31\x45\x84\x10\x02\x2b\xd9\x74\x08\x73\x8c\xd2\x7d\x49\x9b\x40\x20\xc2\xa2\xc4\x09\x84\xd8\x28\xfa\x4d\x73\xd9\xdd\x18\x09\xd6\x16\xbd\x4c\x7f\x90\x60\x9b\xee\x2c\x75\x8d\x31\x3e\xc9\x75\xae\xa5\xaf\x66\xa7\xff\x30\x38\x34\xe7\xd9\xbe\x3c\xd3\x92\x67\x7a\xf1\x6c\x13\x5e\x79\xb2\x77\x6c\x68\xd0\xbf\x1f\x74\xfe\x26\x0a\x8c\xd2\x1d\x22\x4c\xd5\x99\x18\x4f\xc4\x18\x23\x29\xe9\xf3\xac\xc2\x7b\x5e\xcb\x6a\x99\x7e\x23\x81\xc0\x4c\x33\xdb\x74\x97\x7f\x0b\xb5\x6c\x61\x54\x33\x5d\x8d\xf0\x85\x17\x4c\xe5\x92\x73\x65\x81\x58\xbd\x16\x22\xc6\x1c\x63\xb9\x54\x3d\x67\x8f\x15\x42\x75\x9a\x7a\xa4\x94\xcd\x01\x3f\x41\x8c\x0f\x43\x35\x17\x7e\x7c\x01\x76\x70\x3b\x6e\x39\xec\x7f\x41\xcd\x98\x66\x48\x3b\x9e\x1d\xc9\x0e\x62\xda\x2f\x4c\x57\x83\x46\x50\xb8\xad\x06\x19\xd1\x08\x8a\x6e\xad\x35\xb8\x93\x7f\x3b\x6f\x57\x78\xaf\xc2\x87\x54\x88\x67\xdd\x5e\xd6\xb8\x64\xfc\xd4\xe7\x81\xcf\x9b\x8c\x5f\x30\x7e\xcb\x78\x9b\xf1\x96\xe2\x34\x93\x03\x5a\xcc\x67\x12\xaf\x17\x37\x1b\xe4\x3e\xff\x06\x00\x00\xff\xff\xb1\x9c\xef\xe8\x5c\x03\x00\x00")
-
-func yi_usJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_yi_usJson,
-		"yi_US.json",
-	)
-}
-
-func yi_usJson() (*asset, error) {
-	bytes, err := yi_usJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "yi_US.json", size: 860, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _yo_ngJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x92\xc1\xae\x9a\x40\x14\x86\xd7\xf0\x14\x93\x49\x66\x67\xe2\x9e\x1d\x0a\x5a\x4d\x47\x89\x40\x1b\xdb\x34\x8d\xc4\x56\x6b\x2b\x18\xa4\x0b\xd3\x34\xc1\xa4\x9b\x26\xf2\x0e\x12\x37\x35\xb1\x9b\x7b\x37\x17\x58\x9e\xdc\x1d\x2f\x31\x6f\x72\x03\x33\x43\xb8\x6e\x26\xff\xfc\xe7\x9f\x33\x1f\x87\xf9\xa5\x2a\x78\x64\x60\x0d\xe1\x43\xf0\x79\x32\xc4\x1d\x55\xc1\xc6\xe2\xb0\xc7\x1a\xfa\xa8\x2a\x0a\x86\x18\x6e\xdf\x21\xaf\x7c\x05\xeb\x1b\xb8\x72\x05\x27\x96\x5d\x58\x9e\x95\xc7\xd5\x4f\x9f\x5b\xac\x38\x6d\x58\x91\x94\xc7\x50\xc6\x1b\xc7\xab\xd6\x58\x98\xf9\x53\x19\x47\x70\x13\x7d\x62\x0f\xd2\x6d\xdd\x28\x5a\x60\x55\xf9\x54\xdd\x6f\xaf\x83\x30\x6a\x43\xd8\xee\x84\xc7\xe9\x54\x08\xc7\x35\xb9\x78\x6f\x1a\xc2\x79\xe3\x72\x31\x98\x8d\xb8\xb0\x75\x47\x76\xa4\x81\x1f\xad\x9b\x76\x63\x48\x7d\xc8\x21\x0d\x25\xc5\xa0\x06\xf0\x5e\x79\x14\x52\x38\xb3\xec\xd2\x90\xfe\xdd\xc1\xff\x56\xf5\x0a\xff\xb8\x1c\x43\x0e\x99\x0f\x59\xb3\xfb\x01\xa9\x8c\xb1\xe2\x54\x1e\xeb\x11\xac\x58\x91\xec\xa5\x6d\x57\xf7\xc5\x51\xbd\x3e\xff\xf1\xe0\xdc\xa4\x23\x78\x6c\xb6\x13\x78\xf8\x7a\x1f\x31\xe0\xb6\x6f\x79\xed\x81\xdd\x7d\xa3\x2e\x26\x35\x30\x7b\x02\x59\x9f\x89\xbf\x68\xcd\xa4\x33\x17\xd0\x72\xbe\x63\xf7\xad\xc8\xb8\x43\x81\x6a\x5a\x5c\x4c\xfb\x8e\xc0\x9a\xbe\x13\x2c\x66\x5f\x02\xe8\xd4\xa2\xf2\x66\x9d\xf2\xb2\x45\x65\xd5\x58\x44\x5f\xaa\x17\x46\x96\x5d\xb2\xed\x92\x03\x96\xa6\xf3\x6d\x5b\x17\x58\x91\xf0\x87\x82\x88\xde\x41\x64\x89\x82\x6a\xf0\x19\x22\x3d\xc4\x8a\x64\x09\xb9\x8f\xc8\x1c\x11\x07\x91\x0f\xf5\x59\x79\x8e\x84\xcd\x56\x10\x60\x32\xd2\x08\xd5\x88\x8d\xc8\x0e\xab\xbf\x5f\x02\x00\x00\xff\xff\xac\xee\xf1\x57\xde\x02\x00\x00")
-
-func yo_ngJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_yo_ngJson,
-		"yo_NG.json",
-	)
-}
-
-func yo_ngJson() (*asset, error) {
-	bytes, err := yo_ngJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "yo_NG.json", size: 734, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _yue_hkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xe6\xe2\x54\xf2\x74\x51\xb2\x52\x50\xaa\x2c\x4d\x8d\xf7\xf0\x56\xd2\xe1\xe2\x54\x72\x49\xac\x2c\x56\xb2\x52\x88\xe6\xe2\xe4\x54\x7a\x36\x63\xfe\xb3\x39\xf3\x9f\x4d\x5f\x0a\x92\x81\x71\x9f\xec\x68\x40\xe1\xee\xea\x41\x95\xed\x44\xe6\x3e\x9d\x3d\x1b\x55\xf1\x14\x14\xd9\xd6\xb5\x4a\x5c\x9c\xb1\x20\x6b\x83\x33\xf2\x8b\x4a\x50\xec\x86\xd9\x0a\xb7\x0f\x6e\x13\xdc\x0e\xb8\xe9\x70\x73\x91\x4c\xf4\xcd\xcf\x2b\xc9\x80\x1b\xf7\x64\x47\xc3\xb3\x39\x1d\x70\x83\x10\xec\x1d\x9d\x70\xf6\xd3\xd9\xb3\x91\xd4\x4c\x41\x88\xb7\xae\x45\x52\xdf\x8c\x24\xbe\x1a\x21\xbe\x73\x2e\x42\xbc\xb7\x11\x99\x8d\x6c\x35\x88\x0b\xb1\x1d\xd9\xdf\xa8\x4e\x35\x84\xab\x36\x82\xb3\x8c\xe1\x2c\x13\x38\xcb\x14\xce\x32\x83\xb3\xcc\xe1\x2c\x0b\x38\xcb\x12\xce\x32\x34\x40\x30\x11\xb6\x18\x1a\x21\xb9\xc7\xd1\x37\xc0\x17\x11\x66\x5d\x4f\x7b\xe1\xfe\xee\x06\xb1\xa1\xaa\x5c\x12\x4b\x52\x41\xe9\x46\x35\xf2\xe9\xce\x2d\xaa\xb9\xcf\xe6\x74\xa8\xa6\x3c\x9b\xbe\x54\x41\xd5\x51\x09\x26\x1d\x92\x99\x8b\x43\x89\x82\xaa\xc7\xcb\xdd\xf3\x54\x7d\x9f\x76\xb4\xa9\x06\x3f\x5f\x3e\x09\xac\x05\xae\x1c\x87\x1c\xd4\x5d\x4a\xaa\x05\xaa\x9e\xa8\x2a\xb8\x6a\x01\x01\x00\x00\xff\xff\xd1\x4d\x92\x9e\xc8\x02\x00\x00")
-
-func yue_hkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_yue_hkJson,
-		"yue_HK.json",
-	)
-}
-
-func yue_hkJson() (*asset, error) {
-	bytes, err := yue_hkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "yue_HK.json", size: 712, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _zh_cnJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xe6\xe2\x54\xf2\x74\x51\xb2\x52\x50\xaa\xca\x88\x77\xf6\x53\xd2\xe1\xe2\x54\x72\x49\xac\x2c\x56\xb2\x52\x88\xe6\xe2\xe4\x54\x7a\x36\x63\xfe\xb3\x39\xf3\x9f\x4d\x5f\x0a\x92\x81\x71\x9f\xec\x68\x40\xe1\xee\xea\x41\x95\xed\x44\xe6\x3e\x9d\x3d\x1b\x55\xf1\x14\x14\xd9\xd6\xb5\x4a\x5c\x9c\xb1\x20\x6b\x83\x33\xf2\x8b\x4a\x50\xec\x86\xd9\x0a\xb7\x0f\x6e\x13\xdc\x0e\xb8\xe9\x70\x73\x91\x4c\xf4\xcd\xcf\x2b\xc9\x80\x1b\xf7\x64\x47\xc3\xb3\x39\x1d\x70\x83\x10\xec\x1d\x9d\x70\xf6\xd3\xd9\xb3\x91\xd4\x4c\x41\x88\xb7\xae\x45\x52\xdf\x8c\x24\xbe\x1a\x21\xbe\x73\x2e\x42\xbc\xb7\x11\x99\x8d\x6c\x35\x88\x0b\xb1\x1d\xd9\xdf\xa8\x4e\x35\x84\xab\x36\x82\xb3\x8c\xe1\x2c\x13\x38\xcb\x14\xce\x32\x83\xb3\xcc\xe1\x2c\x0b\x38\xcb\x12\xce\x32\x34\x40\x30\x11\xb6\x18\x1a\x21\xb9\xc7\xd1\x37\xc0\x17\x11\x66\x5d\x4f\x7b\xe1\xfe\xee\x06\xb1\xa1\xaa\x5c\x12\x4b\x52\x41\xc9\x46\x35\xf2\xe9\xce\x2d\xaa\xb9\xcf\xe6\x74\xa8\xa6\x40\x23\x0c\x2c\x17\x92\x99\x8b\x4d\x5e\x41\xd5\x51\x41\xd5\xe3\xd9\xf4\x6d\xaa\xbe\x4f\x3b\xda\x54\x83\x9f\x2f\x9f\x04\xd6\x02\x57\x8e\x43\x0e\xea\x28\x25\xd5\x02\x05\x55\x4f\x54\x25\x5c\xb5\x80\x00\x00\x00\xff\xff\xdf\xce\xa2\x4e\xc5\x02\x00\x00")
-
-func zh_cnJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_zh_cnJson,
-		"zh_CN.json",
-	)
-}
-
-func zh_cnJson() (*asset, error) {
-	bytes, err := zh_cnJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "zh_CN.json", size: 709, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _zh_hkJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xe6\xe2\x54\xf2\x74\x51\xb2\x52\x50\xaa\xca\x88\xf7\xf0\x56\xd2\xe1\xe2\x54\x72\x49\xac\x2c\x56\xb2\x52\x88\xe6\xe2\xe4\x54\x7a\x36\x63\xfe\xb3\x39\xf3\x9f\x4d\x5f\x0a\x92\x81\x71\x9f\xec\x68\x40\xe1\xee\xea\x41\x95\xed\x44\xe6\x3e\x9d\x3d\x1b\x55\xf1\x14\x14\xd9\xd6\xb5\x4a\x5c\x9c\xb1\x20\x6b\x83\x33\xf2\x8b\x4a\x50\xec\x86\xd9\x0a\xb7\x0f\x6e\x13\xdc\x0e\xb8\xe9\x70\x73\x91\x4c\xf4\xcd\xcf\x2b\xc9\x80\x1b\xf7\x64\x47\xc3\xb3\x39\x1d\x70\x83\x10\xec\x1d\x9d\x70\xf6\xd3\xd9\xb3\x91\xd4\x4c\x41\x88\xb7\xae\x45\x52\xdf\x8c\x24\xbe\x1a\x21\xbe\x73\x2e\x42\xbc\xb7\x11\x99\x8d\x6c\x35\x88\x0b\xb1\x1d\xd9\xdf\xa8\x4e\x35\x84\xab\x36\x82\xb3\x8c\xe1\x2c\x13\x38\xcb\x14\xce\x32\x83\xb3\xcc\xe1\x2c\x0b\x38\xcb\x12\xce\x32\x34\x40\x30\x11\xb6\x18\x1a\x21\xb9\xc7\xd1\x37\xc0\x17\x11\x66\x5d\x4f\x7b\xe1\xfe\xee\x06\xb1\xa1\xaa\x5c\x12\x4b\x52\x41\xc9\x46\x35\xf2\xe9\xce\x2d\xaa\xb9\xcf\xe6\x74\xa8\xa6\x3c\x9b\xbe\x54\x41\xd5\x51\x09\x26\x1d\x92\x99\x8b\x43\x89\x82\xaa\x87\x95\xaa\xaf\x95\x6a\x30\x58\x2d\x5c\x9d\xe7\xb3\x99\x4d\xaa\xbe\x4f\x3b\xda\x54\x83\x9f\x2f\x9f\xa4\xa0\x1a\x05\x97\x86\xba\x49\x49\xb5\x40\x41\xd5\x13\xaa\x95\xab\x16\x10\x00\x00\xff\xff\xbc\x39\xef\xa7\xbd\x02\x00\x00")
-
-func zh_hkJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_zh_hkJson,
-		"zh_HK.json",
-	)
-}
-
-func zh_hkJson() (*asset, error) {
-	bytes, err := zh_hkJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "zh_HK.json", size: 701, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _zh_sgJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xe6\xe2\x54\xf2\x74\x51\xb2\x52\x50\xaa\xca\x88\x0f\x76\x57\xd2\xe1\xe2\x54\x72\x49\xac\x2c\x56\xb2\x52\x88\xe6\xe2\xe4\x54\x7a\x36\x63\xfe\xb3\x39\xf3\x9f\x4d\x5f\x0a\x92\x81\x71\x9f\xec\x68\x40\xe1\xee\xea\x41\x95\xed\x44\xe6\x3e\x9d\x3d\x1b\x55\xf1\x14\x14\xd9\xd6\xb5\x4a\x5c\x9c\xb1\x20\x6b\x83\x33\xf2\x8b\x4a\x06\xc8\x6e\xdf\xfc\xbc\x92\x0c\xb8\xc5\x4f\x76\x34\x3c\x9b\xd3\x01\x51\xfa\x64\x57\x0f\x82\xbd\xa3\x13\xce\x7e\x3a\x7b\x36\x92\x9a\x29\x08\xf1\xd6\xb5\x48\xea\x9b\x91\xc4\x57\x23\xc4\x77\xce\x45\x88\xf7\x36\x22\xb3\x91\xad\x06\x71\x21\xb6\x23\x87\xd0\xd0\x70\xaa\xa3\x6f\x80\x2f\xc2\x8d\x5d\x4f\x7b\xe1\xf6\x74\x83\xd8\x50\x55\x2e\x89\x25\xa9\xa0\xb4\xa7\x1a\xf9\x74\xe7\x16\xd5\xdc\x67\x73\x3a\x54\x53\xa0\xf1\x0d\x96\x0b\xc9\xcc\xc5\x26\xaf\xa0\xea\xf1\x6c\xfa\x36\x55\xdf\xa7\x1d\x6d\xaa\xc1\xcf\x97\x4f\x52\x50\x8d\x02\x6b\x81\x2b\xc7\x2d\x0d\x75\x97\x92\x12\x57\x2d\x20\x00\x00\xff\xff\x94\xab\x94\x76\xfb\x02\x00\x00")
-
-func zh_sgJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_zh_sgJson,
-		"zh_SG.json",
-	)
-}
-
-func zh_sgJson() (*asset, error) {
-	bytes, err := zh_sgJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "zh_SG.json", size: 763, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _zh_twJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xaa\xe6\xe2\x54\xf2\x74\x51\xb2\x52\x50\xaa\xca\x88\x0f\x09\x57\xd2\xe1\xe2\x54\x72\x49\xac\x2c\x56\xb2\x52\x88\xe6\xe2\xe4\x54\x7a\xd9\xb0\xf1\xd9\xf4\xa5\x20\x61\x30\xfb\xc9\x8e\x06\x04\x7b\x57\x0f\x92\x78\x27\x9c\xfd\x74\xf6\x6c\x24\x35\x53\x10\xe2\xad\x6b\x95\xb8\x38\x63\x41\x36\x04\x67\xe4\x17\x95\x20\x5b\x03\xb7\x03\x6e\x01\xdc\x74\xb8\xd1\x70\x73\xe1\x86\x22\x99\xe8\x9b\x9f\x57\x92\x01\x37\xee\xc9\x8e\x86\x67\x73\x3a\xe0\x06\x21\xd8\x3b\x3a\xe1\xec\xa7\xb3\x67\x23\xa9\x99\x82\x10\x6f\x5d\x8b\xa4\xbe\x19\x49\x7c\x35\x42\x7c\xe7\x5c\x84\x78\x6f\x23\x32\x1b\xd9\x6a\x10\x17\x62\x3b\xb2\xbf\x51\x9d\x6a\x08\x57\x6d\x04\x67\x19\xc3\x59\x26\x70\x96\x29\x9c\x65\x06\x67\x99\xc3\x59\x16\x70\x96\x25\x9c\x65\x68\x80\x60\x22\x6c\x31\x34\x42\x72\x8f\xa3\x6f\x80\x2f\x22\xcc\xba\x9e\xf6\xc2\xfd\xdd\x0d\x62\x43\x55\xb9\x24\x96\xa4\x82\x52\x88\x6a\xe4\xd3\x9d\x5b\x54\x73\x9f\xcd\xe9\x50\x4d\x81\x46\x18\x58\x2e\x24\x33\x17\x9b\xbc\x82\x86\xaa\xa3\xa6\x82\xaa\xc7\xb3\x99\x4d\xaa\xbe\x4f\x3b\xda\x54\x83\x9f\x2f\x9f\x04\xd6\x04\xd7\x80\x43\x0e\xea\x2c\x25\xd5\x02\x05\x55\x4f\x54\x25\x5c\xb5\x80\x00\x00\x00\xff\xff\xdf\x8c\xaa\x48\xb2\x02\x00\x00")
-
-func zh_twJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_zh_twJson,
-		"zh_TW.json",
-	)
-}
-
-func zh_twJson() (*asset, error) {
-	bytes, err := zh_twJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "zh_TW.json", size: 690, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _zu_zaJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x52\x3d\x6b\xc3\x30\x10\x9d\xed\x5f\x61\x04\xda\x0a\xd9\xb3\xa5\x64\x68\x21\x72\x0b\xf6\x12\x97\x52\x4e\xb1\x63\xa9\x39\x4b\xa4\x92\x30\x4d\xe9\x7f\x2f\xa9\x75\x8a\xb2\xdd\xfb\xc8\x3d\xdd\x8b\x7f\xca\x82\x3d\x6f\xd9\xba\x62\x97\xf0\xd1\x6d\xd8\x43\x59\xb0\x2d\x7c\x3b\xb6\xae\xde\xca\xa2\x60\xba\xb1\xc6\xdb\x2b\x5d\xb0\x20\x9c\x9d\x64\xc0\x70\x22\x62\x37\x0f\x4e\x4b\x8d\x3a\xc7\x5e\x81\x57\x21\x67\xcc\x90\x23\x85\x60\x48\x16\xe3\x59\xcb\x01\x2d\x2b\x8b\xf7\x6b\x74\xa3\xec\x97\xcf\xf3\x1b\x6b\x16\xab\x70\x31\xf4\x51\xe3\x32\xb4\x0a\x96\xa1\xd1\xd1\xf3\x84\x91\x11\xe3\x99\x36\x0a\x6b\xbc\x4a\xeb\x82\x00\xa7\xcd\x08\x26\x1a\x43\xad\xd0\x22\x98\xcf\x84\x7b\x70\x34\x0b\x79\x9b\x6b\x85\x20\x73\x60\xc6\x80\xd9\x1a\x1f\x50\x9f\xe0\x42\x45\xd4\x87\xf9\x66\x17\x60\xfa\x80\xa9\xc4\x63\x98\x8e\x59\x3d\xe9\x37\x9d\x96\x60\x7a\x1c\x10\xee\xda\xb8\x3f\x40\x80\x5b\xec\x2f\x74\x6b\xdd\xd3\xd1\x14\xb8\x49\x83\x19\xa3\xc7\xc7\xc0\xfa\x30\x47\x33\x50\xaf\xf4\x96\xdd\x1c\xff\xa5\x4e\x4b\x7a\xc0\x46\xbc\x0a\x4a\x5e\x44\x52\xb6\xe0\x87\xeb\x57\xc3\xfb\x15\x9f\x56\x7c\xcf\x88\x6c\xf5\xb4\x08\x50\xf1\xbe\xe2\xb2\xe2\xfb\x8a\xb7\x15\xef\xfe\x1d\x49\x6d\x13\x8c\x19\x8c\x95\xbf\x7f\x01\x00\x00\xff\xff\x3c\x9f\xd2\x89\x8d\x02\x00\x00")
-
-func zu_zaJsonBytes() ([]byte, error) {
-	return bindataRead(
-		_zu_zaJson,
-		"zu_ZA.json",
-	)
-}
-
-func zu_zaJson() (*asset, error) {
-	bytes, err := zu_zaJsonBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "zu_ZA.json", size: 653, mode: os.FileMode(420), modTime: time.Unix(1487355856, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"POSIX.json": posixJson,
-	"aa_DJ.json": aa_djJson,
-	"aa_ER.json": aa_erJson,
-	"aa_ER@saaho.json": aa_erSaahoJson,
-	"aa_ET.json": aa_etJson,
-	"af_ZA.json": af_zaJson,
-	"am_ET.json": am_etJson,
-	"an_ES.json": an_esJson,
-	"ar_AE.json": ar_aeJson,
-	"ar_BH.json": ar_bhJson,
-	"ar_DZ.json": ar_dzJson,
-	"ar_EG.json": ar_egJson,
-	"ar_IN.json": ar_inJson,
-	"ar_IQ.json": ar_iqJson,
-	"ar_JO.json": ar_joJson,
-	"ar_KW.json": ar_kwJson,
-	"ar_LB.json": ar_lbJson,
-	"ar_LY.json": ar_lyJson,
-	"ar_MA.json": ar_maJson,
-	"ar_OM.json": ar_omJson,
-	"ar_QA.json": ar_qaJson,
-	"ar_SA.json": ar_saJson,
-	"ar_SD.json": ar_sdJson,
-	"ar_SY.json": ar_syJson,
-	"ar_TN.json": ar_tnJson,
-	"ar_YE.json": ar_yeJson,
-	"as_IN.json": as_inJson,
-	"ast_ES.json": ast_esJson,
-	"az_AZ.json": az_azJson,
-	"be_BY.json": be_byJson,
-	"be_BY@latin.json": be_byLatinJson,
-	"bem_ZM.json": bem_zmJson,
-	"ber_DZ.json": ber_dzJson,
-	"ber_MA.json": ber_maJson,
-	"bg_BG.json": bg_bgJson,
-	"bho_IN.json": bho_inJson,
-	"bn_BD.json": bn_bdJson,
-	"bn_IN.json": bn_inJson,
-	"bo_CN.json": bo_cnJson,
-	"bo_IN.json": bo_inJson,
-	"br_FR.json": br_frJson,
-	"brx_IN.json": brx_inJson,
-	"bs_BA.json": bs_baJson,
-	"byn_ER.json": byn_erJson,
-	"ca_AD.json": ca_adJson,
-	"ca_ES.json": ca_esJson,
-	"ca_FR.json": ca_frJson,
-	"ca_IT.json": ca_itJson,
-	"crh_UA.json": crh_uaJson,
-	"cs_CZ.json": cs_czJson,
-	"csb_PL.json": csb_plJson,
-	"cv_RU.json": cv_ruJson,
-	"cy_GB.json": cy_gbJson,
-	"da_DK.json": da_dkJson,
-	"de_AT.json": de_atJson,
-	"de_BE.json": de_beJson,
-	"de_CH.json": de_chJson,
-	"de_DE.json": de_deJson,
-	"de_LI.json": de_liJson,
-	"de_LU.json": de_luJson,
-	"dv_MV.json": dv_mvJson,
-	"dz_BT.json": dz_btJson,
-	"el_CY.json": el_cyJson,
-	"el_GR.json": el_grJson,
-	"en_AG.json": en_agJson,
-	"en_AU.json": en_auJson,
-	"en_BW.json": en_bwJson,
-	"en_CA.json": en_caJson,
-	"en_DK.json": en_dkJson,
-	"en_GB.json": en_gbJson,
-	"en_HK.json": en_hkJson,
-	"en_IE.json": en_ieJson,
-	"en_IN.json": en_inJson,
-	"en_NG.json": en_ngJson,
-	"en_NZ.json": en_nzJson,
-	"en_PH.json": en_phJson,
-	"en_SG.json": en_sgJson,
-	"en_US.json": en_usJson,
-	"en_ZA.json": en_zaJson,
-	"en_ZM.json": en_zmJson,
-	"en_ZW.json": en_zwJson,
-	"eo.json": eoJson,
-	"eo_US.json": eo_usJson,
-	"es_AR.json": es_arJson,
-	"es_BO.json": es_boJson,
-	"es_CL.json": es_clJson,
-	"es_CO.json": es_coJson,
-	"es_CR.json": es_crJson,
-	"es_CU.json": es_cuJson,
-	"es_DO.json": es_doJson,
-	"es_EC.json": es_ecJson,
-	"es_ES.json": es_esJson,
-	"es_GT.json": es_gtJson,
-	"es_HN.json": es_hnJson,
-	"es_MX.json": es_mxJson,
-	"es_NI.json": es_niJson,
-	"es_PA.json": es_paJson,
-	"es_PE.json": es_peJson,
-	"es_PR.json": es_prJson,
-	"es_PY.json": es_pyJson,
-	"es_SV.json": es_svJson,
-	"es_US.json": es_usJson,
-	"es_UY.json": es_uyJson,
-	"es_VE.json": es_veJson,
-	"et_EE.json": et_eeJson,
-	"eu_ES.json": eu_esJson,
-	"eu_FR.json": eu_frJson,
-	"fa_IR.json": fa_irJson,
-	"ff_SN.json": ff_snJson,
-	"fi_FI.json": fi_fiJson,
-	"fil_PH.json": fil_phJson,
-	"fo_FO.json": fo_foJson,
-	"fr_BE.json": fr_beJson,
-	"fr_CA.json": fr_caJson,
-	"fr_CH.json": fr_chJson,
-	"fr_FR.json": fr_frJson,
-	"fr_LU.json": fr_luJson,
-	"fur_IT.json": fur_itJson,
-	"fy_NL.json": fy_nlJson,
-	"ga_IE.json": ga_ieJson,
-	"gd_GB.json": gd_gbJson,
-	"gez_ER.json": gez_erJson,
-	"gez_ET.json": gez_etJson,
-	"gl_ES.json": gl_esJson,
-	"gu_IN.json": gu_inJson,
-	"gv_GB.json": gv_gbJson,
-	"ha_NG.json": ha_ngJson,
-	"he_IL.json": he_ilJson,
-	"hi_IN.json": hi_inJson,
-	"hne_IN.json": hne_inJson,
-	"hr_HR.json": hr_hrJson,
-	"hsb_DE.json": hsb_deJson,
-	"ht_HT.json": ht_htJson,
-	"hu_HU.json": hu_huJson,
-	"hy_AM.json": hy_amJson,
-	"i18n.json": i18nJson,
-	"ia.json": iaJson,
-	"id_ID.json": id_idJson,
-	"ig_NG.json": ig_ngJson,
-	"ik_CA.json": ik_caJson,
-	"is_IS.json": is_isJson,
-	"it_CH.json": it_chJson,
-	"it_IT.json": it_itJson,
-	"iu_CA.json": iu_caJson,
-	"iw_IL.json": iw_ilJson,
-	"ja_JP.json": ja_jpJson,
-	"ka_GE.json": ka_geJson,
-	"kk_KZ.json": kk_kzJson,
-	"kl_GL.json": kl_glJson,
-	"km_KH.json": km_khJson,
-	"kn_IN.json": kn_inJson,
-	"ko_KR.json": ko_krJson,
-	"kok_IN.json": kok_inJson,
-	"ks_IN.json": ks_inJson,
-	"ks_IN@devanagari.json": ks_inDevanagariJson,
-	"ku_TR.json": ku_trJson,
-	"kw_GB.json": kw_gbJson,
-	"ky_KG.json": ky_kgJson,
-	"lb_LU.json": lb_luJson,
-	"lg_UG.json": lg_ugJson,
-	"lij_IT.json": lij_itJson,
-	"lo_LA.json": lo_laJson,
-	"lt_LT.json": lt_ltJson,
-	"lv_LV.json": lv_lvJson,
-	"mai_IN.json": mai_inJson,
-	"mg_MG.json": mg_mgJson,
-	"mhr_RU.json": mhr_ruJson,
-	"mi_NZ.json": mi_nzJson,
-	"mk_MK.json": mk_mkJson,
-	"ml_IN.json": ml_inJson,
-	"mn_MN.json": mn_mnJson,
-	"mr_IN.json": mr_inJson,
-	"ms_MY.json": ms_myJson,
-	"mt_MT.json": mt_mtJson,
-	"my_MM.json": my_mmJson,
-	"nan_TW@latin.json": nan_twLatinJson,
-	"nb_NO.json": nb_noJson,
-	"ne_NP.json": ne_npJson,
-	"nl_AW.json": nl_awJson,
-	"nl_BE.json": nl_beJson,
-	"nl_NL.json": nl_nlJson,
-	"nn_NO.json": nn_noJson,
-	"nr_ZA.json": nr_zaJson,
-	"nso_ZA.json": nso_zaJson,
-	"oc_FR.json": oc_frJson,
-	"om_ET.json": om_etJson,
-	"om_KE.json": om_keJson,
-	"or_IN.json": or_inJson,
-	"os_RU.json": os_ruJson,
-	"pa_IN.json": pa_inJson,
-	"pa_PK.json": pa_pkJson,
-	"pap_AN.json": pap_anJson,
-	"pl_PL.json": pl_plJson,
-	"ps_AF.json": ps_afJson,
-	"pt_BR.json": pt_brJson,
-	"pt_PT.json": pt_ptJson,
-	"ro_RO.json": ro_roJson,
-	"ru_RU.json": ru_ruJson,
-	"ru_UA.json": ru_uaJson,
-	"rw_RW.json": rw_rwJson,
-	"sa_IN.json": sa_inJson,
-	"sc_IT.json": sc_itJson,
-	"sd_IN.json": sd_inJson,
-	"sd_IN@devanagari.json": sd_inDevanagariJson,
-	"sd_PK.json": sd_pkJson,
-	"se_NO.json": se_noJson,
-	"shs_CA.json": shs_caJson,
-	"si_LK.json": si_lkJson,
-	"sid_ET.json": sid_etJson,
-	"sk_SK.json": sk_skJson,
-	"sl_SI.json": sl_siJson,
-	"so_DJ.json": so_djJson,
-	"so_ET.json": so_etJson,
-	"so_KE.json": so_keJson,
-	"so_SO.json": so_soJson,
-	"sq_AL.json": sq_alJson,
-	"sq_MK.json": sq_mkJson,
-	"sr_ME.json": sr_meJson,
-	"sr_RS.json": sr_rsJson,
-	"sr_RS@latin.json": sr_rsLatinJson,
-	"ss_ZA.json": ss_zaJson,
-	"st_ZA.json": st_zaJson,
-	"sv_FI.json": sv_fiJson,
-	"sv_SE.json": sv_seJson,
-	"sw_KE.json": sw_keJson,
-	"sw_TZ.json": sw_tzJson,
-	"ta_IN.json": ta_inJson,
-	"ta_LK.json": ta_lkJson,
-	"te_IN.json": te_inJson,
-	"tg_TJ.json": tg_tjJson,
-	"th_TH.json": th_thJson,
-	"ti_ER.json": ti_erJson,
-	"ti_ET.json": ti_etJson,
-	"tig_ER.json": tig_erJson,
-	"tk_TM.json": tk_tmJson,
-	"tl_PH.json": tl_phJson,
-	"tn_ZA.json": tn_zaJson,
-	"tr_CY.json": tr_cyJson,
-	"tr_TR.json": tr_trJson,
-	"ts_ZA.json": ts_zaJson,
-	"tt_RU.json": tt_ruJson,
-	"tt_RU@iqtelif.json": tt_ruIqtelifJson,
-	"ug_CN.json": ug_cnJson,
-	"ug_CN@latin.json": ug_cnLatinJson,
-	"uk_UA.json": uk_uaJson,
-	"unm_US.json": unm_usJson,
-	"ur_IN.json": ur_inJson,
-	"ur_PK.json": ur_pkJson,
-	"uz_UZ.json": uz_uzJson,
-	"uz_UZ@cyrillic.json": uz_uzCyrillicJson,
-	"ve_ZA.json": ve_zaJson,
-	"vi_VN.json": vi_vnJson,
-	"wa_BE.json": wa_beJson,
-	"wae_CH.json": wae_chJson,
-	"wal_ET.json": wal_etJson,
-	"wo_SN.json": wo_snJson,
-	"xh_ZA.json": xh_zaJson,
-	"yi_US.json": yi_usJson,
-	"yo_NG.json": yo_ngJson,
-	"yue_HK.json": yue_hkJson,
-	"zh_CN.json": zh_cnJson,
-	"zh_HK.json": zh_hkJson,
-	"zh_SG.json": zh_sgJson,
-	"zh_TW.json": zh_twJson,
-	"zu_ZA.json": zu_zaJson,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-var _bintree = &bintree{nil, map[string]*bintree{
-	"POSIX.json": &bintree{posixJson, map[string]*bintree{}},
-	"aa_DJ.json": &bintree{aa_djJson, map[string]*bintree{}},
-	"aa_ER.json": &bintree{aa_erJson, map[string]*bintree{}},
-	"aa_ER@saaho.json": &bintree{aa_erSaahoJson, map[string]*bintree{}},
-	"aa_ET.json": &bintree{aa_etJson, map[string]*bintree{}},
-	"af_ZA.json": &bintree{af_zaJson, map[string]*bintree{}},
-	"am_ET.json": &bintree{am_etJson, map[string]*bintree{}},
-	"an_ES.json": &bintree{an_esJson, map[string]*bintree{}},
-	"ar_AE.json": &bintree{ar_aeJson, map[string]*bintree{}},
-	"ar_BH.json": &bintree{ar_bhJson, map[string]*bintree{}},
-	"ar_DZ.json": &bintree{ar_dzJson, map[string]*bintree{}},
-	"ar_EG.json": &bintree{ar_egJson, map[string]*bintree{}},
-	"ar_IN.json": &bintree{ar_inJson, map[string]*bintree{}},
-	"ar_IQ.json": &bintree{ar_iqJson, map[string]*bintree{}},
-	"ar_JO.json": &bintree{ar_joJson, map[string]*bintree{}},
-	"ar_KW.json": &bintree{ar_kwJson, map[string]*bintree{}},
-	"ar_LB.json": &bintree{ar_lbJson, map[string]*bintree{}},
-	"ar_LY.json": &bintree{ar_lyJson, map[string]*bintree{}},
-	"ar_MA.json": &bintree{ar_maJson, map[string]*bintree{}},
-	"ar_OM.json": &bintree{ar_omJson, map[string]*bintree{}},
-	"ar_QA.json": &bintree{ar_qaJson, map[string]*bintree{}},
-	"ar_SA.json": &bintree{ar_saJson, map[string]*bintree{}},
-	"ar_SD.json": &bintree{ar_sdJson, map[string]*bintree{}},
-	"ar_SY.json": &bintree{ar_syJson, map[string]*bintree{}},
-	"ar_TN.json": &bintree{ar_tnJson, map[string]*bintree{}},
-	"ar_YE.json": &bintree{ar_yeJson, map[string]*bintree{}},
-	"as_IN.json": &bintree{as_inJson, map[string]*bintree{}},
-	"ast_ES.json": &bintree{ast_esJson, map[string]*bintree{}},
-	"az_AZ.json": &bintree{az_azJson, map[string]*bintree{}},
-	"be_BY.json": &bintree{be_byJson, map[string]*bintree{}},
-	"be_BY@latin.json": &bintree{be_byLatinJson, map[string]*bintree{}},
-	"bem_ZM.json": &bintree{bem_zmJson, map[string]*bintree{}},
-	"ber_DZ.json": &bintree{ber_dzJson, map[string]*bintree{}},
-	"ber_MA.json": &bintree{ber_maJson, map[string]*bintree{}},
-	"bg_BG.json": &bintree{bg_bgJson, map[string]*bintree{}},
-	"bho_IN.json": &bintree{bho_inJson, map[string]*bintree{}},
-	"bn_BD.json": &bintree{bn_bdJson, map[string]*bintree{}},
-	"bn_IN.json": &bintree{bn_inJson, map[string]*bintree{}},
-	"bo_CN.json": &bintree{bo_cnJson, map[string]*bintree{}},
-	"bo_IN.json": &bintree{bo_inJson, map[string]*bintree{}},
-	"br_FR.json": &bintree{br_frJson, map[string]*bintree{}},
-	"brx_IN.json": &bintree{brx_inJson, map[string]*bintree{}},
-	"bs_BA.json": &bintree{bs_baJson, map[string]*bintree{}},
-	"byn_ER.json": &bintree{byn_erJson, map[string]*bintree{}},
-	"ca_AD.json": &bintree{ca_adJson, map[string]*bintree{}},
-	"ca_ES.json": &bintree{ca_esJson, map[string]*bintree{}},
-	"ca_FR.json": &bintree{ca_frJson, map[string]*bintree{}},
-	"ca_IT.json": &bintree{ca_itJson, map[string]*bintree{}},
-	"crh_UA.json": &bintree{crh_uaJson, map[string]*bintree{}},
-	"cs_CZ.json": &bintree{cs_czJson, map[string]*bintree{}},
-	"csb_PL.json": &bintree{csb_plJson, map[string]*bintree{}},
-	"cv_RU.json": &bintree{cv_ruJson, map[string]*bintree{}},
-	"cy_GB.json": &bintree{cy_gbJson, map[string]*bintree{}},
-	"da_DK.json": &bintree{da_dkJson, map[string]*bintree{}},
-	"de_AT.json": &bintree{de_atJson, map[string]*bintree{}},
-	"de_BE.json": &bintree{de_beJson, map[string]*bintree{}},
-	"de_CH.json": &bintree{de_chJson, map[string]*bintree{}},
-	"de_DE.json": &bintree{de_deJson, map[string]*bintree{}},
-	"de_LI.json": &bintree{de_liJson, map[string]*bintree{}},
-	"de_LU.json": &bintree{de_luJson, map[string]*bintree{}},
-	"dv_MV.json": &bintree{dv_mvJson, map[string]*bintree{}},
-	"dz_BT.json": &bintree{dz_btJson, map[string]*bintree{}},
-	"el_CY.json": &bintree{el_cyJson, map[string]*bintree{}},
-	"el_GR.json": &bintree{el_grJson, map[string]*bintree{}},
-	"en_AG.json": &bintree{en_agJson, map[string]*bintree{}},
-	"en_AU.json": &bintree{en_auJson, map[string]*bintree{}},
-	"en_BW.json": &bintree{en_bwJson, map[string]*bintree{}},
-	"en_CA.json": &bintree{en_caJson, map[string]*bintree{}},
-	"en_DK.json": &bintree{en_dkJson, map[string]*bintree{}},
-	"en_GB.json": &bintree{en_gbJson, map[string]*bintree{}},
-	"en_HK.json": &bintree{en_hkJson, map[string]*bintree{}},
-	"en_IE.json": &bintree{en_ieJson, map[string]*bintree{}},
-	"en_IN.json": &bintree{en_inJson, map[string]*bintree{}},
-	"en_NG.json": &bintree{en_ngJson, map[string]*bintree{}},
-	"en_NZ.json": &bintree{en_nzJson, map[string]*bintree{}},
-	"en_PH.json": &bintree{en_phJson, map[string]*bintree{}},
-	"en_SG.json": &bintree{en_sgJson, map[string]*bintree{}},
-	"en_US.json": &bintree{en_usJson, map[string]*bintree{}},
-	"en_ZA.json": &bintree{en_zaJson, map[string]*bintree{}},
-	"en_ZM.json": &bintree{en_zmJson, map[string]*bintree{}},
-	"en_ZW.json": &bintree{en_zwJson, map[string]*bintree{}},
-	"eo.json": &bintree{eoJson, map[string]*bintree{}},
-	"eo_US.json": &bintree{eo_usJson, map[string]*bintree{}},
-	"es_AR.json": &bintree{es_arJson, map[string]*bintree{}},
-	"es_BO.json": &bintree{es_boJson, map[string]*bintree{}},
-	"es_CL.json": &bintree{es_clJson, map[string]*bintree{}},
-	"es_CO.json": &bintree{es_coJson, map[string]*bintree{}},
-	"es_CR.json": &bintree{es_crJson, map[string]*bintree{}},
-	"es_CU.json": &bintree{es_cuJson, map[string]*bintree{}},
-	"es_DO.json": &bintree{es_doJson, map[string]*bintree{}},
-	"es_EC.json": &bintree{es_ecJson, map[string]*bintree{}},
-	"es_ES.json": &bintree{es_esJson, map[string]*bintree{}},
-	"es_GT.json": &bintree{es_gtJson, map[string]*bintree{}},
-	"es_HN.json": &bintree{es_hnJson, map[string]*bintree{}},
-	"es_MX.json": &bintree{es_mxJson, map[string]*bintree{}},
-	"es_NI.json": &bintree{es_niJson, map[string]*bintree{}},
-	"es_PA.json": &bintree{es_paJson, map[string]*bintree{}},
-	"es_PE.json": &bintree{es_peJson, map[string]*bintree{}},
-	"es_PR.json": &bintree{es_prJson, map[string]*bintree{}},
-	"es_PY.json": &bintree{es_pyJson, map[string]*bintree{}},
-	"es_SV.json": &bintree{es_svJson, map[string]*bintree{}},
-	"es_US.json": &bintree{es_usJson, map[string]*bintree{}},
-	"es_UY.json": &bintree{es_uyJson, map[string]*bintree{}},
-	"es_VE.json": &bintree{es_veJson, map[string]*bintree{}},
-	"et_EE.json": &bintree{et_eeJson, map[string]*bintree{}},
-	"eu_ES.json": &bintree{eu_esJson, map[string]*bintree{}},
-	"eu_FR.json": &bintree{eu_frJson, map[string]*bintree{}},
-	"fa_IR.json": &bintree{fa_irJson, map[string]*bintree{}},
-	"ff_SN.json": &bintree{ff_snJson, map[string]*bintree{}},
-	"fi_FI.json": &bintree{fi_fiJson, map[string]*bintree{}},
-	"fil_PH.json": &bintree{fil_phJson, map[string]*bintree{}},
-	"fo_FO.json": &bintree{fo_foJson, map[string]*bintree{}},
-	"fr_BE.json": &bintree{fr_beJson, map[string]*bintree{}},
-	"fr_CA.json": &bintree{fr_caJson, map[string]*bintree{}},
-	"fr_CH.json": &bintree{fr_chJson, map[string]*bintree{}},
-	"fr_FR.json": &bintree{fr_frJson, map[string]*bintree{}},
-	"fr_LU.json": &bintree{fr_luJson, map[string]*bintree{}},
-	"fur_IT.json": &bintree{fur_itJson, map[string]*bintree{}},
-	"fy_NL.json": &bintree{fy_nlJson, map[string]*bintree{}},
-	"ga_IE.json": &bintree{ga_ieJson, map[string]*bintree{}},
-	"gd_GB.json": &bintree{gd_gbJson, map[string]*bintree{}},
-	"gez_ER.json": &bintree{gez_erJson, map[string]*bintree{}},
-	"gez_ET.json": &bintree{gez_etJson, map[string]*bintree{}},
-	"gl_ES.json": &bintree{gl_esJson, map[string]*bintree{}},
-	"gu_IN.json": &bintree{gu_inJson, map[string]*bintree{}},
-	"gv_GB.json": &bintree{gv_gbJson, map[string]*bintree{}},
-	"ha_NG.json": &bintree{ha_ngJson, map[string]*bintree{}},
-	"he_IL.json": &bintree{he_ilJson, map[string]*bintree{}},
-	"hi_IN.json": &bintree{hi_inJson, map[string]*bintree{}},
-	"hne_IN.json": &bintree{hne_inJson, map[string]*bintree{}},
-	"hr_HR.json": &bintree{hr_hrJson, map[string]*bintree{}},
-	"hsb_DE.json": &bintree{hsb_deJson, map[string]*bintree{}},
-	"ht_HT.json": &bintree{ht_htJson, map[string]*bintree{}},
-	"hu_HU.json": &bintree{hu_huJson, map[string]*bintree{}},
-	"hy_AM.json": &bintree{hy_amJson, map[string]*bintree{}},
-	"i18n.json": &bintree{i18nJson, map[string]*bintree{}},
-	"ia.json": &bintree{iaJson, map[string]*bintree{}},
-	"id_ID.json": &bintree{id_idJson, map[string]*bintree{}},
-	"ig_NG.json": &bintree{ig_ngJson, map[string]*bintree{}},
-	"ik_CA.json": &bintree{ik_caJson, map[string]*bintree{}},
-	"is_IS.json": &bintree{is_isJson, map[string]*bintree{}},
-	"it_CH.json": &bintree{it_chJson, map[string]*bintree{}},
-	"it_IT.json": &bintree{it_itJson, map[string]*bintree{}},
-	"iu_CA.json": &bintree{iu_caJson, map[string]*bintree{}},
-	"iw_IL.json": &bintree{iw_ilJson, map[string]*bintree{}},
-	"ja_JP.json": &bintree{ja_jpJson, map[string]*bintree{}},
-	"ka_GE.json": &bintree{ka_geJson, map[string]*bintree{}},
-	"kk_KZ.json": &bintree{kk_kzJson, map[string]*bintree{}},
-	"kl_GL.json": &bintree{kl_glJson, map[string]*bintree{}},
-	"km_KH.json": &bintree{km_khJson, map[string]*bintree{}},
-	"kn_IN.json": &bintree{kn_inJson, map[string]*bintree{}},
-	"ko_KR.json": &bintree{ko_krJson, map[string]*bintree{}},
-	"kok_IN.json": &bintree{kok_inJson, map[string]*bintree{}},
-	"ks_IN.json": &bintree{ks_inJson, map[string]*bintree{}},
-	"ks_IN@devanagari.json": &bintree{ks_inDevanagariJson, map[string]*bintree{}},
-	"ku_TR.json": &bintree{ku_trJson, map[string]*bintree{}},
-	"kw_GB.json": &bintree{kw_gbJson, map[string]*bintree{}},
-	"ky_KG.json": &bintree{ky_kgJson, map[string]*bintree{}},
-	"lb_LU.json": &bintree{lb_luJson, map[string]*bintree{}},
-	"lg_UG.json": &bintree{lg_ugJson, map[string]*bintree{}},
-	"lij_IT.json": &bintree{lij_itJson, map[string]*bintree{}},
-	"lo_LA.json": &bintree{lo_laJson, map[string]*bintree{}},
-	"lt_LT.json": &bintree{lt_ltJson, map[string]*bintree{}},
-	"lv_LV.json": &bintree{lv_lvJson, map[string]*bintree{}},
-	"mai_IN.json": &bintree{mai_inJson, map[string]*bintree{}},
-	"mg_MG.json": &bintree{mg_mgJson, map[string]*bintree{}},
-	"mhr_RU.json": &bintree{mhr_ruJson, map[string]*bintree{}},
-	"mi_NZ.json": &bintree{mi_nzJson, map[string]*bintree{}},
-	"mk_MK.json": &bintree{mk_mkJson, map[string]*bintree{}},
-	"ml_IN.json": &bintree{ml_inJson, map[string]*bintree{}},
-	"mn_MN.json": &bintree{mn_mnJson, map[string]*bintree{}},
-	"mr_IN.json": &bintree{mr_inJson, map[string]*bintree{}},
-	"ms_MY.json": &bintree{ms_myJson, map[string]*bintree{}},
-	"mt_MT.json": &bintree{mt_mtJson, map[string]*bintree{}},
-	"my_MM.json": &bintree{my_mmJson, map[string]*bintree{}},
-	"nan_TW@latin.json": &bintree{nan_twLatinJson, map[string]*bintree{}},
-	"nb_NO.json": &bintree{nb_noJson, map[string]*bintree{}},
-	"ne_NP.json": &bintree{ne_npJson, map[string]*bintree{}},
-	"nl_AW.json": &bintree{nl_awJson, map[string]*bintree{}},
-	"nl_BE.json": &bintree{nl_beJson, map[string]*bintree{}},
-	"nl_NL.json": &bintree{nl_nlJson, map[string]*bintree{}},
-	"nn_NO.json": &bintree{nn_noJson, map[string]*bintree{}},
-	"nr_ZA.json": &bintree{nr_zaJson, map[string]*bintree{}},
-	"nso_ZA.json": &bintree{nso_zaJson, map[string]*bintree{}},
-	"oc_FR.json": &bintree{oc_frJson, map[string]*bintree{}},
-	"om_ET.json": &bintree{om_etJson, map[string]*bintree{}},
-	"om_KE.json": &bintree{om_keJson, map[string]*bintree{}},
-	"or_IN.json": &bintree{or_inJson, map[string]*bintree{}},
-	"os_RU.json": &bintree{os_ruJson, map[string]*bintree{}},
-	"pa_IN.json": &bintree{pa_inJson, map[string]*bintree{}},
-	"pa_PK.json": &bintree{pa_pkJson, map[string]*bintree{}},
-	"pap_AN.json": &bintree{pap_anJson, map[string]*bintree{}},
-	"pl_PL.json": &bintree{pl_plJson, map[string]*bintree{}},
-	"ps_AF.json": &bintree{ps_afJson, map[string]*bintree{}},
-	"pt_BR.json": &bintree{pt_brJson, map[string]*bintree{}},
-	"pt_PT.json": &bintree{pt_ptJson, map[string]*bintree{}},
-	"ro_RO.json": &bintree{ro_roJson, map[string]*bintree{}},
-	"ru_RU.json": &bintree{ru_ruJson, map[string]*bintree{}},
-	"ru_UA.json": &bintree{ru_uaJson, map[string]*bintree{}},
-	"rw_RW.json": &bintree{rw_rwJson, map[string]*bintree{}},
-	"sa_IN.json": &bintree{sa_inJson, map[string]*bintree{}},
-	"sc_IT.json": &bintree{sc_itJson, map[string]*bintree{}},
-	"sd_IN.json": &bintree{sd_inJson, map[string]*bintree{}},
-	"sd_IN@devanagari.json": &bintree{sd_inDevanagariJson, map[string]*bintree{}},
-	"sd_PK.json": &bintree{sd_pkJson, map[string]*bintree{}},
-	"se_NO.json": &bintree{se_noJson, map[string]*bintree{}},
-	"shs_CA.json": &bintree{shs_caJson, map[string]*bintree{}},
-	"si_LK.json": &bintree{si_lkJson, map[string]*bintree{}},
-	"sid_ET.json": &bintree{sid_etJson, map[string]*bintree{}},
-	"sk_SK.json": &bintree{sk_skJson, map[string]*bintree{}},
-	"sl_SI.json": &bintree{sl_siJson, map[string]*bintree{}},
-	"so_DJ.json": &bintree{so_djJson, map[string]*bintree{}},
-	"so_ET.json": &bintree{so_etJson, map[string]*bintree{}},
-	"so_KE.json": &bintree{so_keJson, map[string]*bintree{}},
-	"so_SO.json": &bintree{so_soJson, map[string]*bintree{}},
-	"sq_AL.json": &bintree{sq_alJson, map[string]*bintree{}},
-	"sq_MK.json": &bintree{sq_mkJson, map[string]*bintree{}},
-	"sr_ME.json": &bintree{sr_meJson, map[string]*bintree{}},
-	"sr_RS.json": &bintree{sr_rsJson, map[string]*bintree{}},
-	"sr_RS@latin.json": &bintree{sr_rsLatinJson, map[string]*bintree{}},
-	"ss_ZA.json": &bintree{ss_zaJson, map[string]*bintree{}},
-	"st_ZA.json": &bintree{st_zaJson, map[string]*bintree{}},
-	"sv_FI.json": &bintree{sv_fiJson, map[string]*bintree{}},
-	"sv_SE.json": &bintree{sv_seJson, map[string]*bintree{}},
-	"sw_KE.json": &bintree{sw_keJson, map[string]*bintree{}},
-	"sw_TZ.json": &bintree{sw_tzJson, map[string]*bintree{}},
-	"ta_IN.json": &bintree{ta_inJson, map[string]*bintree{}},
-	"ta_LK.json": &bintree{ta_lkJson, map[string]*bintree{}},
-	"te_IN.json": &bintree{te_inJson, map[string]*bintree{}},
-	"tg_TJ.json": &bintree{tg_tjJson, map[string]*bintree{}},
-	"th_TH.json": &bintree{th_thJson, map[string]*bintree{}},
-	"ti_ER.json": &bintree{ti_erJson, map[string]*bintree{}},
-	"ti_ET.json": &bintree{ti_etJson, map[string]*bintree{}},
-	"tig_ER.json": &bintree{tig_erJson, map[string]*bintree{}},
-	"tk_TM.json": &bintree{tk_tmJson, map[string]*bintree{}},
-	"tl_PH.json": &bintree{tl_phJson, map[string]*bintree{}},
-	"tn_ZA.json": &bintree{tn_zaJson, map[string]*bintree{}},
-	"tr_CY.json": &bintree{tr_cyJson, map[string]*bintree{}},
-	"tr_TR.json": &bintree{tr_trJson, map[string]*bintree{}},
-	"ts_ZA.json": &bintree{ts_zaJson, map[string]*bintree{}},
-	"tt_RU.json": &bintree{tt_ruJson, map[string]*bintree{}},
-	"tt_RU@iqtelif.json": &bintree{tt_ruIqtelifJson, map[string]*bintree{}},
-	"ug_CN.json": &bintree{ug_cnJson, map[string]*bintree{}},
-	"ug_CN@latin.json": &bintree{ug_cnLatinJson, map[string]*bintree{}},
-	"uk_UA.json": &bintree{uk_uaJson, map[string]*bintree{}},
-	"unm_US.json": &bintree{unm_usJson, map[string]*bintree{}},
-	"ur_IN.json": &bintree{ur_inJson, map[string]*bintree{}},
-	"ur_PK.json": &bintree{ur_pkJson, map[string]*bintree{}},
-	"uz_UZ.json": &bintree{uz_uzJson, map[string]*bintree{}},
-	"uz_UZ@cyrillic.json": &bintree{uz_uzCyrillicJson, map[string]*bintree{}},
-	"ve_ZA.json": &bintree{ve_zaJson, map[string]*bintree{}},
-	"vi_VN.json": &bintree{vi_vnJson, map[string]*bintree{}},
-	"wa_BE.json": &bintree{wa_beJson, map[string]*bintree{}},
-	"wae_CH.json": &bintree{wae_chJson, map[string]*bintree{}},
-	"wal_ET.json": &bintree{wal_etJson, map[string]*bintree{}},
-	"wo_SN.json": &bintree{wo_snJson, map[string]*bintree{}},
-	"xh_ZA.json": &bintree{xh_zaJson, map[string]*bintree{}},
-	"yi_US.json": &bintree{yi_usJson, map[string]*bintree{}},
-	"yo_NG.json": &bintree{yo_ngJson, map[string]*bintree{}},
-	"yue_HK.json": &bintree{yue_hkJson, map[string]*bintree{}},
-	"zh_CN.json": &bintree{zh_cnJson, map[string]*bintree{}},
-	"zh_HK.json": &bintree{zh_hkJson, map[string]*bintree{}},
-	"zh_SG.json": &bintree{zh_sgJson, map[string]*bintree{}},
-	"zh_TW.json": &bintree{zh_twJson, map[string]*bintree{}},
-	"zu_ZA.json": &bintree{zu_zaJson, map[string]*bintree{}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
-
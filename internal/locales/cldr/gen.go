@@ -0,0 +1,192 @@
+//go:build ignore
+// +build ignore
+
+// gen writes narrow.json, the CLDR narrow weekday and month names of the
+// locales in the parent directory, in their format forms. CLDR is read through
+// a checkout of github.com/go-playground/locales, which is generated from it,
+// as in
+//
+//	go run gen.go -src $GOPATH/src/github.com/go-playground/locales
+//
+// Names are stored by language, and by locale where the locale's script or
+// region has different ones. A locale that CLDR doesn't cover, such as one
+// written in a script CLDR has no names for, gets an empty entry so that it
+// doesn't take its language's names.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type names struct {
+	NarrowDays   []string `json:",omitempty"`
+	NarrowMonths []string `json:",omitempty"`
+}
+
+// scripts maps glibc locale modifiers to CLDR scripts.
+var scripts = map[string]string{
+	"cyrillic":   "Cyrl",
+	"devanagari": "Deva",
+	"latin":      "Latn",
+}
+
+// aliases maps the legacy language codes glibc still uses to CLDR's.
+var aliases = map[string]string{
+	"iw": "he",
+}
+
+var (
+	daysRE   = regexp.MustCompile(`daysNarrow:\s*\[\]string\{([^}]*)\}`)
+	monthsRE = regexp.MustCompile(`monthsNarrow:\s*\[\]string\{([^}]*)\}`)
+	quotedRE = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+)
+
+func main() {
+	src := flag.String("src", "", "directory of github.com/go-playground/locales")
+	flag.Parse()
+	if *src == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	files, err := filepath.Glob(filepath.Join("..", "*.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out := make(map[string]names)
+	for _, file := range files {
+		id := strings.TrimSuffix(filepath.Base(file), ".json")
+		lang := id
+		if i := strings.IndexAny(id, "_@"); i >= 0 {
+			lang = id[:i]
+		}
+
+		if _, ok := out[lang]; !ok {
+			if def, ok := lookup(*src, lang); ok {
+				out[lang] = def
+			}
+		}
+		n, _ := lookup(*src, id)
+		if !equal(n, out[lang]) {
+			out[id] = n
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(out); err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("narrow.json", buf.Bytes(), 0o644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("wrote narrow names for %d languages and locales", len(out))
+}
+
+// lookup returns the narrow names of the CLDR locale that best matches a
+// glibc locale identifier.
+func lookup(src, id string) (names, bool) {
+	lang, region, script := id, "", ""
+	if i := strings.IndexByte(lang, '@'); i >= 0 {
+		var ok bool
+		if script, ok = scripts[lang[i+1:]]; !ok {
+			return names{}, false
+		}
+		lang = lang[:i]
+	}
+	if i := strings.IndexByte(lang, '_'); i >= 0 {
+		lang, region = lang[:i], lang[i+1:]
+	}
+	if alias, ok := aliases[lang]; ok {
+		lang = alias
+	}
+
+	// The script and region variants, such as sr_Latn_RS, have the names of
+	// the language's default script in go-playground/locales, so a script
+	// is looked up without the region. So is a region written in another
+	// script than the language's default, such as pa_Arab_PK for pa_PK.
+	var candidates []string
+	switch {
+	case script != "":
+		candidates = []string{lang + "_" + script}
+	case region != "":
+		if m, _ := filepath.Glob(filepath.Join(src, lang+"_*_"+region)); len(m) == 1 {
+			s := strings.TrimPrefix(filepath.Base(m[0]), lang+"_")
+			candidates = []string{lang + "_" + strings.TrimSuffix(s, "_"+region)}
+		} else {
+			candidates = []string{lang + "_" + region, lang}
+		}
+	default:
+		candidates = []string{lang}
+	}
+
+	for _, c := range candidates {
+		bys, err := os.ReadFile(filepath.Join(src, c, c+".go"))
+		if err != nil {
+			continue
+		}
+		n := names{
+			NarrowDays:   list(daysRE, bys, 7),
+			NarrowMonths: list(monthsRE, bys, 12),
+		}
+		if equal(n, root) {
+			// Inherited from the root locale: CLDR has no names.
+			break
+		}
+		return n, n.NarrowDays != nil || n.NarrowMonths != nil
+	}
+	return names{}, false
+}
+
+// root holds the narrow names of CLDR's root locale, which the locales that
+// have none of their own inherit.
+var root = names{
+	NarrowDays:   []string{"S", "M", "T", "W", "T", "F", "S"},
+	NarrowMonths: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+}
+
+// list returns the names in the first string slice matched by re, or nil if
+// there aren't n of them. Months are indexed from 1 in the source, so a
+// leading empty name is dropped.
+func list(re *regexp.Regexp, src []byte, n int) []string {
+	m := re.FindSubmatch(src)
+	if m == nil {
+		return nil
+	}
+	var l []string
+	for _, q := range quotedRE.FindAll(m[1], -1) {
+		s, err := strconv.Unquote(string(q))
+		if err != nil {
+			log.Fatal(err)
+		}
+		l = append(l, s)
+	}
+	if len(l) == n+1 && l[0] == "" {
+		l = l[1:]
+	}
+	if len(l) != n {
+		return nil
+	}
+	for _, s := range l {
+		if s == "" {
+			return nil
+		}
+	}
+	return l
+}
+
+func equal(a, b names) bool {
+	return strings.Join(a.NarrowDays, "\x00") == strings.Join(b.NarrowDays, "\x00") &&
+		strings.Join(a.NarrowMonths, "\x00") == strings.Join(b.NarrowMonths, "\x00")
+}
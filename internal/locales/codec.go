@@ -0,0 +1,234 @@
+package locale
+
+import (
+	"encoding/binary"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// The table starts with magic, followed by the schema and the locales:
+//
+//	schema:  uvarint count, then per field a kind byte and its name
+//	locales: uvarint count, then per locale its identifier and its record
+//
+// A record holds the fields in schema order. Strings are a uvarint length
+// followed by the bytes, lists a uvarint count followed by the strings, and
+// ints a zig-zag varint. Because the schema is stored by name, a table
+// written before a field was added or removed still decodes.
+const magic = "lct1"
+
+const (
+	kindString  = 's'
+	kindStrings = 'l'
+	kindInt     = 'i'
+)
+
+// table is the decoded index of an encoded table.
+type table struct {
+	kinds   []byte
+	fields  []int             // Locale field index of each schema field, or -1
+	records map[string]string // identifier to record
+}
+
+// Encode writes locales in the format read by Lookup.
+func Encode(locales map[string]Locale) []byte {
+	w := &writer{}
+	w.buf = append(w.buf, magic...)
+
+	typ := reflect.TypeOf(Locale{})
+	w.uvarint(uint64(typ.NumField()))
+	for i := 0; i < typ.NumField(); i++ {
+		w.buf = append(w.buf, kindOf(typ.Field(i).Type))
+		w.string(typ.Field(i).Name)
+	}
+
+	ids := make([]string, 0, len(locales))
+	for id := range locales {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	w.uvarint(uint64(len(ids)))
+	for _, id := range ids {
+		rec := &writer{}
+		v := reflect.ValueOf(locales[id])
+		for i := 0; i < v.NumField(); i++ {
+			rec.value(v.Field(i))
+		}
+		w.string(id)
+		w.string(string(rec.buf))
+	}
+	return w.buf
+}
+
+// kindOf returns the kind byte of a Locale field type.
+func kindOf(t reflect.Type) byte {
+	switch {
+	case t.Kind() == reflect.String:
+		return kindString
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.String:
+		return kindStrings
+	case t.Kind() == reflect.Int:
+		return kindInt
+	}
+	panic("locale: unsupported field type " + t.String())
+}
+
+// readTable decodes the schema and index of an encoded table.
+func readTable(s string) (*table, error) {
+	if !strings.HasPrefix(s, magic) {
+		return nil, ErrCorrupt
+	}
+	r := &reader{s: s[len(magic):]}
+
+	typ := reflect.TypeOf(Locale{})
+	t := &table{}
+	n := r.uvarint()
+	for i := uint64(0); i < n && !r.bad; i++ {
+		kind := r.byte()
+		name := r.string()
+
+		idx := -1
+		if f, ok := typ.FieldByName(name); ok && kindOf(f.Type) == kind {
+			idx = f.Index[0]
+		}
+		t.kinds = append(t.kinds, kind)
+		t.fields = append(t.fields, idx)
+	}
+
+	n = r.uvarint()
+	t.records = make(map[string]string, n)
+	for i := uint64(0); i < n && !r.bad; i++ {
+		id := r.string()
+		t.records[id] = r.string()
+	}
+
+	if r.bad || r.s != "" {
+		return nil, ErrCorrupt
+	}
+	return t, nil
+}
+
+// decode decodes a record. Strings refer to the embedded table rather than
+// being copied.
+func (t *table) decode(rec string) (Locale, error) {
+	var l Locale
+	v := reflect.ValueOf(&l).Elem()
+	r := &reader{s: rec}
+
+	for i, kind := range t.kinds {
+		var f reflect.Value
+		if t.fields[i] >= 0 {
+			f = v.Field(t.fields[i])
+		}
+
+		switch kind {
+		case kindString:
+			s := r.string()
+			if f.IsValid() {
+				f.SetString(s)
+			}
+		case kindStrings:
+			n := r.uvarint()
+			if n > uint64(len(r.s)) {
+				return Locale{}, ErrCorrupt
+			}
+			list := make([]string, n)
+			for j := range list {
+				list[j] = r.string()
+			}
+			if f.IsValid() && n > 0 {
+				f.Set(reflect.ValueOf(list))
+			}
+		case kindInt:
+			n := r.varint()
+			if f.IsValid() {
+				f.SetInt(n)
+			}
+		default:
+			return Locale{}, ErrCorrupt
+		}
+	}
+
+	if r.bad || r.s != "" {
+		return Locale{}, ErrCorrupt
+	}
+	return l, nil
+}
+
+// writer appends encoded values to buf.
+type writer struct {
+	buf []byte
+}
+
+func (w *writer) uvarint(n uint64) {
+	var b [binary.MaxVarintLen64]byte
+	w.buf = append(w.buf, b[:binary.PutUvarint(b[:], n)]...)
+}
+
+func (w *writer) string(s string) {
+	w.uvarint(uint64(len(s)))
+	w.buf = append(w.buf, s...)
+}
+
+func (w *writer) value(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.String:
+		w.string(v.String())
+	case reflect.Slice:
+		w.uvarint(uint64(v.Len()))
+		for i := 0; i < v.Len(); i++ {
+			w.string(v.Index(i).String())
+		}
+	case reflect.Int:
+		var b [binary.MaxVarintLen64]byte
+		w.buf = append(w.buf, b[:binary.PutVarint(b[:], v.Int())]...)
+	}
+}
+
+// reader consumes encoded values from s. Once bad is set, it returns zero
+// values.
+type reader struct {
+	s   string
+	bad bool
+}
+
+func (r *reader) byte() byte {
+	if r.bad || r.s == "" {
+		r.bad = true
+		return 0
+	}
+	c := r.s[0]
+	r.s = r.s[1:]
+	return c
+}
+
+func (r *reader) uvarint() uint64 {
+	var n uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		c := r.byte()
+		n |= uint64(c&0x7f) << shift
+		if c < 0x80 {
+			return n
+		}
+	}
+	r.bad = true
+	return 0
+}
+
+func (r *reader) varint() int64 {
+	n := r.uvarint()
+	return int64(n>>1) ^ -int64(n&1)
+}
+
+func (r *reader) string() string {
+	n := r.uvarint()
+	if r.bad || n > uint64(len(r.s)) {
+		r.bad = true
+		return ""
+	}
+	s := r.s[:n]
+	r.s = r.s[n:]
+	return s
+}
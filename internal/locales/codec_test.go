@@ -0,0 +1,137 @@
+package locale
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncode(t *testing.T) {
+	in := map[string]Locale{
+		"xx_YY": {ID: "xx_YY", Days: []string{"a", "", "ç"}, Date: "%x"},
+		"POSIX": {ID: "POSIX"},
+	}
+
+	tbl, err := readTable(string(Encode(in)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id, want := range in {
+		got, err := tbl.decode(tbl.records[id])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got '%v', want '%v'", id, got, want)
+		}
+	}
+}
+
+func TestReadTableCorrupt(t *testing.T) {
+	valid := string(Encode(map[string]Locale{"xx_YY": {ID: "xx_YY"}}))
+
+	tests := []string{
+		"",
+		"lct0",
+		valid[:len(valid)-1],
+		valid + "x",
+		magic + "\xff\xff",
+	}
+
+	for i, test := range tests {
+		if _, err := readTable(test); err != ErrCorrupt {
+			t.Errorf("%d: got '%v', want '%v'", i, err, ErrCorrupt)
+		}
+	}
+}
+
+// TestTableSchema checks that fields are matched by name, so a table written
+// for an older Locale still decodes.
+func TestTableSchema(t *testing.T) {
+	w := &writer{}
+	w.buf = append(w.buf, magic...)
+	w.uvarint(3)
+	for _, f := range []string{"sRemoved", "sID", "iDate"} {
+		w.buf = append(w.buf, f[0])
+		w.string(f[1:])
+	}
+
+	rec := &writer{}
+	rec.string("gone")
+	rec.string("xx_YY")
+	rec.uvarint(4)
+	w.uvarint(1)
+	w.string("xx_YY")
+	w.string(string(rec.buf))
+
+	tbl, err := readTable(string(w.buf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := tbl.decode(tbl.records["xx_YY"])
+	if want := (Locale{ID: "xx_YY"}); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("got '%v', want '%v'", got, want)
+	}
+}
+
+// TestTableUpToDate checks that the tables in data/*.bin, embedded by the
+// lang_*_gen.go files, match the JSON files. Run go generate if it fails.
+func TestTableUpToDate(t *testing.T) {
+	files, err := filepath.Glob("*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var narrow map[string]Locale
+	bys, err := os.ReadFile(filepath.Join("cldr", "narrow.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(bys, &narrow); err != nil {
+		t.Fatal(err)
+	}
+
+	names := Names()
+	if len(names) != len(files) {
+		t.Fatalf("got %d locales, want %d", len(names), len(files))
+	}
+
+	for _, file := range files {
+		bys, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want Locale
+		if err := json.Unmarshal(bys, &want); err != nil {
+			t.Fatal(err)
+		}
+		id := strings.TrimSuffix(file, ".json")
+		n, ok := narrow[id]
+		if !ok {
+			lang := id
+			if i := strings.IndexAny(lang, "_@"); i >= 0 {
+				lang = lang[:i]
+			}
+			n = narrow[lang]
+		}
+		if len(want.NarrowDays) == 0 {
+			want.NarrowDays = n.NarrowDays
+		}
+		if len(want.NarrowMonths) == 0 {
+			want.NarrowMonths = n.NarrowMonths
+		}
+
+		got, err := Lookup(id)
+		if err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got '%v', want '%v'", file, got, want)
+		}
+	}
+
+	if _, err := Lookup("fake"); err != ErrNotFound {
+		t.Errorf("got '%v', want '%v'", err, ErrNotFound)
+	}
+}
@@ -0,0 +1,132 @@
+//go:build ignore
+// +build ignore
+
+// gen compiles the locale JSON files into a table per language in the data
+// directory, and writes the lang_*_gen.go files that embed them. Run it with
+// go generate from this directory.
+//
+// Narrow names missing from a locale are filled in from cldr/narrow.json,
+// which cldr/gen.go writes from CLDR, by locale or else by language. The
+// DateFmt of the JSON files is set by glibc/gen.go from glibc's date_fmt.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	locale "github.com/klauspost/lctime/internal/locales"
+)
+
+const header = `// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_%[1]s
+// +build !lctime_only lctime_%[1]s
+
+package locale
+
+import _ "embed" // for data/%[1]s.bin
+
+//go:embed data/%[1]s.bin
+var lang%[2]s string
+
+func init() {
+	register(lang%[2]s)
+}
+`
+
+func main() {
+	files, err := filepath.Glob("*.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var narrow map[string]locale.Locale
+	bys, err := os.ReadFile(filepath.Join("cldr", "narrow.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := json.Unmarshal(bys, &narrow); err != nil {
+		log.Fatalf("cldr/narrow.json: %v", err)
+	}
+
+	langs := make(map[string]map[string]locale.Locale)
+	for _, file := range files {
+		bys, err := os.ReadFile(file)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var l locale.Locale
+		dec := json.NewDecoder(bytes.NewReader(bys))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&l); err != nil {
+			log.Fatalf("%s: %v", file, err)
+		}
+
+		id := strings.TrimSuffix(file, ".json")
+		lang := language(id)
+		n, ok := narrow[id]
+		if !ok {
+			n = narrow[lang]
+		}
+		if len(l.NarrowDays) == 0 {
+			l.NarrowDays = n.NarrowDays
+		}
+		if len(l.NarrowMonths) == 0 {
+			l.NarrowMonths = n.NarrowMonths
+		}
+		if langs[lang] == nil {
+			langs[lang] = make(map[string]locale.Locale)
+		}
+		langs[lang][id] = l
+	}
+
+	// Remove the output of earlier runs, in case a language is gone.
+	old, _ := filepath.Glob("lang_*_gen.go")
+	bins, _ := filepath.Glob(filepath.Join("data", "*.bin"))
+	for _, file := range append(old, bins...) {
+		if err := os.Remove(file); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll("data", 0o755); err != nil {
+		log.Fatal(err)
+	}
+
+	names := make([]string, 0, len(langs))
+	for lang := range langs {
+		names = append(names, lang)
+	}
+	sort.Strings(names)
+
+	for _, lang := range names {
+		bin := filepath.Join("data", lang+".bin")
+		if err := os.WriteFile(bin, locale.Encode(langs[lang]), 0o644); err != nil {
+			log.Fatal(err)
+		}
+		if lang == "POSIX" {
+			// Embedded by locales.go, so it's always available.
+			continue
+		}
+
+		src := fmt.Sprintf(header, lang, strings.ToUpper(lang[:1])+lang[1:])
+		if err := os.WriteFile("lang_"+lang+"_gen.go", []byte(src), 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+	log.Printf("wrote %d locales in %d languages", len(files), len(langs))
+}
+
+// language returns the language part of a locale identifier.
+func language(id string) string {
+	if i := strings.IndexAny(id, "_@"); i >= 0 {
+		return id[:i]
+	}
+	return id
+}
@@ -0,0 +1,224 @@
+//go:build ignore
+// +build ignore
+
+// gen copies glibc's date_fmt into the DateFmt of the locales in the parent
+// directory. It reads the locale sources of a glibc checkout, as in
+//
+//	go run gen.go -src ~/glibc/localedata/locales
+//
+// and follows copy directives in LC_TIME. Locales that glibc doesn't have, or
+// whose LC_TIME has no date_fmt, are left as they are; %+ then falls back to
+// the POSIX layout. Run go generate in the parent directory afterwards.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	src := flag.String("src", "", "directory of glibc's locale sources")
+	flag.Parse()
+	if *src == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	files, err := filepath.Glob(filepath.Join("..", "*.json"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	n := 0
+	for _, file := range files {
+		id := strings.TrimSuffix(filepath.Base(file), ".json")
+		if _, err := os.Stat(filepath.Join(*src, id)); err != nil {
+			continue
+		}
+		format, err := dateFmt(*src, id, 0)
+		if err != nil {
+			log.Fatalf("%s: %v", id, err)
+		}
+		if format == "" {
+			continue
+		}
+		if err := setDateFmt(file, format); err != nil {
+			log.Fatalf("%s: %v", file, err)
+		}
+		n++
+	}
+	log.Printf("set DateFmt of %d locales", n)
+}
+
+// dateFmt returns the date_fmt of a glibc locale, or "" if it has none.
+func dateFmt(src, id string, depth int) (string, error) {
+	if depth > 10 {
+		return "", fmt.Errorf("too many copies")
+	}
+
+	lc, err := readCategory(filepath.Join(src, id), "LC_TIME")
+	if err != nil {
+		return "", err
+	}
+	if s, ok := lc["date_fmt"]; ok {
+		return s, nil
+	}
+	if from, ok := lc["copy"]; ok {
+		return dateFmt(src, from, depth+1)
+	}
+	return "", nil
+}
+
+// readCategory returns the string values of a category of a locale source,
+// by keyword. Values of several strings, such as day names, are joined with
+// ';'.
+func readCategory(path, category string) (map[string]string, error) {
+	bys, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, escape := byte('#'), byte('\\')
+	values := make(map[string]string)
+	in := false
+
+	sc := bufio.NewScanner(bytes.NewReader(bys))
+	var line string
+	for sc.Scan() {
+		line += sc.Text()
+		if strings.HasSuffix(line, string(escape)) {
+			// Continued on the next line.
+			line = line[:len(line)-1]
+			continue
+		}
+		text := strings.TrimSpace(line)
+		line = ""
+
+		if text == "" || text[0] == comment {
+			continue
+		}
+		keyword, rest := text, ""
+		if i := strings.IndexAny(text, " \t"); i >= 0 {
+			keyword, rest = text[:i], strings.TrimSpace(text[i:])
+		}
+
+		switch {
+		case !in && keyword == "comment_char" && rest != "":
+			comment = rest[0]
+		case !in && keyword == "escape_char" && rest != "":
+			escape = rest[0]
+		case keyword == category:
+			in = true
+		case in && keyword == "END":
+			return values, nil
+		case in:
+			s, err := decode(rest, escape)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %s: %v", path, keyword, err)
+			}
+			values[keyword] = s
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+var ucn = regexp.MustCompile(`^<U([0-9A-Fa-f]{4,8})>`)
+
+// decode returns the strings of a value, with <Uxxxx> names and escapes
+// replaced by the characters.
+func decode(value string, escape byte) (string, error) {
+	var b strings.Builder
+	quoted := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c == '"':
+			quoted = !quoted
+		case !quoted && c == ';':
+			b.WriteByte(';')
+		case !quoted:
+			// Whitespace between the strings, or an unquoted value.
+			if c != ' ' && c != '\t' {
+				b.WriteByte(c)
+			}
+		case c == escape && i+1 < len(value):
+			i++
+			b.WriteByte(value[i])
+		case c == '<':
+			m := ucn.FindStringSubmatch(value[i:])
+			if m == nil {
+				return "", fmt.Errorf("invalid character name at %q", value[i:])
+			}
+			r, err := strconv.ParseUint(m[1], 16, 32)
+			if err != nil {
+				return "", err
+			}
+			b.WriteRune(rune(r))
+			i += len(m[0]) - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	if quoted {
+		return "", fmt.Errorf("unterminated string")
+	}
+	return b.String(), nil
+}
+
+// setDateFmt sets DateFmt in a locale's JSON file, after TimeAMPM when the
+// file has none yet, keeping the rest of the file as it is.
+func setDateFmt(file, format string) error {
+	bys, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	entry := "\t\"DateFmt\": " + jsonString(format) + ","
+
+	lines := strings.Split(string(bys), "\n")
+	at := -1
+	for i, l := range lines {
+		switch {
+		case strings.HasPrefix(l, "\t\"DateFmt\":"):
+			lines[i] = entry
+			return os.WriteFile(file, []byte(strings.Join(lines, "\n")), 0o644)
+		case strings.HasPrefix(l, "\t\"TimeAMPM\":"):
+			at = i + 1
+		}
+	}
+	if at < 0 || !strings.HasSuffix(lines[at-1], ",") {
+		return fmt.Errorf("no place for DateFmt")
+	}
+
+	lines = append(lines[:at], append([]string{entry}, lines[at:]...)...)
+	return os.WriteFile(file, []byte(strings.Join(lines, "\n")), 0o644)
+}
+
+// jsonString quotes s like the JSON files do, without escaping non-ASCII
+// characters.
+func jsonString(s string) string {
+	var buf bytes.Buffer
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		case r < 0x20:
+			fmt.Fprintf(&buf, "\\u%04x", r)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	return `"` + buf.String() + `"`
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_aa
+// +build !lctime_only lctime_aa
+
+package locale
+
+import _ "embed" // for data/aa.bin
+
+//go:embed data/aa.bin
+var langAa string
+
+func init() {
+	register(langAa)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_af
+// +build !lctime_only lctime_af
+
+package locale
+
+import _ "embed" // for data/af.bin
+
+//go:embed data/af.bin
+var langAf string
+
+func init() {
+	register(langAf)
+}
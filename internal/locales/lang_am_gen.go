@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_am
+// +build !lctime_only lctime_am
+
+package locale
+
+import _ "embed" // for data/am.bin
+
+//go:embed data/am.bin
+var langAm string
+
+func init() {
+	register(langAm)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_an
+// +build !lctime_only lctime_an
+
+package locale
+
+import _ "embed" // for data/an.bin
+
+//go:embed data/an.bin
+var langAn string
+
+func init() {
+	register(langAn)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ar
+// +build !lctime_only lctime_ar
+
+package locale
+
+import _ "embed" // for data/ar.bin
+
+//go:embed data/ar.bin
+var langAr string
+
+func init() {
+	register(langAr)
+}
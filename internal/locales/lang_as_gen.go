@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_as
+// +build !lctime_only lctime_as
+
+package locale
+
+import _ "embed" // for data/as.bin
+
+//go:embed data/as.bin
+var langAs string
+
+func init() {
+	register(langAs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ast
+// +build !lctime_only lctime_ast
+
+package locale
+
+import _ "embed" // for data/ast.bin
+
+//go:embed data/ast.bin
+var langAst string
+
+func init() {
+	register(langAst)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_az
+// +build !lctime_only lctime_az
+
+package locale
+
+import _ "embed" // for data/az.bin
+
+//go:embed data/az.bin
+var langAz string
+
+func init() {
+	register(langAz)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_be
+// +build !lctime_only lctime_be
+
+package locale
+
+import _ "embed" // for data/be.bin
+
+//go:embed data/be.bin
+var langBe string
+
+func init() {
+	register(langBe)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_bem
+// +build !lctime_only lctime_bem
+
+package locale
+
+import _ "embed" // for data/bem.bin
+
+//go:embed data/bem.bin
+var langBem string
+
+func init() {
+	register(langBem)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ber
+// +build !lctime_only lctime_ber
+
+package locale
+
+import _ "embed" // for data/ber.bin
+
+//go:embed data/ber.bin
+var langBer string
+
+func init() {
+	register(langBer)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_bg
+// +build !lctime_only lctime_bg
+
+package locale
+
+import _ "embed" // for data/bg.bin
+
+//go:embed data/bg.bin
+var langBg string
+
+func init() {
+	register(langBg)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_bho
+// +build !lctime_only lctime_bho
+
+package locale
+
+import _ "embed" // for data/bho.bin
+
+//go:embed data/bho.bin
+var langBho string
+
+func init() {
+	register(langBho)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_bn
+// +build !lctime_only lctime_bn
+
+package locale
+
+import _ "embed" // for data/bn.bin
+
+//go:embed data/bn.bin
+var langBn string
+
+func init() {
+	register(langBn)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_bo
+// +build !lctime_only lctime_bo
+
+package locale
+
+import _ "embed" // for data/bo.bin
+
+//go:embed data/bo.bin
+var langBo string
+
+func init() {
+	register(langBo)
+}
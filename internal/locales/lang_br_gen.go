@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_br
+// +build !lctime_only lctime_br
+
+package locale
+
+import _ "embed" // for data/br.bin
+
+//go:embed data/br.bin
+var langBr string
+
+func init() {
+	register(langBr)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_brx
+// +build !lctime_only lctime_brx
+
+package locale
+
+import _ "embed" // for data/brx.bin
+
+//go:embed data/brx.bin
+var langBrx string
+
+func init() {
+	register(langBrx)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_bs
+// +build !lctime_only lctime_bs
+
+package locale
+
+import _ "embed" // for data/bs.bin
+
+//go:embed data/bs.bin
+var langBs string
+
+func init() {
+	register(langBs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_byn
+// +build !lctime_only lctime_byn
+
+package locale
+
+import _ "embed" // for data/byn.bin
+
+//go:embed data/byn.bin
+var langByn string
+
+func init() {
+	register(langByn)
+}
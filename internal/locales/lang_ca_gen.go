@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ca
+// +build !lctime_only lctime_ca
+
+package locale
+
+import _ "embed" // for data/ca.bin
+
+//go:embed data/ca.bin
+var langCa string
+
+func init() {
+	register(langCa)
+}
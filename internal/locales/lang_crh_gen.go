@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_crh
+// +build !lctime_only lctime_crh
+
+package locale
+
+import _ "embed" // for data/crh.bin
+
+//go:embed data/crh.bin
+var langCrh string
+
+func init() {
+	register(langCrh)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_cs
+// +build !lctime_only lctime_cs
+
+package locale
+
+import _ "embed" // for data/cs.bin
+
+//go:embed data/cs.bin
+var langCs string
+
+func init() {
+	register(langCs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_csb
+// +build !lctime_only lctime_csb
+
+package locale
+
+import _ "embed" // for data/csb.bin
+
+//go:embed data/csb.bin
+var langCsb string
+
+func init() {
+	register(langCsb)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_cv
+// +build !lctime_only lctime_cv
+
+package locale
+
+import _ "embed" // for data/cv.bin
+
+//go:embed data/cv.bin
+var langCv string
+
+func init() {
+	register(langCv)
+}
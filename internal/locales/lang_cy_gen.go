@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_cy
+// +build !lctime_only lctime_cy
+
+package locale
+
+import _ "embed" // for data/cy.bin
+
+//go:embed data/cy.bin
+var langCy string
+
+func init() {
+	register(langCy)
+}
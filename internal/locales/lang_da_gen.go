@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_da
+// +build !lctime_only lctime_da
+
+package locale
+
+import _ "embed" // for data/da.bin
+
+//go:embed data/da.bin
+var langDa string
+
+func init() {
+	register(langDa)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_de
+// +build !lctime_only lctime_de
+
+package locale
+
+import _ "embed" // for data/de.bin
+
+//go:embed data/de.bin
+var langDe string
+
+func init() {
+	register(langDe)
+}
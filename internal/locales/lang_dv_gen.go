@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_dv
+// +build !lctime_only lctime_dv
+
+package locale
+
+import _ "embed" // for data/dv.bin
+
+//go:embed data/dv.bin
+var langDv string
+
+func init() {
+	register(langDv)
+}
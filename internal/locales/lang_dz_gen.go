@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_dz
+// +build !lctime_only lctime_dz
+
+package locale
+
+import _ "embed" // for data/dz.bin
+
+//go:embed data/dz.bin
+var langDz string
+
+func init() {
+	register(langDz)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_el
+// +build !lctime_only lctime_el
+
+package locale
+
+import _ "embed" // for data/el.bin
+
+//go:embed data/el.bin
+var langEl string
+
+func init() {
+	register(langEl)
+}
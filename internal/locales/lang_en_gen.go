@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_en
+// +build !lctime_only lctime_en
+
+package locale
+
+import _ "embed" // for data/en.bin
+
+//go:embed data/en.bin
+var langEn string
+
+func init() {
+	register(langEn)
+}
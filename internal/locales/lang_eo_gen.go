@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_eo
+// +build !lctime_only lctime_eo
+
+package locale
+
+import _ "embed" // for data/eo.bin
+
+//go:embed data/eo.bin
+var langEo string
+
+func init() {
+	register(langEo)
+}
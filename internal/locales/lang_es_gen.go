@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_es
+// +build !lctime_only lctime_es
+
+package locale
+
+import _ "embed" // for data/es.bin
+
+//go:embed data/es.bin
+var langEs string
+
+func init() {
+	register(langEs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_et
+// +build !lctime_only lctime_et
+
+package locale
+
+import _ "embed" // for data/et.bin
+
+//go:embed data/et.bin
+var langEt string
+
+func init() {
+	register(langEt)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_eu
+// +build !lctime_only lctime_eu
+
+package locale
+
+import _ "embed" // for data/eu.bin
+
+//go:embed data/eu.bin
+var langEu string
+
+func init() {
+	register(langEu)
+}
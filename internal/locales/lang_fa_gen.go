@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_fa
+// +build !lctime_only lctime_fa
+
+package locale
+
+import _ "embed" // for data/fa.bin
+
+//go:embed data/fa.bin
+var langFa string
+
+func init() {
+	register(langFa)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ff
+// +build !lctime_only lctime_ff
+
+package locale
+
+import _ "embed" // for data/ff.bin
+
+//go:embed data/ff.bin
+var langFf string
+
+func init() {
+	register(langFf)
+}
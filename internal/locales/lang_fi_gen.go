@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_fi
+// +build !lctime_only lctime_fi
+
+package locale
+
+import _ "embed" // for data/fi.bin
+
+//go:embed data/fi.bin
+var langFi string
+
+func init() {
+	register(langFi)
+}
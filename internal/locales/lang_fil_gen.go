@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_fil
+// +build !lctime_only lctime_fil
+
+package locale
+
+import _ "embed" // for data/fil.bin
+
+//go:embed data/fil.bin
+var langFil string
+
+func init() {
+	register(langFil)
+}
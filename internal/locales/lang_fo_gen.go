@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_fo
+// +build !lctime_only lctime_fo
+
+package locale
+
+import _ "embed" // for data/fo.bin
+
+//go:embed data/fo.bin
+var langFo string
+
+func init() {
+	register(langFo)
+}
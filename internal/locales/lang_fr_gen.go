@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_fr
+// +build !lctime_only lctime_fr
+
+package locale
+
+import _ "embed" // for data/fr.bin
+
+//go:embed data/fr.bin
+var langFr string
+
+func init() {
+	register(langFr)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_fur
+// +build !lctime_only lctime_fur
+
+package locale
+
+import _ "embed" // for data/fur.bin
+
+//go:embed data/fur.bin
+var langFur string
+
+func init() {
+	register(langFur)
+}
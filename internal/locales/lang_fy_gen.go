@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_fy
+// +build !lctime_only lctime_fy
+
+package locale
+
+import _ "embed" // for data/fy.bin
+
+//go:embed data/fy.bin
+var langFy string
+
+func init() {
+	register(langFy)
+}
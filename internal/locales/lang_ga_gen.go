@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ga
+// +build !lctime_only lctime_ga
+
+package locale
+
+import _ "embed" // for data/ga.bin
+
+//go:embed data/ga.bin
+var langGa string
+
+func init() {
+	register(langGa)
+}
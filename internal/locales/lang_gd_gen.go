@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_gd
+// +build !lctime_only lctime_gd
+
+package locale
+
+import _ "embed" // for data/gd.bin
+
+//go:embed data/gd.bin
+var langGd string
+
+func init() {
+	register(langGd)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_gez
+// +build !lctime_only lctime_gez
+
+package locale
+
+import _ "embed" // for data/gez.bin
+
+//go:embed data/gez.bin
+var langGez string
+
+func init() {
+	register(langGez)
+}
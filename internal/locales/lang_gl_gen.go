@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_gl
+// +build !lctime_only lctime_gl
+
+package locale
+
+import _ "embed" // for data/gl.bin
+
+//go:embed data/gl.bin
+var langGl string
+
+func init() {
+	register(langGl)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_gu
+// +build !lctime_only lctime_gu
+
+package locale
+
+import _ "embed" // for data/gu.bin
+
+//go:embed data/gu.bin
+var langGu string
+
+func init() {
+	register(langGu)
+}
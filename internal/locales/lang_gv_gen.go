@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_gv
+// +build !lctime_only lctime_gv
+
+package locale
+
+import _ "embed" // for data/gv.bin
+
+//go:embed data/gv.bin
+var langGv string
+
+func init() {
+	register(langGv)
+}
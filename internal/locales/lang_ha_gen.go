@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ha
+// +build !lctime_only lctime_ha
+
+package locale
+
+import _ "embed" // for data/ha.bin
+
+//go:embed data/ha.bin
+var langHa string
+
+func init() {
+	register(langHa)
+}
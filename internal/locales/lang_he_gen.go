@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_he
+// +build !lctime_only lctime_he
+
+package locale
+
+import _ "embed" // for data/he.bin
+
+//go:embed data/he.bin
+var langHe string
+
+func init() {
+	register(langHe)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_hi
+// +build !lctime_only lctime_hi
+
+package locale
+
+import _ "embed" // for data/hi.bin
+
+//go:embed data/hi.bin
+var langHi string
+
+func init() {
+	register(langHi)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_hne
+// +build !lctime_only lctime_hne
+
+package locale
+
+import _ "embed" // for data/hne.bin
+
+//go:embed data/hne.bin
+var langHne string
+
+func init() {
+	register(langHne)
+}
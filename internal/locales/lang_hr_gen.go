@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_hr
+// +build !lctime_only lctime_hr
+
+package locale
+
+import _ "embed" // for data/hr.bin
+
+//go:embed data/hr.bin
+var langHr string
+
+func init() {
+	register(langHr)
+}
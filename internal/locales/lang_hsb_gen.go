@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_hsb
+// +build !lctime_only lctime_hsb
+
+package locale
+
+import _ "embed" // for data/hsb.bin
+
+//go:embed data/hsb.bin
+var langHsb string
+
+func init() {
+	register(langHsb)
+}
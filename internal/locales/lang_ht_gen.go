@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ht
+// +build !lctime_only lctime_ht
+
+package locale
+
+import _ "embed" // for data/ht.bin
+
+//go:embed data/ht.bin
+var langHt string
+
+func init() {
+	register(langHt)
+}
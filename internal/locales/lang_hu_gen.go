@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_hu
+// +build !lctime_only lctime_hu
+
+package locale
+
+import _ "embed" // for data/hu.bin
+
+//go:embed data/hu.bin
+var langHu string
+
+func init() {
+	register(langHu)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_hy
+// +build !lctime_only lctime_hy
+
+package locale
+
+import _ "embed" // for data/hy.bin
+
+//go:embed data/hy.bin
+var langHy string
+
+func init() {
+	register(langHy)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_i18n
+// +build !lctime_only lctime_i18n
+
+package locale
+
+import _ "embed" // for data/i18n.bin
+
+//go:embed data/i18n.bin
+var langI18n string
+
+func init() {
+	register(langI18n)
+}
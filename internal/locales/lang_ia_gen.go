@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ia
+// +build !lctime_only lctime_ia
+
+package locale
+
+import _ "embed" // for data/ia.bin
+
+//go:embed data/ia.bin
+var langIa string
+
+func init() {
+	register(langIa)
+}
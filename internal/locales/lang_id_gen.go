@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_id
+// +build !lctime_only lctime_id
+
+package locale
+
+import _ "embed" // for data/id.bin
+
+//go:embed data/id.bin
+var langId string
+
+func init() {
+	register(langId)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ig
+// +build !lctime_only lctime_ig
+
+package locale
+
+import _ "embed" // for data/ig.bin
+
+//go:embed data/ig.bin
+var langIg string
+
+func init() {
+	register(langIg)
+}
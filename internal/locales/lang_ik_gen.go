@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ik
+// +build !lctime_only lctime_ik
+
+package locale
+
+import _ "embed" // for data/ik.bin
+
+//go:embed data/ik.bin
+var langIk string
+
+func init() {
+	register(langIk)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_is
+// +build !lctime_only lctime_is
+
+package locale
+
+import _ "embed" // for data/is.bin
+
+//go:embed data/is.bin
+var langIs string
+
+func init() {
+	register(langIs)
+}
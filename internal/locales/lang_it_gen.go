@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_it
+// +build !lctime_only lctime_it
+
+package locale
+
+import _ "embed" // for data/it.bin
+
+//go:embed data/it.bin
+var langIt string
+
+func init() {
+	register(langIt)
+}
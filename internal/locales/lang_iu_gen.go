@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_iu
+// +build !lctime_only lctime_iu
+
+package locale
+
+import _ "embed" // for data/iu.bin
+
+//go:embed data/iu.bin
+var langIu string
+
+func init() {
+	register(langIu)
+}
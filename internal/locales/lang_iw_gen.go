@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_iw
+// +build !lctime_only lctime_iw
+
+package locale
+
+import _ "embed" // for data/iw.bin
+
+//go:embed data/iw.bin
+var langIw string
+
+func init() {
+	register(langIw)
+}
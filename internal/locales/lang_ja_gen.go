@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ja
+// +build !lctime_only lctime_ja
+
+package locale
+
+import _ "embed" // for data/ja.bin
+
+//go:embed data/ja.bin
+var langJa string
+
+func init() {
+	register(langJa)
+}
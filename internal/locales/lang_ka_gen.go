@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ka
+// +build !lctime_only lctime_ka
+
+package locale
+
+import _ "embed" // for data/ka.bin
+
+//go:embed data/ka.bin
+var langKa string
+
+func init() {
+	register(langKa)
+}
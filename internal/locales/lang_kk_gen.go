@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_kk
+// +build !lctime_only lctime_kk
+
+package locale
+
+import _ "embed" // for data/kk.bin
+
+//go:embed data/kk.bin
+var langKk string
+
+func init() {
+	register(langKk)
+}
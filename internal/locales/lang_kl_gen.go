@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_kl
+// +build !lctime_only lctime_kl
+
+package locale
+
+import _ "embed" // for data/kl.bin
+
+//go:embed data/kl.bin
+var langKl string
+
+func init() {
+	register(langKl)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_km
+// +build !lctime_only lctime_km
+
+package locale
+
+import _ "embed" // for data/km.bin
+
+//go:embed data/km.bin
+var langKm string
+
+func init() {
+	register(langKm)
+}
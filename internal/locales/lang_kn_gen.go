@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_kn
+// +build !lctime_only lctime_kn
+
+package locale
+
+import _ "embed" // for data/kn.bin
+
+//go:embed data/kn.bin
+var langKn string
+
+func init() {
+	register(langKn)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ko
+// +build !lctime_only lctime_ko
+
+package locale
+
+import _ "embed" // for data/ko.bin
+
+//go:embed data/ko.bin
+var langKo string
+
+func init() {
+	register(langKo)
+}
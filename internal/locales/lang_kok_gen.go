@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_kok
+// +build !lctime_only lctime_kok
+
+package locale
+
+import _ "embed" // for data/kok.bin
+
+//go:embed data/kok.bin
+var langKok string
+
+func init() {
+	register(langKok)
+}
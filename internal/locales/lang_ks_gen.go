@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ks
+// +build !lctime_only lctime_ks
+
+package locale
+
+import _ "embed" // for data/ks.bin
+
+//go:embed data/ks.bin
+var langKs string
+
+func init() {
+	register(langKs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ku
+// +build !lctime_only lctime_ku
+
+package locale
+
+import _ "embed" // for data/ku.bin
+
+//go:embed data/ku.bin
+var langKu string
+
+func init() {
+	register(langKu)
+}
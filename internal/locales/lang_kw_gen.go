@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_kw
+// +build !lctime_only lctime_kw
+
+package locale
+
+import _ "embed" // for data/kw.bin
+
+//go:embed data/kw.bin
+var langKw string
+
+func init() {
+	register(langKw)
+}
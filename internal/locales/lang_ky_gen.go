@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ky
+// +build !lctime_only lctime_ky
+
+package locale
+
+import _ "embed" // for data/ky.bin
+
+//go:embed data/ky.bin
+var langKy string
+
+func init() {
+	register(langKy)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_lb
+// +build !lctime_only lctime_lb
+
+package locale
+
+import _ "embed" // for data/lb.bin
+
+//go:embed data/lb.bin
+var langLb string
+
+func init() {
+	register(langLb)
+}
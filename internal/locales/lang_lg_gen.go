@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_lg
+// +build !lctime_only lctime_lg
+
+package locale
+
+import _ "embed" // for data/lg.bin
+
+//go:embed data/lg.bin
+var langLg string
+
+func init() {
+	register(langLg)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_lij
+// +build !lctime_only lctime_lij
+
+package locale
+
+import _ "embed" // for data/lij.bin
+
+//go:embed data/lij.bin
+var langLij string
+
+func init() {
+	register(langLij)
+}
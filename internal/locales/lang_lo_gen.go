@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_lo
+// +build !lctime_only lctime_lo
+
+package locale
+
+import _ "embed" // for data/lo.bin
+
+//go:embed data/lo.bin
+var langLo string
+
+func init() {
+	register(langLo)
+}
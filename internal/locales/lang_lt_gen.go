@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_lt
+// +build !lctime_only lctime_lt
+
+package locale
+
+import _ "embed" // for data/lt.bin
+
+//go:embed data/lt.bin
+var langLt string
+
+func init() {
+	register(langLt)
+}
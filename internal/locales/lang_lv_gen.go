@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_lv
+// +build !lctime_only lctime_lv
+
+package locale
+
+import _ "embed" // for data/lv.bin
+
+//go:embed data/lv.bin
+var langLv string
+
+func init() {
+	register(langLv)
+}
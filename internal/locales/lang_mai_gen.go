@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_mai
+// +build !lctime_only lctime_mai
+
+package locale
+
+import _ "embed" // for data/mai.bin
+
+//go:embed data/mai.bin
+var langMai string
+
+func init() {
+	register(langMai)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_mg
+// +build !lctime_only lctime_mg
+
+package locale
+
+import _ "embed" // for data/mg.bin
+
+//go:embed data/mg.bin
+var langMg string
+
+func init() {
+	register(langMg)
+}
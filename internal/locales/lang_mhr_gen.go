@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_mhr
+// +build !lctime_only lctime_mhr
+
+package locale
+
+import _ "embed" // for data/mhr.bin
+
+//go:embed data/mhr.bin
+var langMhr string
+
+func init() {
+	register(langMhr)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_mi
+// +build !lctime_only lctime_mi
+
+package locale
+
+import _ "embed" // for data/mi.bin
+
+//go:embed data/mi.bin
+var langMi string
+
+func init() {
+	register(langMi)
+}
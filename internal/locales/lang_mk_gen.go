@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_mk
+// +build !lctime_only lctime_mk
+
+package locale
+
+import _ "embed" // for data/mk.bin
+
+//go:embed data/mk.bin
+var langMk string
+
+func init() {
+	register(langMk)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ml
+// +build !lctime_only lctime_ml
+
+package locale
+
+import _ "embed" // for data/ml.bin
+
+//go:embed data/ml.bin
+var langMl string
+
+func init() {
+	register(langMl)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_mn
+// +build !lctime_only lctime_mn
+
+package locale
+
+import _ "embed" // for data/mn.bin
+
+//go:embed data/mn.bin
+var langMn string
+
+func init() {
+	register(langMn)
+}
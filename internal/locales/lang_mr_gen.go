@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_mr
+// +build !lctime_only lctime_mr
+
+package locale
+
+import _ "embed" // for data/mr.bin
+
+//go:embed data/mr.bin
+var langMr string
+
+func init() {
+	register(langMr)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ms
+// +build !lctime_only lctime_ms
+
+package locale
+
+import _ "embed" // for data/ms.bin
+
+//go:embed data/ms.bin
+var langMs string
+
+func init() {
+	register(langMs)
+}
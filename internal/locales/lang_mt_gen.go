@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_mt
+// +build !lctime_only lctime_mt
+
+package locale
+
+import _ "embed" // for data/mt.bin
+
+//go:embed data/mt.bin
+var langMt string
+
+func init() {
+	register(langMt)
+}
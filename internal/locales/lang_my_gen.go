@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_my
+// +build !lctime_only lctime_my
+
+package locale
+
+import _ "embed" // for data/my.bin
+
+//go:embed data/my.bin
+var langMy string
+
+func init() {
+	register(langMy)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_nan
+// +build !lctime_only lctime_nan
+
+package locale
+
+import _ "embed" // for data/nan.bin
+
+//go:embed data/nan.bin
+var langNan string
+
+func init() {
+	register(langNan)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_nb
+// +build !lctime_only lctime_nb
+
+package locale
+
+import _ "embed" // for data/nb.bin
+
+//go:embed data/nb.bin
+var langNb string
+
+func init() {
+	register(langNb)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ne
+// +build !lctime_only lctime_ne
+
+package locale
+
+import _ "embed" // for data/ne.bin
+
+//go:embed data/ne.bin
+var langNe string
+
+func init() {
+	register(langNe)
+}
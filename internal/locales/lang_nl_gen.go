@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_nl
+// +build !lctime_only lctime_nl
+
+package locale
+
+import _ "embed" // for data/nl.bin
+
+//go:embed data/nl.bin
+var langNl string
+
+func init() {
+	register(langNl)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_nn
+// +build !lctime_only lctime_nn
+
+package locale
+
+import _ "embed" // for data/nn.bin
+
+//go:embed data/nn.bin
+var langNn string
+
+func init() {
+	register(langNn)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_nr
+// +build !lctime_only lctime_nr
+
+package locale
+
+import _ "embed" // for data/nr.bin
+
+//go:embed data/nr.bin
+var langNr string
+
+func init() {
+	register(langNr)
+}
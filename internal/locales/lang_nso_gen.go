@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_nso
+// +build !lctime_only lctime_nso
+
+package locale
+
+import _ "embed" // for data/nso.bin
+
+//go:embed data/nso.bin
+var langNso string
+
+func init() {
+	register(langNso)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_oc
+// +build !lctime_only lctime_oc
+
+package locale
+
+import _ "embed" // for data/oc.bin
+
+//go:embed data/oc.bin
+var langOc string
+
+func init() {
+	register(langOc)
+}
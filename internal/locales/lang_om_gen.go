@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_om
+// +build !lctime_only lctime_om
+
+package locale
+
+import _ "embed" // for data/om.bin
+
+//go:embed data/om.bin
+var langOm string
+
+func init() {
+	register(langOm)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_or
+// +build !lctime_only lctime_or
+
+package locale
+
+import _ "embed" // for data/or.bin
+
+//go:embed data/or.bin
+var langOr string
+
+func init() {
+	register(langOr)
+}
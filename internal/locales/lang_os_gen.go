@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_os
+// +build !lctime_only lctime_os
+
+package locale
+
+import _ "embed" // for data/os.bin
+
+//go:embed data/os.bin
+var langOs string
+
+func init() {
+	register(langOs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_pa
+// +build !lctime_only lctime_pa
+
+package locale
+
+import _ "embed" // for data/pa.bin
+
+//go:embed data/pa.bin
+var langPa string
+
+func init() {
+	register(langPa)
+}
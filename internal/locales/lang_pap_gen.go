@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_pap
+// +build !lctime_only lctime_pap
+
+package locale
+
+import _ "embed" // for data/pap.bin
+
+//go:embed data/pap.bin
+var langPap string
+
+func init() {
+	register(langPap)
+}
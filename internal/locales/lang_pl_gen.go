@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_pl
+// +build !lctime_only lctime_pl
+
+package locale
+
+import _ "embed" // for data/pl.bin
+
+//go:embed data/pl.bin
+var langPl string
+
+func init() {
+	register(langPl)
+}
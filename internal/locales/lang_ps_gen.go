@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ps
+// +build !lctime_only lctime_ps
+
+package locale
+
+import _ "embed" // for data/ps.bin
+
+//go:embed data/ps.bin
+var langPs string
+
+func init() {
+	register(langPs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_pt
+// +build !lctime_only lctime_pt
+
+package locale
+
+import _ "embed" // for data/pt.bin
+
+//go:embed data/pt.bin
+var langPt string
+
+func init() {
+	register(langPt)
+}
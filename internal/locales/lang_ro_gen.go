@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ro
+// +build !lctime_only lctime_ro
+
+package locale
+
+import _ "embed" // for data/ro.bin
+
+//go:embed data/ro.bin
+var langRo string
+
+func init() {
+	register(langRo)
+}
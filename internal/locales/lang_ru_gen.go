@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ru
+// +build !lctime_only lctime_ru
+
+package locale
+
+import _ "embed" // for data/ru.bin
+
+//go:embed data/ru.bin
+var langRu string
+
+func init() {
+	register(langRu)
+}
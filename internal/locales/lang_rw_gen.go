@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_rw
+// +build !lctime_only lctime_rw
+
+package locale
+
+import _ "embed" // for data/rw.bin
+
+//go:embed data/rw.bin
+var langRw string
+
+func init() {
+	register(langRw)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sa
+// +build !lctime_only lctime_sa
+
+package locale
+
+import _ "embed" // for data/sa.bin
+
+//go:embed data/sa.bin
+var langSa string
+
+func init() {
+	register(langSa)
+}
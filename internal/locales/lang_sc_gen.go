@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sc
+// +build !lctime_only lctime_sc
+
+package locale
+
+import _ "embed" // for data/sc.bin
+
+//go:embed data/sc.bin
+var langSc string
+
+func init() {
+	register(langSc)
+}
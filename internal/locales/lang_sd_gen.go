@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sd
+// +build !lctime_only lctime_sd
+
+package locale
+
+import _ "embed" // for data/sd.bin
+
+//go:embed data/sd.bin
+var langSd string
+
+func init() {
+	register(langSd)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_se
+// +build !lctime_only lctime_se
+
+package locale
+
+import _ "embed" // for data/se.bin
+
+//go:embed data/se.bin
+var langSe string
+
+func init() {
+	register(langSe)
+}
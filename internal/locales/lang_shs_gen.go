@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_shs
+// +build !lctime_only lctime_shs
+
+package locale
+
+import _ "embed" // for data/shs.bin
+
+//go:embed data/shs.bin
+var langShs string
+
+func init() {
+	register(langShs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_si
+// +build !lctime_only lctime_si
+
+package locale
+
+import _ "embed" // for data/si.bin
+
+//go:embed data/si.bin
+var langSi string
+
+func init() {
+	register(langSi)
+}
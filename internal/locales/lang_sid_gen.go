@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sid
+// +build !lctime_only lctime_sid
+
+package locale
+
+import _ "embed" // for data/sid.bin
+
+//go:embed data/sid.bin
+var langSid string
+
+func init() {
+	register(langSid)
+}
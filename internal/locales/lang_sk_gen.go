@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sk
+// +build !lctime_only lctime_sk
+
+package locale
+
+import _ "embed" // for data/sk.bin
+
+//go:embed data/sk.bin
+var langSk string
+
+func init() {
+	register(langSk)
+}
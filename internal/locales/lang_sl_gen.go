@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sl
+// +build !lctime_only lctime_sl
+
+package locale
+
+import _ "embed" // for data/sl.bin
+
+//go:embed data/sl.bin
+var langSl string
+
+func init() {
+	register(langSl)
+}
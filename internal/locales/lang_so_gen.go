@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_so
+// +build !lctime_only lctime_so
+
+package locale
+
+import _ "embed" // for data/so.bin
+
+//go:embed data/so.bin
+var langSo string
+
+func init() {
+	register(langSo)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sq
+// +build !lctime_only lctime_sq
+
+package locale
+
+import _ "embed" // for data/sq.bin
+
+//go:embed data/sq.bin
+var langSq string
+
+func init() {
+	register(langSq)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sr
+// +build !lctime_only lctime_sr
+
+package locale
+
+import _ "embed" // for data/sr.bin
+
+//go:embed data/sr.bin
+var langSr string
+
+func init() {
+	register(langSr)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ss
+// +build !lctime_only lctime_ss
+
+package locale
+
+import _ "embed" // for data/ss.bin
+
+//go:embed data/ss.bin
+var langSs string
+
+func init() {
+	register(langSs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_st
+// +build !lctime_only lctime_st
+
+package locale
+
+import _ "embed" // for data/st.bin
+
+//go:embed data/st.bin
+var langSt string
+
+func init() {
+	register(langSt)
+}
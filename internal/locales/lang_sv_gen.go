@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sv
+// +build !lctime_only lctime_sv
+
+package locale
+
+import _ "embed" // for data/sv.bin
+
+//go:embed data/sv.bin
+var langSv string
+
+func init() {
+	register(langSv)
+}
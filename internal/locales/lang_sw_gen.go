@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_sw
+// +build !lctime_only lctime_sw
+
+package locale
+
+import _ "embed" // for data/sw.bin
+
+//go:embed data/sw.bin
+var langSw string
+
+func init() {
+	register(langSw)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ta
+// +build !lctime_only lctime_ta
+
+package locale
+
+import _ "embed" // for data/ta.bin
+
+//go:embed data/ta.bin
+var langTa string
+
+func init() {
+	register(langTa)
+}
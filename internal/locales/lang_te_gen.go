@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_te
+// +build !lctime_only lctime_te
+
+package locale
+
+import _ "embed" // for data/te.bin
+
+//go:embed data/te.bin
+var langTe string
+
+func init() {
+	register(langTe)
+}
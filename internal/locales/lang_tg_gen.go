@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_tg
+// +build !lctime_only lctime_tg
+
+package locale
+
+import _ "embed" // for data/tg.bin
+
+//go:embed data/tg.bin
+var langTg string
+
+func init() {
+	register(langTg)
+}
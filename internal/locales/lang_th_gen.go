@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_th
+// +build !lctime_only lctime_th
+
+package locale
+
+import _ "embed" // for data/th.bin
+
+//go:embed data/th.bin
+var langTh string
+
+func init() {
+	register(langTh)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ti
+// +build !lctime_only lctime_ti
+
+package locale
+
+import _ "embed" // for data/ti.bin
+
+//go:embed data/ti.bin
+var langTi string
+
+func init() {
+	register(langTi)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_tig
+// +build !lctime_only lctime_tig
+
+package locale
+
+import _ "embed" // for data/tig.bin
+
+//go:embed data/tig.bin
+var langTig string
+
+func init() {
+	register(langTig)
+}
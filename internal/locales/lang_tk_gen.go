@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_tk
+// +build !lctime_only lctime_tk
+
+package locale
+
+import _ "embed" // for data/tk.bin
+
+//go:embed data/tk.bin
+var langTk string
+
+func init() {
+	register(langTk)
+}
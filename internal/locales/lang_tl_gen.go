@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_tl
+// +build !lctime_only lctime_tl
+
+package locale
+
+import _ "embed" // for data/tl.bin
+
+//go:embed data/tl.bin
+var langTl string
+
+func init() {
+	register(langTl)
+}
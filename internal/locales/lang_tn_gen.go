@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_tn
+// +build !lctime_only lctime_tn
+
+package locale
+
+import _ "embed" // for data/tn.bin
+
+//go:embed data/tn.bin
+var langTn string
+
+func init() {
+	register(langTn)
+}
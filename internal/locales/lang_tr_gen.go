@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_tr
+// +build !lctime_only lctime_tr
+
+package locale
+
+import _ "embed" // for data/tr.bin
+
+//go:embed data/tr.bin
+var langTr string
+
+func init() {
+	register(langTr)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ts
+// +build !lctime_only lctime_ts
+
+package locale
+
+import _ "embed" // for data/ts.bin
+
+//go:embed data/ts.bin
+var langTs string
+
+func init() {
+	register(langTs)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_tt
+// +build !lctime_only lctime_tt
+
+package locale
+
+import _ "embed" // for data/tt.bin
+
+//go:embed data/tt.bin
+var langTt string
+
+func init() {
+	register(langTt)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ug
+// +build !lctime_only lctime_ug
+
+package locale
+
+import _ "embed" // for data/ug.bin
+
+//go:embed data/ug.bin
+var langUg string
+
+func init() {
+	register(langUg)
+}
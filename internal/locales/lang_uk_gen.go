@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_uk
+// +build !lctime_only lctime_uk
+
+package locale
+
+import _ "embed" // for data/uk.bin
+
+//go:embed data/uk.bin
+var langUk string
+
+func init() {
+	register(langUk)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_unm
+// +build !lctime_only lctime_unm
+
+package locale
+
+import _ "embed" // for data/unm.bin
+
+//go:embed data/unm.bin
+var langUnm string
+
+func init() {
+	register(langUnm)
+}
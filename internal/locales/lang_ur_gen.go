@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ur
+// +build !lctime_only lctime_ur
+
+package locale
+
+import _ "embed" // for data/ur.bin
+
+//go:embed data/ur.bin
+var langUr string
+
+func init() {
+	register(langUr)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_uz
+// +build !lctime_only lctime_uz
+
+package locale
+
+import _ "embed" // for data/uz.bin
+
+//go:embed data/uz.bin
+var langUz string
+
+func init() {
+	register(langUz)
+}
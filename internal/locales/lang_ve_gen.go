@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_ve
+// +build !lctime_only lctime_ve
+
+package locale
+
+import _ "embed" // for data/ve.bin
+
+//go:embed data/ve.bin
+var langVe string
+
+func init() {
+	register(langVe)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_vi
+// +build !lctime_only lctime_vi
+
+package locale
+
+import _ "embed" // for data/vi.bin
+
+//go:embed data/vi.bin
+var langVi string
+
+func init() {
+	register(langVi)
+}
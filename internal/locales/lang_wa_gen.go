@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_wa
+// +build !lctime_only lctime_wa
+
+package locale
+
+import _ "embed" // for data/wa.bin
+
+//go:embed data/wa.bin
+var langWa string
+
+func init() {
+	register(langWa)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_wae
+// +build !lctime_only lctime_wae
+
+package locale
+
+import _ "embed" // for data/wae.bin
+
+//go:embed data/wae.bin
+var langWae string
+
+func init() {
+	register(langWae)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_wal
+// +build !lctime_only lctime_wal
+
+package locale
+
+import _ "embed" // for data/wal.bin
+
+//go:embed data/wal.bin
+var langWal string
+
+func init() {
+	register(langWal)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_wo
+// +build !lctime_only lctime_wo
+
+package locale
+
+import _ "embed" // for data/wo.bin
+
+//go:embed data/wo.bin
+var langWo string
+
+func init() {
+	register(langWo)
+}
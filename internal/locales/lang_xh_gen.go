@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_xh
+// +build !lctime_only lctime_xh
+
+package locale
+
+import _ "embed" // for data/xh.bin
+
+//go:embed data/xh.bin
+var langXh string
+
+func init() {
+	register(langXh)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_yi
+// +build !lctime_only lctime_yi
+
+package locale
+
+import _ "embed" // for data/yi.bin
+
+//go:embed data/yi.bin
+var langYi string
+
+func init() {
+	register(langYi)
+}
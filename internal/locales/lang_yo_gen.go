@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_yo
+// +build !lctime_only lctime_yo
+
+package locale
+
+import _ "embed" // for data/yo.bin
+
+//go:embed data/yo.bin
+var langYo string
+
+func init() {
+	register(langYo)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_yue
+// +build !lctime_only lctime_yue
+
+package locale
+
+import _ "embed" // for data/yue.bin
+
+//go:embed data/yue.bin
+var langYue string
+
+func init() {
+	register(langYue)
+}
@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_zh
+// +build !lctime_only lctime_zh
+
+package locale
+
+import _ "embed" // for data/zh.bin
+
+//go:embed data/zh.bin
+var langZh string
+
+func init() {
+	register(langZh)
+}
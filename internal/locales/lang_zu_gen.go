@@ -0,0 +1,15 @@
+// Code generated by gen.go. DO NOT EDIT.
+
+//go:build !lctime_only || lctime_zu
+// +build !lctime_only lctime_zu
+
+package locale
+
+import _ "embed" // for data/zu.bin
+
+//go:embed data/zu.bin
+var langZu string
+
+func init() {
+	register(langZu)
+}
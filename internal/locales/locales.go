@@ -0,0 +1,131 @@
+// Package locale holds the locale data bundled with lctime.
+//
+// The locales are defined by the JSON files in this directory. Running go
+// generate after changing any of them compiles them into a compact binary
+// table per language, in the data directory, and a lang_*_gen.go file that
+// embeds it. POSIX is always included. The other languages are left out when
+// building with the lctime_only tag, unless their own tag is given too, as in
+// -tags "lctime_only lctime_de lctime_ja".
+package locale
+
+//go:generate go run gen.go
+
+import (
+	_ "embed" // for the data directory
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Locale mirrors lctime.LocaleData, which it's converted to.
+type Locale struct {
+	ID string
+
+	Days        []string
+	ShortDays   []string
+	Months      []string
+	ShortMonths []string
+	AMPM        []string
+
+	GenitiveMonths      []string
+	ShortGenitiveMonths []string
+	NarrowDays          []string
+	NarrowMonths        []string
+
+	Date     string
+	DateTime string
+	Time     string
+	TimeAMPM string
+	DateFmt  string
+
+	AltDigits   []string
+	Era         []string
+	EraDate     string
+	EraDateTime string
+	EraTime     string
+
+	Calendar      string
+	PersianMonths []string
+	IslamicMonths []string
+	HebrewMonths  []string
+
+	DecimalPoint string
+
+	FirstWeekday       time.Weekday
+	FirstWorkday       time.Weekday
+	MinDaysInFirstWeek int
+	CalendarDirection  int
+}
+
+var (
+	// ErrNotFound is returned by Lookup for an unknown locale.
+	ErrNotFound = errors.New("locale not found")
+	// ErrCorrupt is returned if the embedded table can't be decoded.
+	ErrCorrupt = errors.New("corrupt locale table")
+)
+
+//go:embed data/POSIX.bin
+var posix string
+
+// blobs holds the encoded tables of the compiled-in languages.
+var blobs = []string{posix}
+
+// register adds the encoded table of a language. It's called by the
+// generated files.
+func register(blob string) {
+	blobs = append(blobs, blob)
+}
+
+var (
+	indexOnce sync.Once
+	index     map[string]*table // locale identifier to its table
+	indexErr  error
+)
+
+// loadIndex decodes the indexes of the embedded tables once.
+func loadIndex() (map[string]*table, error) {
+	indexOnce.Do(func() {
+		index = make(map[string]*table)
+		for _, blob := range blobs {
+			t, err := readTable(blob)
+			if err != nil {
+				index, indexErr = nil, err
+				return
+			}
+			for id := range t.records {
+				index[id] = t
+			}
+		}
+	})
+	return index, indexErr
+}
+
+// Names returns the sorted identifiers of the compiled-in locales.
+func Names() []string {
+	idx, err := loadIndex()
+	if err != nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(idx))
+	for id := range idx {
+		names = append(names, id)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup returns the compiled-in locale with the given identifier.
+func Lookup(id string) (Locale, error) {
+	idx, err := loadIndex()
+	if err != nil {
+		return Locale{}, err
+	}
+
+	t, ok := idx[id]
+	if !ok {
+		return Locale{}, ErrNotFound
+	}
+	return t.decode(t.records[id])
+}
@@ -0,0 +1,49 @@
+package lctime
+
+// islamicCivil is the tabular Islamic calendar, which approximates the lunar
+// months with alternating 30 and 29 days, and adds a day to the last month in
+// 11 years of each 30-year cycle. Its epoch is Friday, July 16, 622 (Julian),
+// as in CLDR's islamic-civil. Dates may differ by a day or two from those of
+// sighting-based calendars such as Umm al-Qura.
+type islamicCivil struct{}
+
+// islamicEpoch is the day before 1 Muharram 1 AH.
+var islamicEpoch = gregorianDays(622, 7, 19) - 1
+
+func (islamicCivil) date(days int) (year, month, day int) {
+	year = floorDiv(30*(days-islamicEpoch-1)+10646, 10631)
+	first, _ := islamicCivil{}.days(year, 1, 1)
+	month = floorDiv(11*(days-first)+330, 325)
+	start, _ := islamicCivil{}.days(year, month, 1)
+	return year, month, days - start + 1
+}
+
+func (islamicCivil) maxYearDay() int {
+	return 355
+}
+
+func (islamicCivil) days(year, month, day int) (int, bool) {
+	length := 30 - (month+1)%2
+	if month == 12 && floorMod(14+11*year, 30) < 11 {
+		length = 30
+	}
+	ok := month >= 1 && month <= 12 && day >= 1 && day <= length
+
+	days := islamicEpoch + (year-1)*354 + floorDiv(3+11*year, 30) +
+		29*(month-1) + month/2 + day
+	return days, ok
+}
+
+// floorDiv returns a/b rounded down, for b > 0.
+func floorDiv(a, b int) int {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
+
+// floorMod returns a modulo b in [0, b), for b > 0.
+func floorMod(a, b int) int {
+	return a - floorDiv(a, b)*b
+}
@@ -0,0 +1,65 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIslamicCivilDate(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		gy, gm, gd       int
+	}{
+		{1, 1, 1, 622, 7, 19},
+		{1389, 10, 22, 1970, 1, 1},
+		{1437, 3, 13, 2015, 12, 25},
+		{1445, 9, 1, 2024, 3, 11},
+		{1445, 12, 30, 2024, 7, 7},
+		{1446, 1, 1, 2024, 7, 8},
+		{1448, 5, 6, 2026, 10, 18},
+	}
+
+	for _, test := range tests {
+		days := gregorianDays(test.gy, test.gm, test.gd)
+		year, month, day := islamicCivil{}.date(days)
+		if year != test.year || month != test.month || day != test.day {
+			t.Errorf(gotWantKey, fmt.Sprint(test.gy, "-", test.gm, "-", test.gd),
+				fmt.Sprint(year, "/", month, "/", day),
+				fmt.Sprint(test.year, "/", test.month, "/", test.day))
+		}
+
+		got, ok := islamicCivil{}.days(test.year, test.month, test.day)
+		if got != days || !ok {
+			t.Errorf(gotWantKey, fmt.Sprint(test.year, "/", test.month, "/", test.day),
+				got, days)
+		}
+	}
+}
+
+func TestIslamicCivilDays(t *testing.T) {
+	// Every day from 500 to 2100 follows the previous one.
+	first := gregorianDays(500, 1, 1)
+	year, month, day := islamicCivil{}.date(first)
+	for days := first; days < gregorianDays(2100, 1, 1); days++ {
+		y, m, d := islamicCivil{}.date(days)
+		next := d == day+1 && m == month && y == year ||
+			d == 1 && m == month+1 && y == year ||
+			d == 1 && m == 1 && month == 12 && y == year+1
+		if days > first && !next {
+			t.Fatalf(gotWantKey, fmt.Sprint(days), fmt.Sprint(y, "/", m, "/", d),
+				fmt.Sprint(year, "/", month, "/", day, " + 1"))
+		}
+		if got, ok := (islamicCivil{}).days(y, m, d); got != days || !ok {
+			t.Fatalf(gotWantKey, fmt.Sprint(y, "/", m, "/", d), got, days)
+		}
+		year, month, day = y, m, d
+	}
+
+	// 1445 is a leap year, 1446 isn't.
+	invalid := [][3]int{{1446, 12, 30}, {1445, 2, 30}, {1445, 13, 1}, {1445, 1, 0}}
+	for _, d := range invalid {
+		if _, ok := (islamicCivil{}).days(d[0], d[1], d[2]); ok {
+			t.Errorf(gotWantKey, fmt.Sprint(d), ok, false)
+		}
+	}
+}
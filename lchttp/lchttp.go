@@ -0,0 +1,32 @@
+/*
+Package lchttp negotiates an lctime locale for HTTP requests.
+
+Middleware matches the request's Accept-Language header against the
+available locales and stores the resulting Localizer in the request context,
+where handlers can find it with lctime.FromContext or use it through
+lctime.StrftimeCtx.
+
+	http.Handle("/", lchttp.Middleware(handler))
+*/
+package lchttp
+
+import (
+	"net/http"
+
+	"github.com/klauspost/lctime"
+)
+
+// Middleware returns a handler that negotiates the locale of each request
+// from its Accept-Language header before calling next. Requests without a
+// matching locale are passed on unchanged, so lctime.StrftimeCtx falls back
+// to the active locale for them.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Language")
+
+		if l, _, err := lctime.MatchAcceptLanguage(r.Header.Get("Accept-Language")); err == nil {
+			r = r.WithContext(lctime.WithLocalizer(r.Context(), l))
+		}
+		next.ServeHTTP(w, r)
+	})
+}
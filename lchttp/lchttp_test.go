@@ -0,0 +1,51 @@
+package lchttp
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/klauspost/lctime"
+)
+
+func TestMiddleware(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+	lctime.SetLocale("en_US")
+
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s, err := lctime.StrftimeCtx(r.Context(), "%A", dt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprint(w, s)
+	}))
+
+	tests := []struct {
+		header string
+		want   string
+	}{
+		{"pt-BR,pt;q=0.9,en;q=0.8", "sexta"},
+		{"en;q=0.5, da", "fredag"},
+		{"xx, es-MX;q=0.1", "viernes"},
+		{"", "Friday"},
+		{"xx-YY", "Friday"},
+	}
+
+	for i, test := range tests {
+		r := httptest.NewRequest("GET", "/", nil)
+		if test.header != "" {
+			r.Header.Set("Accept-Language", test.header)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+
+		if got := w.Body.String(); got != test.want {
+			t.Errorf("%d: got '%v', want '%v'", i, got, test.want)
+		}
+		if got := w.Header().Get("Vary"); got != "Accept-Language" {
+			t.Errorf("%d: got '%v', want '%v'", i, got, "Accept-Language")
+		}
+	}
+}
@@ -4,15 +4,23 @@ directives. More importantly, it does so in a locale-aware fashion. This
 allows developers to format time based on a user's locale.
 
 An initial locale is loaded at import time. It's determined by the first,
-non-empty locale identifier from these environment variables.
+non-empty and known locale identifier from these environment variables.
 
     1. LC_TIME
     2. LC_ALL
     3. LANG
 
-If all of the previous variables are empty, then POSIX will be the initial
+If none of the previous variables name a locale, then POSIX will be the initial
 locale used. All locales use UTF-8 character encoding.
 
+Locale identifiers are resolved the way ResolveLocale describes, so forms such
+as "ru", "pt-BR" or "de_DE.UTF-8@euro" are accepted wherever a locale is.
+
+All locales are compiled in by default. To keep binaries small, build with the
+lctime_only tag plus a tag per language to include, such as
+-tags "lctime_only lctime_en lctime_de". POSIX is always available, and
+GetLocales reports only the locales that were compiled in.
+
 The formats used are loosely based on glibc locale files.
 
 These are the supported strftime directives. They're loosely based on The Open
@@ -28,18 +36,27 @@ http://pubs.opengroup.org/onlinepubs/9699919799/functions/strftime.html.
    %d  day of the month as a decimal number [01,31]
    %D  %m/%d/%y
    %e  day of the month as a decimal number [1,31]
+   %f  microseconds as a decimal number [000000,999999]
    %F  %Y/%m/%d
    %g  last 2 digits of the week-based year as a decimal number
    %G  week-based year as a decimal number (for example, 1977)
+   %h  same as %b
    %H  hour (24-hour clock) as a decimal number [00,23]
    %I  hour (12-hour clock) as a decimal number [01,12]
    %j  day of the year as a decimal number [001,366]
+   %k  hour (24-hour clock) as a decimal number [0,23], padded with a space
+   %l  hour (12-hour clock) as a decimal number [1,12], padded with a space
+   %L  milliseconds as a decimal number [000,999]
    %m  month as a decimal number [01,12]
    %M  minute as a decimal number [00,59]
    %n  returns a newline
+   %N  nanoseconds as a decimal number [000000000,999999999]
    %p  locale's equivalent of either a.m. or p.m.
+   %P  like %p but in lower case
+   %q  quarter of the year as a decimal number [1,4]
    %r  time in a.m. and p.m. notation.
    %R  time in 24-hour notation %H:%M
+   %s  seconds since the Unix epoch
    %S  second as a decimal number [00,60]
    %t  returns a tab
    %T  %H:%M:%S
@@ -52,19 +69,85 @@ http://pubs.opengroup.org/onlinepubs/9699919799/functions/strftime.html.
    %X  locale's appropriate time representation
    %y  last two digits of the year as a decimal number [00,99]
    %Y  year as a decimal number (for example, 1997)
-   %z  offset from UTC in the ISO 8601:2000 standard format
+   %z  offset from UTC as +hhmm
+   %:z    offset from UTC as +hh:mm
+   %::z   offset from UTC as +hh:mm:ss
+   %:::z  offset from UTC with only the precision needed, as in +hh or +hh:mm
    %Z  timezone name or abbreviation
+   %+  locale's date(1) format
    %%  %
+
+Like glibc, a directive may carry flags and a field width between the % and
+the conversion character, as in %-d or %_10B.
+
+   -   don't pad a numeric result
+   _   pad a numeric result with spaces
+   0   pad with zeros, including non-numeric results
+   ^   convert the result to upper case
+   #   swap the case of the result: upper case for names, lower case for %p
+       and %Z
+   .   put the locale's decimal point before %f, %L or %N, as in %T%.3N
+
+The width is the minimum number of characters to produce. Numbers are padded
+with zeros, or with spaces for %e, %k and %l, and everything else with
+spaces. For %f, %L and %N the width is instead the number of digits, so %3N
+gives milliseconds. Extra digits are truncated, not rounded, and as in GNU
+date, a width above 9 pads the nanoseconds on the left with zeros.
+
+The E and O modifiers, written after any flags and width, select a locale's
+alternative representation where it has one.
+
+   %Ea  locale's narrow weekday name (e.g., S)
+   %Eb  locale's narrow month name (e.g., D); same as %Eh
+   %Ec  locale's alternative date and time representation
+   %EC  name of the era
+   %Ex  locale's alternative date representation
+   %EX  locale's alternative time representation
+   %Ey  year within the era
+   %EY  full alternative year representation
+   %EV  week number of the year, with the locale's first day of the week and
+        first week of the year
+   %OB  locale's standalone full month name
+   %Ob  locale's standalone abbreviated month name
+   %Od  numeric directives such as %Od, %OH or %Oy use the locale's
+        alternative digits
+
+%Ea, %Eb, %Eh and %EV are extensions of this package, which glibc outputs as
+written; the other E and O directives are glibc's. The narrow names of %Ea
+and %Eb come from CLDR. Locales that CLDR doesn't cover, such as pa_PK in
+the Arabic script, use the first letter of the full name instead.
+
+In languages that inflect month names, such as Russian or Polish, %B and %b
+give the genitive form when the format also has %d or %e, as in "25 декабря",
+and the standalone form otherwise. %OB and %Ob always give the standalone
+form.
+
+Locales without eras or alternative digits fall back to the plain directive.
+Other combinations, such as %Ed or %OY, are output as written. So are
+unknown directives and a trailing '%'. ValidateFormat and StrftimeStrict
+report them as errors instead.
+
+Locales such as fa_IR use a calendar other than the Gregorian by default.
+The year, month, day and month name directives then follow that calendar,
+and %U and %W count the weeks of its year. The ISO 8601 week directives %G,
+%g and %V, %EV and the era directives stay Gregorian; with %Y, Strptime finds
+their week in the year of the calendar. WithCalendar selects another
+calendar, such as IslamicCivil for the Arabic locales or Hebrew for he_IL.
+In the Hebrew calendar, %Od, %Oe and %Oy use Hebrew numerals, as in
+"כ״ה בכסלו תשפ״ו" for "%Od ב%B %Oy".
+
+Strptime does the reverse and parses a string into a time.Time using the same
+directives. Names are matched against the locale's tables, so anything
+formatted by Strftime can be parsed back with the same format.
 */
 package lctime
 
 import (
-	"encoding/json"
 	"errors"
+	"io"
 	"os"
-	"path/filepath"
-	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/klauspost/lctime/internal/locales"
@@ -73,9 +156,24 @@ import (
 // Localizer provides translation to a locale.
 type Localizer interface {
 	Strftime(format string, t time.Time) string
+	StrftimeStrict(format string, t time.Time) (string, error)
+	AppendStrftime(dst []byte, format string, t time.Time) []byte
+	StrftimeTo(w io.Writer, format string, t time.Time) (int, error)
+	Strptime(format, value string) (time.Time, error)
+	Compile(format string) (*Format, error)
+	Info() Info
+	MonthNames(width Width) []string
+	WeekdayNames(width Width) []string
+	AMPM() []string
+	Patterns() Patterns
+	Calendar() Calendar
+	WithCalendar(c Calendar) (Localizer, error)
 }
 
-type localeData struct {
+// LocaleData is the definition of a locale. The fields follow glibc's LC_TIME
+// category: Days and ShortDays start with Sunday, and the formats may use any
+// of the Strftime directives.
+type LocaleData struct {
 	ID string
 
 	Days        []string
@@ -84,10 +182,77 @@ type localeData struct {
 	ShortMonths []string
 	AMPM        []string
 
+	// GenitiveMonths and ShortGenitiveMonths hold the month names in the form
+	// used after a day of the month, for languages that inflect them. Months
+	// and ShortMonths are then the standalone forms, used elsewhere and by
+	// %OB and %Ob. In glibc terms these are mon and ab_mon of a locale that
+	// also has alt_mon and ab_alt_mon.
+	GenitiveMonths      []string
+	ShortGenitiveMonths []string
+	// NarrowDays and NarrowMonths hold the shortest names, such as "M" for
+	// Monday, used by %Ea and %Eb. Without them, the first letter of the
+	// full name is used.
+	NarrowDays   []string
+	NarrowMonths []string
+
 	Date     string
 	DateTime string
 	Time     string
 	TimeAMPM string
+	// DateFmt is the format of %+, glibc's date_fmt. It defaults to the
+	// format of the POSIX locale.
+	DateFmt string
+
+	// AltDigits holds the locale's alternative digits for the numbers 0 and
+	// up, used by the %O directives.
+	AltDigits []string
+	// Era holds glibc era entries, used by the %E directives.
+	Era         []string
+	EraDate     string
+	EraDateTime string
+	EraTime     string
+
+	// Calendar is the calendar the locale uses by default, such as "persian",
+	// or empty for the Gregorian calendar. PersianMonths, IslamicMonths and
+	// HebrewMonths hold the month names of the other calendars, which
+	// WithCalendar needs to select them. HebrewMonths has the 13 months of a
+	// leap year, from Tishrei to Elul, followed by the Adar of a common year.
+	Calendar      string
+	PersianMonths []string
+	IslamicMonths []string
+	HebrewMonths  []string
+
+	// DecimalPoint separates seconds from their fraction in %.N, %.L and %.f.
+	// It defaults to ".".
+	DecimalPoint string
+
+	// FirstWeekday is the day that starts the week in calendars, and
+	// FirstWorkday the first working day of the week. MinDaysInFirstWeek is
+	// the number of days that the first week of a year must have in January:
+	// 4 as in ISO 8601, or 1 if the week with January 1 is always week 1.
+	// They're glibc's week, first_weekday and first_workday, and %EV numbers
+	// the weeks by them. A locale without week data, where all three are
+	// zero, gets Sunday, Monday and 1. Otherwise MinDaysInFirstWeek must be
+	// set, and a zero weekday is Sunday.
+	FirstWeekday       time.Weekday
+	FirstWorkday       time.Weekday
+	MinDaysInFirstWeek int
+	// CalendarDirection is glibc's cal_direction, see Direction.
+	CalendarDirection int
+}
+
+// localeData is a loaded locale, ready for use.
+type localeData struct {
+	LocaleData
+
+	eras []era
+
+	// calendar is the calendar of the date directives, and cal converts to
+	// it unless it's Gregorian. The month names in LocaleData are then the
+	// calendar's, and data holds the locale as defined.
+	calendar Calendar
+	cal      calendar
+	data     LocaleData
 }
 
 var (
@@ -95,8 +260,11 @@ var (
 	ErrNoLocale = errors.New("Locale not found")
 	// ErrCorruptLocale is returned if a given locale file is corrupted.
 	ErrCorruptLocale = errors.New("Corrupted locale")
+	// ErrRecursiveFormat is returned if a locale's formats refer to
+	// themselves.
+	ErrRecursiveFormat = errors.New("Recursive locale format")
 
-	lc     localeData
+	active atomic.Value // *localeData
 	loaded sync.Map
 )
 
@@ -109,32 +277,40 @@ func init() {
 	}
 
 	for _, v := range vars {
-		if v != "" {
-			SetLocale(v)
+		if v != "" && SetLocale(v) == nil {
 			break
 		}
 	}
 }
 
-// SetLocale activates the given locale.
+// SetLocale activates the given locale. It's safe to call concurrently with
+// the package-level functions. If the locale can't be loaded, the previously
+// active locale is kept.
 func SetLocale(id string) error {
 	l, err := loadLocale(id)
 	if err != nil {
-		lc = localeData{}
 		return err
 	}
-	lc = *l
+	active.Store(l)
 	return nil
 }
 
+// current returns the active locale.
+func current() *localeData {
+	return active.Load().(*localeData)
+}
+
 // NewLocalizer provides a localizer to a specific locale.
 func NewLocalizer(id string) (Localizer, error) {
 	return loadLocale(id)
 }
 
-// loadLocale will load the locale or fetch it from cache.
+// loadLocale will resolve and load the locale or fetch it from cache.
 func loadLocale(id string) (*localeData, error) {
-	id = removeCodeset(id)
+	id, ok := resolveLocale(id)
+	if !ok {
+		return nil, ErrNoLocale
+	}
 	if l, ok := loaded.Load(id); ok {
 		lc, ok := l.(*localeData)
 		if ok {
@@ -142,36 +318,50 @@ func loadLocale(id string) (*localeData, error) {
 		}
 	}
 
-	bys, err := locale.Asset(id + ".json")
+	data, ok := registered(id)
+	if !ok {
+		l, err := locale.Lookup(id)
+		switch err {
+		case nil:
+		case locale.ErrNotFound:
+			return nil, ErrNoLocale
+		default:
+			return nil, ErrCorruptLocale
+		}
+		data = LocaleData(l)
+	}
+
+	lc, err := newLocaleData(data)
 	if err != nil {
-		return nil, ErrNoLocale
+		return nil, err
 	}
 
-	// All locales use UTF-8.
-	var lc localeData
-	if err := json.Unmarshal(bys, &lc); err != nil {
-		return nil, ErrCorruptLocale
+	l, _ := loaded.LoadOrStore(id, lc)
+	return l.(*localeData), nil
+}
+
+// newLocaleData prepares a locale definition for use.
+func newLocaleData(data LocaleData) (*localeData, error) {
+	lc := &localeData{LocaleData: data, data: data, calendar: Gregorian}
+	for _, s := range lc.Era {
+		e, ok := parseEra(s)
+		if !ok {
+			return nil, ErrCorruptLocale
+		}
+		lc.eras = append(lc.eras, e)
 	}
-	
-	loaded.Store(id, &lc)
-	return &lc, nil
+	return lc.withCalendar("")
 }
 
 // GetLocale returns the currently active locale.
 func GetLocale() string {
-	return lc.ID
+	return current().ID
 }
 
-// GetLocales returns a slice of available locales.
+// GetLocales returns a slice of available locales, including registered
+// ones.
 func GetLocales() []string {
-	lcs := locale.AssetNames()
-
-	names := make([]string, 0, len(lcs))
-	for _, l := range lcs {
-		names = append(names, strings.TrimSuffix(l, filepath.Ext(l)))
-	}
-
-	return names
+	return append([]string(nil), locales()...)
 }
 
 // cleanID takes in a valid locale ID and returns the same ID, except without
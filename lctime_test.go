@@ -44,16 +44,19 @@ func TestGetLocale(t *testing.T) {
 		input string
 		want  string
 	}{
-		{"fake", ""},
 		{"POSIX", "POSIX"},
+		{"fake", "POSIX"},
 		{"uz_UZ@cyrillic", "uz_UZ@cyrillic"},
-		{"ab_CD", ""},
-		{"", ""},
+		{"ab_CD", "uz_UZ@cyrillic"},
+		{"", "uz_UZ@cyrillic"},
 		{"nan_TW@latin", "nan_TW@latin"},
 		{"en_US", "en_US"},
 		{"es_MX", "es_MX"},
 		{"eo", "eo"},
 		{"sr_RS.UTF-8@latin", "sr_RS@latin"},
+		{"ru", "ru_RU"},
+		{"de_DE.UTF-8@euro", "de_DE"},
+		{"C.UTF-8", "POSIX"},
 	}
 
 	for i, test := range tests {
@@ -72,6 +75,9 @@ func TestGetLocales(t *testing.T) {
 			return err
 		}
 
+		if fi.IsDir() && p != "internal/locales" {
+			return filepath.SkipDir
+		}
 		if fi.IsDir() || fi.Name()[0] == '.' {
 			return nil
 		}
@@ -139,3 +145,13 @@ func BenchmarkNewLocalizer(b *testing.B) {
 	}
 	loc = lc
 }
+
+func BenchmarkLoadLocale(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		loaded.Delete("en_US")
+		if _, err := loadLocale("en_US"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
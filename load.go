@@ -0,0 +1,283 @@
+package lctime
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// LocaleError describes an invalid locale definition. It wraps
+// ErrCorruptLocale, unless Err holds a more specific cause.
+type LocaleError struct {
+	Path  string // file the definition was read from, if any
+	ID    string // identifier of the locale, if known
+	Field string // invalid field, if any
+	Msg   string // description of the problem
+	Err   error  // underlying error
+}
+
+// Error returns the string representation of a LocaleError.
+func (e *LocaleError) Error() string {
+	s := "locale"
+	if e.ID != "" {
+		s += " " + e.ID
+	}
+	if e.Path != "" {
+		s += " (" + e.Path + ")"
+	}
+	if e.Field != "" {
+		s += ": " + e.Field
+	}
+	s += ": " + e.Msg
+	if e.Err != nil && e.Err != ErrCorruptLocale {
+		s += ": " + e.Err.Error()
+	}
+	return s
+}
+
+// Unwrap returns the underlying error.
+func (e *LocaleError) Unwrap() error {
+	return e.Err
+}
+
+// LoadLocaleFile registers the locale defined in a JSON file, which uses the
+// same schema as LocaleData. Without an ID in the file, the file name minus
+// its extension is used. Invalid definitions are reported as a *LocaleError.
+func LoadLocaleFile(path string) error {
+	bys, err := os.ReadFile(path)
+	if err != nil {
+		return &LocaleError{Path: path, Msg: "cannot read file", Err: err}
+	}
+
+	data, err := decodeLocale(path, filepath.Base(path), bys)
+	if err != nil {
+		return err
+	}
+	return RegisterLocale(data.ID, data)
+}
+
+// LoadLocalesFS registers the locales defined by the *.json files in dir of
+// fsys, like LoadLocaleFile. All files are checked before any is registered,
+// so nothing is registered if one of them is invalid.
+func LoadLocalesFS(fsys fs.FS, dir string) error {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return &LocaleError{Path: dir, Msg: "cannot read directory", Err: err}
+	}
+
+	var all []LocaleData
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".json" {
+			continue
+		}
+
+		p := path.Join(dir, e.Name())
+		bys, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return &LocaleError{Path: p, Msg: "cannot read file", Err: err}
+		}
+
+		data, err := decodeLocale(p, e.Name(), bys)
+		if err != nil {
+			return err
+		}
+		all = append(all, data)
+	}
+
+	for _, data := range all {
+		if err := RegisterLocale(data.ID, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodeLocale decodes and checks a locale definition read from a file.
+func decodeLocale(path, name string, bys []byte) (LocaleData, error) {
+	var data LocaleData
+	if err := json.Unmarshal(bys, &data); err != nil {
+		return data, &LocaleError{Path: path, Msg: "invalid JSON", Err: err}
+	}
+
+	if data.ID == "" {
+		data.ID = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	if err := data.validate(); err != nil {
+		err.Path = path
+		return data, err
+	}
+	return data, nil
+}
+
+// validate checks that the definition can be used for formatting, and fills
+// in the week fields of a locale without week data.
+func (d *LocaleData) validate() *LocaleError {
+	fail := func(field, format string, args ...interface{}) *LocaleError {
+		return &LocaleError{ID: d.ID, Field: field, Msg: fmt.Sprintf(format, args...),
+			Err: ErrCorruptLocale}
+	}
+
+	if d.ID == "" || strings.Contains(d.ID, ".") {
+		return &LocaleError{ID: d.ID, Field: "ID", Msg: "invalid identifier",
+			Err: ErrInvalidLocaleID}
+	}
+
+	names := []struct {
+		field    string
+		names    []string
+		want     int
+		optional bool
+	}{
+		{"Days", d.Days, 7, false},
+		{"ShortDays", d.ShortDays, 7, false},
+		{"Months", d.Months, 12, false},
+		{"ShortMonths", d.ShortMonths, 12, false},
+		{"AMPM", d.AMPM, 2, false},
+		{"GenitiveMonths", d.GenitiveMonths, 12, true},
+		{"ShortGenitiveMonths", d.ShortGenitiveMonths, 12, true},
+		{"NarrowDays", d.NarrowDays, 7, true},
+		{"NarrowMonths", d.NarrowMonths, 12, true},
+		{"PersianMonths", d.PersianMonths, 12, true},
+		{"IslamicMonths", d.IslamicMonths, 12, true},
+		{"HebrewMonths", d.HebrewMonths, 14, true},
+	}
+	for _, n := range names {
+		if len(n.names) != n.want && !(n.optional && len(n.names) == 0) {
+			return fail(n.field, "need %d names, have %d", n.want, len(n.names))
+		}
+	}
+
+	formats := []struct {
+		field  string
+		format string
+	}{
+		{"Date", d.Date},
+		{"DateTime", d.DateTime},
+		{"Time", d.Time},
+		{"TimeAMPM", d.TimeAMPM},
+		{"DateFmt", d.DateFmt},
+		{"EraDate", d.EraDate},
+		{"EraDateTime", d.EraDateTime},
+		{"EraTime", d.EraTime},
+	}
+	for _, f := range formats {
+		if msg := checkFormat(f.format); msg != "" {
+			return fail(f.field, "%s in %q", msg, f.format)
+		}
+	}
+
+	for _, s := range d.Era {
+		e, ok := parseEra(s)
+		if !ok {
+			return fail("Era", "invalid era %q", s)
+		}
+		if msg := checkFormat(e.format); msg != "" {
+			return fail("Era", "%s in %q", msg, s)
+		}
+		if refersToEra(e.format) {
+			return fail("Era", "format refers to itself in %q", s)
+		}
+	}
+
+	if d.FirstWeekday == time.Sunday && d.FirstWorkday == time.Sunday &&
+		d.MinDaysInFirstWeek == 0 {
+		// No week data.
+		d.FirstWorkday, d.MinDaysInFirstWeek = time.Monday, 1
+	}
+
+	switch {
+	case d.FirstWeekday < time.Sunday || d.FirstWeekday > time.Saturday:
+		return fail("FirstWeekday", "invalid weekday %d", d.FirstWeekday)
+	case d.FirstWorkday < time.Sunday || d.FirstWorkday > time.Saturday:
+		return fail("FirstWorkday", "invalid weekday %d", d.FirstWorkday)
+	case d.MinDaysInFirstWeek < 1 || d.MinDaysInFirstWeek > 7:
+		return fail("MinDaysInFirstWeek", "need 1 to 7 days, have %d", d.MinDaysInFirstWeek)
+	case d.CalendarDirection < 0 || d.CalendarDirection > int(RightToLeft):
+		return fail("CalendarDirection", "invalid direction %d", d.CalendarDirection)
+	}
+
+	if d.Calendar != "" && Calendar(d.Calendar) != Gregorian &&
+		len(d.calendarMonths(Calendar(d.Calendar))) == 0 {
+		return fail("Calendar", "no month names for calendar %q", d.Calendar)
+	}
+
+	lc := &localeData{LocaleData: *d}
+	for _, s := range d.Era {
+		e, _ := parseEra(s)
+		lc.eras = append(lc.eras, e)
+	}
+	if !lc.bounded("%c%x%X%r%+%Ec%Ex%EX%EY", 0) {
+		return &LocaleError{ID: d.ID, Msg: "formats refer to themselves",
+			Err: ErrRecursiveFormat}
+	}
+	return nil
+}
+
+// bounded reports whether format expands within maxDepth levels, following
+// both the locale formats and the era formats that %EY expands to.
+func (lc *localeData) bounded(format string, depth int) bool {
+	if depth > maxDepth {
+		return false
+	}
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		d, n := parseDirective(format[i:])
+		if n == 0 {
+			break
+		}
+		i += n - 1
+
+		if sub, ok := lc.composite(d); ok && !lc.bounded(sub, depth+1) {
+			return false
+		}
+		if d.mod == 'E' && d.conv == 'Y' {
+			for _, e := range lc.eras {
+				if !lc.bounded(e.format, depth+1) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// checkFormat returns a description of the first malformed directive in
+// format, or an empty string if there's none. Like glibc, unknown conversions
+// are allowed and output as written.
+func checkFormat(format string) string {
+	if err := validateFormat(format, false); err != nil {
+		return fmt.Sprintf("%s %q", err.Msg, err.Directive)
+	}
+	return ""
+}
+
+// refersToEra reports whether an era format uses %EY or one of the locale
+// formats, including %+, which would format the era again.
+func refersToEra(format string) bool {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		d, n := parseDirective(format[i:])
+		if n == 0 {
+			return false
+		}
+		switch {
+		case d.mod == 'E' && d.conv == 'Y', strings.IndexByte("cxXr+", d.conv) >= 0:
+			return true
+		}
+		i += n - 1
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+package lctime
+
+import (
+	"errors"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// testLocale returns a valid definition to base test cases on.
+func testLocale(id string) string {
+	return `{
+	"ID": "` + id + `",
+	"Days": ["d0", "d1", "d2", "d3", "d4", "d5", "d6"],
+	"ShortDays": ["a0", "a1", "a2", "a3", "a4", "a5", "a6"],
+	"Months": ["m1", "m2", "m3", "m4", "m5", "m6", "m7", "m8", "m9", "m10", "m11", "m12"],
+	"ShortMonths": ["b1", "b2", "b3", "b4", "b5", "b6", "b7", "b8", "b9", "b10", "b11", "b12"],
+	"AMPM": ["am", "pm"],
+	"Date": "%d/%m/%Y",
+	"DateTime": "%a %x %X",
+	"Time": "%T",
+	"TimeAMPM": "%I:%M:%S %p"
+}`
+}
+
+func TestLoadLocaleFile(t *testing.T) {
+	if err := LoadLocaleFile("testdata/qz_QZ.json"); err != nil {
+		t.Fatal(err)
+	}
+
+	dt := time.Date(2015, 9, 25, 15, 2, 1, 0, time.UTC)
+	got, err := StrftimeLoc("qz_QZ", "%c", dt)
+	if want := "Fri 25 Sept 2015 15:02"; err != nil || got != want {
+		t.Errorf(gotWant, got, want)
+	}
+
+	err = LoadLocaleFile("testdata/missing.json")
+	if e, ok := err.(*LocaleError); !ok || e.Path != "testdata/missing.json" {
+		t.Errorf(gotWant, err, "*LocaleError")
+	}
+}
+
+func TestLoadLocalesFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/qa_QA.json":     {Data: []byte(testLocale("qa_QA"))},
+		"locales/qb_QB.json":     {Data: []byte(testLocale(""))},
+		"locales/README":         {Data: []byte("not a locale")},
+		"locales/sub/qc_QC.json": {Data: []byte(testLocale("qc_QC"))},
+	}
+
+	if err := LoadLocalesFS(fsys, "locales"); err != nil {
+		t.Fatal(err)
+	}
+
+	dt := time.Date(2015, 9, 25, 15, 2, 1, 0, time.UTC)
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{"qa_QA", "a5 25/09/2015 15:02:01"},
+		{"qb_QB", "a5 25/09/2015 15:02:01"},
+	}
+	for i, test := range tests {
+		if got, err := StrftimeLoc(test.locale, "%c", dt); err != nil || got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+
+	if _, err := NewLocalizer("qc_QC"); err != ErrNoLocale {
+		t.Errorf(gotWant, err, ErrNoLocale)
+	}
+}
+
+func TestLoadLocalesFSErrors(t *testing.T) {
+	replace := func(old, new string) []byte {
+		s := testLocale("qd_QD")
+		for i := 0; i+len(old) <= len(s); i++ {
+			if s[i:i+len(old)] == old {
+				return []byte(s[:i] + new + s[i+len(old):])
+			}
+		}
+		panic("missing " + old)
+	}
+
+	tests := []struct {
+		data  []byte
+		field string
+		want  error
+	}{
+		{[]byte("{"), "", nil},
+		{replace(`"d6"]`, `"d6", "d7"]`), "Days", ErrCorruptLocale},
+		{replace(`"a0", `, ``), "ShortDays", ErrCorruptLocale},
+		{replace(`"m12"`, `"m12", "m13"`), "Months", ErrCorruptLocale},
+		{replace(`"b1", `, ``), "ShortMonths", ErrCorruptLocale},
+		{replace(`["am", "pm"]`, `[]`), "AMPM", ErrCorruptLocale},
+		{replace(`["am", "pm"]`, `["am", "pm"], "GenitiveMonths": ["g1"]`), "GenitiveMonths", ErrCorruptLocale},
+		{replace(`["am", "pm"]`, `["am", "pm"], "NarrowDays": ["S"]`), "NarrowDays", ErrCorruptLocale},
+		{replace(`["am", "pm"]`, `["am", "pm"], "Calendar": "persian"`), "Calendar", ErrCorruptLocale},
+		{replace(`["am", "pm"]`, `["am", "pm"], "PersianMonths": ["m"]`), "PersianMonths", ErrCorruptLocale},
+		{replace(`["am", "pm"]`, `["am", "pm"], "Calendar": "islamic-civil"`), "Calendar", ErrCorruptLocale},
+		{replace(`["am", "pm"]`, `["am", "pm"], "HebrewMonths": ["h"]`), "HebrewMonths", ErrCorruptLocale},
+		{replace(`"%d/%m/%Y"`, `"%d/%m/%"`), "Date", ErrCorruptLocale},
+		{replace(`"%T"`, `"%Ed"`), "Time", ErrCorruptLocale},
+		{replace(`"%a %x %X"`, `"%a %c"`), "", ErrRecursiveFormat},
+		{replace(`"%I:%M:%S %p"`, `"%I:%M:%S %p", "Era": ["+:1:2000"]`), "Era", ErrCorruptLocale},
+		{replace(`"%I:%M:%S %p"`, `"%I:%M:%S %p", "Era": ["+:1:2000/01/01:+*:E:%EY"]`), "Era", ErrCorruptLocale},
+		{replace(`"qd_QD"`, `"qd_QD.UTF-8"`), "ID", ErrInvalidLocaleID},
+	}
+
+	for i, test := range tests {
+		fsys := fstest.MapFS{
+			"qe_QE.json": {Data: []byte(testLocale("qe_QE"))},
+			"qd_QD.json": {Data: test.data},
+		}
+
+		err := LoadLocalesFS(fsys, ".")
+		e, ok := err.(*LocaleError)
+		if !ok {
+			t.Errorf(gotWantIdx, i, err, "*LocaleError")
+			continue
+		}
+		if e.Field != test.field || e.Path != "qd_QD.json" {
+			t.Errorf(gotWantIdx, i, e, test.field)
+		}
+		if test.want != nil && !errors.Is(err, test.want) {
+			t.Errorf(gotWantIdx, i, err, test.want)
+		}
+	}
+
+	// Nothing is registered when one of the files is invalid.
+	if _, err := NewLocalizer("qe_QE"); err != ErrNoLocale {
+		t.Errorf(gotWant, err, ErrNoLocale)
+	}
+
+	if _, ok := LoadLocalesFS(fstest.MapFS{}, "missing").(*LocaleError); !ok {
+		t.Error("missing directory not reported as *LocaleError")
+	}
+}
+
+func TestEmbeddedLocalesValid(t *testing.T) {
+	for _, id := range GetLocales() {
+		l, err := loadLocale(id)
+		if err != nil {
+			t.Errorf(gotWantKey, id, err, nil)
+			continue
+		}
+		if err := l.LocaleData.validate(); err != nil {
+			t.Errorf(gotWantKey, id, err, nil)
+		}
+	}
+}
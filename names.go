@@ -0,0 +1,96 @@
+package lctime
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Width selects the length of day and month names.
+type Width int
+
+// Name widths, as used by MonthNames and WeekdayNames.
+const (
+	Wide        Width = iota // "January", "Monday"
+	Abbreviated              // "Jan", "Mon"
+	Narrow                   // "J", "M"
+)
+
+// Patterns holds a locale's formats, which may use any of the Strftime
+// directives.
+type Patterns struct {
+	Date     string // %x
+	DateTime string // %c
+	Time     string // %X
+	TimeAMPM string // %r
+}
+
+// MonthNames returns the locale's month names, starting with January, in the
+// standalone form used by %B and %b outside of a date. In another calendar they
+// are the names of its months, as listed in LocaleData. The slice is a copy.
+func (lc *localeData) MonthNames(width Width) []string {
+	switch width {
+	case Abbreviated:
+		return copyNames(lc.ShortMonths)
+	case Narrow:
+		if len(lc.NarrowMonths) > 0 {
+			return copyNames(lc.NarrowMonths)
+		}
+		return narrowNames(lc.Months)
+	}
+	return copyNames(lc.Months)
+}
+
+// WeekdayNames returns the locale's weekday names, starting with Sunday. The
+// slice is a copy.
+func (lc *localeData) WeekdayNames(width Width) []string {
+	switch width {
+	case Abbreviated:
+		return copyNames(lc.ShortDays)
+	case Narrow:
+		if len(lc.NarrowDays) > 0 {
+			return copyNames(lc.NarrowDays)
+		}
+		return narrowNames(lc.Days)
+	}
+	return copyNames(lc.Days)
+}
+
+// AMPM returns the locale's equivalents of a.m. and p.m., which are empty if
+// the locale uses the 24-hour clock. The slice is a copy.
+func (lc *localeData) AMPM() []string {
+	return copyNames(lc.LocaleData.AMPM)
+}
+
+// Patterns returns the locale's date and time formats.
+func (lc *localeData) Patterns() Patterns {
+	return Patterns{
+		Date:     lc.Date,
+		DateTime: lc.DateTime,
+		Time:     lc.Time,
+		TimeAMPM: lc.TimeAMPM,
+	}
+}
+
+func copyNames(names []string) []string {
+	return append([]string(nil), names...)
+}
+
+// narrowNames derives narrow names from the first letter of each name, in
+// upper case.
+func narrowNames(names []string) []string {
+	narrow := make([]string, len(names))
+	for i, name := range names {
+		narrow[i] = string(appendNarrow(nil, name))
+	}
+	return narrow
+}
+
+// appendNarrow appends the first letter of name in upper case, which stands
+// in for the narrow name of a locale without one.
+func appendNarrow(b []byte, name string) []byte {
+	r, _ := utf8.DecodeRuneInString(name)
+	if r == utf8.RuneError {
+		return b
+	}
+	return append(b, string(unicode.ToUpper(r))...)
+}
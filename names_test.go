@@ -0,0 +1,128 @@
+package lctime
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestMonthNames(t *testing.T) {
+	tests := []struct {
+		locale string
+		width  Width
+		want   []string
+	}{
+		{"en_US", Wide, []string{"January", "February", "March", "April", "May",
+			"June", "July", "August", "September", "October", "November", "December"}},
+		{"en_US", Abbreviated, []string{"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec"}},
+		{"en_US", Narrow, []string{"J", "F", "M", "A", "M", "J", "J", "A", "S",
+			"O", "N", "D"}},
+		{"ru_RU", Wide, []string{"Январь", "Февраль", "Март", "Апрель", "Май",
+			"Июнь", "Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь"}},
+		{"fr_FR", Narrow, []string{"J", "F", "M", "A", "M", "J", "J", "A", "S",
+			"O", "N", "D"}},
+		{"ko_KR", Narrow, []string{"1월", "2월", "3월", "4월", "5월", "6월", "7월",
+			"8월", "9월", "10월", "11월", "12월"}},
+	}
+
+	for _, test := range tests {
+		l, err := NewLocalizer(test.locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.MonthNames(test.width); !reflect.DeepEqual(got, test.want) {
+			t.Errorf(gotWantKey, test.locale, got, test.want)
+		}
+	}
+}
+
+func TestWeekdayNames(t *testing.T) {
+	tests := []struct {
+		locale string
+		width  Width
+		want   []string
+	}{
+		{"en_US", Wide, []string{"Sunday", "Monday", "Tuesday", "Wednesday",
+			"Thursday", "Friday", "Saturday"}},
+		{"en_US", Abbreviated, []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri",
+			"Sat"}},
+		{"en_US", Narrow, []string{"S", "M", "T", "W", "T", "F", "S"}},
+		{"de_DE", Abbreviated, []string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"}},
+		{"de_DE", Narrow, []string{"S", "M", "D", "M", "D", "F", "S"}},
+		{"ja_JP", Narrow, []string{"日", "月", "火", "水", "木", "金", "土"}},
+		{"zh_CN", Narrow, []string{"日", "一", "二", "三", "四", "五", "六"}},
+		{"he_IL", Narrow, []string{"א׳", "ב׳", "ג׳", "ד׳", "ה׳", "ו׳", "ש׳"}},
+		{"sr_RS", Narrow, []string{"н", "п", "у", "с", "ч", "п", "с"}},
+		{"sr_RS@latin", Narrow, []string{"n", "p", "u", "s", "č", "p", "s"}},
+		// CLDR has no Punjabi names in the Arabic script.
+		{"pa_PK", Narrow, []string{"ا", "پ", "م", "ب", "ج", "ج", "ه"}},
+	}
+
+	for _, test := range tests {
+		l, err := NewLocalizer(test.locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.WeekdayNames(test.width); !reflect.DeepEqual(got, test.want) {
+			t.Errorf(gotWantKey, test.locale, got, test.want)
+		}
+	}
+}
+
+func TestNamesAreCopies(t *testing.T) {
+	l, err := NewLocalizer("en_US")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l.MonthNames(Wide)[0] = "x"
+	l.WeekdayNames(Abbreviated)[0] = "x"
+	l.AMPM()[0] = "x"
+
+	dt := time.Date(2015, 1, 4, 3, 2, 1, 0, time.UTC)
+	if got, want := l.Strftime("%B %a %p", dt), "January Sun AM"; got != want {
+		t.Errorf(gotWant, got, want)
+	}
+}
+
+func TestAMPMAndPatterns(t *testing.T) {
+	tests := []struct {
+		locale   string
+		ampm     []string
+		patterns Patterns
+	}{
+		{"en_US", []string{"AM", "PM"},
+			Patterns{"%m/%d/%Y", "%a %d %b %Y %r %Z", "%r", "%I:%M:%S %p"}},
+		{"de_DE", []string{"", ""},
+			Patterns{"%d.%m.%Y", "%a %d %b %Y %T %Z", "%T", ""}},
+	}
+
+	for _, test := range tests {
+		l, err := NewLocalizer(test.locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.AMPM(); !reflect.DeepEqual(got, test.ampm) {
+			t.Errorf(gotWantKey, test.locale, got, test.ampm)
+		}
+		if got := l.Patterns(); got != test.patterns {
+			t.Errorf(gotWantKey, test.locale, got, test.patterns)
+		}
+	}
+}
+
+func ExampleLocalizer_names() {
+	l, err := NewLocalizer("de_DE")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(l.WeekdayNames(Abbreviated))
+	fmt.Println(l.MonthNames(Narrow))
+
+	// Output:
+	// [So Mo Di Mi Do Fr Sa]
+	// [J F M A M J J A S O N D]
+}
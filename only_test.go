@@ -0,0 +1,37 @@
+//go:build lctime_only && lctime_de && !lctime_en
+// +build lctime_only,lctime_de,!lctime_en
+
+package lctime
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestOnlyLocales checks a build with only the German locales, using
+// go test -tags "lctime_only lctime_de" -run TestOnlyLocales.
+func TestOnlyLocales(t *testing.T) {
+	for _, id := range GetLocales() {
+		if id != "POSIX" && !strings.HasPrefix(id, "de_") {
+			t.Errorf(gotWant, id, "de_* or POSIX")
+		}
+	}
+
+	tests := []struct {
+		input string
+		want  error
+	}{
+		{"de_DE", nil},
+		{"de", nil},
+		{"POSIX", nil},
+		{"C.UTF-8", nil},
+		{"en_US", ErrNoLocale},
+		{"fr", ErrNoLocale},
+	}
+
+	for i, test := range tests {
+		if _, err := NewLocalizer(test.input); err != test.want {
+			t.Errorf(gotWantIdx, i, err, test.want)
+		}
+	}
+}
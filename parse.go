@@ -2,13 +2,26 @@ package lctime
 
 import (
 	"bytes"
+	"io"
+	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 )
 
+// bufPool holds scratch buffers, so formatting to a string or an io.Writer
+// doesn't allocate more than the result.
+var bufPool = sync.Pool{
+	New: func() interface{} {
+		b := make([]byte, 0, 64)
+		return &b
+	},
+}
+
 // Strftime formats a time.Time. It's locale-aware, so make sure you call
 // SetLocale if needed.
 func Strftime(format string, t time.Time) string {
-	return lc.Strftime(format, t)
+	return current().Strftime(format, t)
 }
 
 // StrftimeLoc formats a time.Time. It's locale-aware, so make sure you call
@@ -21,106 +34,601 @@ func StrftimeLoc(locale, format string, t time.Time) (string, error) {
 	return lc.Strftime(format, t), nil
 }
 
+// AppendStrftime is like Strftime but appends the formatted time to dst and
+// returns the extended buffer.
+func AppendStrftime(dst []byte, format string, t time.Time) []byte {
+	return current().AppendStrftime(dst, format, t)
+}
+
+// StrftimeTo is like Strftime but writes the formatted time to w.
+func StrftimeTo(w io.Writer, format string, t time.Time) (int, error) {
+	return current().StrftimeTo(w, format, t)
+}
+
 func (lc *localeData) Strftime(format string, t time.Time) string {
 	if len(format) < 1 {
 		return format
 	}
 
-	buf := new(bytes.Buffer)
+	buf := bufPool.Get().(*[]byte)
+	*buf = lc.AppendStrftime((*buf)[:0], format, t)
+	s := string(*buf)
+	bufPool.Put(buf)
+	return s
+}
+
+func (lc *localeData) AppendStrftime(b []byte, format string, t time.Time) []byte {
 	end := len(format)
 
 	for i := 0; i < end; i++ {
-		if format[i] == '%' && i+2 <= end {
-			buf.WriteString(lc.parseDirective(format[i:i+2], t))
-			i++
+		if format[i] == '%' {
+			if d, n := parseDirective(format[i:]); n > 0 {
+				d.genitive = lc.genitive(d, format)
+				b = lc.appendDirective(b, d, t)
+				i += n - 1
+				continue
+			}
+		}
+
+		b = append(b, format[i])
+	}
+
+	return b
+}
+
+func (lc *localeData) StrftimeTo(w io.Writer, format string, t time.Time) (int, error) {
+	buf := bufPool.Get().(*[]byte)
+	*buf = lc.AppendStrftime((*buf)[:0], format, t)
+	n, err := w.Write(*buf)
+	bufPool.Put(buf)
+	return n, err
+}
+
+// directive is a parsed conversion specification,
+// %[flags][width][modifier][colons]conv.
+type directive struct {
+	text   string // the specification as written, including the '%'
+	pad    byte   // '-', '_' or '0'; zero for the conversion's default
+	upper  bool   // '^' flag
+	swap   bool   // '#' flag
+	point  bool   // '.' flag, the locale's decimal point before a fraction
+	width  int
+	mod    byte // 'E' or 'O'; zero if there is no modifier
+	colons int  // number of colons before a 'z', as in %:z
+	conv   byte
+
+	// genitive is set for a month name in a format with a day of the month.
+	genitive bool
+}
+
+// parseDirective parses the conversion specification at the start of s, which
+// must begin with '%'. It returns the number of bytes used, or 0 if s ends
+// before the conversion character.
+func parseDirective(s string) (directive, int) {
+	var d directive
+
+	i := 1
+	for ; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '-', '_', '0':
+			d.pad = c
+			continue
+		case '^':
+			d.upper = true
+			continue
+		case '#':
+			d.swap = true
+			continue
+		case '.':
+			d.point = true
+			continue
+		}
+		break
+	}
+
+	for ; i < len(s) && '0' <= s[i] && s[i] <= '9'; i++ {
+		d.width = d.width*10 + int(s[i]-'0')
+	}
+
+	if i < len(s) && (s[i] == 'E' || s[i] == 'O') {
+		d.mod = s[i]
+		i++
+	}
+
+	for i < len(s) && s[i] == ':' && d.colons < 3 {
+		d.colons++
+		i++
+	}
+
+	if i >= len(s) {
+		return directive{}, 0
+	}
+
+	d.conv = s[i]
+	d.text = s[:i+1]
+	return d, i + 1
+}
+
+// plain reports whether the directive has no flags or width.
+func (d directive) plain() bool {
+	return d.pad == 0 && !d.upper && !d.swap && !d.point && d.width == 0
+}
+
+// validModifier reports whether the directive's modifier goes with its
+// conversion character. That's every combination glibc accepts, and %Ea, %Eb,
+// %Eh and %EV, which are this package's own and which glibc rejects. Invalid
+// combinations are copied as written.
+func (d directive) validModifier() bool {
+	switch d.mod {
+	case 'E':
+		return strings.IndexByte("ABdDefFgGHIjklLmMNPqsSUwW+", d.conv) < 0
+	case 'O':
+		return strings.IndexByte("aAcDfFLNPxXY+", d.conv) < 0
+	}
+	return true
+}
+
+// appendDirective appends a directive, applying its flags and width. Unknown
+// directives are copied as written.
+func (lc *localeData) appendDirective(b []byte, d directive, t time.Time) []byte {
+	start := len(b)
+
+	ok := d.validModifier()
+	if ok {
+		b, ok = lc.appendConv(b, d, t)
+	}
+	if !ok {
+		b = append(b, d.text...)
+	}
+
+	numeric := ok && isNumeric(d.conv)
+	if numeric && d.mod == 'O' {
+		if alt, ok := lc.alternative(d, t, b[start:]); ok {
+			b = append(b[:start], alt...)
+			numeric = false
+		}
+	}
+
+	if ok && isFraction(d.conv) {
+		// The width of a fraction is its number of digits.
+		return b
+	}
+
+	if d.plain() {
+		return b
+	}
+
+	if numeric {
+		return d.padNumber(b, start)
+	}
+
+	switch {
+	case d.conv == 'P':
+		// Like glibc, %P stays in lower case whatever the flags.
+	case d.swap && (d.conv == 'p' || d.conv == 'Z'):
+		b = toLower(b, start)
+	case d.upper, d.swap && isName(d.conv):
+		b = toUpper(b, start)
+	}
+
+	fill := byte(' ')
+	if d.pad == '0' {
+		fill = '0'
+	}
+	return padLeft(b, start, d.width, fill)
+}
+
+// padNumber re-pads the number in b[start:] according to the directive. The
+// default rendering already has the conversion's default width.
+func (d directive) padNumber(b []byte, start int) []byte {
+	num := b[start:]
+	width := len(num)
+	if d.width > width {
+		width = d.width
+	}
+
+	fill := byte('0')
+	switch d.conv {
+	case 'e', 'k', 'l':
+		fill = ' '
+	}
+
+	switch d.pad {
+	case '-':
+		width, fill = d.width, ' '
+	case '_':
+		fill = ' '
+	case '0':
+		fill = '0'
+	}
+
+	// Trim the default padding, keeping the sign and at least one digit.
+	i := 0
+	for i < len(num)-1 && num[i] == ' ' {
+		i++
+	}
+	sign := byte(0)
+	if num[i] == '-' || num[i] == '+' {
+		sign = num[i]
+		i++
+	}
+	// In offsets such as +00:00, only the hours are trimmed.
+	for i < len(num)-1 && num[i] == '0' &&
+		num[i+1] >= '0' && num[i+1] <= '9' {
+		i++
+	}
+
+	digits := start + i
+	if sign != 0 {
+		// The sign goes in front of zeros but after spaces.
+		b[start] = sign
+		if fill == '0' {
+			start++
+			width--
+		} else {
+			b = append(b[:start+1], b[digits:]...)
+			return padLeft(b, start, width, fill)
+		}
+	}
+
+	return padLeft(append(b[:start], b[digits:]...), start, width, fill)
+}
+
+// padLeft pads b[start:] on the left with fill to at least width characters.
+func padLeft(b []byte, start, width int, fill byte) []byte {
+	n := width - utf8.RuneCount(b[start:])
+	if n <= 0 {
+		return b
+	}
+
+	for i := 0; i < n; i++ {
+		b = append(b, fill)
+	}
+	copy(b[start+n:], b[start:len(b)-n])
+	for i := start; i < start+n; i++ {
+		b[i] = fill
+	}
+	return b
+}
+
+// toUpper upper-cases b[start:].
+func toUpper(b []byte, start int) []byte {
+	if isASCII(b[start:]) {
+		for i := start; i < len(b); i++ {
+			if 'a' <= b[i] && b[i] <= 'z' {
+				b[i] -= 'a' - 'A'
+			}
+		}
+		return b
+	}
+	return append(b[:start], bytes.ToUpper(b[start:])...)
+}
+
+// toLower lower-cases b[start:].
+func toLower(b []byte, start int) []byte {
+	if isASCII(b[start:]) {
+		for i := start; i < len(b); i++ {
+			if 'A' <= b[i] && b[i] <= 'Z' {
+				b[i] += 'a' - 'A'
+			}
+		}
+		return b
+	}
+	return append(b[:start], bytes.ToLower(b[start:])...)
+}
+
+func isASCII(b []byte) bool {
+	for _, c := range b {
+		if c >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// alternative returns the alternative representation of the number that d
+// rendered in num. In the Hebrew calendar, days and years are written in Hebrew
+// numerals, and other numbers with the locale's alternative digits.
+func (lc *localeData) alternative(d directive, t time.Time, num []byte) (string, bool) {
+	if lc.calendar == Hebrew {
+		year, _, day := lc.date(t)
+		switch {
+		case d.conv == 'd' || d.conv == 'e':
+			return string(appendGematria(nil, day)), true
+		case d.conv == 'y' && year%1000 > 0:
+			return string(appendGematria(nil, year%1000)), true
+		}
+	}
+	return lc.altDigits(num)
+}
+
+// altDigits returns the locale's alternative digits for a number rendered in
+// decimal, if it has them.
+func (lc *localeData) altDigits(num []byte) (string, bool) {
+	n, digits := 0, 0
+	for _, c := range num {
+		switch {
+		case '0' <= c && c <= '9':
+			n = n*10 + int(c-'0')
+			digits++
+		case c != ' ' || digits > 0:
+			return "", false
+		}
+		if n >= len(lc.AltDigits) {
+			return "", false
+		}
+	}
+
+	if digits == 0 {
+		return "", false
+	}
+	return lc.AltDigits[n], true
+}
+
+// isNumeric reports whether a conversion produces a padded number.
+func isNumeric(c byte) bool {
+	switch c {
+	case 'C', 'd', 'e', 'g', 'G', 'H', 'I', 'j', 'k', 'l', 'm', 'M', 'q', 's',
+		'S', 'u', 'U', 'V', 'w', 'W', 'y', 'Y', 'z':
+		return true
+	}
+	return false
+}
+
+// isFraction reports whether a conversion produces a fraction of a second,
+// whose width is its number of digits rather than padding.
+func isFraction(c byte) bool {
+	return c == 'f' || c == 'L' || c == 'N'
+}
+
+// isName reports whether a conversion produces a day or month name, which the
+// '#' flag upper-cases.
+func isName(c byte) bool {
+	return c == 'a' || c == 'A' || c == 'b' || c == 'B' || c == 'h'
+}
+
+// appendConv appends the default rendering of a directive, ignoring its
+// flags. It reports false if the conversion character is unknown.
+func (lc *localeData) appendConv(b []byte, d directive, t time.Time) ([]byte, bool) {
+	if d.colons > 0 {
+		switch {
+		case d.conv != 'z':
+			return b, false
+		case d.colons == 1:
+			return lc.perColonz(b, t), true
+		case d.colons == 2:
+			return lc.perColonColonz(b, t), true
+		}
+		return lc.perColon3z(b, t), true
+	}
+	if isFraction(d.conv) {
+		if d.point {
+			b = append(b, lc.decimalPoint()...)
+		}
+		if d.width > 0 {
+			return appendFraction(b, t, d.width), true
+		}
+	} else if d.point {
+		return b, false
+	}
+	if d.mod == 'E' {
+		switch d.conv {
+		case 'c':
+			return lc.perEc(b, t), true
+		case 'C':
+			return lc.perEC(b, t), true
+		case 'x':
+			return lc.perEx(b, t), true
+		case 'X':
+			return lc.perEX(b, t), true
+		case 'y':
+			return lc.perEy(b, t), true
+		case 'Y':
+			return lc.perEY(b, t), true
+		case 'V':
+			return lc.perEV(b, t), true
+		case 'a':
+			return lc.perEa(b, t), true
+		case 'b', 'h':
+			return lc.perEb(b, t), true
+		}
+	}
+	if d.genitive && d.mod == 0 {
+		switch d.conv {
+		case 'b', 'h':
+			return lc.perGenitiveb(b, t), true
+		case 'B':
+			return lc.perGenitiveB(b, t), true
+		}
+	}
+
+	switch d.conv {
+	case 'a':
+		return lc.pera(b, t), true
+	case 'A':
+		return lc.perA(b, t), true
+	case 'b':
+		return lc.perb(b, t), true
+	case 'B':
+		return lc.perB(b, t), true
+	case 'c':
+		return lc.perc(b, t), true
+	case 'C':
+		return lc.perC(b, t), true
+	case 'd':
+		return lc.perd(b, t), true
+	case 'D':
+		return lc.perD(b, t), true
+	case 'e':
+		return lc.pere(b, t), true
+	case 'f':
+		return lc.perf(b, t), true
+	case 'F':
+		return lc.perF(b, t), true
+	case 'g':
+		return lc.perg(b, t), true
+	case 'G':
+		return lc.perG(b, t), true
+	case 'h':
+		return lc.perh(b, t), true
+	case 'H':
+		return lc.perH(b, t), true
+	case 'I':
+		return lc.perI(b, t), true
+	case 'j':
+		return lc.perj(b, t), true
+	case 'k':
+		return lc.perk(b, t), true
+	case 'l':
+		return lc.perl(b, t), true
+	case 'm':
+		return lc.perm(b, t), true
+	case 'L':
+		return lc.perL(b, t), true
+	case 'M':
+		return lc.perM(b, t), true
+	case 'n':
+		return lc.pern(b, t), true
+	case 'N':
+		return lc.perN(b, t), true
+	case 'p':
+		return lc.perp(b, t), true
+	case 'P':
+		return lc.perP(b, t), true
+	case 'q':
+		return lc.perq(b, t), true
+	case 'r':
+		return lc.perr(b, t), true
+	case 'R':
+		return lc.perR(b, t), true
+	case 's':
+		return lc.pers(b, t), true
+	case 'S':
+		return lc.perS(b, t), true
+	case 't':
+		return lc.pert(b, t), true
+	case 'T':
+		return lc.perT(b, t), true
+	case 'u':
+		return lc.peru(b, t), true
+	case 'U':
+		return lc.perU(b, t), true
+	case 'V':
+		return lc.perV(b, t), true
+	case 'w':
+		return lc.perw(b, t), true
+	case 'W':
+		return lc.perW(b, t), true
+	case 'x':
+		return lc.perx(b, t), true
+	case 'X':
+		return lc.perX(b, t), true
+	case 'y':
+		return lc.pery(b, t), true
+	case 'Y':
+		return lc.perY(b, t), true
+	case 'z':
+		return lc.perz(b, t), true
+	case 'Z':
+		return lc.perZ(b, t), true
+	case '+':
+		return lc.perplus(b, t), true
+	case '%':
+		return lc.perper(b, t), true
+	}
+
+	return b, false
+}
+
+// composite returns the format that a directive is shorthand for.
+func (lc *localeData) composite(d directive) (string, bool) {
+	if d.colons > 0 || d.point {
+		return "", false
+	}
+	if d.mod == 'E' {
+		switch d.conv {
+		case 'c':
+			return lc.eraFormat(lc.EraDateTime, lc.DateTime), true
+		case 'x':
+			return lc.eraFormat(lc.EraDate, lc.Date), true
+		case 'X':
+			return lc.eraFormat(lc.EraTime, lc.Time), true
+		}
+	}
+	if !d.validModifier() {
+		return "", false
+	}
+
+	switch d.conv {
+	case 'c':
+		return lc.DateTime, true
+	case '+':
+		return lc.dateFmt(), true
+	case 'D':
+		return "%m/%d/%y", true
+	case 'F':
+		return "%Y-%m-%d", true
+	case 'r':
+		return lc.TimeAMPM, true
+	case 'R':
+		return "%H:%M", true
+	case 'T':
+		return "%H:%M:%S", true
+	case 'x':
+		return lc.Date, true
+	case 'X':
+		return lc.Time, true
+	}
+
+	return "", false
+}
+
+// genitive reports whether d is a month name that takes the genitive form
+// because format also has a day of the month, as in "%d %B".
+func (lc *localeData) genitive(d directive, format string) bool {
+	if d.conv != 'b' && d.conv != 'B' && d.conv != 'h' {
+		return false
+	}
+	if len(lc.GenitiveMonths) == 0 && len(lc.ShortGenitiveMonths) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
 			continue
 		}
+		d, n := parseDirective(format[i:])
+		if n == 0 {
+			break
+		}
+		if d.conv == 'd' || d.conv == 'e' {
+			return true
+		}
+		i += n - 1
+	}
+	return false
+}
+
+// dateFmt returns the locale's format for %+.
+func (lc *localeData) dateFmt() string {
+	if lc.DateFmt != "" {
+		return lc.DateFmt
+	}
+	return "%a %b %e %H:%M:%S %Z %Y"
+}
 
-		buf.WriteByte(format[i])
-	}
-
-	return buf.String()
-}
-
-func (lc *localeData) parseDirective(direc string, t time.Time) string {
-	if len(direc) < 2 {
-		return direc
-	}
-
-	switch direc[:2] {
-	case "%a":
-		return lc.pera(t)
-	case "%A":
-		return lc.perA(t)
-	case "%b":
-		return lc.perb(t)
-	case "%B":
-		return lc.perB(t)
-	case "%c":
-		return lc.perc(t)
-	case "%C":
-		return lc.perC(t)
-	case "%d":
-		return lc.perd(t)
-	case "%D":
-		return lc.perD(t)
-	case "%e":
-		return lc.pere(t)
-	case "%F":
-		return lc.perF(t)
-	case "%g":
-		return lc.perg(t)
-	case "%G":
-		return lc.perG(t)
-	case "%H":
-		return lc.perH(t)
-	case "%I":
-		return lc.perI(t)
-	case "%j":
-		return lc.perj(t)
-	case "%m":
-		return lc.perm(t)
-	case "%M":
-		return lc.perM(t)
-	case "%n":
-		return lc.pern(t)
-	case "%p":
-		return lc.perp(t)
-	case "%r":
-		return lc.perr(t)
-	case "%R":
-		return lc.perR(t)
-	case "%S":
-		return lc.perS(t)
-	case "%t":
-		return lc.pert(t)
-	case "%T":
-		return lc.perT(t)
-	case "%u":
-		return lc.peru(t)
-	case "%U":
-		return lc.perU(t)
-	case "%V":
-		return lc.perV(t)
-	case "%w":
-		return lc.perw(t)
-	case "%W":
-		return lc.perW(t)
-	case "%x":
-		return lc.perx(t)
-	case "%X":
-		return lc.perX(t)
-	case "%y":
-		return lc.pery(t)
-	case "%Y":
-		return lc.perY(t)
-	case "%z":
-		return lc.perz(t)
-	case "%Z":
-		return lc.perZ(t)
-	case "%%":
-		return lc.perper(t)
-	}
-
-	return direc
+// decimalPoint returns the locale's decimal separator.
+func (lc *localeData) decimalPoint() string {
+	if lc.DecimalPoint != "" {
+		return lc.DecimalPoint
+	}
+	return "."
+}
+
+// eraFormat returns the era variant of a locale format if there is one.
+func (lc *localeData) eraFormat(era, format string) string {
+	if era != "" {
+		return era
+	}
+	return format
 }
@@ -1,12 +1,13 @@
 package lctime
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 	"time"
 )
 
-func TestParseDirective(t *testing.T) {
+func TestAppendDirective(t *testing.T) {
 	lc, err := loadLocale("en_US")
 	if err != nil {
 		t.Fatal(err)
@@ -17,48 +18,51 @@ func TestParseDirective(t *testing.T) {
 		input string
 		want  string
 	}{
-		{"%a", lc.pera(dt)},
-		{"%A", lc.perA(dt)},
-		{"%b", lc.perb(dt)},
-		{"%B", lc.perB(dt)},
-		{"%c", lc.perc(dt)},
-		{"%C", lc.perC(dt)},
-		{"%d", lc.perd(dt)},
-		{"%D", lc.perD(dt)},
-		{"%e", lc.pere(dt)},
-		{"%F", lc.perF(dt)},
-		{"%g", lc.perg(dt)},
-		{"%G", lc.perG(dt)},
-		{"%H", lc.perH(dt)},
-		{"%I", lc.perI(dt)},
-		{"%j", lc.perj(dt)},
-		{"%m", lc.perm(dt)},
-		{"%M", lc.perM(dt)},
-		{"%n", lc.pern(dt)},
-		{"%p", lc.perp(dt)},
-		{"%r", lc.perr(dt)},
-		{"%R", lc.perR(dt)},
-		{"%S", lc.perS(dt)},
-		{"%t", lc.pert(dt)},
-		{"%T", lc.perT(dt)},
-		{"%u", lc.peru(dt)},
-		{"%U", lc.perU(dt)},
-		{"%V", lc.perV(dt)},
-		{"%w", lc.perw(dt)},
-		{"%W", lc.perW(dt)},
-		{"%x", lc.perx(dt)},
-		{"%X", lc.perX(dt)},
-		{"%y", lc.pery(dt)},
-		{"%Y", lc.perY(dt)},
-		{"%z", lc.perz(dt)},
-		{"%Z", lc.perZ(dt)},
-		{"%%", lc.perper(dt)},
+		{"%a", string(lc.pera(nil, dt))},
+		{"%A", string(lc.perA(nil, dt))},
+		{"%b", string(lc.perb(nil, dt))},
+		{"%B", string(lc.perB(nil, dt))},
+		{"%c", string(lc.perc(nil, dt))},
+		{"%C", string(lc.perC(nil, dt))},
+		{"%d", string(lc.perd(nil, dt))},
+		{"%D", string(lc.perD(nil, dt))},
+		{"%e", string(lc.pere(nil, dt))},
+		{"%f", string(lc.perf(nil, dt))},
+		{"%F", string(lc.perF(nil, dt))},
+		{"%g", string(lc.perg(nil, dt))},
+		{"%G", string(lc.perG(nil, dt))},
+		{"%H", string(lc.perH(nil, dt))},
+		{"%I", string(lc.perI(nil, dt))},
+		{"%j", string(lc.perj(nil, dt))},
+		{"%L", string(lc.perL(nil, dt))},
+		{"%m", string(lc.perm(nil, dt))},
+		{"%M", string(lc.perM(nil, dt))},
+		{"%n", string(lc.pern(nil, dt))},
+		{"%N", string(lc.perN(nil, dt))},
+		{"%p", string(lc.perp(nil, dt))},
+		{"%r", string(lc.perr(nil, dt))},
+		{"%R", string(lc.perR(nil, dt))},
+		{"%S", string(lc.perS(nil, dt))},
+		{"%t", string(lc.pert(nil, dt))},
+		{"%T", string(lc.perT(nil, dt))},
+		{"%u", string(lc.peru(nil, dt))},
+		{"%U", string(lc.perU(nil, dt))},
+		{"%V", string(lc.perV(nil, dt))},
+		{"%w", string(lc.perw(nil, dt))},
+		{"%W", string(lc.perW(nil, dt))},
+		{"%x", string(lc.perx(nil, dt))},
+		{"%X", string(lc.perX(nil, dt))},
+		{"%y", string(lc.pery(nil, dt))},
+		{"%Y", string(lc.perY(nil, dt))},
+		{"%z", string(lc.perz(nil, dt))},
+		{"%Z", string(lc.perZ(nil, dt))},
+		{"%%", string(lc.perper(nil, dt))},
 		{"--", "--"},
 		{"", ""},
 	}
 
 	for i, test := range tests {
-		if got := lc.parseDirective(test.input, dt); got != test.want {
+		if got := string(lc.AppendStrftime(nil, test.input, dt)); got != test.want {
 			t.Errorf(gotWantIdx, i, got, test.want)
 		}
 	}
@@ -89,6 +93,383 @@ func TestStrftime(t *testing.T) {
 	}
 }
 
+func TestStrftimeFlags(t *testing.T) {
+	l, err := loadLocale("POSIX")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gmt := time.FixedZone("GMT", 0)
+	dt1 := time.Date(2015, 12, 5, 3, 2, 1, 0, gmt)
+	dt2 := time.Date(2008, 7, 14, 15, 45, 9, 0, gmt)
+
+	// Recorded from glibc strftime in the C locale.
+	tests := []struct {
+		input string
+		want1 string
+		want2 string
+	}{
+		{"%-d", "5", "14"},
+		{"%_d", " 5", "14"},
+		{"%0e", "05", "14"},
+		{"%-e", "5", "14"},
+		{"%10d", "0000000005", "0000000014"},
+		{"%_10d", "         5", "        14"},
+		{"%-10d", "         5", "        14"},
+		{"%1d", "05", "14"},
+		{"%-m/%-d/%y", "12/5/15", "7/14/08"},
+		{"%_H:%-M", " 3:2", "15:45"},
+		{"%-I", "3", "3"},
+		{"%_I", " 3", " 3"},
+		{"%-j", "339", "196"},
+		{"%_j", "339", "196"},
+		{"%_3j", "339", "196"},
+		{"%5j", "00339", "00196"},
+		{"%10Y", "0000002015", "0000002008"},
+		{"%_Y", "2015", "2008"},
+		{"%-y", "15", "8"},
+		{"%-C", "20", "20"},
+		{"%_5e", "    5", "   14"},
+		{"%010e", "0000000005", "0000000014"},
+		{"%^a", "SAT", "MON"},
+		{"%^A", "SATURDAY", "MONDAY"},
+		{"%^B", "DECEMBER", "JULY"},
+		{"%#b", "DEC", "JUL"},
+		{"%#B", "DECEMBER", "JULY"},
+		{"%#A", "SATURDAY", "MONDAY"},
+		{"%10B", "  December", "      July"},
+		{"%-10B", "  December", "      July"},
+		{"%010B", "00December", "000000July"},
+		{"%_10b", "       Dec", "       Jul"},
+		{"%^10a", "       SAT", "       MON"},
+		{"%#p", "am", "pm"},
+		{"%^p", "AM", "PM"},
+		{"%#Z", "gmt", "gmt"},
+		{"%^Z", "GMT", "GMT"},
+		{"%^#Z", "gmt", "gmt"},
+		{"%^c", "SAT DEC  5 03:02:01 2015", "MON JUL 14 15:45:09 2008"},
+		{"%#c", "Sat Dec  5 03:02:01 2015", "Mon Jul 14 15:45:09 2008"},
+		{"%^x", "12/05/15", "07/14/08"},
+		{"%30c", "      Sat Dec  5 03:02:01 2015", "      Mon Jul 14 15:45:09 2008"},
+		{"%5%", "    %", "    %"},
+		{"%-%", "%", "%"},
+		{"%10Q", "      %10Q", "      %10Q"},
+		{"%-", "%-", "%-"},
+		{"%^", "%^", "%^"},
+		{"%-u", "6", "1"},
+		{"%_w", "6", "1"},
+		{"%_V", "49", "29"},
+		{"%-G", "2015", "2008"},
+		{"%_g", "15", " 8"},
+		{"%-S", "1", "9"},
+		{"%_M", " 2", "45"},
+		{"%-H", "3", "15"},
+		{"%k", " 3", "15"},
+		{"%l", " 3", " 3"},
+		{"%-k", "3", "15"},
+		{"%0k", "03", "15"},
+		{"%P", "am", "pm"},
+		{"%^P", "am", "pm"},
+		{"%#P", "am", "pm"},
+		{"%q", "4", "3"},
+		{"%3q", "004", "003"},
+		{"%h", "Dec", "Jul"},
+		{"%^h", "DEC", "JUL"},
+		{"%s", "1449284521", "1216050309"},
+		{"%12s", "001449284521", "001216050309"},
+		{"%_12s", "  1449284521", "  1216050309"},
+		{"%+", "Sat Dec  5 03:02:01 GMT 2015", "Mon Jul 14 15:45:09 GMT 2008"},
+		{"%^+", "SAT DEC  5 03:02:01 GMT 2015", "MON JUL 14 15:45:09 GMT 2008"},
+	}
+
+	for i, test := range tests {
+		if got := l.Strftime(test.input, dt1); got != test.want1 {
+			t.Errorf(gotWantIdx, i, got, test.want1)
+		}
+		if got := l.Strftime(test.input, dt2); got != test.want2 {
+			t.Errorf(gotWantIdx, i, got, test.want2)
+		}
+	}
+}
+
+func TestStrftimeLocaleFlags(t *testing.T) {
+	dt := time.Date(2015, 12, 5, 3, 2, 1, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		input  string
+		want   string
+	}{
+		{"cs_CZ", "%x", "5.12.2015"},
+		{"cs_CZ", "%c", "So 5. prosince 2015, 03:02:01 UTC"},
+		{"pl_PL", "%c", "sob, 5 gru 2015, 03:02:01"},
+		{"xh_ZA", "%c", "Mgq 5 Mng 2015 03:02:01 UTC"},
+		{"de_DE", "%^B", "DEZEMBER"},
+		{"ru_RU", "%^B", "ДЕКАБРЬ"},
+		{"ru_RU", "%10b", "      дек."},
+		{"ru_RU", "%-10b", "      дек."},
+		{"ru_RU", "%+", "Сб. 5 дек. 2015 03:02:01 UTC"},
+		{"ko_KR", "%+", "2015. 12. 05. (토) 03:02:01 UTC"},
+	}
+
+	for i, test := range tests {
+		got, err := StrftimeLoc(test.locale, test.input, dt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestStrftimeModifiers(t *testing.T) {
+	dt := time.Date(2015, 12, 5, 3, 2, 1, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		input  string
+		want   string
+	}{
+		{"th_TH", "%x", "05/12/2558"},
+		{"th_TH", "%Ex", " 5 ธ.ค. 2558"},
+		{"th_TH", "%EC %Ey", "พ.ศ. 2558"},
+		{"th_TH", "%EY", "พ.ศ. 2558"},
+		{"th_TH", "%EX", "03.02.01 น."},
+		{"th_TH", "%Ec", "วันเสาร์ที่  5 ธันวาคม พ.ศ. 2558, 03.02.01 น."},
+		{"lo_LA", "%EY", "ພ.ສ. 2558"},
+		{"fa_IR", "%x", "۹۴/۰۹/۱۴"},
+		{"fa_IR", "%X", "۰۳:۰۲:۰۱"},
+		{"fa_IR", "%Oe", "۱۴"},
+		{"or_IN", "%x", "୫-୧୨-୧୫"},
+		{"or_IN", "%_3Od", "  ୫"},
+		{"or_IN", "%OY", "%OY"},
+		{"en_US", "%Ey %EC %EY", "15 20 2015"},
+		{"en_US", "%Ex", "12/05/2015"},
+		{"en_US", "%Od %OH", "05 03"},
+		{"en_US", "%EA %Ed %Oa %OY", "%EA %Ed %Oa %OY"},
+		{"en_US", "%Ea %Eb %Eh", "S D D"},
+		{"de_DE", "%Ea %^Eb", "S D"},
+		{"zh_CN", "%Ea %Eb", "六 12"},
+		{"th_TH", "%Ea %Eb", "ส ธ.ค."},
+		{"ar_EG", "%Ea", "س"},
+		{"en_US", "%E5d %O-d", "%E5d %O-d"},
+		{"en_US", "%-Om %_4Oy", "12   15"},
+	}
+
+	for i, test := range tests {
+		got, err := StrftimeLoc(test.locale, test.input, dt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestStrftimeOffset(t *testing.T) {
+	dt := time.Date(2015, 12, 5, 3, 2, 1, 0, time.FixedZone("NPT", 20700))
+
+	// Recorded from GNU date, except for the widths, where glibc misplaces
+	// the sign and gnulib's behavior is followed instead.
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"%z", "+0545"},
+		{"%Ez %Oz", "+0545 +0545"},
+		{"%:z", "+05:45"},
+		{"%::z", "+05:45:00"},
+		{"%:::z", "+05:45"},
+		{"%-z", "+545"},
+		{"%10z", "+000000545"},
+		{"%_10z", "      +545"},
+		{"%:a %::Y", "%:a %::Y"},
+		{"%::::z", "%::::z"},
+	}
+
+	for i, test := range tests {
+		got, err := StrftimeLoc("POSIX", test.input, dt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+
+	// At a zero offset, only the hours lose their zeros.
+	utc := time.Date(2015, 12, 5, 3, 2, 1, 0, time.UTC)
+	tests = []struct {
+		input string
+		want  string
+	}{
+		{"%-z", "+0"},
+		{"%-:z", "+0:00"},
+		{"%-::z", "+0:00:00"},
+		{"%_10:z", "     +0:00"},
+		{"%10:z", "+000000:00"},
+	}
+
+	for i, test := range tests {
+		got, err := StrftimeLoc("POSIX", test.input, utc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestStrftimeFraction(t *testing.T) {
+	dt1 := time.Date(2024, 2, 22, 0, 36, 21, 795187684, time.UTC)
+	dt2 := time.Date(2024, 2, 22, 0, 36, 21, 5, time.UTC)
+
+	tests := []struct {
+		locale string
+		input  string
+		want1  string
+		want2  string
+	}{
+		{"POSIX", "%N", "795187684", "000000005"},
+		{"POSIX", "%3N", "795", "000"},
+		{"POSIX", "%6N", "795187", "000000"},
+		{"POSIX", "%10N", "0795187684", "0000000005"},
+		{"POSIX", "%12N", "000795187684", "000000000005"},
+		{"POSIX", "%L", "795", "000"},
+		{"POSIX", "%f", "795187", "000000"},
+		{"POSIX", "%T.%L", "00:36:21.795", "00:36:21.000"},
+		{"POSIX", "%T%.3N", "00:36:21.795", "00:36:21.000"},
+		{"de_DE", "%T%.3N", "00:36:21,795", "00:36:21,000"},
+		{"de_DE", "%S%.f", "21,795187", "21,000000"},
+		{"fa_IR", "%.L", "٫795", "٫000"},
+		{"POSIX", "%-N %_N %^N", "795187684 795187684 795187684", "000000005 000000005 000000005"},
+		{"POSIX", "%EN %OL %.d %.c", "%EN %OL %.d %.c", "%EN %OL %.d %.c"},
+	}
+
+	for i, test := range tests {
+		l, err := NewLocalizer(test.locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.Strftime(test.input, dt1); got != test.want1 {
+			t.Errorf(gotWantIdx, i, got, test.want1)
+		}
+		f, err := l.Compile(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := f.Strftime(dt2); got != test.want2 {
+			t.Errorf(gotWantIdx, i, got, test.want2)
+		}
+	}
+}
+
+func TestStrftimeGenitive(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+
+	tests := []struct {
+		locale string
+		input  string
+		want   string
+	}{
+		{"ru_RU", "%d %B %Y", "25 декабря 2015"},
+		{"ru_RU", "%B %Y", "Декабрь 2015"},
+		{"ru_RU", "%e %b", "25 дек."},
+		{"ru_RU", "%-d %OB", "25 Декабрь"},
+		{"ru_RU", "%^B %d", "ДЕКАБРЯ 25"},
+		{"ru_RU", "%x, %B", "25.12.2015, Декабрь"},
+		{"pl_PL", "%-d %B %Y", "25 grudnia 2015"},
+		{"pl_PL", "%B %Y", "grudzień 2015"},
+		{"uk_UA", "%d %B", "25 грудня"},
+		{"uk_UA", "%OB", "грудень"},
+		{"cs_CZ", "%-d. %B", "25. prosince"},
+		{"lt_LT", "%B %d d.", "gruodžio 25 d."},
+		{"lt_LT", "%Y m. %B", "2015 m. gruodis"},
+		{"el_GR", "%d %B %Y", "25 Δεκεμβρίου 2015"},
+		{"el_GR", "%B %Y", "Δεκέμβριος 2015"},
+		{"de_DE", "%d. %B %Y", "25. Dezember 2015"},
+		{"fi_FI", "%e. %B", "25. joulukuuta"},
+		{"fi_FI", "%B %Y", "joulukuu 2015"},
+		{"fi_FI", "%c", "pe 25. joulukuuta 2015 03.02.01"},
+	}
+
+	for i, test := range tests {
+		l, err := NewLocalizer(test.locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := l.Strftime(test.input, dt); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+		f, err := l.Compile(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := f.Strftime(dt); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestAppendStrftime(t *testing.T) {
+	SetLocale("en_US")
+	dt := time.Date(2444, 3, 8, 3, 8, 59, 284117260, time.UTC)
+
+	tests := []struct {
+		dst   string
+		input string
+		want  string
+	}{
+		{"", "", ""},
+		{"x", "%a", "xTue"},
+		{"abc ", "%c", "abc Tue 08 Mar 2444 03:08:59 AM UTC"},
+		{"", "%FT%T%z", "2444-03-08T03:08:59+0000"},
+	}
+
+	for i, test := range tests {
+		if got := string(AppendStrftime([]byte(test.dst), test.input, dt)); got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestStrftimeTo(t *testing.T) {
+	SetLocale("en_US")
+	dt := time.Date(2444, 3, 8, 3, 8, 59, 284117260, time.UTC)
+
+	var buf bytes.Buffer
+	n, err := StrftimeTo(&buf, "%A %B %Y", dt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := buf.String(), "Tuesday March 2444"; got != want {
+		t.Errorf(gotWant, got, want)
+	}
+	if n != buf.Len() {
+		t.Errorf(gotWant, n, buf.Len())
+	}
+}
+
+func TestAppendStrftimeAllocs(t *testing.T) {
+	l, err := loadLocale("en_US")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+	buf := make([]byte, 0, 64)
+
+	for _, format := range []string{"%c", "%F %T", "%x %X %z %j %U %V %G"} {
+		allocs := testing.AllocsPerRun(100, func() {
+			buf = l.AppendStrftime(buf[:0], format, dt)
+		})
+		if allocs != 0 {
+			t.Errorf(gotWantKey, format, allocs, 0)
+		}
+	}
+}
+
 func ExampleStrftime() {
 	// Initial locale based on env vars. If not set, then POSIX is used.
 	t := time.Date(2000, 1, 2, 3, 4, 5, 6, time.UTC)
@@ -117,3 +498,21 @@ func ExampleLocalizer() {
 	fmt.Println(l.Strftime("%A den %d. %B %Y", t))
 	// Output: fredag den 25. december 2015
 }
+
+func BenchmarkAppendStrftime(b *testing.B) {
+	l, err := loadLocale("en_US")
+	if err != nil {
+		b.Fatal(err)
+	}
+	dt := time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)
+
+	for _, format := range []string{"%c", "%F %T"} {
+		b.Run(format, func(b *testing.B) {
+			buf := make([]byte, 0, 64)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf = l.AppendStrftime(buf[:0], format, dt)
+			}
+		})
+	}
+}
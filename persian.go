@@ -0,0 +1,87 @@
+package lctime
+
+// persian is the Solar Hijri calendar. Its year starts at the vernal equinox,
+// and the first six months have 31 days, the next five 30, and Esfand 29, or
+// 30 in a leap year. Leap years follow Borkowski's arithmetic, which matches
+// the astronomical calendar for the years 1 to 3177 AP.
+type persian struct{}
+
+// persianBreaks are the years that start a new series of 33-year cycles.
+var persianBreaks = [...]int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181,
+	1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// persianYear returns the Gregorian year in which a Persian year starts, the
+// day of March on which it starts and the number of years since the last
+// leap year, which is 0 in a leap year.
+func persianYear(year int) (gy, march, leap int) {
+	gy = year + 621
+	leapJ, jp, jump := -14, persianBreaks[0], 0
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return gy, march, leap
+}
+
+func (persian) date(days int) (year, month, day int) {
+	gy, _, _ := gregorianDate(days)
+	year = gy - 621
+	_, march, leap := persianYear(year)
+
+	k := days - gregorianDays(gy, 3, march)
+	switch {
+	case k >= 0 && k <= 185:
+		return year, 1 + k/31, k%31 + 1
+	case k >= 0:
+		k -= 186
+	default:
+		year--
+		k += 179
+		if leap == 1 {
+			k++
+		}
+	}
+	return year, 7 + k/30, k%30 + 1
+}
+
+func (persian) maxYearDay() int {
+	return 366
+}
+
+func (persian) days(year, month, day int) (int, bool) {
+	gy, march, leap := persianYear(year)
+
+	length := 31
+	switch {
+	case month == 12 && leap == 0:
+		length = 30
+	case month == 12:
+		length = 29
+	case month >= 7:
+		length = 30
+	}
+	ok := month >= 1 && month <= 12 && day >= 1 && day <= length
+
+	days := gregorianDays(gy, 3, march) + (month-1)*31 - month/7*(month-7) + day - 1
+	return days, ok
+}
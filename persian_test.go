@@ -0,0 +1,69 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPersianDate(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		gy, gm, gd       int
+	}{
+		{1348, 10, 11, 1970, 1, 1},
+		{1394, 10, 4, 2015, 12, 25},
+		{1399, 12, 30, 2021, 3, 20},
+		{1400, 1, 1, 2021, 3, 21},
+		{1403, 1, 1, 2024, 3, 20},
+		{1403, 12, 30, 2025, 3, 20},
+		{1404, 1, 1, 2025, 3, 21},
+		{1404, 6, 31, 2025, 9, 22},
+		{1404, 7, 1, 2025, 9, 23},
+		{1404, 12, 29, 2026, 3, 20},
+		{1405, 7, 26, 2026, 10, 18},
+	}
+
+	for _, test := range tests {
+		days := gregorianDays(test.gy, test.gm, test.gd)
+		year, month, day := persian{}.date(days)
+		if year != test.year || month != test.month || day != test.day {
+			t.Errorf(gotWantKey, fmt.Sprint(test.gy, "-", test.gm, "-", test.gd),
+				fmt.Sprint(year, "/", month, "/", day),
+				fmt.Sprint(test.year, "/", test.month, "/", test.day))
+		}
+
+		got, ok := persian{}.days(test.year, test.month, test.day)
+		if got != days || !ok {
+			t.Errorf(gotWantKey, fmt.Sprint(test.year, "/", test.month, "/", test.day),
+				got, days)
+		}
+	}
+}
+
+func TestPersianDays(t *testing.T) {
+	// Every day from 1900 to 2100 follows the previous one.
+	first := gregorianDays(1900, 1, 1)
+	year, month, day := persian{}.date(first)
+	for days := first; days < gregorianDays(2100, 1, 1); days++ {
+		y, m, d := persian{}.date(days)
+		next := d == day+1 && m == month && y == year ||
+			d == 1 && m == month+1 && y == year ||
+			d == 1 && m == 1 && month == 12 && y == year+1
+		if days > first && !next {
+			t.Fatalf(gotWantKey, fmt.Sprint(days), fmt.Sprint(y, "/", m, "/", d),
+				fmt.Sprint(year, "/", month, "/", day, " + 1"))
+		}
+		if got, ok := (persian{}).days(y, m, d); got != days || !ok {
+			t.Fatalf(gotWantKey, fmt.Sprint(y, "/", m, "/", d), got, days)
+		}
+		year, month, day = y, m, d
+	}
+
+	invalid := [][3]int{{1403, 13, 1}, {1403, 0, 1}, {1404, 12, 30}, {1404, 7, 31},
+		{1404, 1, 0}}
+	for _, d := range invalid {
+		if _, ok := (persian{}).days(d[0], d[1], d[2]); ok {
+			t.Errorf(gotWantKey, fmt.Sprint(d), ok, false)
+		}
+	}
+}
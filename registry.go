@@ -0,0 +1,131 @@
+package lctime
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/klauspost/lctime/internal/locales"
+)
+
+// ErrInvalidLocaleID is returned when registering a locale under an empty
+// identifier or one with a codeset.
+var ErrInvalidLocaleID = errors.New("Invalid locale identifier")
+
+// catalog lists the available locales. It's replaced rather than modified,
+// so lookups don't need a lock.
+type catalog struct {
+	names []string               // sorted
+	set   map[string]*LocaleData // nil for embedded locales
+}
+
+var (
+	catalogOnce sync.Once
+	catalogMu   sync.Mutex // serializes registrations
+	catalogVal  atomic.Value
+)
+
+// loadCatalog returns the current catalog.
+func loadCatalog() *catalog {
+	catalogOnce.Do(func() {
+		c := &catalog{set: make(map[string]*LocaleData)}
+		c.names = locale.Names()
+		for _, id := range c.names {
+			c.set[id] = nil
+		}
+		catalogVal.Store(c)
+	})
+	return catalogVal.Load().(*catalog)
+}
+
+// registered returns the data of a registered locale.
+func registered(id string) (LocaleData, bool) {
+	if d := loadCatalog().set[id]; d != nil {
+		return *d, true
+	}
+	return LocaleData{}, false
+}
+
+// RegisterLocale adds a locale, or replaces an existing one with the same
+// identifier. The identifier may not contain a codeset, and data.ID is set
+// to it. An invalid definition is reported as a *LocaleError. The locale is
+// available to every function taking a locale as soon as RegisterLocale
+// returns, and if it's the active locale, SetLocale is applied to it again.
+func RegisterLocale(id string, data LocaleData) error {
+	if id == "" || strings.Contains(id, ".") {
+		return ErrInvalidLocaleID
+	}
+
+	data = data.clone()
+	data.ID = id
+	if err := data.validate(); err != nil {
+		return err
+	}
+	lc, err := newLocaleData(data)
+	if err != nil {
+		return err
+	}
+
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+
+	old := loadCatalog()
+	c := &catalog{set: make(map[string]*LocaleData, len(old.set)+1)}
+	for k, v := range old.set {
+		c.set[k] = v
+	}
+	c.names = old.names
+	if _, ok := old.set[id]; !ok {
+		c.names = append(append([]string(nil), old.names...), id)
+		sort.Strings(c.names)
+	}
+	c.set[id] = &data
+	catalogVal.Store(c)
+
+	loaded.Store(id, lc)
+	if current().ID == id {
+		active.Store(lc)
+	}
+	return nil
+}
+
+// OverrideLocale changes an existing locale. The function gets a copy of the
+// locale's data to modify, which is then registered in place of the
+// original, as in:
+//
+//	lctime.OverrideLocale("en_US", func(d *lctime.LocaleData) {
+//		d.ShortMonths[8] = "Sept"
+//	})
+//
+// The identifier is resolved like ResolveLocale does, and ErrNoLocale is
+// returned if it doesn't exist.
+func OverrideLocale(id string, fn func(*LocaleData)) error {
+	name, err := ResolveLocale(id)
+	if err != nil {
+		return err
+	}
+	lc, err := loadLocale(name)
+	if err != nil {
+		return err
+	}
+
+	data := lc.LocaleData.clone()
+	fn(&data)
+	return RegisterLocale(name, data)
+}
+
+// clone returns a copy of d that shares no slices with it.
+func (d LocaleData) clone() LocaleData {
+	c := d
+	for _, s := range []*[]string{&c.Days, &c.ShortDays, &c.Months,
+		&c.ShortMonths, &c.AMPM, &c.GenitiveMonths, &c.ShortGenitiveMonths,
+		&c.NarrowDays, &c.NarrowMonths, &c.AltDigits, &c.Era, &c.PersianMonths,
+		&c.IslamicMonths, &c.HebrewMonths} {
+		if *s != nil {
+			*s = append([]string(nil), (*s)...)
+		}
+	}
+	return c
+}
@@ -0,0 +1,142 @@
+package lctime
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRegisterLocale(t *testing.T) {
+	dt := time.Date(2015, 9, 25, 15, 2, 1, 0, time.UTC)
+	data := LocaleData{
+		Days:        []string{"Sun", "Mun", "Tun", "Wen", "Thu", "Fru", "Sat"},
+		ShortDays:   []string{"Su", "Mu", "Tu", "We", "Th", "Fr", "Sa"},
+		Months:      []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+		ShortMonths: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+		AMPM:        []string{"am", "pm"},
+		Date:        "%d|%m|%Y",
+		DateTime:    "%A %x",
+		Time:        "%T",
+		TimeAMPM:    "%I:%M:%S %p",
+	}
+
+	if err := RegisterLocale("qx_QX", data); err != nil {
+		t.Fatal(err)
+	}
+	data.Days[5] = "changed"
+
+	got, err := StrftimeLoc("qx_QX", "%c", dt)
+	if want := "Fru 25|09|2015"; err != nil || got != want {
+		t.Errorf(gotWant, got, want)
+	}
+	if got, _ := ResolveLocale("qx"); got != "qx_QX" {
+		t.Errorf(gotWant, got, "qx_QX")
+	}
+
+	found := false
+	for _, l := range GetLocales() {
+		found = found || l == "qx_QX"
+	}
+	if !found {
+		t.Error("qx_QX missing from GetLocales")
+	}
+
+	// Replacing the active locale takes effect immediately.
+	if err := SetLocale("qx_QX"); err != nil {
+		t.Fatal(err)
+	}
+	data.Date = "%Y"
+	if err := RegisterLocale("qx_QX", data); err != nil {
+		t.Fatal(err)
+	}
+	if got := Strftime("%x", dt); got != "2015" {
+		t.Errorf(gotWant, got, "2015")
+	}
+	if got := GetLocale(); got != "qx_QX" {
+		t.Errorf(gotWant, got, "qx_QX")
+	}
+}
+
+// recursiveEra is a locale whose %+ and era format expand to each other.
+var recursiveEra = LocaleData{
+	Days:        []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+	ShortDays:   []string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+	Months:      []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+	ShortMonths: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12"},
+	AMPM:        []string{"am", "pm"},
+	Date:        "%d|%m|%Y",
+	DateTime:    "%x",
+	Time:        "%T",
+	DateFmt:     "%EY",
+	Era:         []string{"+:1:2000/01/01:+*:X:%+"},
+}
+
+func TestRegisterLocaleErrors(t *testing.T) {
+	tests := []struct {
+		id   string
+		data LocaleData
+		want error
+	}{
+		{"", LocaleData{}, ErrInvalidLocaleID},
+		{"qx_QX.UTF-8", LocaleData{}, ErrInvalidLocaleID},
+		{"qx_QY", LocaleData{Era: []string{"bad"}}, ErrCorruptLocale},
+		{"qx_QY", recursiveEra, ErrCorruptLocale},
+	}
+
+	for i, test := range tests {
+		got := RegisterLocale(test.id, test.data)
+		if !errors.Is(got, test.want) {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+		if _, ok := got.(*LocaleError); test.data.Days != nil && !ok {
+			t.Errorf(gotWantIdx, i, got, "*LocaleError")
+		}
+	}
+
+	if _, err := StrftimeLoc("qx_QY", "%+", time.Now()); err != ErrNoLocale {
+		t.Errorf(gotWant, err, ErrNoLocale)
+	}
+
+	if got := OverrideLocale("fake", func(*LocaleData) {}); got != ErrNoLocale {
+		t.Errorf(gotWant, got, ErrNoLocale)
+	}
+}
+
+func TestOverrideLocale(t *testing.T) {
+	dt := time.Date(2015, 9, 25, 15, 2, 1, 0, time.UTC)
+	l, err := loadLocale("en_AU")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer RegisterLocale("en_AU", l.LocaleData)
+
+	err = OverrideLocale("en-au", func(d *LocaleData) {
+		d.ShortMonths[8] = "Sept"
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, _ := StrftimeLoc("en_AU", "%d %b", dt); got != "25 Sept" {
+		t.Errorf(gotWant, got, "25 Sept")
+	}
+	if got := l.Strftime("%d %b", dt); got != "25 Sep" {
+		t.Errorf(gotWant, got, "25 Sep")
+	}
+}
+
+func ExampleOverrideLocale() {
+	t := time.Date(2015, 9, 25, 15, 2, 1, 0, time.UTC)
+	err := OverrideLocale("en_GB", func(d *LocaleData) {
+		d.ShortMonths[8] = "Sept"
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	s, _ := StrftimeLoc("en_GB", "%e %b %Y", t)
+	fmt.Println(s)
+	// Output: 25 Sept 2015
+}
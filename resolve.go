@@ -0,0 +1,195 @@
+package lctime
+
+import "strings"
+
+// localeAliases maps the names from glibc's locale.alias, without their
+// codesets, as well as the C locale to the locales they stand for.
+var localeAliases = map[string]string{
+	"c":          "POSIX",
+	"posix":      "POSIX",
+	"bokmal":     "nb_NO",
+	"bokmål":     "nb_NO",
+	"catalan":    "ca_ES",
+	"croatian":   "hr_HR",
+	"czech":      "cs_CZ",
+	"danish":     "da_DK",
+	"dansk":      "da_DK",
+	"deutsch":    "de_DE",
+	"dutch":      "nl_NL",
+	"eesti":      "et_EE",
+	"estonian":   "et_EE",
+	"finnish":    "fi_FI",
+	"français":   "fr_FR",
+	"french":     "fr_FR",
+	"galego":     "gl_ES",
+	"galician":   "gl_ES",
+	"german":     "de_DE",
+	"greek":      "el_GR",
+	"hebrew":     "he_IL",
+	"hrvatski":   "hr_HR",
+	"hungarian":  "hu_HU",
+	"icelandic":  "is_IS",
+	"italian":    "it_IT",
+	"japanese":   "ja_JP",
+	"korean":     "ko_KR",
+	"lithuanian": "lt_LT",
+	"no_no":      "nb_NO",
+	"norwegian":  "nb_NO",
+	"nynorsk":    "nn_NO",
+	"polish":     "pl_PL",
+	"portuguese": "pt_PT",
+	"romanian":   "ro_RO",
+	"russian":    "ru_RU",
+	"slovak":     "sk_SK",
+	"slovene":    "sl_SI",
+	"slovenian":  "sl_SI",
+	"spanish":    "es_ES",
+	"swedish":    "sv_SE",
+	"thai":       "th_TH",
+	"turkish":    "tr_TR",
+}
+
+// defaultTerritories holds the territory used for a bare language when it
+// isn't simply the language in upper case (ru_RU) or the only one available.
+var defaultTerritories = map[string]string{
+	"aa":  "ET",
+	"ar":  "EG",
+	"bn":  "BD",
+	"bo":  "CN",
+	"ca":  "ES",
+	"el":  "GR",
+	"en":  "US",
+	"eu":  "ES",
+	"gez": "ET",
+	"om":  "ET",
+	"pa":  "IN",
+	"pt":  "BR",
+	"sd":  "PK",
+	"sq":  "AL",
+	"sr":  "RS",
+	"sv":  "SE",
+	"sw":  "TZ",
+	"ta":  "IN",
+	"ti":  "ET",
+	"ur":  "PK",
+	"zh":  "CN",
+}
+
+// ResolveLocale returns the identifier of the locale that id refers to, as
+// used by SetLocale and the other functions taking a locale. Besides exact
+// identifiers it accepts:
+//
+//   - any codeset, which is ignored (de_DE.UTF-8)
+//   - hyphens and any case (pt-br)
+//   - glibc's locale aliases (german) and C or C.UTF-8 for POSIX
+//   - a bare language, which gets a default territory (ru becomes ru_RU)
+//   - an unknown modifier, which falls back to the base locale (de_DE@euro)
+//
+// It returns ErrNoLocale if there's no matching locale.
+func ResolveLocale(id string) (string, error) {
+	if name, ok := resolveLocale(id); ok {
+		return name, nil
+	}
+	return "", ErrNoLocale
+}
+
+// resolveLocale implements ResolveLocale.
+func resolveLocale(id string) (string, bool) {
+	id = removeCodeset(id)
+	if hasLocale(id) {
+		return id, true
+	}
+
+	norm := strings.ToLower(strings.Replace(id, "-", "_", -1))
+	if name, ok := localeAliases[norm]; ok {
+		return name, hasLocale(name)
+	}
+
+	lang, territory, modifier := splitLocale(norm)
+	if lang == "" {
+		return "", false
+	}
+
+	mods := []string{modifier}
+	if modifier != "" {
+		mods = append(mods, "")
+	}
+
+	for _, mod := range mods {
+		suffix := ""
+		if mod != "" {
+			suffix = "@" + mod
+		}
+
+		if territory != "" {
+			if name := lang + "_" + territory + suffix; hasLocale(name) {
+				return name, true
+			}
+			continue
+		}
+
+		if hasLocale(lang + suffix) {
+			return lang + suffix, true
+		}
+		if name, ok := defaultLocale(lang, suffix); ok {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// splitLocale splits a normalized identifier in the form
+// language[_territory][@modifier] into its parts. The territory is returned
+// in upper case.
+func splitLocale(id string) (lang, territory, modifier string) {
+	if i := strings.IndexByte(id, '@'); i >= 0 {
+		id, modifier = id[:i], id[i+1:]
+	}
+	if i := strings.IndexByte(id, '_'); i >= 0 {
+		id, territory = id[:i], strings.ToUpper(id[i+1:])
+	}
+	return id, territory, modifier
+}
+
+// defaultLocale returns the locale to use for a bare language, with the
+// given modifier suffix.
+func defaultLocale(lang, suffix string) (string, bool) {
+	if t, ok := defaultTerritories[lang]; ok && hasLocale(lang+"_"+t+suffix) {
+		return lang + "_" + t + suffix, true
+	}
+	if name := lang + "_" + strings.ToUpper(lang) + suffix; hasLocale(name) {
+		return name, true
+	}
+
+	// Otherwise the first territory that has the modifier, or any locale for
+	// the language if no modifier was asked for.
+	prefix, fallback := lang+"_", ""
+	for _, name := range locales() {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		base := name
+		if i := strings.IndexByte(name, '@'); i >= 0 {
+			base = name[:i]
+		}
+		if name == base+suffix {
+			return name, true
+		}
+		if fallback == "" && suffix == "" {
+			fallback = name
+		}
+	}
+	return fallback, fallback != ""
+}
+
+// hasLocale reports whether there's a locale with the exact identifier.
+func hasLocale(id string) bool {
+	_, ok := loadCatalog().set[id]
+	return ok
+}
+
+// locales returns the sorted identifiers of the available locales.
+func locales() []string {
+	return loadCatalog().names
+}
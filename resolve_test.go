@@ -0,0 +1,71 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestResolveLocale(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"en_US", "en_US"},
+		{"en_US.UTF-8", "en_US"},
+		{"sr_RS.UTF-8@latin", "sr_RS@latin"},
+		{"eo", "eo"},
+		{"POSIX", "POSIX"},
+		{"C", "POSIX"},
+		{"C.UTF-8", "POSIX"},
+		{"c.utf8", "POSIX"},
+		{"ru", "ru_RU"},
+		{"RU", "ru_RU"},
+		{"en", "en_US"},
+		{"de", "de_DE"},
+		{"zh", "zh_CN"},
+		{"sv", "sv_SE"},
+		{"pt", "pt_BR"},
+		{"fil", "fil_PH"},
+		{"nan", "nan_TW@latin"},
+		{"pt-BR", "pt_BR"},
+		{"pt-br", "pt_BR"},
+		{"EN_us", "en_US"},
+		{"german", "de_DE"},
+		{"German.ISO-8859-1", "de_DE"},
+		{"français", "fr_FR"},
+		{"no_NO", "nb_NO"},
+		{"de_DE@euro", "de_DE"},
+		{"de_DE.UTF-8@euro", "de_DE"},
+		{"sr@latin", "sr_RS@latin"},
+		{"be@latin", "be_BY@latin"},
+		{"uz_UZ@Cyrillic", "uz_UZ@cyrillic"},
+		{"", ""},
+		{"fake", ""},
+		{"ab_CD", ""},
+		{"en_XX", ""},
+		{"_US", ""},
+	}
+
+	for i, test := range tests {
+		got, err := ResolveLocale(test.input)
+		if got != test.want || (err == nil) != (test.want != "") {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func ExampleResolveLocale() {
+	for _, id := range []string{"ru", "pt-BR", "de_DE.UTF-8@euro", "C"} {
+		name, err := ResolveLocale(id)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(name)
+	}
+	// Output:
+	// ru_RU
+	// pt_BR
+	// de_DE
+	// POSIX
+}
@@ -0,0 +1,862 @@
+package lctime
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxDepth limits how deeply locale formats such as %c may nest.
+const maxDepth = 8
+
+// ParseError describes a problem parsing a time string.
+type ParseError struct {
+	Format string // the format being parsed against
+	Value  string // the value being parsed
+	Offset int    // byte offset in Value where parsing failed
+	Msg    string // description of the problem
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parsing time %q as %q: %s", e.Value, e.Format, e.Msg)
+}
+
+// Strptime parses a string into a time.Time using the same directives as
+// Strftime. It's locale-aware, so make sure you call SetLocale if needed.
+func Strptime(format, value string) (time.Time, error) {
+	return current().Strptime(format, value)
+}
+
+// StrptimeLoc parses a string into a time.Time using the same directives as
+// StrftimeLoc, with names taken from the given locale.
+func StrptimeLoc(locale, format, value string) (time.Time, error) {
+	lc, err := loadLocale(locale)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return lc.Strptime(format, value)
+}
+
+// Strptime parses value according to format. Day, month and AM/PM names are
+// matched case-insensitively against the locale's tables, accepting both the
+// full and abbreviated forms.
+//
+// Elements missing from the format are assumed to be zero or, when zero is
+// impossible, one. An era year without a month and day starts when the era
+// does, so 令和元年 is May 1, 2019. Without %z or %Z the result is in UTC.
+func (lc *localeData) Strptime(format, value string) (time.Time, error) {
+	p := parser{lc: lc, value: value}
+	if msg := p.parse(format, 0); msg != "" {
+		return time.Time{}, &ParseError{format, value, p.pos, msg}
+	}
+
+	if p.pos < len(value) {
+		msg := fmt.Sprintf("extra text %q", value[p.pos:])
+		return time.Time{}, &ParseError{format, value, p.pos, msg}
+	}
+
+	t, msg := p.time()
+	if msg != "" {
+		return time.Time{}, &ParseError{format, value, 0, msg}
+	}
+	return t, nil
+}
+
+// parser holds the state of a single Strptime call. Fields are recorded as
+// they're parsed and combined into a time.Time once the whole value has been
+// consumed.
+type parser struct {
+	lc    *localeData
+	value string
+	pos   int
+	width int  // width of the current directive, if any
+	mod   byte // modifier of the current directive, if any
+
+	year, century, yy   int
+	month, day, yday    int
+	quarter             int
+	isoYear, isoYY      int
+	weekU, weekW, weekV int
+	weekEV              int
+	wday                int
+	hour, min, sec      int
+	nsec                int
+	epoch               int64
+	pm                  bool
+	era                 *era
+	eraYear             int
+
+	offset int
+	zone   string
+
+	haveYear, haveCentury, haveYY bool
+	haveMonth, haveDay, haveYday  bool
+	haveMonthName                 bool
+	haveQuarter, haveEpoch        bool
+	haveISOYear, haveISOYY        bool
+	haveU, haveW, haveV, haveWday bool
+	haveEV                        bool
+	have12, haveAMPM              bool
+	haveOffset, haveZone          bool
+	haveEraYear                   bool
+}
+
+// parse consumes p.value according to format. It returns a non-empty message
+// when the value doesn't match.
+func (p *parser) parse(format string, depth int) string {
+	if depth > maxDepth {
+		return "locale format nested too deeply"
+	}
+
+	end := len(format)
+	for i := 0; i < end; i++ {
+		c := format[i]
+		d, n := directive{}, 0
+		if c == '%' {
+			d, n = parseDirective(format[i:])
+		}
+
+		if n == 0 {
+			if isSpace(c) {
+				p.skipSpace()
+				continue
+			}
+			if p.pos >= len(p.value) || p.value[p.pos] != c {
+				return fmt.Sprintf("cannot parse %q as %q", p.rest(), format[i:])
+			}
+			p.pos++
+			continue
+		}
+
+		if msg := p.parseDirective(d, depth); msg != "" {
+			return msg
+		}
+		i += n - 1
+	}
+
+	return ""
+}
+
+// parseDirective consumes the text of a single directive. Flags are accepted
+// but ignored, except that a width limits the digits read for a number.
+// Numbers written with %O may use the locale's alternative digits, and the
+// %E forms of C, y and Y match the locale's eras.
+func (p *parser) parseDirective(d directive, depth int) string {
+	lc := p.lc
+	if sub, ok := lc.composite(d); ok {
+		return p.parse(sub, depth+1)
+	}
+
+	p.width, p.mod = d.width, d.mod
+
+	conv := d.conv
+	if !d.known() {
+		// Strftime echoes invalid combinations such as %Ed.
+		conv = 0
+	}
+	if d.mod == 'E' && len(lc.eras) > 0 {
+		switch conv {
+		case 'C', 'y', 'Y':
+			return p.parseEra(d, depth)
+		}
+	}
+
+	var ok bool
+	switch conv {
+	case 'a', 'A':
+		if d.mod == 'E' {
+			p.wday, ok = p.name(lc.WeekdayNames(Narrow))
+		} else {
+			p.wday, ok = p.name(lc.Days, lc.ShortDays)
+		}
+		p.haveWday = true
+	case 'b', 'B', 'h':
+		if d.mod == 'E' {
+			p.month, ok = p.name(lc.MonthNames(Narrow))
+		} else {
+			p.month, ok = p.name(lc.Months, lc.ShortMonths, lc.GenitiveMonths,
+				lc.ShortGenitiveMonths)
+		}
+		p.month++
+		p.haveMonth, p.haveMonthName = true, true
+	case 'C':
+		p.century, ok = p.number(0, 99, 2)
+		p.haveCentury = true
+	case 'd', 'e':
+		p.day, ok = p.number(1, 31, 2)
+		p.haveDay = true
+	case 'f':
+		p.nsec, ok = p.fraction(d.point, 6)
+	case 'g':
+		p.isoYY, ok = p.number(0, 99, 2)
+		p.haveISOYY = true
+	case 'G':
+		p.isoYear, ok = p.number(0, 9999, 4)
+		p.haveISOYear = true
+	case 'H', 'k':
+		p.hour, ok = p.number(0, 23, 2)
+		p.have12 = false
+	case 'I', 'l':
+		p.hour, ok = p.number(1, 12, 2)
+		p.have12 = true
+	case 'j':
+		p.yday, ok = p.number(1, lc.maxYearDay(), 3)
+		p.haveYday = true
+	case 'm':
+		months := 12
+		if lc.calendar == Hebrew {
+			months = 13
+		}
+		p.month, ok = p.number(1, months, 2)
+		p.haveMonth, p.haveMonthName = true, false
+	case 'L':
+		p.nsec, ok = p.fraction(d.point, 3)
+	case 'M':
+		p.min, ok = p.number(0, 59, 2)
+	case 'N':
+		p.nsec, ok = p.fraction(d.point, 9)
+	case 'n', 't':
+		p.skipSpace()
+		ok = true
+	case 'p', 'P':
+		var i int
+		i, ok = p.name(lc.LocaleData.AMPM)
+		p.pm = i == 1
+		p.haveAMPM = p.haveAMPM || ok
+	case 'q':
+		p.quarter, ok = p.number(1, 4, 1)
+		p.haveQuarter = true
+	case 's':
+		p.epoch, ok = p.seconds()
+		p.haveEpoch = true
+	case 'S':
+		p.sec, ok = p.number(0, 60, 2)
+	case 'u':
+		p.wday, ok = p.number(1, 7, 1)
+		p.wday %= 7
+		p.haveWday = true
+	case 'U':
+		p.weekU, ok = p.number(0, lc.maxWeek(), 2)
+		p.haveU = true
+	case 'V':
+		if d.mod == 'E' {
+			p.weekEV, ok = p.number(1, 53, 2)
+			p.haveEV = true
+			break
+		}
+		p.weekV, ok = p.number(1, 53, 2)
+		p.haveV = true
+	case 'w':
+		p.wday, ok = p.number(0, 6, 1)
+		p.haveWday = true
+	case 'W':
+		p.weekW, ok = p.number(0, lc.maxWeek(), 2)
+		p.haveW = true
+	case 'y':
+		if d.mod == 'O' && lc.calendar == Hebrew {
+			// Hebrew numerals leave out the thousands of the year.
+			p.year, ok = p.number(1, 999, 3)
+			p.year += 5000
+			p.haveYear = true
+			break
+		}
+		p.yy, ok = p.number(0, 99, 2)
+		p.haveYY = true
+	case 'Y':
+		p.year, ok = p.number(0, 9999, 4)
+		p.haveYear = true
+	case 'z':
+		p.offset, ok = p.zoneOffset()
+		p.haveOffset = true
+	case 'Z':
+		p.zone, ok = p.zoneName()
+		p.haveZone = true
+	default:
+		// Unknown directives are echoed by Strftime, so expect them verbatim.
+		text := d.text
+		if d.conv == '%' {
+			text = "%"
+		}
+		ok = strings.HasPrefix(p.value[p.pos:], text)
+		if ok {
+			p.pos += len(text)
+		}
+	}
+
+	if !ok {
+		return fmt.Sprintf("cannot parse %q as %s", p.rest(), d.text)
+	}
+	return ""
+}
+
+// parseEra consumes %EC, %Ey or %EY.
+func (p *parser) parseEra(d directive, depth int) string {
+	ok := false
+	switch d.conv {
+	case 'C':
+		ok = p.eraName()
+	case 'y':
+		p.eraYear, ok = p.number(0, 9999, 4)
+		p.haveEraYear = true
+	case 'Y':
+		// Each era has its own format, so try them in turn.
+		for i := range p.lc.eras {
+			e, saved := &p.lc.eras[i], *p
+			if p.parse(e.format, depth+1) != "" {
+				*p = saved
+				continue
+			}
+			if !p.haveEraYear {
+				// Formats such as "%EC元年" stand for the first year of
+				// their own era only.
+				if p.era != nil && p.era.name != e.name {
+					*p = saved
+					continue
+				}
+				p.era, p.eraYear, p.haveEraYear = e, e.offset, true
+			}
+			if p.era == nil {
+				p.era = e
+			}
+			ok = true
+			break
+		}
+	}
+
+	if !ok {
+		return fmt.Sprintf("cannot parse %q as %s", p.rest(), d.text)
+	}
+	return ""
+}
+
+// eraName consumes the longest matching era name.
+func (p *parser) eraName() bool {
+	rest := p.rest()
+	length := 0
+
+	for i := range p.lc.eras {
+		name := p.lc.eras[i].name
+		if len(name) <= length || len(name) > len(rest) {
+			continue
+		}
+		if strings.EqualFold(rest[:len(name)], name) {
+			p.era, length = &p.lc.eras[i], len(name)
+		}
+	}
+
+	p.pos += length
+	return length > 0
+}
+
+// rest returns the unparsed remainder of the value.
+func (p *parser) rest() string {
+	return p.value[p.pos:]
+}
+
+// skipSpace consumes zero or more white-space characters.
+func (p *parser) skipSpace() {
+	for p.pos < len(p.value) {
+		r, n := utf8.DecodeRuneInString(p.value[p.pos:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		p.pos += n
+	}
+}
+
+// number consumes a decimal number of up to width digits, optionally preceded
+// by spaces, and checks that it's within [min,max]. After an O modifier the
+// locale's alternative digits are accepted as well.
+func (p *parser) number(min, max, width int) (int, bool) {
+	for p.pos < len(p.value) && p.value[p.pos] == ' ' {
+		p.pos++
+	}
+
+	if p.mod == 'O' {
+		if n, ok := p.altNumber(); ok && n >= min && n <= max {
+			return n, true
+		}
+	}
+
+	if p.width > 0 {
+		width = p.width
+	}
+
+	n, i := 0, p.pos
+	for ; i < len(p.value) && i-p.pos < width; i++ {
+		c := p.value[i]
+		if c < '0' || c > '9' {
+			break
+		}
+		n = n*10 + int(c-'0')
+	}
+
+	if i == p.pos || n < min || n > max {
+		return 0, false
+	}
+
+	p.pos = i
+	return n, true
+}
+
+// seconds consumes a signed number of seconds since the Unix epoch.
+func (p *parser) seconds() (int64, bool) {
+	i := p.pos
+	if i < len(p.value) && p.value[i] == '-' {
+		i++
+	}
+	for i < len(p.value) && '0' <= p.value[i] && p.value[i] <= '9' {
+		i++
+	}
+
+	n, err := strconv.ParseInt(p.value[p.pos:i], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	p.pos = i
+	return n, true
+}
+
+// fraction consumes the digits of a fractional second, up to the width or
+// the given default, and returns them as nanoseconds. Like in Strftime, a
+// width of more than nine digits holds the nanoseconds padded on the left.
+// With point set, the digits must follow the locale's decimal point or a '.'.
+func (p *parser) fraction(point bool, width int) (int, bool) {
+	if point {
+		rest := p.rest()
+		switch dp := p.lc.decimalPoint(); {
+		case strings.HasPrefix(rest, dp):
+			p.pos += len(dp)
+		case strings.HasPrefix(rest, "."):
+			p.pos++
+		default:
+			return 0, false
+		}
+	}
+
+	if p.width > 0 {
+		width = p.width
+	}
+
+	if width > 9 {
+		return p.number(0, 999999999, width)
+	}
+
+	n, scale, i := 0, 1000000000, p.pos
+	for ; i < len(p.value) && i-p.pos < width; i++ {
+		c := p.value[i]
+		if c < '0' || c > '9' {
+			break
+		}
+		scale /= 10
+		n += int(c-'0') * scale
+	}
+
+	if i == p.pos {
+		return 0, false
+	}
+	p.pos = i
+	return n, true
+}
+
+// altNumber consumes Hebrew numerals in the Hebrew calendar, or else the
+// longest matching alternative digits.
+func (p *parser) altNumber() (int, bool) {
+	rest := p.rest()
+	if p.lc.calendar == Hebrew {
+		if n, length := parseGematria(rest); length > 0 {
+			p.pos += length
+			return n, true
+		}
+	}
+
+	idx, length := -1, 0
+
+	for i, digits := range p.lc.AltDigits {
+		if len(digits) <= length || len(digits) > len(rest) {
+			continue
+		}
+		if rest[:len(digits)] == digits {
+			idx, length = i, len(digits)
+		}
+	}
+
+	if idx < 0 {
+		return 0, false
+	}
+	p.pos += length
+	return idx, true
+}
+
+// name consumes the longest case-insensitive match from the given tables and
+// returns its index. Empty names never match.
+func (p *parser) name(tables ...[]string) (int, bool) {
+	rest := p.rest()
+	idx, length := -1, 0
+
+	for _, names := range tables {
+		for i, name := range names {
+			if len(name) <= length || len(name) > len(rest) {
+				continue
+			}
+			if strings.EqualFold(rest[:len(name)], name) {
+				idx, length = i, len(name)
+			}
+		}
+	}
+
+	if idx < 0 {
+		// A locale without the names, such as an empty AMPM, accepts nothing.
+		for _, names := range tables {
+			for _, name := range names {
+				if name != "" {
+					return 0, false
+				}
+			}
+		}
+		return 0, true
+	}
+
+	p.pos += length
+	return idx, true
+}
+
+// zoneOffset consumes a UTC offset in the form +hhmm, +hh:mm, +hh:mm:ss, +hh
+// or Z, which covers all of %z, %:z, %::z and %:::z.
+func (p *parser) zoneOffset() (int, bool) {
+	rest := p.rest()
+	if strings.HasPrefix(rest, "Z") {
+		p.pos++
+		return 0, true
+	}
+	if len(rest) < 3 || (rest[0] != '+' && rest[0] != '-') {
+		return 0, false
+	}
+
+	sign := 1
+	if rest[0] == '-' {
+		sign = -1
+	}
+
+	p.pos++
+	hh, ok := p.digits(2)
+	if !ok {
+		return 0, false
+	}
+
+	mm, ss := 0, 0
+	if p.pos < len(p.value) && p.value[p.pos] == ':' {
+		p.pos++
+		if mm, ok = p.digits(2); !ok {
+			return 0, false
+		}
+		if p.pos < len(p.value) && p.value[p.pos] == ':' {
+			p.pos++
+			if ss, ok = p.digits(2); !ok {
+				return 0, false
+			}
+		}
+	} else if m, ok := p.digits(2); ok {
+		mm = m
+	}
+
+	if hh > 24 || mm > 59 || ss > 59 {
+		return 0, false
+	}
+	return sign * (hh*3600 + mm*60 + ss), true
+}
+
+// digits consumes exactly n digits.
+func (p *parser) digits(n int) (int, bool) {
+	if len(p.value)-p.pos < n {
+		return 0, false
+	}
+
+	v := 0
+	for i := 0; i < n; i++ {
+		c := p.value[p.pos+i]
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		v = v*10 + int(c-'0')
+	}
+
+	p.pos += n
+	return v, true
+}
+
+// zoneName consumes a time zone abbreviation such as PST or +03.
+func (p *parser) zoneName() (string, bool) {
+	i := p.pos
+	if i < len(p.value) && (p.value[i] == '+' || p.value[i] == '-') {
+		i++
+	}
+	for ; i < len(p.value); i++ {
+		c := p.value[i]
+		if !('A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9') {
+			break
+		}
+	}
+
+	if i == p.pos {
+		return "", false
+	}
+
+	name := p.value[p.pos:i]
+	p.pos = i
+	return name, true
+}
+
+// time combines the parsed fields into a time.Time.
+func (p *parser) time() (time.Time, string) {
+	if p.haveEpoch {
+		// The seconds determine the instant, and the zone only its display.
+		t := time.Unix(p.epoch, int64(p.nsec))
+		return t.In(p.location(t.UTC().Year())), ""
+	}
+
+	year := p.year
+	var era *era
+	switch {
+	case p.haveYear:
+	case p.haveEraYear:
+		var ok bool
+		if year, era, ok = p.eraToYear(); !ok {
+			return time.Time{}, "era year out of range"
+		}
+	case p.haveYY && p.haveCentury:
+		year = p.century*100 + p.yy
+	case p.haveYY:
+		year = p.lc.fullYear(p.yy)
+	case p.haveCentury:
+		year = p.century * 100
+	}
+
+	hour := p.hour
+	if p.have12 {
+		hour %= 12
+		if p.haveAMPM && p.pm {
+			hour += 12
+		}
+	}
+
+	loc := p.location(year)
+
+	// at returns the time of day on a day counted from January 1, 1970.
+	at := func(days int) time.Time {
+		return time.Date(1970, 1, 1+days, hour, p.min, p.sec, p.nsec, loc)
+	}
+
+	var t time.Time
+	switch {
+	case p.haveYday:
+		first, _ := p.lc.days(year, 1, 1)
+		next, _ := p.lc.days(year+1, 1, 1)
+		if p.yday > next-first {
+			return time.Time{}, "day of year out of range"
+		}
+		t = at(first + p.yday - 1)
+	case p.haveMonth || p.haveDay || p.haveQuarter:
+		month, day := 1, 1
+		if p.haveQuarter {
+			month = p.quarter*3 - 2
+		}
+		if p.haveMonth {
+			month = p.month
+		}
+		if p.haveMonthName {
+			var ok bool
+			if month, ok = p.lc.monthOf(year, p.month-1); !ok {
+				return time.Time{}, "month out of range"
+			}
+		}
+		if p.haveDay {
+			day = p.day
+		}
+		days, ok := p.lc.days(year, month, day)
+		if !ok {
+			return time.Time{}, "day out of range"
+		}
+		if era != nil && p.lc.cal == nil && !era.contains([3]int{year, month, day}) {
+			return time.Time{}, "date outside of era"
+		}
+		t = at(days)
+	case p.haveU, p.haveW:
+		start, _ := p.lc.days(year, 1, 1)
+		jan1 := int(at(start).Weekday())
+		week, wday := p.weekU, p.wday
+		first := start + (7-jan1)%7
+		if !p.haveU {
+			week, wday = p.weekW, (p.wday+6)%7
+			first = start + (8-jan1)%7
+			if !p.haveWday {
+				wday = 0
+			}
+		}
+		day := first + (week-1)*7 + wday
+		if !p.haveWday && day < start {
+			// Week 0 starts in the previous year.
+			day = start
+		}
+		t = at(day)
+		if y, _, _ := p.lc.date(t); y != year {
+			return time.Time{}, "week out of range"
+		}
+	case p.haveEV:
+		ok := false
+		for _, gy := range p.gregorianYears(year) {
+			day := p.lc.weekStart(gy, p.weekEV)
+			if p.haveWday {
+				day += (p.wday - int(p.lc.Info().FirstWeekday) + 7) % 7
+			}
+			t = time.Date(gy, 1, day, hour, p.min, p.sec, p.nsec, loc)
+			if wy, w := p.lc.week(t); wy == gy && w == p.weekEV && p.inYear(t, year) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return time.Time{}, "week out of range"
+		}
+	case p.haveV:
+		isoYears := p.gregorianYears(year)
+		if p.haveISOYear {
+			isoYears = []int{p.isoYear}
+		} else if p.haveISOYY {
+			isoYear := expandYear(p.isoYY)
+			if p.haveCentury {
+				isoYear = p.century*100 + p.isoYY
+			}
+			isoYears = []int{isoYear}
+		}
+
+		ok := false
+		for _, isoYear := range isoYears {
+			jan4 := int(time.Date(isoYear, 1, 4, 0, 0, 0, 0, time.UTC).Weekday())
+			monday := 4 - (jan4+6)%7
+			wday := (p.wday + 6) % 7
+			if !p.haveWday {
+				wday = 0
+			}
+			t = time.Date(isoYear, 1, monday+(p.weekV-1)*7+wday, hour, p.min, p.sec, p.nsec, loc)
+			if wy, w := t.ISOWeek(); wy == isoYear && w == p.weekV &&
+				(len(isoYears) == 1 || p.inYear(t, year)) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return time.Time{}, "week out of range"
+		}
+	default:
+		first, _ := p.lc.days(year, 1, 1)
+		if era != nil && p.lc.cal == nil {
+			// An era year starts with the era, as 令和元年 does in May.
+			d := era.first(year)
+			first = gregorianDays(d[0], d[1], d[2])
+		}
+		t = at(first)
+	}
+
+	return t, ""
+}
+
+// gregorianYears returns the Gregorian years that overlap a year of the
+// locale's calendar, in which the Gregorian week directives are looked up.
+func (p *parser) gregorianYears(year int) []int {
+	if p.lc.cal == nil {
+		return []int{year}
+	}
+	first, _ := p.lc.days(year, 1, 1)
+	next, _ := p.lc.days(year+1, 1, 1)
+	y1, _, _ := gregorianDate(first)
+	y2, _, _ := gregorianDate(next - 1)
+
+	years := make([]int, 0, 2)
+	for y := y1; y <= y2; y++ {
+		years = append(years, y)
+	}
+	return years
+}
+
+// inYear reports whether t falls within a year of the locale's calendar. In
+// the Gregorian calendar, where the week directives' years are the parsed
+// year, any t does.
+func (p *parser) inYear(t time.Time, year int) bool {
+	if p.lc.cal == nil {
+		return true
+	}
+	y, _, _ := p.lc.date(t)
+	return y == year
+}
+
+// eraToYear converts the parsed era year to a Gregorian year, and returns the
+// era. Without an era name, the first era that contains the resulting date is
+// used.
+func (p *parser) eraToYear() (int, *era, bool) {
+	if p.era != nil {
+		return p.era.gregorian(p.eraYear), p.era, true
+	}
+
+	for i := range p.lc.eras {
+		e := &p.lc.eras[i]
+		year := e.gregorian(p.eraYear)
+		date := e.first(year)
+		if p.haveMonth || p.haveDay {
+			date = [3]int{year, 1, 1}
+			if p.haveMonth {
+				date[1] = p.month
+			}
+			if p.haveDay {
+				date[2] = p.day
+			}
+		}
+		if e.contains(date) {
+			return year, e, true
+		}
+	}
+	return 0, nil, false
+}
+
+// location returns the time zone described by the parsed %z and %Z fields.
+func (p *parser) location(year int) *time.Location {
+	switch {
+	case p.haveOffset && p.offset == 0 && (!p.haveZone || p.zone == "UTC"):
+		return time.UTC
+	case p.haveOffset:
+		return time.FixedZone(p.zone, p.offset)
+	case !p.haveZone, p.zone == "UTC", p.zone == "GMT", p.zone == "Z":
+		return time.UTC
+	}
+
+	// Like time.Parse, prefer the local zone if it uses the abbreviation.
+	for _, m := range []time.Month{time.January, time.July} {
+		if name, _ := time.Date(year, m, 1, 0, 0, 0, 0, time.Local).Zone(); name == p.zone {
+			return time.Local
+		}
+	}
+	return time.FixedZone(p.zone, 0)
+}
+
+// expandYear converts a two-digit year to a full one. Values 69-99 refer to
+// the 20th century and values 00-68 to the 21st, as POSIX specifies.
+func expandYear(yy int) int {
+	if yy < 69 {
+		return 2000 + yy
+	}
+	return 1900 + yy
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(m time.Month, year int) int {
+	return time.Date(year, m+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// isSpace reports whether c is an ASCII white-space character.
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f'
+}
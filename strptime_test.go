@@ -0,0 +1,203 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestStrptime(t *testing.T) {
+	tests := []struct {
+		locale string
+		format string
+		input  string
+		want   time.Time
+	}{
+		{"en_US", "%Y-%m-%d", "2015-12-25",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%F %T", "2015-12-25 03:02:01",
+			time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)},
+		{"en_US", "%A, %B %e, %Y", "friday, DECEMBER 25, 2015",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%a %b %d", "Fri Dec 25",
+			time.Date(0, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%I:%M %p", "03:04 PM",
+			time.Date(0, 1, 1, 15, 4, 0, 0, time.UTC)},
+		{"en_US", "%I:%M %p", "12:04 AM",
+			time.Date(0, 1, 1, 0, 4, 0, 0, time.UTC)},
+		{"en_US", "%D", "12/25/15",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%D", "12/25/69",
+			time.Date(1969, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%C%y", "1998",
+			time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%Y %j", "2016 366",
+			time.Date(2016, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%Y %U", "2015 00",
+			time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%Y %U %w", "2015 00 4",
+			time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%Y %U %a", "2015 51 Fri",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%Y %W %u", "2015 51 5",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%G-W%V-%u", "2015-W53-7",
+			time.Date(2016, 1, 3, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%FT%T%z", "2015-12-25T03:02:01+0100",
+			time.Date(2015, 12, 25, 3, 2, 1, 0, time.FixedZone("", 3600))},
+		{"en_US", "%FT%T%z", "2015-12-25T03:02:01-03:30",
+			time.Date(2015, 12, 25, 3, 2, 1, 0, time.FixedZone("", -12600))},
+		{"en_US", "%T%::z", "03:02:01+05:45:30",
+			time.Date(0, 1, 1, 3, 2, 1, 0, time.FixedZone("", 20730))},
+		{"en_US", "%T%:::z", "03:02:01+01",
+			time.Date(0, 1, 1, 3, 2, 1, 0, time.FixedZone("", 3600))},
+		{"en_US", "%d %h %Y %k:%M", "25 Dec 2015  3:02",
+			time.Date(2015, 12, 25, 3, 2, 0, 0, time.UTC)},
+		{"en_GB", "%l:%M %P", " 3:02 pm",
+			time.Date(0, 1, 1, 15, 2, 0, 0, time.UTC)},
+		{"en_US", "%Y Q%q", "2015 Q3",
+			time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%s", "1451012521",
+			time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)},
+		{"en_US", "%s.%N %z", "5.25 +0100",
+			time.Date(1970, 1, 1, 1, 0, 5, 250000000, time.FixedZone("", 3600))},
+		{"en_US", "%s", "-86400",
+			time.Date(1969, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"de_DE", "%+", "Fr 25. Dez 03:02:01 UTC 2015",
+			time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)},
+		{"ru_RU", "%+", "Пн. 11 марта 2024 12:00:00 UTC",
+			time.Date(2024, 3, 11, 12, 0, 0, 0, time.UTC)},
+		{"ru_RU", "%d %B %Y", "25 декабря 2015",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"ru_RU", "%B %Y", "декабрь 2015",
+			time.Date(2015, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"ru_RU", "%d %b", "25 мая",
+			time.Date(0, 5, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%Eb %Y", "D 2015",
+			time.Date(2015, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%T.%f", "03:02:01.5",
+			time.Date(0, 1, 1, 3, 2, 1, 500000000, time.UTC)},
+		{"en_US", "%T%.N", "03:02:01.795187684",
+			time.Date(0, 1, 1, 3, 2, 1, 795187684, time.UTC)},
+		{"en_US", "%T%.3N%z", "03:02:01.795+0000",
+			time.Date(0, 1, 1, 3, 2, 1, 795000000, time.UTC)},
+		{"de_DE", "%T%.L", "03:02:01,795",
+			time.Date(0, 1, 1, 3, 2, 1, 795000000, time.UTC)},
+		{"de_DE", "%T%.L", "03:02:01.795",
+			time.Date(0, 1, 1, 3, 2, 1, 795000000, time.UTC)},
+		{"en_US", "%S.%12N", "01.000123456789",
+			time.Date(0, 1, 1, 0, 0, 1, 123456789, time.UTC)},
+		{"en_US", "%S.%10N", "01.0000000005",
+			time.Date(0, 1, 1, 0, 0, 1, 5, time.UTC)},
+		{"en_US", "%T %Z", "03:02:01 UTC",
+			time.Date(0, 1, 1, 3, 2, 1, 0, time.UTC)},
+		{"en_US", "%d%n%m%t%Y", "25 \t 12\n2015",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%c", "Fri 25 Dec 2015 03:02:01 AM UTC",
+			time.Date(2015, 12, 25, 3, 2, 1, 0, time.UTC)},
+		{"es_MX", "%A, %d de %B de %Y", "viernes, 25 de diciembre de 2015",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"de_DE", "%x", "25.12.2015",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"ru_RU", "%d %B %Y", "01 декабрь 1988",
+			time.Date(1988, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "100%% %Y", "100% 2015",
+			time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"th_TH", "%x", "25/12/2558",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"th_TH", "%e %b %EC %Ey", "25 ธ.ค. พ.ศ. 2558",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"th_TH", "%EY-%m", "พ.ศ. 2500-01",
+			time.Date(1957, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"fa_IR", "%x", "۹۴/۱۰/۰۴",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"fa_IR", "%Od.%m.%Y", "۰۴.10.1394",
+			time.Date(2015, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"en_US", "%Ey %Od %EA", "15 25 %EA",
+			time.Date(2015, 1, 25, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for i, test := range tests {
+		got, err := StrptimeLoc(test.locale, test.format, test.input)
+		if err != nil {
+			t.Errorf("%d: %v", i, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestStrptimeErrors(t *testing.T) {
+	tests := []struct {
+		format string
+		input  string
+	}{
+		{"%Y", "abc"},
+		{"%m", "13"},
+		{"%d", "0"},
+		{"%Y-%m-%d", "2015-02-30"},
+		{"%Y-%m-%d", "2015-12-25 extra"},
+		{"%A", "Someday"},
+		{"%H:%M", "12-30"},
+		{"%z", "0100"},
+		{"%z", "+01:00:60"},
+		{"%.N", "5"},
+		{"%s", "-"},
+		{"%q", "5"},
+		{"%Ed", "25"},
+		{"%Od", "۲۵"},
+		{"%Y %U", "2015 53"},
+		{"%Y %U %w", "2016 00 0"},
+		{"%Y %W %u", "2015 52 7"},
+		{"%G %V", "2016 53"},
+	}
+
+	SetLocale("en_US")
+	for i, test := range tests {
+		_, err := Strptime(test.format, test.input)
+		if _, ok := err.(*ParseError); !ok {
+			t.Errorf(gotWantIdx, i, err, "*ParseError")
+		}
+	}
+}
+
+func TestStrptimeRoundTrip(t *testing.T) {
+	dt := time.Date(2015, 12, 25, 15, 2, 1, 0, time.UTC)
+	locales := []string{"en_US", "es_MX", "da_DK", "ru_RU", "de_DE", "bn_IN",
+		"zh_CN", "pt_BR", "fr_FR", "ar_EG", "ja_JP", "th_TH", "fa_IR", "or_IN",
+		"POSIX"}
+	formats := []string{"%c", "%x %X", "%A %d %B %Y %H:%M:%S", "%a %e %b %Y %r",
+		"%Ec", "%Ex %EX"}
+
+	for _, locale := range locales {
+		l, err := NewLocalizer(locale)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, format := range formats {
+			s := l.Strftime(format, dt)
+			got, err := l.Strptime(format, s)
+			if err != nil {
+				t.Errorf("%s %q: %v", locale, format, err)
+				continue
+			}
+			if got.Year() != dt.Year() || got.YearDay() != dt.YearDay() {
+				t.Errorf(gotWantKey, locale+" "+format, got, dt)
+			}
+		}
+	}
+}
+
+func ExampleStrptimeLoc() {
+	t, err := StrptimeLoc("es_MX", "%A, %d de %B de %Y",
+		"viernes, 25 de diciembre de 2015")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(t.Format("2006-01-02"))
+	// Output: 2015-12-25
+}
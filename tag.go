@@ -0,0 +1,175 @@
+package lctime
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// tagScripts maps BCP 47 script subtags to glibc modifiers.
+var tagScripts = map[string]string{
+	"latn": "@latin",
+	"cyrl": "@cyrillic",
+	"deva": "@devanagari",
+}
+
+// tagLanguages maps deprecated or macro language subtags to the ones used by
+// the locale identifiers.
+var tagLanguages = map[string]string{
+	"in": "id",
+	"iw": "he",
+	"ji": "yi",
+	"no": "nb",
+}
+
+// NewLocalizerForTag provides a localizer for a BCP 47 language tag such as
+// "pt-BR", "sr-Latn" or "zh-Hant". Like a BCP 47 lookup, subtags are dropped
+// from the end until a locale matches, so "de-AQ" gives de_DE.
+func NewLocalizerForTag(tag string) (Localizer, error) {
+	id, ok := resolveTag(tag)
+	if !ok {
+		return nil, ErrNoLocale
+	}
+	return loadLocale(id)
+}
+
+// MatchAcceptLanguage picks the best available locale for an HTTP
+// Accept-Language header, such as "pt-BR,pt;q=0.9,en;q=0.8". Tags are tried
+// in order of their quality values and then the order they appear in, and
+// the first one that resolves to a locale wins. It returns the localizer and
+// the identifier of the chosen locale, or ErrNoLocale if nothing matches.
+func MatchAcceptLanguage(header string) (Localizer, string, error) {
+	for _, tag := range parseAcceptLanguage(header) {
+		if id, ok := resolveTag(tag); ok {
+			l, err := loadLocale(id)
+			if err != nil {
+				return nil, "", err
+			}
+			return l, id, nil
+		}
+	}
+	return nil, "", ErrNoLocale
+}
+
+// parseAcceptLanguage returns the tags of an Accept-Language header, sorted
+// by descending quality. Tags with a quality of zero, wildcards and invalid
+// entries are left out.
+func parseAcceptLanguage(header string) []string {
+	type entry struct {
+		tag string
+		q   float64
+	}
+
+	var entries []entry
+	for _, part := range strings.Split(header, ",") {
+		params := strings.Split(part, ";")
+		tag := strings.TrimSpace(params[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		for _, p := range params[1:] {
+			p = strings.TrimSpace(p)
+			if len(p) < 2 || (p[0] != 'q' && p[0] != 'Q') || p[1] != '=' {
+				continue
+			}
+			v, err := strconv.ParseFloat(p[2:], 64)
+			if err != nil || v < 0 || v > 1 {
+				v = 0
+			}
+			q = v
+		}
+
+		if q > 0 {
+			entries = append(entries, entry{tag, q})
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].q > entries[j].q
+	})
+
+	tags := make([]string, len(entries))
+	for i, e := range entries {
+		tags[i] = e.tag
+	}
+	return tags
+}
+
+// resolveTag returns the locale for a BCP 47 tag, dropping subtags from the
+// end until one resolves.
+func resolveTag(tag string) (string, bool) {
+	subtags := strings.FieldsFunc(strings.ToLower(tag), func(r rune) bool {
+		return r == '-' || r == '_'
+	})
+
+	// Extensions and private use subtags don't select a locale.
+	for i, s := range subtags {
+		if len(s) == 1 {
+			subtags = subtags[:i]
+			break
+		}
+	}
+
+	for n := len(subtags); n > 0; n-- {
+		if id, ok := tagToLocale(subtags[:n]); ok {
+			if id, ok := resolveLocale(id); ok {
+				return id, true
+			}
+		}
+	}
+	return "", false
+}
+
+// tagToLocale converts the lower case subtags of a BCP 47 tag to a locale
+// identifier in the form language[_territory][@modifier].
+func tagToLocale(subtags []string) (string, bool) {
+	lang := subtags[0]
+	if len(lang) < 2 || len(lang) > 3 || lang == "und" {
+		return "", false
+	}
+	if l, ok := tagLanguages[lang]; ok {
+		lang = l
+	}
+
+	var script, region string
+	for _, s := range subtags[1:] {
+		switch {
+		case len(s) == 4 && script == "" && region == "":
+			script = s
+		case len(s) == 2 && region == "", len(s) == 3 && isDigits(s) && region == "":
+			region = strings.ToUpper(s)
+		}
+	}
+
+	modifier := tagScripts[script]
+	if lang == "zh" {
+		// Chinese locales differ by script rather than by modifier.
+		modifier = ""
+		if region == "" {
+			switch script {
+			case "hans":
+				region = "CN"
+			case "hant":
+				region = "TW"
+			}
+		}
+	}
+
+	id := lang
+	if region != "" {
+		id += "_" + region
+	}
+	return id + modifier, true
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
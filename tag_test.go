@@ -0,0 +1,96 @@
+package lctime
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestResolveTag(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"en-US", "en_US"},
+		{"en", "en_US"},
+		{"pt-BR", "pt_BR"},
+		{"pt", "pt_BR"},
+		{"de-AQ", "de_DE"},
+		{"sr-Latn", "sr_RS@latin"},
+		{"sr-Latn-RS", "sr_RS@latin"},
+		{"sr-Cyrl-RS", "sr_RS"},
+		{"uz-Cyrl-UZ", "uz_UZ@cyrillic"},
+		{"sd-Deva", "sd_IN@devanagari"},
+		{"zh-Hans", "zh_CN"},
+		{"zh-Hant", "zh_TW"},
+		{"zh-Hant-HK", "zh_HK"},
+		{"zh-TW", "zh_TW"},
+		{"no", "nb_NO"},
+		{"iw", "he_IL"},
+		{"de-DE-1996", "de_DE"},
+		{"fr-CA-u-ca-gregory", "fr_CA"},
+		{"es-419", "es_ES"},
+		{"und", ""},
+		{"x-private", ""},
+		{"", ""},
+		{"*", ""},
+	}
+
+	for i, test := range tests {
+		got, _ := resolveTag(test.input)
+		if got != test.want {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"pt-BR,pt;q=0.9,en;q=0.8", []string{"pt-BR", "pt", "en"}},
+		{"en;q=0.5, da", []string{"da", "en"}},
+		{"fr;q=0.5,de;q=0.5,*;q=0.1", []string{"fr", "de"}},
+		{"fr;q=0,de;Q=1", []string{"de"}},
+		{"fr;q=x,de;q=2,es", []string{"es"}},
+		{"", []string{}},
+	}
+
+	for i, test := range tests {
+		if got := parseAcceptLanguage(test.input); !reflect.DeepEqual(got, test.want) {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func TestMatchAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		err   error
+	}{
+		{"pt-BR,pt;q=0.9,en;q=0.8", "pt_BR", nil},
+		{"xx,pt;q=0.9,en;q=0.8", "pt_BR", nil},
+		{"en-GB;q=0.8,ru", "ru_RU", nil},
+		{"xx, yy", "", ErrNoLocale},
+		{"", "", ErrNoLocale},
+	}
+
+	for i, test := range tests {
+		l, got, err := MatchAcceptLanguage(test.input)
+		if got != test.want || err != test.err || (l == nil) != (err != nil) {
+			t.Errorf(gotWantIdx, i, got, test.want)
+		}
+	}
+}
+
+func ExampleMatchAcceptLanguage() {
+	_, id, err := MatchAcceptLanguage("pt-BR,pt;q=0.9,en;q=0.8")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(id)
+	// Output: pt_BR
+}
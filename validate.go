@@ -0,0 +1,88 @@
+package lctime
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// conversions lists the conversion characters known to Strftime.
+const conversions = "aAbBcCdDefFgGhHIjklLmMnNpPqrRsStTuUVwWxXyYzZ+%"
+
+// FormatError describes an invalid directive in a format.
+type FormatError struct {
+	Format    string // the format being checked
+	Offset    int    // byte offset in Format where the directive starts
+	Directive string // the offending directive
+	Msg       string // description of the problem
+}
+
+// Error returns the string representation of a FormatError.
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("format %q: %s %q at offset %d", e.Format, e.Msg,
+		e.Directive, e.Offset)
+}
+
+// ValidateFormat checks that every directive in format is one Strftime knows.
+// It returns a *FormatError for the first unknown directive, modifier
+// combination glibc doesn't accept, or incomplete directive such as a
+// trailing '%', all of which Strftime would output as written.
+func ValidateFormat(format string) error {
+	if err := validateFormat(format, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateFormat returns the first malformed directive in format. Unless
+// strict is set, unknown conversions are allowed, as they are in glibc locale
+// files.
+func validateFormat(format string, strict bool) *FormatError {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		d, n := parseDirective(format[i:])
+		msg := ""
+		switch {
+		case n == 0:
+			d.text, msg = format[i:], "incomplete directive"
+		case !d.validModifier():
+			msg = "invalid modifier in"
+		case strict && !d.known():
+			msg = "unknown directive"
+		}
+		if msg != "" {
+			return &FormatError{format, i, d.text, msg}
+		}
+		i += n - 1
+	}
+	return nil
+}
+
+// known reports whether Strftime formats the directive rather than
+// outputting it as written.
+func (d directive) known() bool {
+	switch {
+	case !d.validModifier(), d.colons > 0 && d.conv != 'z',
+		d.point && !isFraction(d.conv):
+		return false
+	}
+	return strings.IndexByte(conversions, d.conv) >= 0
+}
+
+// StrftimeStrict is like Strftime but returns a *FormatError instead of
+// outputting unknown directives as written.
+func StrftimeStrict(format string, t time.Time) (string, error) {
+	return current().StrftimeStrict(format, t)
+}
+
+// StrftimeStrict is like Strftime but returns a *FormatError instead of
+// outputting unknown directives as written.
+func (lc *localeData) StrftimeStrict(format string, t time.Time) (string, error) {
+	if err := ValidateFormat(format); err != nil {
+		return "", err
+	}
+	return lc.Strftime(format, t), nil
+}
@@ -0,0 +1,102 @@
+package lctime
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestValidateFormat(t *testing.T) {
+	tests := []struct {
+		input     string
+		offset    int
+		directive string // empty if the format is valid
+	}{
+		{"", 0, ""},
+		{"%Y-%m-%d %H:%M:%S", 0, ""},
+		{"%c %Ec %Od %%", 0, ""},
+		{"%-d %_10B %^a %#Z", 0, ""},
+		{"%:z %::z %:::z %.3N %+", 0, ""},
+		{"100%", 3, "%"},
+		{"%Y-%", 3, "%"},
+		{"%Y-%_", 3, "%_"},
+		{"%Y-%Q", 3, "%Q"},
+		{"%Y %10Q", 3, "%10Q"},
+		{"%Ed", 0, "%Ed"},
+		{"%d %OY", 3, "%OY"},
+		{"%:a", 0, "%:a"},
+		{"%.S", 0, "%.S"},
+		{"%::::z", 0, "%::::"},
+	}
+
+	for i, test := range tests {
+		err := ValidateFormat(test.input)
+		if test.directive == "" {
+			if err != nil {
+				t.Errorf(gotWantIdx, i, err, nil)
+			}
+			continue
+		}
+
+		e, ok := err.(*FormatError)
+		if !ok {
+			t.Errorf(gotWantIdx, i, err, "*FormatError")
+			continue
+		}
+		if e.Offset != test.offset || e.Directive != test.directive {
+			t.Errorf(gotWantIdx, i, fmt.Sprint(e.Offset, " ", e.Directive),
+				fmt.Sprint(test.offset, " ", test.directive))
+		}
+	}
+}
+
+func TestKnownDirectives(t *testing.T) {
+	lc := current()
+	dt := time.Date(2015, 12, 5, 3, 2, 1, 0, time.UTC)
+
+	for c := 0; c < 256; c++ {
+		for _, prefix := range []string{"%", "%E", "%O", "%:", "%."} {
+			d, n := parseDirective(prefix + string(rune(c)))
+			if n == 0 {
+				continue
+			}
+			_, ok := lc.appendConv(nil, d, dt)
+			ok = ok && d.validModifier()
+			if d.known() != ok {
+				t.Errorf(gotWantKey, d.text, d.known(), ok)
+			}
+		}
+	}
+}
+
+func TestStrftimeStrict(t *testing.T) {
+	dt := time.Date(2015, 12, 5, 3, 2, 1, 0, time.UTC)
+
+	l, err := NewLocalizer("de_DE")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := l.StrftimeStrict("%A, %-d. %B %Y", dt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Samstag, 5. Dezember 2015"; got != want {
+		t.Errorf(gotWant, got, want)
+	}
+
+	if got, err = l.StrftimeStrict("%A %Q", dt); err == nil {
+		t.Errorf(gotWant, got, "*FormatError")
+	}
+	if got := l.Strftime("%A %Q", dt); got != "Samstag %Q" {
+		t.Errorf(gotWant, got, "Samstag %Q")
+	}
+}
+
+func ExampleValidateFormat() {
+	err := ValidateFormat("%Y-%m-%d %H:%M:%s%")
+	fmt.Println(err)
+
+	// Output:
+	// format "%Y-%m-%d %H:%M:%s%": incomplete directive "%" at offset 17
+}